@@ -4,11 +4,11 @@ package clients
 
 import (
 	containerservice_v2022_09_02_preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview"
-	loadtestservice_v2021_12_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2021-12-01-preview"
 	managedidentity_v2022_01_31_preview "github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2022-01-31-preview"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	containers "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
 	loadtestservice "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/client"
+	loadtestservice_v2021_12_01_preview "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2021-12-01-preview"
 	managedidentity "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedidentity/client"
 )
 
@@ -12,10 +12,13 @@ type UserFeatures struct {
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 	ResourceGroup          ResourceGroupFeatures
 	ManagedDisk            ManagedDiskFeatures
+	Network                NetworkFeatures
+	Storage                StorageFeatures
 }
 
 type CognitiveAccountFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
+	RecoverSoftDeleted       bool
 }
 
 type VirtualMachineFeatures struct {
@@ -40,6 +43,7 @@ type KeyVaultFeatures struct {
 	RecoverSoftDeletedKeys           bool
 	RecoverSoftDeletedCerts          bool
 	RecoverSoftDeletedSecrets        bool
+	RecoverSoftDeletedHSMs           bool
 }
 
 type TemplateDeploymentFeatures struct {
@@ -67,7 +71,16 @@ type ManagedDiskFeatures struct {
 	ExpandWithoutDowntime bool
 }
 
+type NetworkFeatures struct {
+	MigrateBasicSkuInPlace bool
+}
+
 type AppConfigurationFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 	RecoverSoftDeleted       bool
 }
+
+type StorageFeatures struct {
+	RecoverSoftDeletedContainers bool
+	RecoverSoftDeletedShares     bool
+}
@@ -81,6 +81,12 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  true,
 					},
+
+					"recover_soft_deleted": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
 				},
 			},
 		},
@@ -126,6 +132,13 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Default:     true,
 					},
 
+					"recover_soft_deleted_hardware_security_modules": {
+						Description: "When enabled soft-deleted `azurerm_key_vault_managed_hardware_security_module` resources will be restored, instead of creating new ones",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+
 					"recover_soft_deleted_certificates": {
 						Description: "When enabled soft-deleted `azurerm_key_vault_certificate` resources will be restored, instead of creating new ones",
 						Type:        pluginsdk.TypeBool,
@@ -180,7 +193,37 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				Schema: map[string]*pluginsdk.Schema{
 					"relaxed_locking": {
 						Type:     pluginsdk.TypeBool,
-						Required: true,
+						Optional: true,
+						Default:  false,
+					},
+
+					"migrate_basic_sku_in_place": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"storage": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"recover_soft_deleted_containers": {
+						Description: "When enabled soft-deleted `azurerm_storage_container` resources will be restored, instead of creating new ones",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+
+					"recover_soft_deleted_shares": {
+						Description: "When enabled soft-deleted `azurerm_storage_share` resources will be restored, instead of creating new ones",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
 					},
 				},
 			},
@@ -357,6 +400,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := cognitiveRaw["purge_soft_delete_on_destroy"]; ok {
 				featuresMap.CognitiveAccount.PurgeSoftDeleteOnDestroy = v.(bool)
 			}
+			if v, ok := cognitiveRaw["recover_soft_deleted"]; ok {
+				featuresMap.CognitiveAccount.RecoverSoftDeleted = v.(bool)
+			}
 		}
 	}
 
@@ -391,6 +437,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := keyVaultRaw["recover_soft_deleted_secrets"]; ok {
 				featuresMap.KeyVault.RecoverSoftDeletedSecrets = v.(bool)
 			}
+			if v, ok := keyVaultRaw["recover_soft_deleted_hardware_security_modules"]; ok {
+				featuresMap.KeyVault.RecoverSoftDeletedHSMs = v.(bool)
+			}
 		}
 	}
 
@@ -404,6 +453,19 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["storage"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			storageRaw := items[0].(map[string]interface{})
+			if v, ok := storageRaw["recover_soft_deleted_containers"]; ok {
+				featuresMap.Storage.RecoverSoftDeletedContainers = v.(bool)
+			}
+			if v, ok := storageRaw["recover_soft_deleted_shares"]; ok {
+				featuresMap.Storage.RecoverSoftDeletedShares = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["template_deployment"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -466,5 +528,15 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["network"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			networkRaw := items[0].(map[string]interface{})
+			if v, ok := networkRaw["migrate_basic_sku_in_place"]; ok {
+				featuresMap.Network.MigrateBasicSkuInPlace = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
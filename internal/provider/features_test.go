@@ -31,6 +31,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
@@ -42,6 +43,8 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+
+					RecoverSoftDeletedHSMs: true,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
@@ -49,6 +52,10 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: true,
 				},
+				Storage: features.StorageFeatures{
+					RecoverSoftDeletedContainers: false,
+					RecoverSoftDeletedShares:     false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -91,6 +98,7 @@ func TestExpandFeatures(t *testing.T) {
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
 						},
 					},
 					"key_vault": []interface{}{
@@ -104,6 +112,8 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted_keys":                               true,
 							"recover_soft_deleted_key_vaults":                         true,
 							"recover_soft_deleted_secrets":                            true,
+
+							"recover_soft_deleted_hardware_security_modules": true,
 						},
 					},
 					"log_analytics_workspace": []interface{}{
@@ -118,7 +128,8 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"network": []interface{}{
 						map[string]interface{}{
-							"relaxed_locking": true,
+							"relaxed_locking":            true,
+							"migrate_basic_sku_in_place": true,
 						},
 					},
 					"resource_group": []interface{}{
@@ -126,6 +137,12 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": true,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_containers": true,
+							"recover_soft_deleted_shares":     true,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": true,
@@ -161,6 +178,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
@@ -172,6 +190,8 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+
+					RecoverSoftDeletedHSMs: true,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
@@ -179,9 +199,16 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: true,
 				},
+				Network: features.NetworkFeatures{
+					MigrateBasicSkuInPlace: true,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				Storage: features.StorageFeatures{
+					RecoverSoftDeletedContainers: true,
+					RecoverSoftDeletedShares:     true,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -221,6 +248,7 @@ func TestExpandFeatures(t *testing.T) {
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
 						},
 					},
 					"key_vault": []interface{}{
@@ -234,6 +262,8 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted_keys":                               false,
 							"recover_soft_deleted_key_vaults":                         false,
 							"recover_soft_deleted_secrets":                            false,
+
+							"recover_soft_deleted_hardware_security_modules": false,
 						},
 					},
 					"log_analytics_workspace": []interface{}{
@@ -256,6 +286,12 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": false,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_containers": false,
+							"recover_soft_deleted_shares":     false,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": false,
@@ -291,6 +327,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
 				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   false,
@@ -302,6 +339,8 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeys:           false,
 					RecoverSoftDeletedKeyVaults:      false,
 					RecoverSoftDeletedSecrets:        false,
+
+					RecoverSoftDeletedHSMs: false,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
@@ -312,6 +351,10 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				Storage: features.StorageFeatures{
+					RecoverSoftDeletedContainers: false,
+					RecoverSoftDeletedShares:     false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: false,
 				},
@@ -560,6 +603,7 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 			Expected: features.UserFeatures{
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 			},
 		},
@@ -577,6 +621,7 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 			Expected: features.UserFeatures{
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 			},
 		},
@@ -594,6 +639,7 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 			Expected: features.UserFeatures{
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       true,
 				},
 			},
 		},
@@ -633,6 +679,8 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+
+					RecoverSoftDeletedHSMs: true,
 				},
 			},
 		},
@@ -651,6 +699,8 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 							"recover_soft_deleted_keys":                               true,
 							"recover_soft_deleted_key_vaults":                         true,
 							"recover_soft_deleted_secrets":                            true,
+
+							"recover_soft_deleted_hardware_security_modules": true,
 						},
 					},
 				},
@@ -666,6 +716,8 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+
+					RecoverSoftDeletedHSMs: true,
 				},
 			},
 		},
@@ -684,6 +736,8 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 							"recover_soft_deleted_keys":                               false,
 							"recover_soft_deleted_key_vaults":                         false,
 							"recover_soft_deleted_secrets":                            false,
+
+							"recover_soft_deleted_hardware_security_modules": false,
 						},
 					},
 				},
@@ -699,6 +753,8 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeyVaults:      false,
 					RecoverSoftDeletedKeys:           false,
 					RecoverSoftDeletedSecrets:        false,
+
+					RecoverSoftDeletedHSMs: false,
 				},
 			},
 		},
@@ -713,6 +769,76 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesStorage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					RecoverSoftDeletedContainers: false,
+					RecoverSoftDeletedShares:     false,
+				},
+			},
+		},
+		{
+			Name: "Recover Soft Deleted Containers and Shares Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_containers": true,
+							"recover_soft_deleted_shares":     true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					RecoverSoftDeletedContainers: true,
+					RecoverSoftDeletedShares:     true,
+				},
+			},
+		},
+		{
+			Name: "Recover Soft Deleted Containers and Shares Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_containers": false,
+							"recover_soft_deleted_shares":     false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					RecoverSoftDeletedContainers: false,
+					RecoverSoftDeletedShares:     false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Storage, testCase.Expected.Storage) {
+			t.Fatalf("Expected %+v but got %+v", result.Storage, testCase.Expected.Storage)
+		}
+	}
+}
+
 func TestExpandFeaturesTemplateDeployment(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -1203,3 +1329,68 @@ func TestExpandFeaturesManagedDisk(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesNetwork(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					MigrateBasicSkuInPlace: false,
+				},
+			},
+		},
+		{
+			Name: "Basic SKU Migration Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{
+						map[string]interface{}{
+							"migrate_basic_sku_in_place": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					MigrateBasicSkuInPlace: true,
+				},
+			},
+		},
+		{
+			Name: "Basic SKU Migration Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{
+						map[string]interface{}{
+							"migrate_basic_sku_in_place": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					MigrateBasicSkuInPlace: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Network, testCase.Expected.Network) {
+			t.Fatalf("Expected %+v but got %+v", result.Network, testCase.Expected.Network)
+		}
+	}
+}
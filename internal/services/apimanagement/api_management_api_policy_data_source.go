@@ -0,0 +1,120 @@
+package apimanagement
+
+import (
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceApiManagementApiPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceApiManagementApiPolicyRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"api_management_name": schemaz.SchemaApiManagementDataSourceName(),
+
+			"api_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ApiManagementApiName,
+			},
+
+			"operation_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"format": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(apimanagement.PolicyExportFormatXML),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.PolicyExportFormatXML),
+					string(apimanagement.PolicyExportFormatRawxml),
+				}, false),
+			},
+
+			"xml_content": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceApiManagementApiPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	apiName := d.Get("api_name").(string)
+	operationId := d.Get("operation_id").(string)
+	format := apimanagement.PolicyExportFormat(d.Get("format").(string))
+
+	var policyValue *string
+	if operationId != "" {
+		client := meta.(*clients.Client).ApiManagement.ApiOperationPoliciesClient
+
+		id := parse.NewApiOperationPolicyID(subscriptionId, resourceGroup, serviceName, apiName, operationId, string(format))
+		resp, err := client.Get(ctx, resourceGroup, serviceName, apiName, operationId, format)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("no policy exists for API Operation %q (API %q / API Management Service %q / Resource Group %q)", operationId, apiName, serviceName, resourceGroup)
+			}
+
+			return fmt.Errorf("retrieving policy for API Operation %q (API %q / API Management Service %q / Resource Group %q): %+v", operationId, apiName, serviceName, resourceGroup, err)
+		}
+
+		if properties := resp.PolicyContractProperties; properties != nil {
+			policyValue = properties.Value
+		}
+
+		d.SetId(id.ID())
+	} else {
+		client := meta.(*clients.Client).ApiManagement.ApiPoliciesClient
+
+		id := parse.NewApiPolicyID(subscriptionId, resourceGroup, serviceName, apiName, string(format))
+		resp, err := client.Get(ctx, resourceGroup, serviceName, apiName, format)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("no policy exists for API %q (API Management Service %q / Resource Group %q)", apiName, serviceName, resourceGroup)
+			}
+
+			return fmt.Errorf("retrieving policy for API %q (API Management Service %q / Resource Group %q): %+v", apiName, serviceName, resourceGroup, err)
+		}
+
+		if properties := resp.PolicyContractProperties; properties != nil {
+			policyValue = properties.Value
+		}
+
+		d.SetId(id.ID())
+	}
+
+	xmlContent := ""
+	if policyValue != nil {
+		xmlContent = html.UnescapeString(*policyValue)
+	}
+	d.Set("xml_content", xmlContent)
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package apimanagement_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ApiManagementApiPolicyDataSource struct{}
+
+func TestAccDataSourceApiManagementApiPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_management_api_policy", "test")
+	r := ApiManagementApiPolicyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("xml_content").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceApiManagementApiPolicy_operation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_management_api_policy", "test")
+	r := ApiManagementApiPolicyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.operation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("xml_content").Exists(),
+			),
+		},
+	})
+}
+
+func (r ApiManagementApiPolicyDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_policy" "test" {
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  xml_content = <<XML
+<policies>
+  <inbound>
+    <find-and-replace from="abc" to="xyz" />
+  </inbound>
+</policies>
+XML
+}
+
+data "azurerm_api_management_api_policy" "test" {
+  api_name            = azurerm_api_management_api_policy.test.api_name
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, r.template(data))
+}
+
+func (r ApiManagementApiPolicyDataSource) operation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_operation" "test" {
+  operation_id        = "acctest-operation"
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  display_name        = "Example Operation"
+  method              = "GET"
+  url_template        = "/example"
+}
+
+resource "azurerm_api_management_api_operation_policy" "test" {
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  operation_id        = azurerm_api_management_api_operation.test.operation_id
+
+  xml_content = <<XML
+<policies>
+  <inbound>
+    <find-and-replace from="abc" to="xyz" />
+  </inbound>
+</policies>
+XML
+}
+
+data "azurerm_api_management_api_policy" "test" {
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  operation_id        = azurerm_api_management_api_operation_policy.test.operation_id
+}
+`, r.template(data))
+}
+
+func (ApiManagementApiPolicyDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Consumption_0"
+}
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "Test API"
+  path                = "test"
+  revision            = "1"
+  protocols           = ["https"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -147,6 +147,7 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
+								string(apimanagement.ContentFormatGraphqlLink),
 								string(apimanagement.ContentFormatOpenapi),
 								string(apimanagement.ContentFormatOpenapijson),
 								string(apimanagement.ContentFormatOpenapijsonLink),
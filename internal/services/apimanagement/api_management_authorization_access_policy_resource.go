@@ -0,0 +1,182 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorizationaccesspolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementAuthorizationAccessPolicyModel struct {
+	Name            string `tfschema:"name"`
+	AuthorizationId string `tfschema:"authorization_id"`
+	TenantId        string `tfschema:"tenant_id"`
+	ObjectId        string `tfschema:"object_id"`
+}
+
+type ApiManagementAuthorizationAccessPolicyResource struct{}
+
+var _ sdk.Resource = ApiManagementAuthorizationAccessPolicyResource{}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) ModelObject() interface{} {
+	return &ApiManagementAuthorizationAccessPolicyModel{}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) ResourceType() string {
+	return "azurerm_api_management_authorization_access_policy"
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return authorizationaccesspolicy.ValidateAuthorizationAccessPolicyID
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"authorization_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: authorization.ValidateAuthorizationID,
+		},
+
+		"tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"object_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			var model ApiManagementAuthorizationAccessPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			authorizationId, err := authorization.ParseAuthorizationID(model.AuthorizationId)
+			if err != nil {
+				return err
+			}
+
+			id := authorizationaccesspolicy.NewAuthorizationAccessPolicyID(authorizationId.SubscriptionId, authorizationId.ResourceGroupName, authorizationId.ServiceName, authorizationId.AuthorizationProviderId, authorizationId.AuthorizationId, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := authorizationaccesspolicy.AuthorizationAccessPolicyContract{
+				Properties: &authorizationaccesspolicy.AuthorizationAccessPolicyContractProperties{
+					ObjectId: pointer.To(model.ObjectId),
+					TenantId: pointer.To(model.TenantId),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters, authorizationaccesspolicy.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			id, err := authorizationaccesspolicy.ParseAuthorizationAccessPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			authorizationId := authorization.NewAuthorizationID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.AuthorizationProviderId, id.AuthorizationId)
+
+			state := ApiManagementAuthorizationAccessPolicyModel{
+				Name:            id.AuthorizationAccessPolicyId,
+				AuthorizationId: authorizationId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.TenantId = pointer.From(props.TenantId)
+					state.ObjectId = pointer.From(props.ObjectId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			id, err := authorizationaccesspolicy.ParseAuthorizationAccessPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := authorizationaccesspolicy.DeleteOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorizationaccesspolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementAuthorizationAccessPolicyResource struct{}
+
+func TestAccApiManagementAuthorizationAccessPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_access_policy", "test")
+	r := ApiManagementAuthorizationAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementAuthorizationAccessPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_access_policy", "test")
+	r := ApiManagementAuthorizationAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (ApiManagementAuthorizationAccessPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := authorizationaccesspolicy.ParseAuthorizationAccessPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.AuthorizationAccessPolicyClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_api_management_authorization_access_policy" "test" {
+  name             = "acctest-accesspolicy-%d"
+  authorization_id = azurerm_api_management_authorization.test.id
+  tenant_id        = data.azurerm_client_config.current.tenant_id
+  object_id        = data.azurerm_client_config.current.object_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_access_policy" "import" {
+  name             = azurerm_api_management_authorization_access_policy.test.name
+  authorization_id = azurerm_api_management_authorization_access_policy.test.authorization_id
+  tenant_id        = azurerm_api_management_authorization_access_policy.test.tenant_id
+  object_id        = azurerm_api_management_authorization_access_policy.test.object_id
+}
+`, r.basic(data))
+}
+
+func (ApiManagementAuthorizationAccessPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Consumption_0"
+}
+
+resource "azurerm_api_management_authorization_provider" "test" {
+  name              = "acctest-authprovider-%[1]d"
+  api_management_id = azurerm_api_management.test.id
+  display_name      = "Test Authorization Provider"
+  identity_provider = "oauth2"
+
+  client_credentials_parameters = {
+    clientId     = "00000000-0000-0000-0000-000000000000"
+    clientSecret = "s3cr3t"
+    scopes       = "https://example.com/.default"
+    tokenUrl     = "https://login.example.com/oauth2/v2.0/token"
+  }
+}
+
+resource "azurerm_api_management_authorization" "test" {
+  name                      = "acctest-authorization-%[1]d"
+  authorization_provider_id = azurerm_api_management_authorization_provider.test.id
+  authorization_type        = "OAuth2"
+  oauth2_grant_type         = "ClientCredentials"
+
+  parameters = {
+    clientId     = "00000000-0000-0000-0000-000000000000"
+    clientSecret = "s3cr3t"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,270 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementAuthorizationProviderModel struct {
+	Name              string            `tfschema:"name"`
+	ApiManagementId   string            `tfschema:"api_management_id"`
+	DisplayName       string            `tfschema:"display_name"`
+	IdentityProvider  string            `tfschema:"identity_provider"`
+	RedirectUrl       string            `tfschema:"redirect_url"`
+	AuthorizationCode map[string]string `tfschema:"authorization_code_parameters"`
+	ClientCredentials map[string]string `tfschema:"client_credentials_parameters"`
+}
+
+type ApiManagementAuthorizationProviderResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementAuthorizationProviderResource{}
+
+func (r ApiManagementAuthorizationProviderResource) ModelObject() interface{} {
+	return &ApiManagementAuthorizationProviderModel{}
+}
+
+func (r ApiManagementAuthorizationProviderResource) ResourceType() string {
+	return "azurerm_api_management_authorization_provider"
+}
+
+func (r ApiManagementAuthorizationProviderResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return authorizationprovider.ValidateAuthorizationProviderID
+}
+
+func (r ApiManagementAuthorizationProviderResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"identity_provider": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"redirect_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"authorization_code_parameters": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"client_credentials_parameters": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			var model ApiManagementAuthorizationProviderModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiManagementId, err := parse.ApiManagementID(model.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			id := authorizationprovider.NewAuthorizationProviderID(apiManagementId.SubscriptionId, apiManagementId.ResourceGroup, apiManagementId.ServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandAuthorizationProvider(model), authorizationprovider.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			id, err := authorizationprovider.ParseAuthorizationProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			apiManagementId := parse.NewApiManagementID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+
+			state := ApiManagementAuthorizationProviderModel{
+				Name:            id.AuthorizationProviderId,
+				ApiManagementId: apiManagementId.ID(),
+				// the grant type parameters contain secrets which aren't returned by the API, so
+				// these are preserved from the prior state
+				AuthorizationCode: flattenAuthorizationProviderParameters(metadata.ResourceData.Get("authorization_code_parameters").(map[string]interface{})),
+				ClientCredentials: flattenAuthorizationProviderParameters(metadata.ResourceData.Get("client_credentials_parameters").(map[string]interface{})),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.IdentityProvider = pointer.From(props.IdentityProvider)
+					if oauth2 := props.Oauth2; oauth2 != nil {
+						state.RedirectUrl = pointer.From(oauth2.RedirectUrl)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			id, err := authorizationprovider.ParseAuthorizationProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementAuthorizationProviderModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := authorizationprovider.CreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, expandAuthorizationProvider(model), options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			id, err := authorizationprovider.ParseAuthorizationProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := authorizationprovider.DeleteOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenAuthorizationProviderParameters(input map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
+
+func expandAuthorizationProvider(model ApiManagementAuthorizationProviderModel) authorizationprovider.AuthorizationProviderContract {
+	oauth2 := &authorizationprovider.AuthorizationProviderOAuth2Settings{}
+
+	if model.RedirectUrl != "" {
+		oauth2.RedirectUrl = pointer.To(model.RedirectUrl)
+	}
+
+	grantTypes := &authorizationprovider.AuthorizationProviderOAuth2GrantTypes{}
+	if len(model.AuthorizationCode) > 0 {
+		grantTypes.AuthorizationCode = pointer.To(model.AuthorizationCode)
+	}
+	if len(model.ClientCredentials) > 0 {
+		grantTypes.ClientCredentials = pointer.To(model.ClientCredentials)
+	}
+	oauth2.GrantTypes = grantTypes
+
+	return authorizationprovider.AuthorizationProviderContract{
+		Properties: &authorizationprovider.AuthorizationProviderContractProperties{
+			DisplayName:      pointer.To(model.DisplayName),
+			IdentityProvider: pointer.To(model.IdentityProvider),
+			Oauth2:           oauth2,
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementAuthorizationProviderResource struct{}
+
+func TestAccApiManagementAuthorizationProvider_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_provider", "test")
+	r := ApiManagementAuthorizationProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authorization_code_parameters", "client_credentials_parameters"),
+	})
+}
+
+func TestAccApiManagementAuthorizationProvider_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_provider", "test")
+	r := ApiManagementAuthorizationProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementAuthorizationProvider_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_provider", "test")
+	r := ApiManagementAuthorizationProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authorization_code_parameters", "client_credentials_parameters"),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authorization_code_parameters", "client_credentials_parameters"),
+	})
+}
+
+func (ApiManagementAuthorizationProviderResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := authorizationprovider.ParseAuthorizationProviderID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.AuthorizationProviderClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementAuthorizationProviderResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_provider" "test" {
+  name              = "acctest-authprovider-%d"
+  api_management_id = azurerm_api_management.test.id
+  display_name      = "Test Authorization Provider"
+  identity_provider = "oauth2"
+
+  client_credentials_parameters = {
+    clientId     = "00000000-0000-0000-0000-000000000000"
+    clientSecret = "s3cr3t"
+    scopes       = "https://example.com/.default"
+    tokenUrl     = "https://login.example.com/oauth2/v2.0/token"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationProviderResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_provider" "import" {
+  name              = azurerm_api_management_authorization_provider.test.name
+  api_management_id = azurerm_api_management_authorization_provider.test.api_management_id
+  display_name      = azurerm_api_management_authorization_provider.test.display_name
+  identity_provider = azurerm_api_management_authorization_provider.test.identity_provider
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementAuthorizationProviderResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_provider" "test" {
+  name              = "acctest-authprovider-%d"
+  api_management_id = azurerm_api_management.test.id
+  display_name      = "Test Authorization Provider Updated"
+  identity_provider = "oauth2"
+
+  client_credentials_parameters = {
+    clientId     = "00000000-0000-0000-0000-000000000000"
+    clientSecret = "upd4t3d"
+    scopes       = "https://example.com/.default"
+    tokenUrl     = "https://login.example.com/oauth2/v2.0/token"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ApiManagementAuthorizationProviderResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Consumption_0"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
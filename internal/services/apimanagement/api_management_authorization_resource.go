@@ -0,0 +1,238 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementAuthorizationModel struct {
+	Name                    string            `tfschema:"name"`
+	AuthorizationProviderId string            `tfschema:"authorization_provider_id"`
+	AuthorizationType       string            `tfschema:"authorization_type"`
+	OAuth2GrantType         string            `tfschema:"oauth2_grant_type"`
+	Parameters              map[string]string `tfschema:"parameters"`
+	Status                  string            `tfschema:"status"`
+}
+
+type ApiManagementAuthorizationResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementAuthorizationResource{}
+
+func (r ApiManagementAuthorizationResource) ModelObject() interface{} {
+	return &ApiManagementAuthorizationModel{}
+}
+
+func (r ApiManagementAuthorizationResource) ResourceType() string {
+	return "azurerm_api_management_authorization"
+}
+
+func (r ApiManagementAuthorizationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return authorization.ValidateAuthorizationID
+}
+
+func (r ApiManagementAuthorizationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"authorization_provider_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: authorizationprovider.ValidateAuthorizationProviderID,
+		},
+
+		"authorization_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(authorization.PossibleValuesForAuthorizationType(), false),
+		},
+
+		"oauth2_grant_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(authorization.PossibleValuesForOAuth2GrantType(), false),
+		},
+
+		"parameters": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			var model ApiManagementAuthorizationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			providerId, err := authorizationprovider.ParseAuthorizationProviderID(model.AuthorizationProviderId)
+			if err != nil {
+				return err
+			}
+
+			id := authorization.NewAuthorizationID(providerId.SubscriptionId, providerId.ResourceGroupName, providerId.ServiceName, providerId.AuthorizationProviderId, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandAuthorization(model), authorization.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			id, err := authorization.ParseAuthorizationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			providerId := authorizationprovider.NewAuthorizationProviderID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.AuthorizationProviderId)
+
+			state := ApiManagementAuthorizationModel{
+				Name:                    id.AuthorizationId,
+				AuthorizationProviderId: providerId.ID(),
+				// the parameters contain secrets (e.g. client secrets and refresh tokens) which aren't
+				// returned by the API, so these are preserved from the prior state
+				Parameters: flattenAuthorizationProviderParameters(metadata.ResourceData.Get("parameters").(map[string]interface{})),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AuthorizationType = string(pointer.From(props.AuthorizationType))
+					state.OAuth2GrantType = string(pointer.From(props.OAuth2GrantType))
+					state.Status = pointer.From(props.Status)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			id, err := authorization.ParseAuthorizationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementAuthorizationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := authorization.CreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, expandAuthorization(model), options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			id, err := authorization.ParseAuthorizationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := authorization.DeleteOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAuthorization(model ApiManagementAuthorizationModel) authorization.AuthorizationContract {
+	properties := &authorization.AuthorizationContractProperties{
+		AuthorizationType: pointer.To(authorization.AuthorizationType(model.AuthorizationType)),
+		OAuth2GrantType:   pointer.To(authorization.OAuth2GrantType(model.OAuth2GrantType)),
+	}
+
+	if len(model.Parameters) > 0 {
+		properties.Parameters = pointer.To(model.Parameters)
+	}
+
+	return authorization.AuthorizationContract{
+		Properties: properties,
+	}
+}
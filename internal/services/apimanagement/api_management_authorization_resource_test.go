@@ -0,0 +1,127 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementAuthorizationResource struct{}
+
+func TestAccApiManagementAuthorization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization", "test")
+	r := ApiManagementAuthorizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("parameters"),
+	})
+}
+
+func TestAccApiManagementAuthorization_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization", "test")
+	r := ApiManagementAuthorizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (ApiManagementAuthorizationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := authorization.ParseAuthorizationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.AuthorizationClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementAuthorizationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization" "test" {
+  name                      = "acctest-authorization-%d"
+  authorization_provider_id = azurerm_api_management_authorization_provider.test.id
+  authorization_type        = "OAuth2"
+  oauth2_grant_type         = "ClientCredentials"
+
+  parameters = {
+    clientId     = "00000000-0000-0000-0000-000000000000"
+    clientSecret = "s3cr3t"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization" "import" {
+  name                      = azurerm_api_management_authorization.test.name
+  authorization_provider_id = azurerm_api_management_authorization.test.authorization_provider_id
+  authorization_type        = azurerm_api_management_authorization.test.authorization_type
+  oauth2_grant_type         = azurerm_api_management_authorization.test.oauth2_grant_type
+}
+`, r.basic(data))
+}
+
+func (ApiManagementAuthorizationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Consumption_0"
+}
+
+resource "azurerm_api_management_authorization_provider" "test" {
+  name              = "acctest-authprovider-%[1]d"
+  api_management_id = azurerm_api_management.test.id
+  display_name      = "Test Authorization Provider"
+  identity_provider = "oauth2"
+
+  client_credentials_parameters = {
+    clientId     = "00000000-0000-0000-0000-000000000000"
+    clientSecret = "s3cr3t"
+    scopes       = "https://example.com/.default"
+    tokenUrl     = "https://login.example.com/oauth2/v2.0/token"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
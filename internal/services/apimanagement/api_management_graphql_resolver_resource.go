@@ -0,0 +1,305 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/graphqlapiresolverpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementGraphQLResolverModel struct {
+	Name        string `tfschema:"name"`
+	ApiId       string `tfschema:"api_id"`
+	DisplayName string `tfschema:"display_name"`
+	Path        string `tfschema:"path"`
+	Description string `tfschema:"description"`
+	XmlContent  string `tfschema:"xml_content"`
+	XmlLink     string `tfschema:"xml_link"`
+}
+
+type ApiManagementGraphQLResolverResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementGraphQLResolverResource{}
+
+func (r ApiManagementGraphQLResolverResource) ModelObject() interface{} {
+	return &ApiManagementGraphQLResolverModel{}
+}
+
+func (r ApiManagementGraphQLResolverResource) ResourceType() string {
+	return "azurerm_api_management_graphql_resolver"
+}
+
+func (r ApiManagementGraphQLResolverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return graphqlapiresolver.ValidateGraphQLApiResolverID
+}
+
+func (r ApiManagementGraphQLResolverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"xml_content": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ConflictsWith:    []string{"xml_link"},
+			DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+		},
+
+		"xml_link": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"xml_content"},
+		},
+	}
+}
+
+func (r ApiManagementGraphQLResolverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementGraphQLResolverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			var model ApiManagementGraphQLResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiId, err := parse.ApiID(model.ApiId)
+			if err != nil {
+				return err
+			}
+
+			id := graphqlapiresolver.NewGraphQLApiResolverID(apiId.SubscriptionId, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandGraphQLResolver(model), graphqlapiresolver.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if model.XmlContent != "" || model.XmlLink != "" {
+				policyClient := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+				policyId := graphqlapiresolverpolicy.NewGraphQLApiResolverPolicyID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId, id.ResolverId)
+				if _, err := policyClient.CreateOrUpdate(ctx, policyId, expandGraphQLResolverPolicy(model), graphqlapiresolverpolicy.DefaultCreateOrUpdateOperationOptions()); err != nil {
+					return fmt.Errorf("creating policy for %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLResolverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+			policyClient := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+
+			id, err := graphqlapiresolver.ParseGraphQLApiResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			apiId := parse.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId)
+
+			state := ApiManagementGraphQLResolverModel{
+				Name:    id.ResolverId,
+				ApiId:   apiId.ID(),
+				XmlLink: metadata.ResourceData.Get("xml_link").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.Path = pointer.From(props.Path)
+					state.Description = pointer.From(props.Description)
+				}
+			}
+
+			policyId := graphqlapiresolverpolicy.NewGraphQLApiResolverPolicyID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId, id.ResolverId)
+			policyResp, err := policyClient.Get(ctx, policyId)
+			if err != nil && !response.WasNotFound(policyResp.HttpResponse) {
+				return fmt.Errorf("retrieving policy for %s: %+v", *id, err)
+			}
+			if model := policyResp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					// as with the other policy resources the API unescapes the content when applying
+					// an `xml_link`, so the content is unescaped before being set into the state
+					state.XmlContent = html.UnescapeString(props.Value)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementGraphQLResolverResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+			policyClient := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+
+			id, err := graphqlapiresolver.ParseGraphQLApiResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementGraphQLResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := graphqlapiresolver.CreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, expandGraphQLResolver(model), options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if metadata.ResourceData.HasChange("xml_content") || metadata.ResourceData.HasChange("xml_link") {
+				policyId := graphqlapiresolverpolicy.NewGraphQLApiResolverPolicyID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId, id.ResolverId)
+				if model.XmlContent != "" || model.XmlLink != "" {
+					policyOptions := graphqlapiresolverpolicy.CreateOrUpdateOperationOptions{
+						IfMatch: pointer.To("*"),
+					}
+					if _, err := policyClient.CreateOrUpdate(ctx, policyId, expandGraphQLResolverPolicy(model), policyOptions); err != nil {
+						return fmt.Errorf("updating policy for %s: %+v", *id, err)
+					}
+				} else {
+					deleteOptions := graphqlapiresolverpolicy.DeleteOperationOptions{
+						IfMatch: pointer.To("*"),
+					}
+					if resp, err := policyClient.Delete(ctx, policyId, deleteOptions); err != nil && !response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("deleting policy for %s: %+v", *id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLResolverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			id, err := graphqlapiresolver.ParseGraphQLApiResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := graphqlapiresolver.DeleteOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandGraphQLResolver(model ApiManagementGraphQLResolverModel) graphqlapiresolver.ResolverContract {
+	properties := &graphqlapiresolver.ResolverProperties{
+		DisplayName: pointer.To(model.DisplayName),
+	}
+
+	if model.Path != "" {
+		properties.Path = pointer.To(model.Path)
+	}
+
+	if model.Description != "" {
+		properties.Description = pointer.To(model.Description)
+	}
+
+	return graphqlapiresolver.ResolverContract{
+		Properties: properties,
+	}
+}
+
+func expandGraphQLResolverPolicy(model ApiManagementGraphQLResolverModel) graphqlapiresolverpolicy.PolicyContract {
+	if model.XmlLink != "" {
+		return graphqlapiresolverpolicy.PolicyContract{
+			Properties: &graphqlapiresolverpolicy.PolicyContractProperties{
+				Format: pointer.To(graphqlapiresolverpolicy.PolicyContentFormatRawxmlNegativelink),
+				Value:  model.XmlLink,
+			},
+		}
+	}
+
+	return graphqlapiresolverpolicy.PolicyContract{
+		Properties: &graphqlapiresolverpolicy.PolicyContractProperties{
+			Format: pointer.To(graphqlapiresolverpolicy.PolicyContentFormatRawxml),
+			Value:  model.XmlContent,
+		},
+	}
+}
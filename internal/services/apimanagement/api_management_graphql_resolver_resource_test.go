@@ -0,0 +1,183 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGraphQLResolverResource struct{}
+
+func TestAccApiManagementGraphQLResolver_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_resolver", "test")
+	r := ApiManagementGraphQLResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementGraphQLResolver_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_resolver", "test")
+	r := ApiManagementGraphQLResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementGraphQLResolver_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_resolver", "test")
+	r := ApiManagementGraphQLResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("xml_link"),
+	})
+}
+
+func TestAccApiManagementGraphQLResolver_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_resolver", "test")
+	r := ApiManagementGraphQLResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("xml_link"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementGraphQLResolverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := graphqlapiresolver.ParseGraphQLApiResolverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.GraphQLApiResolverClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementGraphQLResolverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_resolver" "test" {
+  name         = "acctest-resolver-%d"
+  api_id       = azurerm_api_management_api.test.id
+  display_name = "Test Resolver"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementGraphQLResolverResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_resolver" "import" {
+  name         = azurerm_api_management_graphql_resolver.test.name
+  api_id       = azurerm_api_management_graphql_resolver.test.api_id
+  display_name = azurerm_api_management_graphql_resolver.test.display_name
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementGraphQLResolverResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_resolver" "test" {
+  name         = "acctest-resolver-%d"
+  api_id       = azurerm_api_management_api.test.id
+  display_name = "Test Resolver Updated"
+  path         = "Query/users"
+  description  = "A resolver used for acceptance testing"
+  xml_content  = <<XML
+<http-data-source>
+  <http-request>
+    <set-method>GET</set-method>
+    <set-url>https://example.com/api/users</set-url>
+  </http-request>
+</http-data-source>
+XML
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ApiManagementGraphQLResolverResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Consumption_0"
+}
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctest-graphql-api-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  revision            = "1"
+  display_name        = "Test GraphQL API"
+  path                = "graphql"
+  protocols           = ["https"]
+  api_type            = "graphql"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
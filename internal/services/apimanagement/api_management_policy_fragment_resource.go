@@ -0,0 +1,237 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/policyfragment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementPolicyFragmentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementPolicyFragmentResource{}
+
+type ApiManagementPolicyFragmentModel struct {
+	Name            string `tfschema:"name"`
+	ApiManagementId string `tfschema:"api_management_id"`
+	Format          string `tfschema:"format"`
+	Value           string `tfschema:"value"`
+	Description     string `tfschema:"description"`
+}
+
+func (ApiManagementPolicyFragmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementID,
+		},
+
+		"value": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"format": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(policyfragment.PolicyFragmentContentFormatXml),
+			ValidateFunc: validation.StringInSlice(policyfragment.PossibleValuesForPolicyFragmentContentFormat(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (ApiManagementPolicyFragmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (ApiManagementPolicyFragmentResource) ModelObject() interface{} {
+	return &ApiManagementPolicyFragmentModel{}
+}
+
+func (ApiManagementPolicyFragmentResource) ResourceType() string {
+	return "azurerm_api_management_policy_fragment"
+}
+
+func (ApiManagementPolicyFragmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return policyfragment.ValidatePolicyFragmentID
+}
+
+func (r ApiManagementPolicyFragmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.PolicyFragmentClient
+
+			var model ApiManagementPolicyFragmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiManagementId, err := parse.ApiManagementID(model.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			id := policyfragment.NewPolicyFragmentID(apiManagementId.SubscriptionId, apiManagementId.ResourceGroup, apiManagementId.ServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id, policyfragment.DefaultGetOperationOptions())
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			contract := policyfragment.PolicyFragmentContract{
+				Properties: &policyfragment.PolicyFragmentContractProperties{
+					Format: pointer.To(policyfragment.PolicyFragmentContentFormat(model.Format)),
+					Value:  model.Value,
+				},
+			}
+			if model.Description != "" {
+				contract.Properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, contract, policyfragment.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (ApiManagementPolicyFragmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.PolicyFragmentClient
+
+			id, err := policyfragment.ParsePolicyFragmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the fragment is returned in the format it was created with, so request
+			// the configured format to avoid a diff caused by a converted payload
+			format := policyfragment.PolicyFragmentContentFormat(metadata.ResourceData.Get("format").(string))
+			options := policyfragment.GetOperationOptions{
+				Format: pointer.To(format),
+			}
+
+			resp, err := client.Get(ctx, *id, options)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			apiManagementId := parse.NewApiManagementID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+
+			state := ApiManagementPolicyFragmentModel{
+				Name:            id.PolicyFragmentId,
+				ApiManagementId: apiManagementId.ID(),
+				Format:          string(format),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.Value = model.Properties.Value
+				state.Description = pointer.From(model.Properties.Description)
+				if v := model.Properties.Format; v != nil {
+					state.Format = string(*v)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ApiManagementPolicyFragmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.PolicyFragmentClient
+
+			id, err := policyfragment.ParsePolicyFragmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementPolicyFragmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			contract := policyfragment.PolicyFragmentContract{
+				Properties: &policyfragment.PolicyFragmentContractProperties{
+					Format: pointer.To(policyfragment.PolicyFragmentContentFormat(model.Format)),
+					Value:  model.Value,
+				},
+			}
+			if model.Description != "" {
+				contract.Properties.Description = pointer.To(model.Description)
+			}
+
+			options := policyfragment.CreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, contract, options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ApiManagementPolicyFragmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.PolicyFragmentClient
+
+			id, err := policyfragment.ParsePolicyFragmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := policyfragment.DeleteOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,222 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementWorkspacePolicyModel struct {
+	WorkspaceId string `tfschema:"workspace_id"`
+	XmlContent  string `tfschema:"xml_content"`
+	XmlLink     string `tfschema:"xml_link"`
+}
+
+type ApiManagementWorkspacePolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspacePolicyResource{}
+
+func (r ApiManagementWorkspacePolicyResource) ModelObject() interface{} {
+	return &ApiManagementWorkspacePolicyModel{}
+}
+
+func (r ApiManagementWorkspacePolicyResource) ResourceType() string {
+	return "azurerm_api_management_workspace_policy"
+}
+
+func (r ApiManagementWorkspacePolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspacepolicy.ValidateWorkspacePolicyID
+}
+
+func (r ApiManagementWorkspacePolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"xml_content": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ConflictsWith:    []string{"xml_link"},
+			ExactlyOneOf:     []string{"xml_link", "xml_content"},
+			DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+		},
+
+		"xml_link": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"xml_content"},
+			ExactlyOneOf:  []string{"xml_link", "xml_content"},
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			var model ApiManagementWorkspacePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := workspacepolicy.NewWorkspacePolicyID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId)
+
+			// NOTE: the API returns a default policy for every workspace, so unlike other resources
+			// there's no means of checking whether one has been configured by the user - as such any
+			// existing policy is intentionally overwritten, as called out in the documentation
+			parameters, err := expandWorkspacePolicy(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *parameters, workspacepolicy.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			id, err := workspacepolicy.ParseWorkspacePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			workspaceId := workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId)
+
+			state := ApiManagementWorkspacePolicyModel{
+				WorkspaceId: workspaceId.ID(),
+				XmlLink:     metadata.ResourceData.Get("xml_link").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					// as with the other policy resources the API unescapes the content when applying
+					// an `xml_link`, so the content is unescaped before being set into the state
+					state.XmlContent = html.UnescapeString(props.Value)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			id, err := workspacepolicy.ParseWorkspacePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspacePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters, err := expandWorkspacePolicy(model)
+			if err != nil {
+				return err
+			}
+
+			options := workspacepolicy.CreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, *parameters, options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			id, err := workspacepolicy.ParseWorkspacePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspacepolicy.DeleteOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandWorkspacePolicy(model ApiManagementWorkspacePolicyModel) (*workspacepolicy.PolicyContract, error) {
+	if model.XmlLink != "" {
+		return &workspacepolicy.PolicyContract{
+			Properties: &workspacepolicy.PolicyContractProperties{
+				Format: pointer.To(workspacepolicy.PolicyContentFormatRawxmlNegativelink),
+				Value:  model.XmlLink,
+			},
+		}, nil
+	}
+
+	if model.XmlContent != "" {
+		return &workspacepolicy.PolicyContract{
+			Properties: &workspacepolicy.PolicyContractProperties{
+				Format: pointer.To(workspacepolicy.PolicyContentFormatRawxml),
+				Value:  model.XmlContent,
+			},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("either `xml_content` or `xml_link` must be set")
+}
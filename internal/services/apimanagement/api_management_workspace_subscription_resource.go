@@ -0,0 +1,303 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceproduct"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceSubscriptionModel struct {
+	SubscriptionId string `tfschema:"subscription_id"`
+	WorkspaceId    string `tfschema:"workspace_id"`
+	DisplayName    string `tfschema:"display_name"`
+	ApiId          string `tfschema:"api_id"`
+	ProductId      string `tfschema:"product_id"`
+	State          string `tfschema:"state"`
+	AllowTracing   bool   `tfschema:"allow_tracing"`
+	PrimaryKey     string `tfschema:"primary_key"`
+	SecondaryKey   string `tfschema:"secondary_key"`
+}
+
+type ApiManagementWorkspaceSubscriptionResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceSubscriptionResource{}
+
+func (r ApiManagementWorkspaceSubscriptionResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceSubscriptionModel{}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) ResourceType() string {
+	return "azurerm_api_management_workspace_subscription"
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspacesubscription.ValidateWorkspaceSubscriptionID
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"subscription_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"api_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  workspaceapi.ValidateWorkspaceApiID,
+			ConflictsWith: []string{"product_id"},
+		},
+
+		"product_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  workspaceproduct.ValidateWorkspaceProductID,
+			ConflictsWith: []string{"api_id"},
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(workspacesubscription.SubscriptionStateSubmitted),
+			ValidateFunc: validation.StringInSlice(workspacesubscription.PossibleValuesForSubscriptionState(), false),
+		},
+
+		"allow_tracing": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			var model ApiManagementWorkspaceSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			sid := model.SubscriptionId
+			if sid == "" {
+				uid, err := uuid.NewV4()
+				if err != nil {
+					return fmt.Errorf("generating Subscription ID: %+v", err)
+				}
+				sid = uid.String()
+			}
+
+			id := workspacesubscription.NewWorkspaceSubscriptionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, sid)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := workspacesubscription.SubscriptionCreateParameters{
+				Properties: &workspacesubscription.SubscriptionCreateParameterProperties{
+					AllowTracing: pointer.To(model.AllowTracing),
+					DisplayName:  model.DisplayName,
+					Scope:        expandWorkspaceSubscriptionScope(model),
+					State:        pointer.To(workspacesubscription.SubscriptionState(model.State)),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters, workspacesubscription.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			id, err := workspacesubscription.ParseWorkspaceSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			workspaceId := workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId)
+
+			state := ApiManagementWorkspaceSubscriptionModel{
+				SubscriptionId: id.Sid,
+				WorkspaceId:    workspaceId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.State = string(pointer.From(props.State))
+					state.AllowTracing = pointer.From(props.AllowTracing)
+
+					if apiId, err := workspaceapi.ParseWorkspaceApiID(props.Scope); err == nil {
+						state.ApiId = apiId.ID()
+					}
+					if productId, err := workspaceproduct.ParseWorkspaceProductID(props.Scope); err == nil {
+						state.ProductId = productId.ID()
+					}
+				}
+			}
+
+			// the keys are no longer returned from the Get endpoint and have to be retrieved separately
+			secrets, err := client.ListSecrets(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing secrets for %s: %+v", *id, err)
+			}
+			if model := secrets.Model; model != nil {
+				state.PrimaryKey = pointer.From(model.PrimaryKey)
+				state.SecondaryKey = pointer.From(model.SecondaryKey)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			id, err := workspacesubscription.ParseWorkspaceSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := workspacesubscription.SubscriptionCreateParameters{
+				Properties: &workspacesubscription.SubscriptionCreateParameterProperties{
+					AllowTracing: pointer.To(model.AllowTracing),
+					DisplayName:  model.DisplayName,
+					Scope:        expandWorkspaceSubscriptionScope(model),
+					State:        pointer.To(workspacesubscription.SubscriptionState(model.State)),
+				},
+			}
+
+			options := workspacesubscription.CreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters, options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			id, err := workspacesubscription.ParseWorkspaceSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspacesubscription.DeleteOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandWorkspaceSubscriptionScope(model ApiManagementWorkspaceSubscriptionModel) string {
+	if model.ApiId != "" {
+		return model.ApiId
+	}
+
+	if model.ProductId != "" {
+		return model.ProductId
+	}
+
+	// in the absence of an explicit scope the subscription covers every API within the workspace
+	return "/apis"
+}
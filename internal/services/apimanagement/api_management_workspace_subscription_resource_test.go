@@ -0,0 +1,195 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceSubscriptionResource struct{}
+
+func TestAccApiManagementWorkspaceSubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_id").Exists(),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceSubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withSubscriptionId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceSubscription_productScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.productScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("product_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceSubscription_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementWorkspaceSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspacesubscription.ParseWorkspaceSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.WorkspaceSubscriptionClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  workspace_id = azurerm_api_management_workspace.test.id
+  display_name = "Test Subscription"
+}
+`, r.template(data))
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) withSubscriptionId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  subscription_id = "acctest-subscription-%d"
+  workspace_id    = azurerm_api_management_workspace.test.id
+  display_name    = "Test Subscription"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "import" {
+  subscription_id = azurerm_api_management_workspace_subscription.test.subscription_id
+  workspace_id    = azurerm_api_management_workspace_subscription.test.workspace_id
+  display_name    = azurerm_api_management_workspace_subscription.test.display_name
+}
+`, r.withSubscriptionId(data))
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) productScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "test" {
+  product_id   = "acctest-product-%d"
+  workspace_id = azurerm_api_management_workspace.test.id
+  display_name = "Test Product"
+}
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  workspace_id = azurerm_api_management_workspace.test.id
+  display_name = "Test Subscription"
+  product_id   = azurerm_api_management_workspace_product.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  workspace_id  = azurerm_api_management_workspace.test.id
+  display_name  = "Test Subscription Updated"
+  state         = "active"
+  allow_tracing = false
+}
+`, r.template(data))
+}
+
+func (ApiManagementWorkspaceSubscriptionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Premium_1"
+}
+
+resource "azurerm_api_management_workspace" "test" {
+  name              = "acctest-ws-%[1]d"
+  api_management_id = azurerm_api_management.test.id
+  display_name      = "Test Workspace"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -4,6 +4,17 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement" // nolint: staticcheck
 	pandoraAPIMGlobalSchema "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2021-08-01/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorizationaccesspolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/graphqlapiresolverpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/policyfragment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceproduct"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacesubscription"
 )
 
 type Client struct {
@@ -16,6 +27,9 @@ type Client struct {
 	ApiSchemasClient                   *apimanagement.APISchemaClient
 	ApiTagDescriptionClient            *apimanagement.APITagDescriptionClient
 	ApiVersionSetClient                *apimanagement.APIVersionSetClient
+	AuthorizationAccessPolicyClient    *authorizationaccesspolicy.AuthorizationAccessPolicyClient
+	AuthorizationClient                *authorization.AuthorizationClient
+	AuthorizationProviderClient        *authorizationprovider.AuthorizationProviderClient
 	AuthorizationServersClient         *apimanagement.AuthorizationServerClient
 	BackendClient                      *apimanagement.BackendClient
 	CacheClient                        *apimanagement.CacheClient
@@ -29,6 +43,8 @@ type Client struct {
 	GatewayClient                      *apimanagement.GatewayClient
 	GatewayHostNameConfigurationClient *apimanagement.GatewayHostnameConfigurationClient
 	GlobalSchemaClient                 *pandoraAPIMGlobalSchema.SchemaClient
+	GraphQLApiResolverClient           *graphqlapiresolver.GraphQLApiResolverClient
+	GraphQLApiResolverPolicyClient     *graphqlapiresolverpolicy.GraphQLApiResolverPolicyClient
 	GroupClient                        *apimanagement.GroupClient
 	GroupUsersClient                   *apimanagement.GroupUserClient
 	IdentityProviderClient             *apimanagement.IdentityProviderClient
@@ -38,6 +54,7 @@ type Client struct {
 	NotificationRecipientUserClient    *apimanagement.NotificationRecipientUserClient
 	OpenIdConnectClient                *apimanagement.OpenIDConnectProviderClient
 	PolicyClient                       *apimanagement.PolicyClient
+	PolicyFragmentClient               *policyfragment.PolicyFragmentClient
 	ProductApisClient                  *apimanagement.ProductAPIClient
 	ProductGroupsClient                *apimanagement.ProductGroupClient
 	ProductPoliciesClient              *apimanagement.ProductPolicyClient
@@ -49,6 +66,11 @@ type Client struct {
 	TagClient                          *apimanagement.TagClient
 	TenantAccessClient                 *apimanagement.TenantAccessClient
 	UsersClient                        *apimanagement.UserClient
+	WorkspaceApiClient                 *workspaceapi.WorkspaceApiClient
+	WorkspaceClient                    *workspace.WorkspaceClient
+	WorkspacePolicyClient              *workspacepolicy.WorkspacePolicyClient
+	WorkspaceProductClient             *workspaceproduct.WorkspaceProductClient
+	WorkspaceSubscriptionClient        *workspacesubscription.WorkspaceSubscriptionClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -178,6 +200,39 @@ func NewClient(o *common.ClientOptions) *Client {
 	usersClient := apimanagement.NewUserClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&usersClient.Client, o.ResourceManagerAuthorizer)
 
+	authorizationClient := authorization.NewAuthorizationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&authorizationClient.Client, o.ResourceManagerAuthorizer)
+
+	authorizationAccessPolicyClient := authorizationaccesspolicy.NewAuthorizationAccessPolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&authorizationAccessPolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	authorizationProviderClient := authorizationprovider.NewAuthorizationProviderClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&authorizationProviderClient.Client, o.ResourceManagerAuthorizer)
+
+	graphQLApiResolverClient := graphqlapiresolver.NewGraphQLApiResolverClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&graphQLApiResolverClient.Client, o.ResourceManagerAuthorizer)
+
+	graphQLApiResolverPolicyClient := graphqlapiresolverpolicy.NewGraphQLApiResolverPolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&graphQLApiResolverPolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	policyFragmentClient := policyfragment.NewPolicyFragmentClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policyFragmentClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceClient := workspace.NewWorkspaceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceApiClient := workspaceapi.NewWorkspaceApiClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceApiClient.Client, o.ResourceManagerAuthorizer)
+
+	workspacePolicyClient := workspacepolicy.NewWorkspacePolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspacePolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceProductClient := workspaceproduct.NewWorkspaceProductClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceProductClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceSubscriptionClient := workspacesubscription.NewWorkspaceSubscriptionClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceSubscriptionClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ApiClient:                          &apiClient,
 		ApiDiagnosticClient:                &apiDiagnosticClient,
@@ -188,6 +243,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		ApiSchemasClient:                   &apiSchemasClient,
 		ApiTagDescriptionClient:            &apiTagDescriptionClient,
 		ApiVersionSetClient:                &apiVersionSetClient,
+		AuthorizationAccessPolicyClient:    &authorizationAccessPolicyClient,
+		AuthorizationClient:                &authorizationClient,
+		AuthorizationProviderClient:        &authorizationProviderClient,
 		AuthorizationServersClient:         &authorizationServersClient,
 		BackendClient:                      &backendClient,
 		CacheClient:                        &cacheClient,
@@ -201,6 +259,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		GatewayClient:                      &gatewayClient,
 		GatewayHostNameConfigurationClient: &gatewayHostnameConfigurationClient,
 		GlobalSchemaClient:                 &globalSchemaClient,
+		GraphQLApiResolverClient:           &graphQLApiResolverClient,
+		GraphQLApiResolverPolicyClient:     &graphQLApiResolverPolicyClient,
 		GroupClient:                        &groupClient,
 		GroupUsersClient:                   &groupUsersClient,
 		IdentityProviderClient:             &identityProviderClient,
@@ -210,6 +270,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		NotificationRecipientUserClient:    &notificationRecipientUserClient,
 		OpenIdConnectClient:                &openIdConnectClient,
 		PolicyClient:                       &policyClient,
+		PolicyFragmentClient:               &policyFragmentClient,
 		ProductApisClient:                  &productApisClient,
 		ProductGroupsClient:                &productGroupsClient,
 		ProductPoliciesClient:              &productPoliciesClient,
@@ -221,5 +282,10 @@ func NewClient(o *common.ClientOptions) *Client {
 		TagClient:                          &tagClient,
 		TenantAccessClient:                 &tenantAccessClient,
 		UsersClient:                        &usersClient,
+		WorkspaceApiClient:                 &workspaceApiClient,
+		WorkspaceClient:                    &workspaceClient,
+		WorkspacePolicyClient:              &workspacePolicyClient,
+		WorkspaceProductClient:             &workspaceProductClient,
+		WorkspaceSubscriptionClient:        &workspaceSubscriptionClient,
 	}
 }
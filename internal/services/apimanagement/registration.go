@@ -30,6 +30,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_api_management":                                 dataSourceApiManagementService(),
 		"azurerm_api_management_api":                             dataSourceApiManagementApi(),
+		"azurerm_api_management_api_policy":                      dataSourceApiManagementApiPolicy(),
 		"azurerm_api_management_api_version_set":                 dataSourceApiManagementApiVersionSet(),
 		"azurerm_api_management_gateway":                         dataSourceApiManagementGateway(),
 		"azurerm_api_management_gateway_host_name_configuration": dataSourceApiManagementGatewayHostNameConfiguration(),
@@ -95,7 +96,17 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApiManagementAuthorizationAccessPolicyResource{},
+		ApiManagementAuthorizationProviderResource{},
+		ApiManagementAuthorizationResource{},
+		ApiManagementGraphQLResolverResource{},
 		ApiManagementNotificationRecipientEmailResource{},
 		ApiManagementNotificationRecipientUserResource{},
+		ApiManagementPolicyFragmentResource{},
+		ApiManagementWorkspaceResource{},
+		ApiManagementWorkspaceApiResource{},
+		ApiManagementWorkspacePolicyResource{},
+		ApiManagementWorkspaceProductResource{},
+		ApiManagementWorkspaceSubscriptionResource{},
 	}
 }
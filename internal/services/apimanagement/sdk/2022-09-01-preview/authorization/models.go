@@ -0,0 +1,24 @@
+package authorization
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AuthorizationContract struct {
+	Id         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *AuthorizationContractProperties `json:"properties,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+}
+
+type AuthorizationContractProperties struct {
+	AuthorizationType *AuthorizationType  `json:"authorizationType,omitempty"`
+	Error             *AuthorizationError `json:"error,omitempty"`
+	OAuth2GrantType   *OAuth2GrantType    `json:"oauth2grantType,omitempty"`
+	Parameters        *map[string]string  `json:"parameters,omitempty"`
+	Status            *string             `json:"status,omitempty"`
+}
+
+type AuthorizationError struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
@@ -0,0 +1,16 @@
+package authorizationaccesspolicy
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AuthorizationAccessPolicyContract struct {
+	Id         *string                                      `json:"id,omitempty"`
+	Name       *string                                      `json:"name,omitempty"`
+	Properties *AuthorizationAccessPolicyContractProperties `json:"properties,omitempty"`
+	Type       *string                                      `json:"type,omitempty"`
+}
+
+type AuthorizationAccessPolicyContractProperties struct {
+	ObjectId *string `json:"objectId,omitempty"`
+	TenantId *string `json:"tenantId,omitempty"`
+}
@@ -0,0 +1,27 @@
+package authorizationprovider
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AuthorizationProviderContract struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *AuthorizationProviderContractProperties `json:"properties,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
+
+type AuthorizationProviderContractProperties struct {
+	DisplayName      *string                              `json:"displayName,omitempty"`
+	IdentityProvider *string                              `json:"identityProvider,omitempty"`
+	Oauth2           *AuthorizationProviderOAuth2Settings `json:"oauth2,omitempty"`
+}
+
+type AuthorizationProviderOAuth2Settings struct {
+	GrantTypes  *AuthorizationProviderOAuth2GrantTypes `json:"grantTypes,omitempty"`
+	RedirectUrl *string                                `json:"redirectUrl,omitempty"`
+}
+
+type AuthorizationProviderOAuth2GrantTypes struct {
+	AuthorizationCode *map[string]string `json:"authorizationCode,omitempty"`
+	ClientCredentials *map[string]string `json:"clientCredentials,omitempty"`
+}
@@ -0,0 +1,17 @@
+package graphqlapiresolver
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ResolverContract struct {
+	Id         *string             `json:"id,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Properties *ResolverProperties `json:"properties,omitempty"`
+	Type       *string             `json:"type,omitempty"`
+}
+
+type ResolverProperties struct {
+	Description *string `json:"description,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Path        *string `json:"path,omitempty"`
+}
@@ -0,0 +1,18 @@
+package graphqlapiresolverpolicy
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type GraphQLApiResolverPolicyClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGraphQLApiResolverPolicyClientWithBaseURI(endpoint string) GraphQLApiResolverPolicyClient {
+	return GraphQLApiResolverPolicyClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
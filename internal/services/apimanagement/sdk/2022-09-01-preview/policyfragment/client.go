@@ -0,0 +1,18 @@
+package policyfragment
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PolicyFragmentClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPolicyFragmentClientWithBaseURI(endpoint string) PolicyFragmentClient {
+	return PolicyFragmentClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
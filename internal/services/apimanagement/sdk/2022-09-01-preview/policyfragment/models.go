@@ -0,0 +1,17 @@
+package policyfragment
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PolicyFragmentContract struct {
+	Id         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *PolicyFragmentContractProperties `json:"properties,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
+
+type PolicyFragmentContractProperties struct {
+	Description *string                      `json:"description,omitempty"`
+	Format      *PolicyFragmentContentFormat `json:"format,omitempty"`
+	Value       string                       `json:"value"`
+}
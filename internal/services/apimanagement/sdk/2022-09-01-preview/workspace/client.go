@@ -0,0 +1,18 @@
+package workspace
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type WorkspaceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceClientWithBaseURI(endpoint string) WorkspaceClient {
+	return WorkspaceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
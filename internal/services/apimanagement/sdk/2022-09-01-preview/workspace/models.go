@@ -0,0 +1,16 @@
+package workspace
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type WorkspaceContract struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *WorkspaceContractProperties `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type WorkspaceContractProperties struct {
+	Description *string `json:"description,omitempty"`
+	DisplayName string  `json:"displayName"`
+}
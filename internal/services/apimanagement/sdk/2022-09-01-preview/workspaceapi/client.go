@@ -0,0 +1,18 @@
+package workspaceapi
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type WorkspaceApiClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceApiClientWithBaseURI(endpoint string) WorkspaceApiClient {
+	return WorkspaceApiClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
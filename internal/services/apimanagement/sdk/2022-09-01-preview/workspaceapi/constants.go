@@ -0,0 +1,40 @@
+package workspaceapi
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Protocol string
+
+const (
+	ProtocolHTTP  Protocol = "http"
+	ProtocolHTTPS Protocol = "https"
+	ProtocolWs    Protocol = "ws"
+	ProtocolWss   Protocol = "wss"
+)
+
+func PossibleValuesForProtocol() []string {
+	return []string{
+		string(ProtocolHTTP),
+		string(ProtocolHTTPS),
+		string(ProtocolWs),
+		string(ProtocolWss),
+	}
+}
+
+func parseProtocol(input string) (*Protocol, error) {
+	vals := map[string]Protocol{
+		"http":  ProtocolHTTP,
+		"https": ProtocolHTTPS,
+		"ws":    ProtocolWs,
+		"wss":   ProtocolWss,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Protocol(input)
+	return &out, nil
+}
@@ -0,0 +1,26 @@
+package workspaceapi
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ApiContract struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ApiContractProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type ApiContractProperties struct {
+	ApiRevision            *string     `json:"apiRevision,omitempty"`
+	ApiRevisionDescription *string     `json:"apiRevisionDescription,omitempty"`
+	ApiVersion             *string     `json:"apiVersion,omitempty"`
+	ApiVersionSetId        *string     `json:"apiVersionSetId,omitempty"`
+	Description            *string     `json:"description,omitempty"`
+	DisplayName            *string     `json:"displayName,omitempty"`
+	IsCurrent              *bool       `json:"isCurrent,omitempty"`
+	IsOnline               *bool       `json:"isOnline,omitempty"`
+	Path                   string      `json:"path"`
+	Protocols              *[]Protocol `json:"protocols,omitempty"`
+	ServiceUrl             *string     `json:"serviceUrl,omitempty"`
+	SubscriptionRequired   *bool       `json:"subscriptionRequired,omitempty"`
+}
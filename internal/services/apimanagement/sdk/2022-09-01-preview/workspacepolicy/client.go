@@ -0,0 +1,18 @@
+package workspacepolicy
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type WorkspacePolicyClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspacePolicyClientWithBaseURI(endpoint string) WorkspacePolicyClient {
+	return WorkspacePolicyClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
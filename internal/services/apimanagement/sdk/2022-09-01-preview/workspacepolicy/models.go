@@ -0,0 +1,16 @@
+package workspacepolicy
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PolicyContract struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *PolicyContractProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type PolicyContractProperties struct {
+	Format *PolicyContentFormat `json:"format,omitempty"`
+	Value  string               `json:"value"`
+}
@@ -0,0 +1,18 @@
+package workspaceproduct
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type WorkspaceProductClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceProductClientWithBaseURI(endpoint string) WorkspaceProductClient {
+	return WorkspaceProductClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,21 @@
+package workspaceproduct
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ProductContract struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *ProductContractProperties `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
+
+type ProductContractProperties struct {
+	ApprovalRequired     *bool         `json:"approvalRequired,omitempty"`
+	Description          *string       `json:"description,omitempty"`
+	DisplayName          string        `json:"displayName"`
+	State                *ProductState `json:"state,omitempty"`
+	SubscriptionRequired *bool         `json:"subscriptionRequired,omitempty"`
+	SubscriptionsLimit   *int64        `json:"subscriptionsLimit,omitempty"`
+	Terms                *string       `json:"terms,omitempty"`
+}
@@ -0,0 +1,18 @@
+package workspacesubscription
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type WorkspaceSubscriptionClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceSubscriptionClientWithBaseURI(endpoint string) WorkspaceSubscriptionClient {
+	return WorkspaceSubscriptionClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,40 @@
+package workspacesubscription
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type SubscriptionContract struct {
+	Id         *string                         `json:"id,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties *SubscriptionContractProperties `json:"properties,omitempty"`
+	Type       *string                         `json:"type,omitempty"`
+}
+
+type SubscriptionContractProperties struct {
+	AllowTracing *bool              `json:"allowTracing,omitempty"`
+	DisplayName  *string            `json:"displayName,omitempty"`
+	OwnerId      *string            `json:"ownerId,omitempty"`
+	PrimaryKey   *string            `json:"primaryKey,omitempty"`
+	Scope        string             `json:"scope"`
+	SecondaryKey *string            `json:"secondaryKey,omitempty"`
+	State        *SubscriptionState `json:"state,omitempty"`
+}
+
+type SubscriptionCreateParameters struct {
+	Properties *SubscriptionCreateParameterProperties `json:"properties,omitempty"`
+}
+
+type SubscriptionCreateParameterProperties struct {
+	AllowTracing *bool              `json:"allowTracing,omitempty"`
+	DisplayName  string             `json:"displayName"`
+	OwnerId      *string            `json:"ownerId,omitempty"`
+	PrimaryKey   *string            `json:"primaryKey,omitempty"`
+	Scope        string             `json:"scope"`
+	SecondaryKey *string            `json:"secondaryKey,omitempty"`
+	State        *SubscriptionState `json:"state,omitempty"`
+}
+
+type SubscriptionKeysContract struct {
+	PrimaryKey   *string `json:"primaryKey,omitempty"`
+	SecondaryKey *string `json:"secondaryKey,omitempty"`
+}
@@ -37,3 +37,35 @@ func appConfigurationGetKeyRefreshFunc(ctx context.Context, client *appconfigura
 		return res, "Exists", nil
 	}
 }
+
+func appConfigurationListKeysRefreshFunc(ctx context.Context, client *appconfiguration.BaseClient, label string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.GetKeyValues(ctx, "", label, "", "", []string{})
+		if err != nil {
+			if v, ok := err.(autorest.DetailedError); ok {
+				if utils.ResponseWasForbidden(autorest.Response{Response: v.Response}) {
+					return "Forbidden", "Forbidden", nil
+				}
+			}
+			return res, "Error", nil
+		}
+
+		return res, "Exists", nil
+	}
+}
+
+func appConfigurationGetSnapshotRefreshFunc(ctx context.Context, client *appconfiguration.BaseClient, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.GetSnapshot(ctx, name, "", "")
+		if err != nil {
+			if v, ok := err.(autorest.DetailedError); ok {
+				if utils.ResponseWasForbidden(autorest.Response{Response: v.Response}) {
+					return "Forbidden", "Forbidden", nil
+				}
+			}
+			return res, "Error", nil
+		}
+
+		return res, "Exists", nil
+	}
+}
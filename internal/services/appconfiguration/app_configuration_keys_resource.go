@@ -0,0 +1,385 @@
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2022-05-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/1.0/appconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeysResource struct{}
+
+var _ sdk.ResourceWithUpdate = KeysResource{}
+
+// the data plane API has no batch write endpoint, so "batching" here means writing the
+// key-values over a small pool of concurrent requests rather than one request at a time
+const keyValueSyncWorkers = 10
+
+type KeysResourceModel struct {
+	ConfigurationStoreId string                 `tfschema:"configuration_store_id"`
+	Label                string                 `tfschema:"label"`
+	ContentType          string                 `tfschema:"content_type"`
+	KeyValues            map[string]interface{} `tfschema:"key_values"`
+}
+
+func (k KeysResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+		"label": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		"content_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+		"key_values": {
+			Type:     pluginsdk.TypeMap,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (k KeysResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (k KeysResource) ModelObject() interface{} {
+	return &KeysResourceModel{}
+}
+
+func (k KeysResource) ResourceType() string {
+	return "azurerm_app_configuration_keys"
+}
+
+func (k KeysResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeysResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("app configuration %q was not found", model.ConfigurationStoreId)
+			}
+
+			listClient, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClient(ctx, model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			appCfgKeysResourceID := parse.AppConfigurationKeysId{
+				ConfigurationStoreId: model.ConfigurationStoreId,
+				Label:                model.Label,
+			}
+
+			// from https://learn.microsoft.com/en-us/azure/azure-app-configuration/concept-enable-rbac#azure-built-in-roles-for-azure-app-configuration
+			// allow up to 15 min for role permission to be done propagated
+			metadata.Logger.Infof("[DEBUG] Waiting for App Configuration Keys %q read permission to be done propagated", appCfgKeysResourceID)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:      []string{"Forbidden"},
+				Target:       []string{"Error", "Exists"},
+				Refresh:      appConfigurationListKeysRefreshFunc(ctx, client, model.Label),
+				PollInterval: 20 * time.Second,
+				Timeout:      15 * time.Minute,
+			}
+
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for App Configuration Keys %q read permission to be propagated: %+v", appCfgKeysResourceID, err)
+			}
+
+			// a single list call covers both the requires-import check for every key we're
+			// about to write and avoids one read per key
+			iter, err := listClient.GetKeyValuesComplete(ctx, "", appConfigurationLabelFilter(model.Label), "", "", []string{})
+			if err != nil {
+				return fmt.Errorf("while listing existing key-values for %q: %+v", appCfgKeysResourceID, err)
+			}
+			for iter.NotDone() {
+				if key := utils.NormalizeNilableString(iter.Value().Key); key != "" {
+					if _, ok := model.KeyValues[key]; ok {
+						return tf.ImportAsExistsError(k.ResourceType(), appCfgKeysResourceID.ID())
+					}
+				}
+				if err := iter.NextWithContext(ctx); err != nil {
+					return fmt.Errorf("while listing existing key-values for %q: %+v", appCfgKeysResourceID, err)
+				}
+			}
+
+			if err := writeAppConfigurationKeyValues(ctx, client, model.Label, model.ContentType, expandAppConfigurationKeyValues(model.KeyValues)); err != nil {
+				return err
+			}
+
+			if appCfgKeysResourceID.Label == "" {
+				// We set an empty label as %00 in the resource ID
+				// Otherwise it breaks the ID parsing logic
+				appCfgKeysResourceID.Label = "%00"
+			}
+			metadata.SetID(appCfgKeysResourceID)
+			return nil
+		},
+		Timeout: 45 * time.Minute,
+	}
+}
+
+func (k KeysResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourceID, err := parse.KeysId(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClient(ctx, resourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				// if the parent AppConfiguration is gone, all the data will be too
+				return metadata.MarkAsGone(resourceID)
+			}
+
+			var model KeysResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			iter, err := client.GetKeyValuesComplete(ctx, "", appConfigurationLabelFilter(resourceID.Label), "", "", []string{})
+			if err != nil {
+				return fmt.Errorf("while listing key-values for %q: %+v", resourceID, err)
+			}
+
+			found := map[string]interface{}{}
+			for iter.NotDone() {
+				kv := iter.Value()
+				key := utils.NormalizeNilableString(kv.Key)
+				// only the keys this resource manages are reflected back into state - any
+				// unrelated keys sharing the label are left alone. On import the state is
+				// empty, so every key under the label is adopted instead.
+				if _, ok := model.KeyValues[key]; ok || len(model.KeyValues) == 0 {
+					found[key] = utils.NormalizeNilableString(kv.Value)
+				}
+				if err := iter.NextWithContext(ctx); err != nil {
+					return fmt.Errorf("while listing key-values for %q: %+v", resourceID, err)
+				}
+			}
+
+			model.ConfigurationStoreId = resourceID.ConfigurationStoreId
+			model.Label = resourceID.Label
+			model.KeyValues = found
+			return metadata.Encode(&model)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (k KeysResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourceID, err := parse.KeysId(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			if resourceID.Label == "%00" {
+				resourceID.Label = ""
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, resourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("app configuration %q was not found", resourceID.ConfigurationStoreId)
+			}
+
+			var model KeysResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("key_values") || metadata.ResourceData.HasChange("content_type") {
+				oldRaw, newRaw := metadata.ResourceData.GetChange("key_values")
+				oldKeyValues := expandAppConfigurationKeyValues(oldRaw.(map[string]interface{}))
+				newKeyValues := expandAppConfigurationKeyValues(newRaw.(map[string]interface{}))
+
+				removed := make([]string, 0)
+				for key := range oldKeyValues {
+					if _, ok := newKeyValues[key]; !ok {
+						removed = append(removed, key)
+					}
+				}
+
+				pending := make(map[string]string)
+				for key, value := range newKeyValues {
+					if oldValue, ok := oldKeyValues[key]; !ok || oldValue != value || metadata.ResourceData.HasChange("content_type") {
+						pending[key] = value
+					}
+				}
+
+				if err := deleteAppConfigurationKeyValues(ctx, client, resourceID.Label, removed); err != nil {
+					return err
+				}
+				if err := writeAppConfigurationKeyValues(ctx, client, resourceID.Label, model.ContentType, pending); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Timeout: 45 * time.Minute,
+	}
+}
+
+func (k KeysResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourceID, err := parse.KeysId(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			if resourceID.Label == "%00" {
+				resourceID.Label = ""
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, resourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("app configuration %q was not found", resourceID.ConfigurationStoreId)
+			}
+
+			var model KeysResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			removed := make([]string, 0, len(model.KeyValues))
+			for key := range model.KeyValues {
+				removed = append(removed, key)
+			}
+
+			return deleteAppConfigurationKeyValues(ctx, client, resourceID.Label, removed)
+		},
+		Timeout: 45 * time.Minute,
+	}
+}
+
+func (k KeysResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.AppConfigurationKeysID
+}
+
+func expandAppConfigurationKeyValues(input map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(input))
+	for key, value := range input {
+		result[key] = value.(string)
+	}
+	return result
+}
+
+// appConfigurationLabelFilter returns the label as a list filter - an empty label would
+// match every label when filtering, so the NUL character is sent instead, which the
+// service interprets as matching only the empty label
+func appConfigurationLabelFilter(label string) string {
+	if label == "" {
+		return "\x00"
+	}
+	return label
+}
+
+func writeAppConfigurationKeyValues(ctx context.Context, client *appconfiguration.BaseClient, label string, contentType string, pending map[string]string) error {
+	keys := make(chan string)
+	errs := make(chan error, keyValueSyncWorkers)
+	var wg sync.WaitGroup
+	for i := 0; i < keyValueSyncWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keys {
+				entity := appconfiguration.KeyValue{
+					Key:   utils.String(key),
+					Label: utils.String(label),
+					Value: utils.String(pending[key]),
+				}
+				if contentType != "" {
+					entity.ContentType = utils.String(contentType)
+				}
+				if _, err := client.PutKeyValue(ctx, key, label, &entity, "", ""); err != nil {
+					select {
+					case errs <- fmt.Errorf("while setting key/label pair %q/%q: %+v", key, label, err):
+					default:
+					}
+				}
+			}
+		}()
+	}
+
+	for key := range pending {
+		keys <- key
+	}
+	close(keys)
+	wg.Wait()
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+	}
+	return nil
+}
+
+func deleteAppConfigurationKeyValues(ctx context.Context, client *appconfiguration.BaseClient, label string, pending []string) error {
+	keys := make(chan string)
+	errs := make(chan error, keyValueSyncWorkers)
+	var wg sync.WaitGroup
+	for i := 0; i < keyValueSyncWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keys {
+				if _, err := client.DeleteKeyValue(ctx, key, label, ""); err != nil {
+					select {
+					case errs <- fmt.Errorf("while removing key/label pair %q/%q: %+v", key, label, err):
+					default:
+					}
+				}
+			}
+		}()
+	}
+
+	for _, key := range pending {
+		keys <- key
+	}
+	close(keys)
+	wg.Wait()
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+	}
+	return nil
+}
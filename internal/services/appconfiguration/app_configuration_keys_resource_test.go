@@ -0,0 +1,176 @@
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppConfigurationKeysResource struct{}
+
+func TestAccAppConfigurationKeys_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys", "test")
+	r := AppConfigurationKeysResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_values.%").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationKeys_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys", "test")
+	r := AppConfigurationKeysResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationKeys_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys", "test")
+	r := AppConfigurationKeysResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_values.%").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AppConfigurationKeysResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	resourceID, err := parse.KeysId(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	client, err := clients.AppConfiguration.LinkWorkaroundDataPlaneClient(ctx, resourceID.ConfigurationStoreId)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		// if the AppConfiguration is gone all the data is too
+		return utils.Bool(false), nil
+	}
+
+	iter, err := client.GetKeyValuesComplete(ctx, "", resourceID.Label, "", "", []string{})
+	if err != nil {
+		return nil, fmt.Errorf("while listing key-values for %q: %+v", resourceID, err)
+	}
+
+	return utils.Bool(iter.NotDone()), nil
+}
+
+func (t AppConfigurationKeysResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  label                  = "acctest-ackeyslabel-%d"
+
+  key_values = {
+    "acctest-ackeys-one"   = "first"
+    "acctest-ackeys-two"   = "second"
+    "acctest-ackeys-three" = "third"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, t.template(data), data.RandomInteger)
+}
+
+func (t AppConfigurationKeysResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys" "import" {
+  configuration_store_id = azurerm_app_configuration_keys.test.configuration_store_id
+  label                  = azurerm_app_configuration_keys.test.label
+  key_values             = azurerm_app_configuration_keys.test.key_values
+}
+`, t.basic(data))
+}
+
+func (t AppConfigurationKeysResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  label                  = "acctest-ackeyslabel-%d"
+  content_type           = "text/plain"
+
+  key_values = {
+    "acctest-ackeys-one"  = "first-updated"
+    "acctest-ackeys-four" = "fourth"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, t.template(data), data.RandomInteger)
+}
+
+func (t AppConfigurationKeysResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "test" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testaccappconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_app_configuration.test.id
+  role_definition_name = "App Configuration Data Owner"
+  principal_id         = data.azurerm_client_config.test.object_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -1,6 +1,7 @@
 package appconfiguration
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"net/http"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2022-05-01/replicas"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -89,6 +91,47 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			// both of these are per-resource overrides for the provider-level `features` block, so that
+			// the soft delete behaviour of an individual store can be pinned deterministically in config
+			"purge_soft_delete_on_destroy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"recover_soft_deleted_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"replica": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Set:      resourceAppConfigurationReplicaHash,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ConfigurationStoreName,
+						},
+
+						"location": commonschema.LocationWithoutForceNew(),
+
+						"endpoint": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"sku": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -256,7 +299,8 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 
 	recoverSoftDeleted := false
 	if !response.WasNotFound(deleted.HttpResponse) && !response.WasStatusCode(deleted.HttpResponse, http.StatusForbidden) {
-		if !meta.(*clients.Client).Features.AppConfiguration.RecoverSoftDeleted {
+		// the per-resource flag allows opting into recovery without enabling it provider-wide
+		if !meta.(*clients.Client).Features.AppConfiguration.RecoverSoftDeleted && !d.Get("recover_soft_deleted_enabled").(bool) {
 			return fmt.Errorf(optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location))
 		}
 		recoverSoftDeleted = true
@@ -300,6 +344,17 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("creating %s: %+v", resourceId, err)
 	}
 
+	replicasClient := meta.(*clients.Client).AppConfiguration.ReplicasClient
+	for _, v := range d.Get("replica").(*pluginsdk.Set).List() {
+		replica := v.(map[string]interface{})
+		replicaId := replicas.NewReplicaID(subscriptionId, resourceGroup, name, replica["name"].(string))
+		if err := replicasClient.CreateThenPoll(ctx, replicaId, replicas.Replica{
+			Location: utils.String(azure.NormalizeLocation(replica["location"].(string))),
+		}); err != nil {
+			return fmt.Errorf("creating %s: %+v", replicaId, err)
+		}
+	}
+
 	d.SetId(resourceId.ID())
 	return resourceAppConfigurationRead(d, meta)
 }
@@ -410,6 +465,32 @@ func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
+	if d.HasChange("replica") {
+		replicasClient := meta.(*clients.Client).AppConfiguration.ReplicasClient
+
+		oldReplicas, newReplicas := d.GetChange("replica")
+
+		// replicas cannot be updated in place, so any replica which is no longer configured (or
+		// whose location has changed) needs to be removed before the new ones are created
+		for _, v := range oldReplicas.(*pluginsdk.Set).Difference(newReplicas.(*pluginsdk.Set)).List() {
+			replica := v.(map[string]interface{})
+			replicaId := replicas.NewReplicaID(id.SubscriptionId, id.ResourceGroupName, id.ConfigurationStoreName, replica["name"].(string))
+			if err := replicasClient.DeleteThenPoll(ctx, replicaId); err != nil {
+				return fmt.Errorf("deleting %s: %+v", replicaId, err)
+			}
+		}
+
+		for _, v := range newReplicas.(*pluginsdk.Set).Difference(oldReplicas.(*pluginsdk.Set)).List() {
+			replica := v.(map[string]interface{})
+			replicaId := replicas.NewReplicaID(id.SubscriptionId, id.ResourceGroupName, id.ConfigurationStoreName, replica["name"].(string))
+			if err := replicasClient.CreateThenPoll(ctx, replicaId, replicas.Replica{
+				Location: utils.String(azure.NormalizeLocation(replica["location"].(string))),
+			}); err != nil {
+				return fmt.Errorf("creating %s: %+v", replicaId, err)
+			}
+		}
+	}
+
 	return resourceAppConfigurationRead(d, meta)
 }
 
@@ -438,6 +519,12 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("retrieving access keys for %s: %+v", *id, err)
 	}
 
+	replicasClient := meta.(*clients.Client).AppConfiguration.ReplicasClient
+	replicasResult, err := replicasClient.ListByConfigurationStoreComplete(ctx, replicas.NewConfigurationStoreID(id.SubscriptionId, id.ResourceGroupName, id.ConfigurationStoreName))
+	if err != nil {
+		return fmt.Errorf("retrieving replicas for %s: %+v", *id, err)
+	}
+
 	d.Set("name", id.ConfigurationStoreName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
@@ -470,6 +557,10 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 			d.Set("soft_delete_retention_days", softDeleteRetentionDays)
 		}
 
+		if err := d.Set("replica", flattenAppConfigurationReplicas(replicasResult.Items)); err != nil {
+			return fmt.Errorf("setting `replica`: %+v", err)
+		}
+
 		accessKeys := flattenAppConfigurationAccessKeys(resultPage.Items)
 		d.Set("primary_read_key", accessKeys.primaryReadKey)
 		d.Set("primary_write_key", accessKeys.primaryWriteKey)
@@ -530,7 +621,8 @@ func resourceAppConfigurationDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if meta.(*clients.Client).Features.AppConfiguration.PurgeSoftDeleteOnDestroy && softDeleteEnabled {
+	purgeSoftDeleteOnDestroy := meta.(*clients.Client).Features.AppConfiguration.PurgeSoftDeleteOnDestroy || d.Get("purge_soft_delete_on_destroy_enabled").(bool)
+	if purgeSoftDeleteOnDestroy && softDeleteEnabled {
 		deletedId := deletedconfigurationstores.NewDeletedConfigurationStoreID(subscriptionId, existing.Model.Location, id.ConfigurationStoreName)
 
 		// AppConfiguration with Purge Protection Enabled cannot be deleted unless done by Azure
@@ -679,3 +771,33 @@ func parsePublicNetworkAccess(input string) *configurationstores.PublicNetworkAc
 	out := configurationstores.PublicNetworkAccess(input)
 	return &out
 }
+
+func flattenAppConfigurationReplicas(input []replicas.Replica) []interface{} {
+	results := make([]interface{}, 0)
+	for _, replica := range input {
+		endpoint := ""
+		if replica.Properties != nil && replica.Properties.Endpoint != nil {
+			endpoint = *replica.Properties.Endpoint
+		}
+		results = append(results, map[string]interface{}{
+			"id":       utils.NormalizeNilableString(replica.Id),
+			"name":     utils.NormalizeNilableString(replica.Name),
+			"location": location.NormalizeNilable(replica.Location),
+			"endpoint": endpoint,
+		})
+	}
+	return results
+}
+
+// resourceAppConfigurationReplicaHash only hashes the user-specified fields, so that the
+// computed `endpoint` and `id` attributes don't cause spurious diffs within the Set
+func resourceAppConfigurationReplicaHash(input interface{}) int {
+	var buf bytes.Buffer
+
+	if v, ok := input.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(v["name"].(string))))
+		buf.WriteString(fmt.Sprintf("%s-", location.Normalize(v["location"].(string))))
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
@@ -0,0 +1,324 @@
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2022-05-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/1.0/appconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SnapshotResource struct{}
+
+var _ sdk.Resource = SnapshotResource{}
+
+const (
+	SnapshotStatusProvisioning = "provisioning"
+	SnapshotStatusReady        = "ready"
+	SnapshotStatusArchived     = "archived"
+	SnapshotStatusFailed       = "failed"
+
+	SnapshotCompositionTypeKey      = "key"
+	SnapshotCompositionTypeKeyLabel = "key_label"
+)
+
+type SnapshotResourceModel struct {
+	ConfigurationStoreId     string           `tfschema:"configuration_store_id"`
+	Name                     string           `tfschema:"name"`
+	Filter                   []SnapshotFilter `tfschema:"filter"`
+	CompositionType          string           `tfschema:"composition_type"`
+	RetentionPeriodInSeconds int64            `tfschema:"retention_period_in_seconds"`
+	Etag                     string           `tfschema:"etag"`
+}
+
+type SnapshotFilter struct {
+	Key   string `tfschema:"key"`
+	Label string `tfschema:"label"`
+}
+
+func (s SnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			MaxItems: 3,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"label": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+		},
+		"composition_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  SnapshotCompositionTypeKey,
+			ValidateFunc: validation.StringInSlice([]string{
+				SnapshotCompositionTypeKey,
+				SnapshotCompositionTypeKeyLabel,
+			}, false),
+		},
+		"retention_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(3600, 7776000),
+		},
+	}
+}
+
+func (s SnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (s SnapshotResource) ModelObject() interface{} {
+	return &SnapshotResourceModel{}
+}
+
+func (s SnapshotResource) ResourceType() string {
+	return "azurerm_app_configuration_snapshot"
+}
+
+func (s SnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.AppConfigurationSnapshotID
+}
+
+func (s SnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SnapshotResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("app configuration %q was not found", model.ConfigurationStoreId)
+			}
+
+			snapshotResourceID := parse.AppConfigurationSnapshotId{
+				ConfigurationStoreId: model.ConfigurationStoreId,
+				Name:                 model.Name,
+			}
+
+			// from https://learn.microsoft.com/en-us/azure/azure-app-configuration/concept-enable-rbac#azure-built-in-roles-for-azure-app-configuration
+			// allow up to 15 min for role permission to be done propagated
+			metadata.Logger.Infof("[DEBUG] Waiting for App Configuration Snapshot %q read permission to be done propagated", model.Name)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:      []string{"Forbidden"},
+				Target:       []string{"Error", "Exists"},
+				Refresh:      appConfigurationGetSnapshotRefreshFunc(ctx, client, model.Name),
+				PollInterval: 20 * time.Second,
+				Timeout:      15 * time.Minute,
+			}
+
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for App Configuration Snapshot %q read permission to be propagated: %+v", model.Name, err)
+			}
+
+			existing, err := client.GetSnapshot(ctx, model.Name, "", "")
+			if err != nil {
+				if v, ok := err.(autorest.DetailedError); ok {
+					if !utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
+						return fmt.Errorf("checking for presence of existing %s: %+v", snapshotResourceID, err)
+					}
+				} else {
+					return fmt.Errorf("while checking for snapshot's %q existence: %+v", model.Name, err)
+				}
+			} else if existing.Response.StatusCode == 200 {
+				return tf.ImportAsExistsError(s.ResourceType(), snapshotResourceID.ID())
+			}
+
+			filters := make([]appconfiguration.KeyValueFilter, 0)
+			for _, filter := range model.Filter {
+				item := appconfiguration.KeyValueFilter{
+					Key: utils.String(filter.Key),
+				}
+				if filter.Label != "" {
+					item.Label = utils.String(filter.Label)
+				}
+				filters = append(filters, item)
+			}
+
+			entity := appconfiguration.Snapshot{
+				Filters:         &filters,
+				CompositionType: utils.String(model.CompositionType),
+			}
+			if model.RetentionPeriodInSeconds > 0 {
+				entity.RetentionPeriod = utils.Int64(model.RetentionPeriodInSeconds)
+			}
+
+			if _, err = client.PutSnapshot(ctx, model.Name, &entity, "", ""); err != nil {
+				return fmt.Errorf("creating %s: %+v", snapshotResourceID, err)
+			}
+
+			// snapshots are provisioned asynchronously - wait for the snapshot to become ready
+			// before returning, else the filtered key-values may not be available yet
+			provisionConf := &pluginsdk.StateChangeConf{
+				Pending:      []string{SnapshotStatusProvisioning},
+				Target:       []string{SnapshotStatusReady},
+				Refresh:      appConfigurationSnapshotStatusRefreshFunc(ctx, client, model.Name),
+				PollInterval: 10 * time.Second,
+				Timeout:      30 * time.Minute,
+			}
+
+			if _, err = provisionConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to become ready: %+v", snapshotResourceID, err)
+			}
+
+			metadata.SetID(snapshotResourceID)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (s SnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourceID, err := parse.SnapshotId(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, resourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				// if the parent AppConfiguration is gone, all the data will be too
+				return metadata.MarkAsGone(resourceID)
+			}
+
+			snapshot, err := client.GetSnapshot(ctx, resourceID.Name, "", "")
+			if err != nil {
+				if v, ok := err.(autorest.DetailedError); ok {
+					if utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
+						return metadata.MarkAsGone(resourceID)
+					}
+				}
+				return fmt.Errorf("while checking for snapshot's %q existence: %+v", resourceID.Name, err)
+			}
+
+			// an archived snapshot is no longer usable and cannot be un-archived past its
+			// retention period, so treat it the same as a deleted one
+			if utils.NormalizeNilableString(snapshot.Status) == SnapshotStatusArchived {
+				return metadata.MarkAsGone(resourceID)
+			}
+
+			model := SnapshotResourceModel{
+				ConfigurationStoreId: resourceID.ConfigurationStoreId,
+				Name:                 utils.NormalizeNilableString(snapshot.Name),
+				CompositionType:      utils.NormalizeNilableString(snapshot.CompositionType),
+				Etag:                 utils.NormalizeNilableString(snapshot.Etag),
+			}
+
+			if snapshot.RetentionPeriod != nil {
+				model.RetentionPeriodInSeconds = *snapshot.RetentionPeriod
+			}
+
+			if snapshot.Filters != nil {
+				filters := make([]SnapshotFilter, 0)
+				for _, filter := range *snapshot.Filters {
+					filters = append(filters, SnapshotFilter{
+						Key:   utils.NormalizeNilableString(filter.Key),
+						Label: utils.NormalizeNilableString(filter.Label),
+					})
+				}
+				model.Filter = filters
+			}
+
+			return metadata.Encode(&model)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (s SnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourceID, err := parse.SnapshotId(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, resourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return nil
+			}
+
+			// snapshots cannot be deleted via the API - archiving is the closest equivalent,
+			// after which the snapshot is removed once its retention period expires
+			entity := appconfiguration.SnapshotUpdateParameters{
+				Status: utils.String(SnapshotStatusArchived),
+			}
+			if _, err = client.UpdateSnapshotStatus(ctx, resourceID.Name, &entity, "", ""); err != nil {
+				if v, ok := err.(autorest.DetailedError); ok {
+					if utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
+						return nil
+					}
+				}
+				return fmt.Errorf("archiving %s: %+v", resourceID, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func appConfigurationSnapshotStatusRefreshFunc(ctx context.Context, client *appconfiguration.BaseClient, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.GetSnapshot(ctx, name, "", "")
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving snapshot %q: %+v", name, err)
+		}
+
+		return res, utils.NormalizeNilableString(res.Status), nil
+	}
+}
@@ -0,0 +1,175 @@
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppConfigurationSnapshotResource struct{}
+
+func TestAccAppConfigurationSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("composition_type").HasValue("key"),
+				check.That(data.ResourceName).Key("etag").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("composition_type").HasValue("key_label"),
+				check.That(data.ResourceName).Key("retention_period_in_seconds").HasValue("3600"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AppConfigurationSnapshotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	resourceID, err := parse.SnapshotId(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	client, err := clients.AppConfiguration.DataPlaneClient(ctx, resourceID.ConfigurationStoreId)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		// if the AppConfiguration is gone all the data is too
+		return utils.Bool(false), nil
+	}
+
+	res, err := client.GetSnapshot(ctx, resourceID.Name, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("while checking for snapshot's %q existence: %+v", resourceID.Name, err)
+	}
+
+	return utils.Bool(res.Response.StatusCode == 200), nil
+}
+
+func (t AppConfigurationSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  name                   = "acctest-snapshot-%d"
+
+  filter {
+    key = "acctest-ackey-%d"
+  }
+
+  depends_on = [azurerm_app_configuration_key.test]
+}
+`, t.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (t AppConfigurationSnapshotResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "import" {
+  configuration_store_id = azurerm_app_configuration_snapshot.test.configuration_store_id
+  name                   = azurerm_app_configuration_snapshot.test.name
+
+  filter {
+    key = "acctest-ackey-%d"
+  }
+}
+`, t.basic(data), data.RandomInteger)
+}
+
+func (t AppConfigurationSnapshotResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  configuration_store_id      = azurerm_app_configuration.test.id
+  name                        = "acctest-snapshot-%d"
+  composition_type            = "key_label"
+  retention_period_in_seconds = 3600
+
+  filter {
+    key   = "acctest-ackey-%d"
+    label = "acctest-ackeylabel-%d"
+  }
+
+  depends_on = [azurerm_app_configuration_key.test]
+}
+`, t.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (t AppConfigurationSnapshotResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "test" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testaccappconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_app_configuration.test.id
+  role_definition_name = "App Configuration Data Owner"
+  principal_id         = data.azurerm_client_config.test.object_id
+}
+
+resource "azurerm_app_configuration_key" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-ackey-%d"
+  label                  = "acctest-ackeylabel-%d"
+  value                  = "a test"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
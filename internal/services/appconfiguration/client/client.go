@@ -13,11 +13,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/1.0/appconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2022-05-01/replicas"
 )
 
 type Client struct {
 	ConfigurationStoresClient        *configurationstores.ConfigurationStoresClient
 	DeletedConfigurationStoresClient *deletedconfigurationstores.DeletedConfigurationStoresClient
+	ReplicasClient                   *replicas.ReplicasClient
 	authorizerFunc                   common.ApiAuthorizerFunc
 	configureClientFunc              func(c *autorest.Client, authorizer autorest.Authorizer)
 }
@@ -96,9 +98,13 @@ func NewClient(o *common.ClientOptions) *Client {
 	deletedConfigurationStores := deletedconfigurationstores.NewDeletedConfigurationStoresClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&deletedConfigurationStores.Client, o.ResourceManagerAuthorizer)
 
+	replicasClient := replicas.NewReplicasClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&replicasClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ConfigurationStoresClient:        &configurationStores,
 		DeletedConfigurationStoresClient: &deletedConfigurationStores,
+		ReplicasClient:                   &replicasClient,
 		authorizerFunc:                   o.Authorizers.AuthorizerFunc,
 		configureClientFunc:              o.ConfigureClient,
 	}
@@ -0,0 +1,51 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = AppConfigurationKeysId{}
+
+type AppConfigurationKeysId struct {
+	ConfigurationStoreId string
+	Label                string
+}
+
+func (id AppConfigurationKeysId) ID() string {
+	return fmt.Sprintf("%s/AppConfigurationKeys/%s", id.ConfigurationStoreId, id.Label)
+}
+
+func (id AppConfigurationKeysId) String() string {
+	components := []string{
+		fmt.Sprintf("Configuration Store Id %q", id.ConfigurationStoreId),
+		fmt.Sprintf("Label %q", id.Label),
+	}
+	return fmt.Sprintf("Keys: %s", strings.Join(components, " / "))
+}
+
+func KeysId(input string) (*AppConfigurationKeysId, error) {
+	resourceID, err := parseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	label := resourceID.Path["AppConfigurationKeys"]
+
+	appcfgID := AppConfigurationKeysId{
+		Label: label,
+	}
+
+	// Golang's URL parser will translate %00 to \000 (NUL). This will only happen if we're dealing with an empty
+	// label, so we set the label to the expected value (empty string) and trim the input string, so we can properly
+	// extract the configuration store ID out of it.
+	if label == "\000" {
+		appcfgID.Label = ""
+		input = strings.TrimSuffix(input, "%00")
+	}
+	appcfgID.ConfigurationStoreId = strings.TrimSuffix(input, fmt.Sprintf("/AppConfigurationKeys/%s", appcfgID.Label))
+
+	return &appcfgID, nil
+}
@@ -0,0 +1,44 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = AppConfigurationSnapshotId{}
+
+type AppConfigurationSnapshotId struct {
+	ConfigurationStoreId string
+	Name                 string
+}
+
+func (id AppConfigurationSnapshotId) ID() string {
+	return fmt.Sprintf("%s/AppConfigurationSnapshot/%s", id.ConfigurationStoreId, id.Name)
+}
+
+func (id AppConfigurationSnapshotId) String() string {
+	components := []string{
+		fmt.Sprintf("Configuration Store Id %q", id.ConfigurationStoreId),
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	return fmt.Sprintf("Snapshot: %s", strings.Join(components, " / "))
+}
+
+func SnapshotId(input string) (*AppConfigurationSnapshotId, error) {
+	resourceID, err := parseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	name := resourceID.Path["AppConfigurationSnapshot"]
+	if name == "" {
+		return nil, fmt.Errorf("while parsing resource ID: `AppConfigurationSnapshot` segment was empty in %q", input)
+	}
+
+	return &AppConfigurationSnapshotId{
+		ConfigurationStoreId: strings.TrimSuffix(input, fmt.Sprintf("/AppConfigurationSnapshot/%s", name)),
+		Name:                 name,
+	}, nil
+}
@@ -26,7 +26,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyResource{},
+		KeysResource{},
 		FeatureResource{},
+		SnapshotResource{},
 	}
 }
 
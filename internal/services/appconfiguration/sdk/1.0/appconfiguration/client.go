@@ -1367,6 +1367,100 @@ func (client BaseClient) GetRevisionsComplete(ctx context.Context, key string, l
 	return
 }
 
+// GetSnapshot sends the get snapshot request.
+// Parameters:
+// name - the name of the snapshot.
+// ifMatch - used to perform an operation only if the targeted resource's etag matches the value provided.
+// ifNoneMatch - used to perform an operation only if the targeted resource's etag does not match the value
+// provided.
+func (client BaseClient) GetSnapshot(ctx context.Context, name string, ifMatch string, ifNoneMatch string) (result Snapshot, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetSnapshot")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.GetSnapshotPreparer(ctx, name, ifMatch, ifNoneMatch)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetSnapshotSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetSnapshotResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetSnapshotPreparer prepares the GetSnapshot request.
+func (client BaseClient) GetSnapshotPreparer(ctx context.Context, name string, ifMatch string, ifNoneMatch string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", name),
+	}
+
+	// snapshots are only available from the newer data plane API versions
+	const APIVersion = "2023-10-01"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/snapshots/{name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	if len(client.SyncToken) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("Sync-Token", autorest.String(client.SyncToken)))
+	}
+	if len(ifMatch) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("If-Match", autorest.String(ifMatch)))
+	}
+	if len(ifNoneMatch) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("If-None-Match", autorest.String(ifNoneMatch)))
+	}
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetSnapshotSender sends the GetSnapshot request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetSnapshotSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetSnapshotResponder handles the response to the GetSnapshot request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetSnapshotResponder(resp *http.Response) (result Snapshot, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
 // PutKeyValue sends the put key value request.
 // Parameters:
 // key - the key of the key-value to create.
@@ -1566,3 +1660,204 @@ func (client BaseClient) PutLockResponder(resp *http.Response) (result KeyValue,
 	result.Response = autorest.Response{Response: resp}
 	return
 }
+
+// PutSnapshot sends the put snapshot request.
+// Parameters:
+// name - the name of the snapshot.
+// entity - the snapshot to create.
+// ifMatch - used to perform an operation only if the targeted resource's etag matches the value provided.
+// ifNoneMatch - used to perform an operation only if the targeted resource's etag does not match the value
+// provided.
+func (client BaseClient) PutSnapshot(ctx context.Context, name string, entity *Snapshot, ifMatch string, ifNoneMatch string) (result Snapshot, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.PutSnapshot")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.PutSnapshotPreparer(ctx, name, entity, ifMatch, ifNoneMatch)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "PutSnapshot", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.PutSnapshotSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "PutSnapshot", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.PutSnapshotResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "PutSnapshot", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// PutSnapshotPreparer prepares the PutSnapshot request.
+func (client BaseClient) PutSnapshotPreparer(ctx context.Context, name string, entity *Snapshot, ifMatch string, ifNoneMatch string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", name),
+	}
+
+	// snapshots are only available from the newer data plane API versions
+	const APIVersion = "2023-10-01"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/snapshots/{name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	if entity != nil {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithJSON(entity))
+	}
+	if len(client.SyncToken) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("Sync-Token", autorest.String(client.SyncToken)))
+	}
+	if len(ifMatch) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("If-Match", autorest.String(ifMatch)))
+	}
+	if len(ifNoneMatch) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("If-None-Match", autorest.String(ifNoneMatch)))
+	}
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// PutSnapshotSender sends the PutSnapshot request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) PutSnapshotSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// PutSnapshotResponder handles the response to the PutSnapshot request. The method always
+// closes the http.Response Body.
+func (client BaseClient) PutSnapshotResponder(resp *http.Response) (result Snapshot, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// UpdateSnapshotStatus sends the update snapshot status request. Snapshots cannot be deleted - instead they
+// are archived (and later recovered) by patching their status.
+// Parameters:
+// name - the name of the snapshot.
+// entity - the new status of the snapshot.
+// ifMatch - used to perform an operation only if the targeted resource's etag matches the value provided.
+// ifNoneMatch - used to perform an operation only if the targeted resource's etag does not match the value
+// provided.
+func (client BaseClient) UpdateSnapshotStatus(ctx context.Context, name string, entity *SnapshotUpdateParameters, ifMatch string, ifNoneMatch string) (result Snapshot, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.UpdateSnapshotStatus")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.UpdateSnapshotStatusPreparer(ctx, name, entity, ifMatch, ifNoneMatch)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "UpdateSnapshotStatus", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UpdateSnapshotStatusSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "UpdateSnapshotStatus", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UpdateSnapshotStatusResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "UpdateSnapshotStatus", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UpdateSnapshotStatusPreparer prepares the UpdateSnapshotStatus request.
+func (client BaseClient) UpdateSnapshotStatusPreparer(ctx context.Context, name string, entity *SnapshotUpdateParameters, ifMatch string, ifNoneMatch string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", name),
+	}
+
+	// snapshots are only available from the newer data plane API versions
+	const APIVersion = "2023-10-01"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/merge-patch+json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/snapshots/{name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	if entity != nil {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithJSON(entity))
+	}
+	if len(client.SyncToken) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("Sync-Token", autorest.String(client.SyncToken)))
+	}
+	if len(ifMatch) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("If-Match", autorest.String(ifMatch)))
+	}
+	if len(ifNoneMatch) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("If-None-Match", autorest.String(ifNoneMatch)))
+	}
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// UpdateSnapshotStatusSender sends the UpdateSnapshotStatus request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) UpdateSnapshotStatusSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// UpdateSnapshotStatusResponder handles the response to the UpdateSnapshotStatus request. The method always
+// closes the http.Response Body.
+func (client BaseClient) UpdateSnapshotStatusResponder(resp *http.Response) (result Snapshot, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -248,6 +248,12 @@ func (kv KeyValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(objectMap)
 }
 
+// KeyValueFilter enables filtering of key-values.
+type KeyValueFilter struct {
+	Key   *string `json:"key,omitempty"`
+	Label *string `json:"label,omitempty"`
+}
+
 // KeyValueListResult the result of a list request.
 type KeyValueListResult struct {
 	autorest.Response `json:"-"`
@@ -577,3 +583,42 @@ func NewLabelListResultPage(cur LabelListResult, getNextPage func(context.Contex
 		llr: cur,
 	}
 }
+
+// Snapshot a point-in-time snapshot of key-values within a configuration store.
+type Snapshot struct {
+	autorest.Response `json:"-"`
+	Name              *string            `json:"name,omitempty"`
+	Status            *string            `json:"status,omitempty"`
+	Filters           *[]KeyValueFilter  `json:"filters,omitempty"`
+	CompositionType   *string            `json:"composition_type,omitempty"`
+	Created           *date.Time         `json:"created,omitempty"`
+	Expires           *date.Time         `json:"expires,omitempty"`
+	RetentionPeriod   *int64             `json:"retention_period,omitempty"`
+	Size              *int64             `json:"size,omitempty"`
+	ItemsCount        *int64             `json:"items_count,omitempty"`
+	Tags              map[string]*string `json:"tags"`
+	Etag              *string            `json:"etag,omitempty"`
+}
+
+// MarshalJSON is the custom marshaler for Snapshot.
+func (s Snapshot) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]interface{})
+	if s.Filters != nil {
+		objectMap["filters"] = s.Filters
+	}
+	if s.CompositionType != nil {
+		objectMap["composition_type"] = s.CompositionType
+	}
+	if s.RetentionPeriod != nil {
+		objectMap["retention_period"] = s.RetentionPeriod
+	}
+	if s.Tags != nil {
+		objectMap["tags"] = s.Tags
+	}
+	return json.Marshal(objectMap)
+}
+
+// SnapshotUpdateParameters parameters used to update the status of a snapshot.
+type SnapshotUpdateParameters struct {
+	Status *string `json:"status,omitempty"`
+}
@@ -0,0 +1,9 @@
+package replicas
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ReplicaProperties struct {
+	Endpoint          *string                   `json:"endpoint,omitempty"`
+	ProvisioningState *ReplicaProvisioningState `json:"provisioningState,omitempty"`
+}
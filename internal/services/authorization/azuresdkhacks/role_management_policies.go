@@ -0,0 +1,170 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicies"
+)
+
+// The generated SDK models the individual Role Management Policy Rules as an empty interface,
+// which makes both reading the current rules and patching a subset of them impossible - so
+// until the SDK exposes concrete types for each rule kind we re-implement the Get and Update
+// methods using a flattened rule model which covers every rule kind returned by the API.
+
+type RoleManagementPoliciesWorkaroundClient struct {
+	sdkClient *rolemanagementpolicies.RoleManagementPoliciesClient
+	baseUri   string
+}
+
+func NewRoleManagementPoliciesWorkaroundClient(client *rolemanagementpolicies.RoleManagementPoliciesClient, endpoint string) RoleManagementPoliciesWorkaroundClient {
+	return RoleManagementPoliciesWorkaroundClient{
+		sdkClient: client,
+		baseUri:   endpoint,
+	}
+}
+
+type RoleManagementPolicy struct {
+	Id         *string                         `json:"id,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Type       *string                         `json:"type,omitempty"`
+	Properties *RoleManagementPolicyProperties `json:"properties,omitempty"`
+}
+
+type RoleManagementPolicyProperties struct {
+	Description           *string                     `json:"description,omitempty"`
+	DisplayName           *string                     `json:"displayName,omitempty"`
+	IsOrganizationDefault *bool                       `json:"isOrganizationDefault,omitempty"`
+	Rules                 *[]RoleManagementPolicyRule `json:"rules,omitempty"`
+	Scope                 *string                     `json:"scope,omitempty"`
+}
+
+// RoleManagementPolicyRule is a union of the fields used by the expiration, enablement,
+// authentication context, approval and notification rule kinds - the RuleType field
+// indicates which of them are significant for a given rule.
+type RoleManagementPolicyRule struct {
+	Id       *string                                                `json:"id,omitempty"`
+	RuleType string                                                 `json:"ruleType"`
+	Target   *rolemanagementpolicies.RoleManagementPolicyRuleTarget `json:"target,omitempty"`
+
+	// RoleManagementPolicyExpirationRule
+	IsExpirationRequired *bool   `json:"isExpirationRequired,omitempty"`
+	MaximumDuration      *string `json:"maximumDuration,omitempty"`
+
+	// RoleManagementPolicyEnablementRule
+	EnabledRules *[]string `json:"enabledRules,omitempty"`
+
+	// RoleManagementPolicyAuthenticationContextRule
+	IsEnabled  *bool   `json:"isEnabled,omitempty"`
+	ClaimValue *string `json:"claimValue,omitempty"`
+
+	// RoleManagementPolicyApprovalRule
+	Setting *ApprovalSettings `json:"setting,omitempty"`
+
+	// RoleManagementPolicyNotificationRule
+	IsDefaultRecipientsEnabled *bool     `json:"isDefaultRecipientsEnabled,omitempty"`
+	NotificationLevel          *string   `json:"notificationLevel,omitempty"`
+	NotificationRecipients     *[]string `json:"notificationRecipients,omitempty"`
+	NotificationType           *string   `json:"notificationType,omitempty"`
+	RecipientType              *string   `json:"recipientType,omitempty"`
+}
+
+type ApprovalSettings struct {
+	ApprovalMode                     *string          `json:"approvalMode,omitempty"`
+	ApprovalStages                   *[]ApprovalStage `json:"approvalStages,omitempty"`
+	IsApprovalRequired               *bool            `json:"isApprovalRequired,omitempty"`
+	IsApprovalRequiredForExtension   *bool            `json:"isApprovalRequiredForExtension,omitempty"`
+	IsRequestorJustificationRequired *bool            `json:"isRequestorJustificationRequired,omitempty"`
+}
+
+type ApprovalStage struct {
+	ApprovalStageTimeOutInDays      *int64     `json:"approvalStageTimeOutInDays,omitempty"`
+	EscalationApprovers             *[]UserSet `json:"escalationApprovers,omitempty"`
+	EscalationTimeInMinutes         *int64     `json:"escalationTimeInMinutes,omitempty"`
+	IsApproverJustificationRequired *bool      `json:"isApproverJustificationRequired,omitempty"`
+	IsEscalationEnabled             *bool      `json:"isEscalationEnabled,omitempty"`
+	PrimaryApprovers                *[]UserSet `json:"primaryApprovers,omitempty"`
+}
+
+type UserSet struct {
+	Description *string `json:"description,omitempty"`
+	Id          *string `json:"id,omitempty"`
+	IsBackup    *bool   `json:"isBackup,omitempty"`
+	UserType    *string `json:"userType,omitempty"`
+}
+
+type GetRoleManagementPolicyResponse struct {
+	HttpResponse *http.Response
+	Model        *RoleManagementPolicy
+}
+
+func (c RoleManagementPoliciesWorkaroundClient) Get(ctx context.Context, id rolemanagementpolicies.ScopedRoleManagementPolicyId) (result GetRoleManagementPolicyResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet(), nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.RoleManagementPoliciesWorkaroundClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.sdkClient.Client.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.RoleManagementPoliciesWorkaroundClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.RoleManagementPoliciesWorkaroundClient", "Get", result.HttpResponse, "Failure responding to request")
+	}
+	return
+}
+
+func (c RoleManagementPoliciesWorkaroundClient) Update(ctx context.Context, id rolemanagementpolicies.ScopedRoleManagementPolicyId, input RoleManagementPolicy) (result GetRoleManagementPolicyResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsPatch(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.RoleManagementPoliciesWorkaroundClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.sdkClient.Client.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.RoleManagementPoliciesWorkaroundClient", "Update", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.RoleManagementPoliciesWorkaroundClient", "Update", result.HttpResponse, "Failure responding to request")
+	}
+	return
+}
+
+func (c RoleManagementPoliciesWorkaroundClient) preparer(ctx context.Context, id rolemanagementpolicies.ScopedRoleManagementPolicyId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": "2020-10-01",
+	}
+
+	prepareDecorators := []autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}
+	for _, decorator := range decorators {
+		if decorator != nil {
+			prepareDecorators = append(prepareDecorators, decorator)
+		}
+	}
+
+	preparer := autorest.CreatePreparer(prepareDecorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -2,12 +2,21 @@ package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization" // nolint: staticcheck // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityscheduleinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityschedulerequests"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/azuresdkhacks"
 )
 
 type Client struct {
-	RoleAssignmentsClient *authorization.RoleAssignmentsClient
-	RoleDefinitionsClient *authorization.RoleDefinitionsClient
+	RoleAssignmentsClient                  *authorization.RoleAssignmentsClient
+	RoleDefinitionsClient                  *authorization.RoleDefinitionsClient
+	RoleEligibilityScheduleInstancesClient *roleeligibilityscheduleinstances.RoleEligibilityScheduleInstancesClient
+	RoleEligibilityScheduleRequestsClient  *roleeligibilityschedulerequests.RoleEligibilityScheduleRequestsClient
+	RoleManagementPoliciesClient           *azuresdkhacks.RoleManagementPoliciesWorkaroundClient
+	RoleManagementPolicyAssignmentsClient  *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -17,8 +26,25 @@ func NewClient(o *common.ClientOptions) *Client {
 	roleDefinitionsClient := authorization.NewRoleDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&roleDefinitionsClient.Client, o.ResourceManagerAuthorizer)
 
+	roleEligibilityScheduleInstancesClient := roleeligibilityscheduleinstances.NewRoleEligibilityScheduleInstancesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleEligibilityScheduleInstancesClient.Client, o.ResourceManagerAuthorizer)
+
+	roleEligibilityScheduleRequestsClient := roleeligibilityschedulerequests.NewRoleEligibilityScheduleRequestsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleEligibilityScheduleRequestsClient.Client, o.ResourceManagerAuthorizer)
+
+	roleManagementPoliciesClient := rolemanagementpolicies.NewRoleManagementPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleManagementPoliciesClient.Client, o.ResourceManagerAuthorizer)
+	roleManagementPoliciesWorkaroundClient := azuresdkhacks.NewRoleManagementPoliciesWorkaroundClient(&roleManagementPoliciesClient, o.ResourceManagerEndpoint)
+
+	roleManagementPolicyAssignmentsClient := rolemanagementpolicyassignments.NewRoleManagementPolicyAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleManagementPolicyAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		RoleAssignmentsClient: &roleAssignmentsClient,
-		RoleDefinitionsClient: &roleDefinitionsClient,
+		RoleAssignmentsClient:                  &roleAssignmentsClient,
+		RoleDefinitionsClient:                  &roleDefinitionsClient,
+		RoleEligibilityScheduleInstancesClient: &roleEligibilityScheduleInstancesClient,
+		RoleEligibilityScheduleRequestsClient:  &roleEligibilityScheduleRequestsClient,
+		RoleManagementPoliciesClient:           &roleManagementPoliciesWorkaroundClient,
+		RoleManagementPolicyAssignmentsClient:  &roleManagementPolicyAssignmentsClient,
 	}
 }
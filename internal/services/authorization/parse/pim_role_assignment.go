@@ -0,0 +1,56 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PimRoleAssignmentId is a composite ID of the scope, role definition and principal that a
+// PIM role assignment schedule applies to - the service generates a new schedule (and
+// schedule request) name for every change, so the stable identifier for the assignment is
+// the combination of these three values, joined with a "|".
+type PimRoleAssignmentId struct {
+	Scope            string
+	RoleDefinitionId string
+	PrincipalId      string
+}
+
+func NewPimRoleAssignmentID(scope string, roleDefinitionId string, principalId string) PimRoleAssignmentId {
+	return PimRoleAssignmentId{
+		Scope:            scope,
+		RoleDefinitionId: roleDefinitionId,
+		PrincipalId:      principalId,
+	}
+}
+
+func PimRoleAssignmentID(input string) (*PimRoleAssignmentId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("expected an ID in the format `{scope}|{roleDefinitionId}|{principalId}` but got %q", input)
+	}
+
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("expected an ID in the format `{scope}|{roleDefinitionId}|{principalId}` but got %q", input)
+		}
+	}
+
+	return &PimRoleAssignmentId{
+		Scope:            segments[0],
+		RoleDefinitionId: segments[1],
+		PrincipalId:      segments[2],
+	}, nil
+}
+
+func (id PimRoleAssignmentId) ID() string {
+	return fmt.Sprintf("%s|%s|%s", id.Scope, id.RoleDefinitionId, id.PrincipalId)
+}
+
+func (id PimRoleAssignmentId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope %q", id.Scope),
+		fmt.Sprintf("Role Definition Id %q", id.RoleDefinitionId),
+		fmt.Sprintf("Principal Id %q", id.PrincipalId),
+	}
+	return fmt.Sprintf("Pim Role Assignment (%s)", strings.Join(components, " / "))
+}
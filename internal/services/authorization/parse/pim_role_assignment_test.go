@@ -0,0 +1,60 @@
+package parse
+
+import "testing"
+
+func TestPimRoleAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PimRoleAssignmentId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing principal id
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000|/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/11111111-1111-1111-1111-111111111111",
+			Error: true,
+		},
+		{
+			// empty segment
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000||22222222-2222-2222-2222-222222222222",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000|/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/11111111-1111-1111-1111-111111111111|22222222-2222-2222-2222-222222222222",
+			Expected: &PimRoleAssignmentId{
+				Scope:            "/subscriptions/00000000-0000-0000-0000-000000000000",
+				RoleDefinitionId: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/11111111-1111-1111-1111-111111111111",
+				PrincipalId:      "22222222-2222-2222-2222-222222222222",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PimRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.RoleDefinitionId != v.Expected.RoleDefinitionId {
+			t.Fatalf("Expected %q but got %q for RoleDefinitionId", v.Expected.RoleDefinitionId, actual.RoleDefinitionId)
+		}
+		if actual.PrincipalId != v.Expected.PrincipalId {
+			t.Fatalf("Expected %q but got %q for PrincipalId", v.Expected.PrincipalId, actual.PrincipalId)
+		}
+	}
+}
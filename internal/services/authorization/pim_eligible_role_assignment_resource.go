@@ -0,0 +1,472 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityscheduleinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityschedulerequests"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.Resource = PimEligibleRoleAssignmentResource{}
+
+type PimEligibleRoleAssignmentResource struct{}
+
+type PimEligibleRoleAssignmentModel struct {
+	Scope            string                              `tfschema:"scope"`
+	RoleDefinitionId string                              `tfschema:"role_definition_id"`
+	PrincipalId      string                              `tfschema:"principal_id"`
+	PrincipalType    string                              `tfschema:"principal_type"`
+	Justification    string                              `tfschema:"justification"`
+	Ticket           []PimEligibleRoleAssignmentTicket   `tfschema:"ticket"`
+	Schedule         []PimEligibleRoleAssignmentSchedule `tfschema:"schedule"`
+}
+
+type PimEligibleRoleAssignmentTicket struct {
+	Number string `tfschema:"number"`
+	System string `tfschema:"system"`
+}
+
+type PimEligibleRoleAssignmentSchedule struct {
+	StartDateTime string                                `tfschema:"start_date_time"`
+	Expiration    []PimEligibleRoleAssignmentExpiration `tfschema:"expiration"`
+}
+
+type PimEligibleRoleAssignmentExpiration struct {
+	DurationDays  int64  `tfschema:"duration_days"`
+	DurationHours int64  `tfschema:"duration_hours"`
+	EndDateTime   string `tfschema:"end_date_time"`
+}
+
+func (PimEligibleRoleAssignmentResource) ResourceType() string {
+	return "azurerm_pim_eligible_role_assignment"
+}
+
+func (PimEligibleRoleAssignmentResource) ModelObject() interface{} {
+	return &PimEligibleRoleAssignmentModel{}
+}
+
+func (PimEligibleRoleAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PimRoleAssignmentID
+}
+
+func (PimEligibleRoleAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The scope at which the Role Assignment applies to, such as a Subscription, Resource Group or Management Group.",
+		},
+
+		"role_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The Scoped-ID of the Role Definition.",
+		},
+
+		"principal_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "The ID of the Principal (User, Group or Service Principal) that the Role Definition is assigned to.",
+		},
+
+		"justification": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The justification for this Role Assignment.",
+		},
+
+		"ticket": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"number": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The ticket number used to track this Role Assignment.",
+					},
+					"system": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The ticket system used to track this Role Assignment.",
+					},
+				},
+			},
+			Description: "The ticket details relating to this Role Assignment.",
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_date_time": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+						Description:  "The RFC3339 formatted date from which this Role Assignment is eligible.",
+					},
+					"expiration": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Computed: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"duration_days": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Computed:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+									ConflictsWith: []string{
+										"schedule.0.expiration.0.duration_hours",
+										"schedule.0.expiration.0.end_date_time",
+									},
+									Description: "The number of days this Role Assignment is eligible for.",
+								},
+								"duration_hours": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Computed:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+									ConflictsWith: []string{
+										"schedule.0.expiration.0.duration_days",
+										"schedule.0.expiration.0.end_date_time",
+									},
+									Description: "The number of hours this Role Assignment is eligible for.",
+								},
+								"end_date_time": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Computed:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsRFC3339Time,
+									ConflictsWith: []string{
+										"schedule.0.expiration.0.duration_days",
+										"schedule.0.expiration.0.duration_hours",
+									},
+									Description: "The RFC3339 formatted date until which this Role Assignment is eligible.",
+								},
+							},
+						},
+						Description: "The expiration of this Role Assignment.",
+					},
+				},
+			},
+			Description: "The schedule for which this Role Assignment is eligible.",
+		},
+	}
+}
+
+func (PimEligibleRoleAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"principal_type": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The type of the `principal_id`, e.g. User, Group, Service Principal, Application, etc.",
+		},
+	}
+}
+
+func (r PimEligibleRoleAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Authorization.RoleEligibilityScheduleRequestsClient
+			instancesClient := metadata.Client.Authorization.RoleEligibilityScheduleInstancesClient
+
+			var model PimEligibleRoleAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewPimRoleAssignmentID(model.Scope, model.RoleDefinitionId, model.PrincipalId)
+
+			existing, err := findRoleEligibilityScheduleInstance(ctx, instancesClient, id)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			requestName, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating a name for the Role Eligibility Schedule Request: %+v", err)
+			}
+			requestId := roleeligibilityschedulerequests.NewScopedRoleEligibilityScheduleRequestID(id.Scope, requestName)
+
+			payload := roleeligibilityschedulerequests.RoleEligibilityScheduleRequest{
+				Properties: &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestProperties{
+					PrincipalId:      id.PrincipalId,
+					RequestType:      roleeligibilityschedulerequests.RequestTypeAdminAssign,
+					RoleDefinitionId: id.RoleDefinitionId,
+					ScheduleInfo:     expandPimEligibleRoleAssignmentSchedule(model.Schedule),
+				},
+			}
+			if model.Justification != "" {
+				payload.Properties.Justification = utils.String(model.Justification)
+			}
+			if len(model.Ticket) > 0 {
+				ticket := model.Ticket[0]
+				payload.Properties.TicketInfo = &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestPropertiesTicketInfo{}
+				if ticket.Number != "" {
+					payload.Properties.TicketInfo.TicketNumber = utils.String(ticket.Number)
+				}
+				if ticket.System != "" {
+					payload.Properties.TicketInfo.TicketSystem = utils.String(ticket.System)
+				}
+			}
+
+			if _, err = client.Create(ctx, requestId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// the request is processed asynchronously - wait for the schedule instance it
+			// provisions to become visible before setting the ID, so that the subsequent
+			// Read doesn't race it
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:                   []string{"Missing"},
+				Target:                    []string{"Found"},
+				Refresh:                   pimEligibleRoleAssignmentRefreshFunc(ctx, instancesClient, id),
+				MinTimeout:                30 * time.Second,
+				ContinuousTargetOccurence: 2,
+				Timeout:                   time.Until(deadline),
+			}
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to become available: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (PimEligibleRoleAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			instancesClient := metadata.Client.Authorization.RoleEligibilityScheduleInstancesClient
+
+			id, err := parse.PimRoleAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			instance, err := findRoleEligibilityScheduleInstance(ctx, instancesClient, *id)
+			if err != nil {
+				return err
+			}
+			if instance == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			// the justification, ticket and requested schedule only exist on the original
+			// schedule request, which expires shortly after being processed, so these are
+			// carried over from the prior state
+			var state PimEligibleRoleAssignmentModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Scope = id.Scope
+			state.RoleDefinitionId = id.RoleDefinitionId
+			state.PrincipalId = id.PrincipalId
+
+			if props := instance.Properties; props != nil {
+				if props.PrincipalType != nil {
+					state.PrincipalType = string(*props.PrincipalType)
+				}
+
+				if len(state.Schedule) == 0 {
+					state.Schedule = []PimEligibleRoleAssignmentSchedule{{}}
+				}
+				if props.StartDateTime != nil {
+					state.Schedule[0].StartDateTime = *props.StartDateTime
+				}
+				if props.EndDateTime != nil {
+					if len(state.Schedule[0].Expiration) == 0 {
+						state.Schedule[0].Expiration = []PimEligibleRoleAssignmentExpiration{{}}
+					}
+					// when the expiration was configured as a duration, re-deriving it from
+					// the end date would cause a spurious diff, so only the end date is synced
+					if state.Schedule[0].Expiration[0].DurationDays == 0 && state.Schedule[0].Expiration[0].DurationHours == 0 {
+						state.Schedule[0].Expiration[0].EndDateTime = *props.EndDateTime
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (PimEligibleRoleAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Authorization.RoleEligibilityScheduleRequestsClient
+			instancesClient := metadata.Client.Authorization.RoleEligibilityScheduleInstancesClient
+
+			id, err := parse.PimRoleAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PimEligibleRoleAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			requestName, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating a name for the Role Eligibility Schedule Request: %+v", err)
+			}
+			requestId := roleeligibilityschedulerequests.NewScopedRoleEligibilityScheduleRequestID(id.Scope, requestName)
+
+			payload := roleeligibilityschedulerequests.RoleEligibilityScheduleRequest{
+				Properties: &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestProperties{
+					PrincipalId:      id.PrincipalId,
+					RequestType:      roleeligibilityschedulerequests.RequestTypeAdminRemove,
+					RoleDefinitionId: id.RoleDefinitionId,
+				},
+			}
+			if model.Justification != "" {
+				payload.Properties.Justification = utils.String(model.Justification)
+			}
+
+			if _, err = client.Create(ctx, requestId, payload); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:    []string{"Found"},
+				Target:     []string{"Missing"},
+				Refresh:    pimEligibleRoleAssignmentRefreshFunc(ctx, instancesClient, *id),
+				MinTimeout: 30 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to be removed: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPimEligibleRoleAssignmentSchedule(input []PimEligibleRoleAssignmentSchedule) *roleeligibilityschedulerequests.RoleEligibilityScheduleRequestPropertiesScheduleInfo {
+	expirationType := roleeligibilityschedulerequests.TypeNoExpiration
+	scheduleInfo := &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestPropertiesScheduleInfo{
+		Expiration: &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestPropertiesScheduleInfoExpiration{
+			Type: &expirationType,
+		},
+	}
+
+	if len(input) == 0 {
+		return scheduleInfo
+	}
+
+	schedule := input[0]
+	if schedule.StartDateTime != "" {
+		scheduleInfo.StartDateTime = utils.String(schedule.StartDateTime)
+	}
+
+	if len(schedule.Expiration) > 0 {
+		expiration := schedule.Expiration[0]
+		switch {
+		case expiration.DurationDays != 0:
+			expirationType = roleeligibilityschedulerequests.TypeAfterDuration
+			scheduleInfo.Expiration.Duration = utils.String(fmt.Sprintf("P%dD", expiration.DurationDays))
+		case expiration.DurationHours != 0:
+			expirationType = roleeligibilityschedulerequests.TypeAfterDuration
+			scheduleInfo.Expiration.Duration = utils.String(fmt.Sprintf("PT%dH", expiration.DurationHours))
+		case expiration.EndDateTime != "":
+			expirationType = roleeligibilityschedulerequests.TypeAfterDateTime
+			scheduleInfo.Expiration.EndDateTime = utils.String(expiration.EndDateTime)
+		}
+		scheduleInfo.Expiration.Type = &expirationType
+	}
+
+	return scheduleInfo
+}
+
+func findRoleEligibilityScheduleInstance(ctx context.Context, client *roleeligibilityscheduleinstances.RoleEligibilityScheduleInstancesClient, id parse.PimRoleAssignmentId) (*roleeligibilityscheduleinstances.RoleEligibilityScheduleInstance, error) {
+	options := roleeligibilityscheduleinstances.ListForScopeOperationOptions{
+		Filter: utils.String(fmt.Sprintf("assignedTo('%s')", id.PrincipalId)),
+	}
+
+	instances, err := client.ListForScopeComplete(ctx, commonids.NewScopeID(id.Scope), options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Eligibility Schedule Instances for %s: %+v", id, err)
+	}
+
+	for _, instance := range instances.Items {
+		if props := instance.Properties; props != nil {
+			if props.RoleDefinitionId != nil && strings.EqualFold(*props.RoleDefinitionId, id.RoleDefinitionId) &&
+				props.Scope != nil && strings.EqualFold(*props.Scope, id.Scope) &&
+				props.MemberType != nil && *props.MemberType == roleeligibilityscheduleinstances.MemberTypeDirect {
+				return &instance, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func pimEligibleRoleAssignmentRefreshFunc(ctx context.Context, client *roleeligibilityscheduleinstances.RoleEligibilityScheduleInstancesClient, id parse.PimRoleAssignmentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := findRoleEligibilityScheduleInstance(ctx, client, id)
+		if err != nil {
+			return nil, "Error", err
+		}
+		if instance == nil {
+			return "NotFound", "Missing", nil
+		}
+		return instance, "Found", nil
+	}
+}
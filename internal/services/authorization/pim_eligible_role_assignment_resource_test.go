@@ -0,0 +1,161 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityscheduleinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PimEligibleRoleAssignmentResource struct{}
+
+func TestAccPimEligibleRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_eligible_role_assignment", "test")
+	r := PimEligibleRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_type").HasValue("ServicePrincipal"),
+			),
+		},
+		data.ImportStep("justification", "schedule", "ticket"),
+	})
+}
+
+func TestAccPimEligibleRoleAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_eligible_role_assignment", "test")
+	r := PimEligibleRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPimEligibleRoleAssignment_expirationByDuration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_eligible_role_assignment", "test")
+	r := PimEligibleRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.expirationByDuration(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.expiration.0.duration_hours").HasValue("8"),
+			),
+		},
+		data.ImportStep("justification", "schedule", "ticket"),
+	})
+}
+
+func (PimEligibleRoleAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PimRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := roleeligibilityscheduleinstances.ListForScopeOperationOptions{
+		Filter: utils.String(fmt.Sprintf("assignedTo('%s')", id.PrincipalId)),
+	}
+	instances, err := client.Authorization.RoleEligibilityScheduleInstancesClient.ListForScopeComplete(ctx, commonids.NewScopeID(id.Scope), options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Eligibility Schedule Instances for %s: %+v", id, err)
+	}
+
+	for _, instance := range instances.Items {
+		if props := instance.Properties; props != nil {
+			if props.RoleDefinitionId != nil && strings.EqualFold(*props.RoleDefinitionId, id.RoleDefinitionId) &&
+				props.Scope != nil && strings.EqualFold(*props.Scope, id.Scope) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (PimEligibleRoleAssignmentResource) template() string {
+	return `
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_client_config" "test" {}
+
+data "azurerm_role_definition" "test" {
+  name = "Monitoring Data Reader"
+}
+`
+}
+
+func (r PimEligibleRoleAssignmentResource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_pim_eligible_role_assignment" "test" {
+  scope              = data.azurerm_subscription.primary.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+  principal_id       = data.azurerm_client_config.test.object_id
+  justification      = "acceptance testing"
+}
+`, r.template())
+}
+
+func (r PimEligibleRoleAssignmentResource) requiresImport(_ acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_eligible_role_assignment" "import" {
+  scope              = azurerm_pim_eligible_role_assignment.test.scope
+  role_definition_id = azurerm_pim_eligible_role_assignment.test.role_definition_id
+  principal_id       = azurerm_pim_eligible_role_assignment.test.principal_id
+}
+`, r.basic())
+}
+
+func (r PimEligibleRoleAssignmentResource) expirationByDuration() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_pim_eligible_role_assignment" "test" {
+  scope              = data.azurerm_subscription.primary.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+  principal_id       = data.azurerm_client_config.test.object_id
+  justification      = "acceptance testing"
+
+  ticket {
+    number = "1"
+    system = "example ticket system"
+  }
+
+  schedule {
+    expiration {
+      duration_hours = 8
+    }
+  }
+}
+`, r.template())
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -127,7 +128,12 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				RequiredWith: []string{"condition_version"},
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validate.RoleAssignmentCondition,
+				// the service normalizes the formatting of the condition server-side, so
+				// insignificant whitespace differences shouldn't force a recreation
+				DiffSuppressFunc: func(_, old, new string, _ *pluginsdk.ResourceData) bool {
+					return validate.NormalizeRoleAssignmentCondition(old) == validate.NormalizeRoleAssignmentCondition(new)
+				},
 			},
 
 			"condition_version": {
@@ -0,0 +1,551 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicyassignments"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = RoleManagementPolicyResource{}
+
+type RoleManagementPolicyResource struct{}
+
+type RoleManagementPolicyModel struct {
+	Scope             string                                  `tfschema:"scope"`
+	RoleDefinitionId  string                                  `tfschema:"role_definition_id"`
+	Name              string                                  `tfschema:"name"`
+	DisplayName       string                                  `tfschema:"display_name"`
+	ActivationRules   []RoleManagementPolicyActivationRules   `tfschema:"activation_rules"`
+	NotificationRules []RoleManagementPolicyNotificationRules `tfschema:"notification_rules"`
+}
+
+type RoleManagementPolicyActivationRules struct {
+	MaximumDuration string                              `tfschema:"maximum_duration"`
+	RequireApproval bool                                `tfschema:"require_approval"`
+	ApprovalStages  []RoleManagementPolicyApprovalStage `tfschema:"approval_stage"`
+}
+
+type RoleManagementPolicyApprovalStage struct {
+	PrimaryApprovers []RoleManagementPolicyApprover `tfschema:"primary_approver"`
+}
+
+type RoleManagementPolicyApprover struct {
+	ID   string `tfschema:"object_id"`
+	Type string `tfschema:"type"`
+}
+
+type RoleManagementPolicyNotificationRules struct {
+	EligibleActivations []RoleManagementPolicyNotificationEvent `tfschema:"eligible_activations"`
+}
+
+type RoleManagementPolicyNotificationEvent struct {
+	AdminNotifications    []RoleManagementPolicyNotificationSettings `tfschema:"admin_notifications"`
+	ApproverNotifications []RoleManagementPolicyNotificationSettings `tfschema:"approver_notifications"`
+	AssigneeNotifications []RoleManagementPolicyNotificationSettings `tfschema:"assignee_notifications"`
+}
+
+type RoleManagementPolicyNotificationSettings struct {
+	NotificationLevel    string   `tfschema:"notification_level"`
+	DefaultRecipients    bool     `tfschema:"default_recipients"`
+	AdditionalRecipients []string `tfschema:"additional_recipients"`
+}
+
+func (RoleManagementPolicyResource) ResourceType() string {
+	return "azurerm_role_management_policy"
+}
+
+func (RoleManagementPolicyResource) ModelObject() interface{} {
+	return &RoleManagementPolicyModel{}
+}
+
+func (RoleManagementPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return rolemanagementpolicies.ValidateScopedRoleManagementPolicyID
+}
+
+func (RoleManagementPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The scope of the role to which this policy applies, such as a Subscription, Resource Group or Management Group.",
+		},
+
+		"role_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The Scoped-ID of the Role Definition to which this policy applies.",
+		},
+
+		"activation_rules": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			Computed:     true,
+			MaxItems:     1,
+			AtLeastOneOf: []string{"activation_rules", "notification_rules"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"maximum_duration": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: azValidate.ISO8601Duration,
+						Description:  "The maximum length of time an activated role is valid for, as an ISO8601 duration (e.g. `PT8H`).",
+					},
+					"require_approval": {
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether an approval is required for activation.",
+					},
+					"approval_stage": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						RequiredWith: []string{"activation_rules.0.require_approval"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"primary_approver": {
+									Type:     pluginsdk.TypeSet,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"object_id": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.IsUUID,
+												Description:  "The ID of the object which will act as an approver.",
+											},
+											"type": {
+												Type:     pluginsdk.TypeString,
+												Optional: true,
+												Default:  "User",
+												ValidateFunc: validation.StringInSlice([]string{
+													"User",
+													"Group",
+												}, false),
+												Description: "The type of object acting as an approver, either `User` or `Group`.",
+											},
+										},
+									},
+									Description: "The IDs of the users or groups who can approve the activation.",
+								},
+							},
+						},
+						Description: "The approval stage for role activation.",
+					},
+				},
+			},
+			Description: "The rules applied when the role is activated.",
+		},
+
+		"notification_rules": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			Computed:     true,
+			MaxItems:     1,
+			AtLeastOneOf: []string{"activation_rules", "notification_rules"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"eligible_activations": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Computed: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"admin_notifications":    roleManagementPolicyNotificationSettingsSchema("Notifications sent to the admins when an eligible role is activated."),
+								"approver_notifications": roleManagementPolicyNotificationSettingsSchema("Notifications sent to the approvers when an eligible role is activated."),
+								"assignee_notifications": roleManagementPolicyNotificationSettingsSchema("Notifications sent to the assignee when their eligible role is activated."),
+							},
+						},
+						Description: "The notifications sent when an eligible role is activated.",
+					},
+				},
+			},
+			Description: "The notification rules applied to this policy.",
+		},
+	}
+}
+
+func (RoleManagementPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The name of this policy.",
+		},
+
+		"display_name": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The display name of this policy.",
+		},
+	}
+}
+
+func roleManagementPolicyNotificationSettingsSchema(description string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"notification_level": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"All",
+						"Critical",
+					}, false),
+					Description: "What level of notifications should be sent, either `All` or `Critical`.",
+				},
+				"default_recipients": {
+					Type:        pluginsdk.TypeBool,
+					Required:    true,
+					Description: "Whether the default recipients are notified.",
+				},
+				"additional_recipients": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The additional recipients to notify.",
+				},
+			},
+		},
+		Description: description,
+	}
+}
+
+func (r RoleManagementPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Role Management Policies are created by the service alongside the role itself
+			// and can only be updated, so the create is a thin wrapper around resolving the
+			// policy for the role at the given scope and pushing the configured rules to it
+			return updateRoleManagementPolicy(ctx, metadata, true)
+		},
+	}
+}
+
+func (r RoleManagementPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return updateRoleManagementPolicy(ctx, metadata, false)
+		},
+	}
+}
+
+func (RoleManagementPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Authorization.RoleManagementPoliciesClient
+			assignmentsClient := metadata.Client.Authorization.RoleManagementPolicyAssignmentsClient
+
+			id, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			var state RoleManagementPolicyModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Scope = id.Scope
+
+			// the policy itself doesn't reference the role it applies to, so when importing
+			// this is resolved from the policy assignments at the scope
+			if state.RoleDefinitionId == "" {
+				roleDefinitionId, err := findRoleManagementPolicyRoleDefinition(ctx, assignmentsClient, *id)
+				if err != nil {
+					return err
+				}
+				state.RoleDefinitionId = roleDefinitionId
+			}
+
+			if model := resp.Model; model != nil {
+				state.Name = utils.NormalizeNilableString(model.Name)
+				if props := model.Properties; props != nil {
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+					if props.Rules != nil {
+						flattenRoleManagementPolicyRules(*props.Rules, &state)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (RoleManagementPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Role Management Policies always exist for a role and cannot be deleted - the
+			// service also doesn't expose a way to reset a policy to its default rules, so
+			// deleting this resource only removes it from the state
+			return nil
+		},
+	}
+}
+
+func updateRoleManagementPolicy(ctx context.Context, metadata sdk.ResourceMetaData, isNew bool) error {
+	client := metadata.Client.Authorization.RoleManagementPoliciesClient
+	assignmentsClient := metadata.Client.Authorization.RoleManagementPolicyAssignmentsClient
+
+	var model RoleManagementPolicyModel
+	if err := metadata.Decode(&model); err != nil {
+		return fmt.Errorf("decoding: %+v", err)
+	}
+
+	var id *rolemanagementpolicies.ScopedRoleManagementPolicyId
+	if isNew {
+		policyId, err := findRoleManagementPolicyId(ctx, assignmentsClient, model.Scope, model.RoleDefinitionId)
+		if err != nil {
+			return err
+		}
+		id = policyId
+	} else {
+		policyId, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+		id = policyId
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil || existing.Model.Properties.Rules == nil {
+		return fmt.Errorf("retrieving %s: `properties.rules` was nil", id)
+	}
+
+	payload := azuresdkhacks.RoleManagementPolicy{
+		Properties: &azuresdkhacks.RoleManagementPolicyProperties{
+			Rules: expandRoleManagementPolicyRules(model, *existing.Model.Properties.Rules),
+		},
+	}
+
+	if _, err = client.Update(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if isNew {
+		metadata.SetID(id)
+	}
+	return nil
+}
+
+func findRoleManagementPolicyId(ctx context.Context, client *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient, scope string, roleDefinitionId string) (*rolemanagementpolicies.ScopedRoleManagementPolicyId, error) {
+	assignments, err := client.ListForScopeComplete(ctx, commonids.NewScopeID(scope))
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Management Policy Assignments for scope %q: %+v", scope, err)
+	}
+
+	for _, assignment := range assignments.Items {
+		if props := assignment.Properties; props != nil {
+			if props.RoleDefinitionId != nil && strings.EqualFold(*props.RoleDefinitionId, roleDefinitionId) && props.PolicyId != nil {
+				return rolemanagementpolicies.ParseScopedRoleManagementPolicyIDInsensitively(*props.PolicyId)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no Role Management Policy was found for Role Definition %q at scope %q", roleDefinitionId, scope)
+}
+
+func findRoleManagementPolicyRoleDefinition(ctx context.Context, client *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient, id rolemanagementpolicies.ScopedRoleManagementPolicyId) (string, error) {
+	assignments, err := client.ListForScopeComplete(ctx, commonids.NewScopeID(id.Scope))
+	if err != nil {
+		return "", fmt.Errorf("listing Role Management Policy Assignments for scope %q: %+v", id.Scope, err)
+	}
+
+	for _, assignment := range assignments.Items {
+		if props := assignment.Properties; props != nil {
+			if props.PolicyId != nil && strings.EqualFold(*props.PolicyId, id.ID()) && props.RoleDefinitionId != nil {
+				return *props.RoleDefinitionId, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no Role Definition was found for %s", id)
+}
+
+const (
+	roleManagementPolicyRuleActivationExpiration     = "Expiration_EndUser_Assignment"
+	roleManagementPolicyRuleActivationApproval       = "Approval_EndUser_Assignment"
+	roleManagementPolicyRuleActivationAdminNotify    = "Notification_Admin_EndUser_Assignment"
+	roleManagementPolicyRuleActivationApproverNotify = "Notification_Approver_EndUser_Assignment"
+	roleManagementPolicyRuleActivationAssigneeNotify = "Notification_Requestor_EndUser_Assignment"
+)
+
+func expandRoleManagementPolicyRules(model RoleManagementPolicyModel, existingRules []azuresdkhacks.RoleManagementPolicyRule) *[]azuresdkhacks.RoleManagementPolicyRule {
+	existing := make(map[string]azuresdkhacks.RoleManagementPolicyRule)
+	for _, rule := range existingRules {
+		if rule.Id != nil {
+			existing[*rule.Id] = rule
+		}
+	}
+
+	rules := make([]azuresdkhacks.RoleManagementPolicyRule, 0)
+
+	if len(model.ActivationRules) > 0 {
+		activation := model.ActivationRules[0]
+
+		if activation.MaximumDuration != "" {
+			rule := existing[roleManagementPolicyRuleActivationExpiration]
+			rule.MaximumDuration = utils.String(activation.MaximumDuration)
+			rules = append(rules, rule)
+		}
+
+		rule := existing[roleManagementPolicyRuleActivationApproval]
+		if rule.Setting == nil {
+			rule.Setting = &azuresdkhacks.ApprovalSettings{}
+		}
+		rule.Setting.IsApprovalRequired = utils.Bool(activation.RequireApproval)
+		if len(activation.ApprovalStages) > 0 {
+			primaryApprovers := make([]azuresdkhacks.UserSet, 0)
+			for _, approver := range activation.ApprovalStages[0].PrimaryApprovers {
+				approver := approver
+				primaryApprovers = append(primaryApprovers, azuresdkhacks.UserSet{
+					Id:       utils.String(approver.ID),
+					UserType: utils.String(approver.Type),
+				})
+			}
+			rule.Setting.ApprovalStages = &[]azuresdkhacks.ApprovalStage{{
+				PrimaryApprovers: &primaryApprovers,
+			}}
+		}
+		rules = append(rules, rule)
+	}
+
+	if len(model.NotificationRules) > 0 && len(model.NotificationRules[0].EligibleActivations) > 0 {
+		event := model.NotificationRules[0].EligibleActivations[0]
+		rules = append(rules, expandRoleManagementPolicyNotificationRule(existing, roleManagementPolicyRuleActivationAdminNotify, event.AdminNotifications)...)
+		rules = append(rules, expandRoleManagementPolicyNotificationRule(existing, roleManagementPolicyRuleActivationApproverNotify, event.ApproverNotifications)...)
+		rules = append(rules, expandRoleManagementPolicyNotificationRule(existing, roleManagementPolicyRuleActivationAssigneeNotify, event.AssigneeNotifications)...)
+	}
+
+	return &rules
+}
+
+func expandRoleManagementPolicyNotificationRule(existing map[string]azuresdkhacks.RoleManagementPolicyRule, ruleId string, input []RoleManagementPolicyNotificationSettings) []azuresdkhacks.RoleManagementPolicyRule {
+	if len(input) == 0 {
+		return nil
+	}
+	settings := input[0]
+
+	rule := existing[ruleId]
+	rule.NotificationLevel = utils.String(settings.NotificationLevel)
+	rule.IsDefaultRecipientsEnabled = utils.Bool(settings.DefaultRecipients)
+
+	recipients := make([]string, 0)
+	recipients = append(recipients, settings.AdditionalRecipients...)
+	rule.NotificationRecipients = &recipients
+
+	return []azuresdkhacks.RoleManagementPolicyRule{rule}
+}
+
+func flattenRoleManagementPolicyRules(rules []azuresdkhacks.RoleManagementPolicyRule, state *RoleManagementPolicyModel) {
+	activation := RoleManagementPolicyActivationRules{}
+	event := RoleManagementPolicyNotificationEvent{}
+
+	for _, rule := range rules {
+		if rule.Id == nil {
+			continue
+		}
+
+		switch *rule.Id {
+		case roleManagementPolicyRuleActivationExpiration:
+			if rule.MaximumDuration != nil {
+				activation.MaximumDuration = *rule.MaximumDuration
+			}
+
+		case roleManagementPolicyRuleActivationApproval:
+			if rule.Setting != nil {
+				if rule.Setting.IsApprovalRequired != nil {
+					activation.RequireApproval = *rule.Setting.IsApprovalRequired
+				}
+				if rule.Setting.ApprovalStages != nil && len(*rule.Setting.ApprovalStages) > 0 {
+					stage := (*rule.Setting.ApprovalStages)[0]
+					if stage.PrimaryApprovers != nil && len(*stage.PrimaryApprovers) > 0 {
+						approvers := make([]RoleManagementPolicyApprover, 0)
+						for _, approver := range *stage.PrimaryApprovers {
+							approvers = append(approvers, RoleManagementPolicyApprover{
+								ID:   utils.NormalizeNilableString(approver.Id),
+								Type: utils.NormalizeNilableString(approver.UserType),
+							})
+						}
+						activation.ApprovalStages = []RoleManagementPolicyApprovalStage{{
+							PrimaryApprovers: approvers,
+						}}
+					}
+				}
+			}
+
+		case roleManagementPolicyRuleActivationAdminNotify:
+			event.AdminNotifications = flattenRoleManagementPolicyNotificationRule(rule)
+
+		case roleManagementPolicyRuleActivationApproverNotify:
+			event.ApproverNotifications = flattenRoleManagementPolicyNotificationRule(rule)
+
+		case roleManagementPolicyRuleActivationAssigneeNotify:
+			event.AssigneeNotifications = flattenRoleManagementPolicyNotificationRule(rule)
+		}
+	}
+
+	state.ActivationRules = []RoleManagementPolicyActivationRules{activation}
+	state.NotificationRules = []RoleManagementPolicyNotificationRules{{
+		EligibleActivations: []RoleManagementPolicyNotificationEvent{event},
+	}}
+}
+
+func flattenRoleManagementPolicyNotificationRule(rule azuresdkhacks.RoleManagementPolicyRule) []RoleManagementPolicyNotificationSettings {
+	settings := RoleManagementPolicyNotificationSettings{
+		AdditionalRecipients: make([]string, 0),
+	}
+	if rule.NotificationLevel != nil {
+		settings.NotificationLevel = *rule.NotificationLevel
+	}
+	if rule.IsDefaultRecipientsEnabled != nil {
+		settings.DefaultRecipients = *rule.IsDefaultRecipientsEnabled
+	}
+	if rule.NotificationRecipients != nil {
+		settings.AdditionalRecipients = append(settings.AdditionalRecipients, *rule.NotificationRecipients...)
+	}
+	return []RoleManagementPolicyNotificationSettings{settings}
+}
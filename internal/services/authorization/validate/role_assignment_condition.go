@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RoleAssignmentCondition validates the syntax of an ABAC condition used on a role assignment.
+// The full condition grammar is evaluated server-side, however the most common authoring
+// mistakes (unbalanced brackets and unterminated string literals) can be caught at plan time.
+func RoleAssignmentCondition(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, append(errors, fmt.Errorf("expected type of %s to be string", k))
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return nil, append(errors, fmt.Errorf("%s must not be empty", k))
+	}
+
+	inString := false
+	depths := map[rune]int{'(': 0, '[': 0, '{': 0}
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	for _, r := range v {
+		if r == '\'' {
+			inString = !inString
+			continue
+		}
+		if inString {
+			continue
+		}
+
+		if _, ok := depths[r]; ok {
+			depths[r]++
+			continue
+		}
+		if open, ok := closing[r]; ok {
+			depths[open]--
+			if depths[open] < 0 {
+				return nil, append(errors, fmt.Errorf("%s contains an unbalanced %q in the ABAC condition", k, string(r)))
+			}
+		}
+	}
+
+	if inString {
+		return nil, append(errors, fmt.Errorf("%s contains an unterminated string literal in the ABAC condition", k))
+	}
+	for open, depth := range depths {
+		if depth != 0 {
+			return nil, append(errors, fmt.Errorf("%s contains an unbalanced %q in the ABAC condition", k, string(open)))
+		}
+	}
+
+	return warnings, errors
+}
+
+// NormalizeRoleAssignmentCondition collapses insignificant whitespace within an ABAC
+// condition, since the service normalizes the formatting of the condition on its side.
+// Whitespace inside string literals is preserved.
+func NormalizeRoleAssignmentCondition(input string) string {
+	var out strings.Builder
+	inString := false
+	pendingSpace := false
+	for _, r := range input {
+		if r == '\'' {
+			inString = !inString
+		}
+		if !inString && (r == ' ' || r == '\t' || r == '\r' || r == '\n') {
+			pendingSpace = out.Len() > 0
+			continue
+		}
+		if pendingSpace {
+			out.WriteRune(' ')
+			pendingSpace = false
+		}
+		out.WriteRune(r)
+	}
+	return out.String()
+}
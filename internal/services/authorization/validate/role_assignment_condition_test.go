@@ -0,0 +1,83 @@
+package validate
+
+import "testing"
+
+func TestRoleAssignmentCondition(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "   ",
+			Valid: false,
+		},
+		{
+			Input: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'foo'))",
+			Valid: true,
+		},
+		{
+			// unbalanced parenthesis
+			Input: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'}))",
+			Valid: false,
+		},
+		{
+			// unbalanced bracket
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name StringEquals 'foo'",
+			Valid: false,
+		},
+		{
+			// unterminated string literal
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'foo",
+			Valid: false,
+		},
+		{
+			// brackets within a string literal are not significant
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals '(foo]'",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := RoleAssignmentCondition(tc.Input, "condition")
+		valid := len(errors) == 0
+		if valid != tc.Valid {
+			t.Fatalf("expected valid status %t but got %t for input %q: %+v", tc.Valid, valid, tc.Input, errors)
+		}
+	}
+}
+
+func TestNormalizeRoleAssignmentCondition(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "(\n  (!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'}))\n)",
+			Expected: "( (!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) )",
+		},
+		{
+			// whitespace within string literals is preserved
+			Input:    "@Resource[tags:foo]  StringEquals  'two  spaces'",
+			Expected: "@Resource[tags:foo] StringEquals 'two  spaces'",
+		},
+		{
+			// leading and trailing whitespace is removed
+			Input:    "  ActionMatches{'foo'} ",
+			Expected: "ActionMatches{'foo'}",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := NormalizeRoleAssignmentCondition(tc.Input); actual != tc.Expected {
+			t.Fatalf("expected %q but got %q for input %q", tc.Expected, actual, tc.Input)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runtimeenvironmentpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runtimeenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RuntimeEnvironmentPackageModel struct {
+	RuntimeEnvironmentID string `tfschema:"runtime_environment_id"`
+	Name                 string `tfschema:"name"`
+	ContentUri           string `tfschema:"content_uri"`
+	ContentVersion       string `tfschema:"content_version"`
+	SizeInBytes          int64  `tfschema:"size_in_bytes"`
+	Version              string `tfschema:"version"`
+}
+
+type RuntimeEnvironmentPackageResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*RuntimeEnvironmentPackageResource)(nil)
+
+func (m RuntimeEnvironmentPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"runtime_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: runtimeenvironments.ValidateRuntimeEnvironmentID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_uri": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"content_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (m RuntimeEnvironmentPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"size_in_bytes": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (m RuntimeEnvironmentPackageResource) ModelObject() interface{} {
+	return &RuntimeEnvironmentPackageModel{}
+}
+
+func (m RuntimeEnvironmentPackageResource) ResourceType() string {
+	return "azurerm_automation_runtime_environment_package"
+}
+
+func (m RuntimeEnvironmentPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return runtimeenvironmentpackages.ValidatePackageID
+}
+
+func (m RuntimeEnvironmentPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Automation.RuntimeEnvironmentPackageClient
+
+			var model RuntimeEnvironmentPackageModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			runtimeEnvironmentID, err := runtimeenvironments.ParseRuntimeEnvironmentID(model.RuntimeEnvironmentID)
+			if err != nil {
+				return err
+			}
+			id := runtimeenvironmentpackages.NewPackageID(runtimeEnvironmentID.SubscriptionId, runtimeEnvironmentID.ResourceGroupName, runtimeEnvironmentID.AutomationAccountName, runtimeEnvironmentID.RuntimeEnvironmentName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %v", id, err)
+				}
+				return meta.ResourceRequiresImport(m.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandRuntimeEnvironmentPackageParameters(model)); err != nil {
+				return fmt.Errorf("creating %s: %v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (m RuntimeEnvironmentPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			id, err := runtimeenvironmentpackages.ParsePackageID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := meta.Client.Automation.RuntimeEnvironmentPackageClient
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %v", *id, err)
+			}
+
+			output := RuntimeEnvironmentPackageModel{
+				RuntimeEnvironmentID: runtimeenvironments.NewRuntimeEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.AutomationAccountName, id.RuntimeEnvironmentName).ID(),
+				Name:                 id.PackageName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					output.SizeInBytes = utils.NormaliseNilableInt64(props.SizeInBytes)
+					output.Version = utils.NormalizeNilableString(props.Version)
+
+					if link := props.ContentLink; link != nil {
+						output.ContentUri = utils.NormalizeNilableString(link.Uri)
+						output.ContentVersion = utils.NormalizeNilableString(link.Version)
+					}
+				}
+			}
+
+			// the content link is not returned for packages uploaded from a storage
+			// account, so fall back to what is in the state
+			if output.ContentUri == "" {
+				output.ContentUri = meta.ResourceData.Get("content_uri").(string)
+			}
+			if output.ContentVersion == "" {
+				output.ContentVersion = meta.ResourceData.Get("content_version").(string)
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (m RuntimeEnvironmentPackageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Automation.RuntimeEnvironmentPackageClient
+
+			id, err := runtimeenvironmentpackages.ParsePackageID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model RuntimeEnvironmentPackageModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandRuntimeEnvironmentPackageParameters(model)); err != nil {
+				return fmt.Errorf("updating %s: %v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (m RuntimeEnvironmentPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			id, err := runtimeenvironmentpackages.ParsePackageID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := meta.Client.Automation.RuntimeEnvironmentPackageClient
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandRuntimeEnvironmentPackageParameters(model RuntimeEnvironmentPackageModel) runtimeenvironmentpackages.PackageCreateOrUpdateParameters {
+	contentLink := runtimeenvironmentpackages.ContentLink{
+		Uri: utils.String(model.ContentUri),
+	}
+
+	if model.ContentVersion != "" {
+		contentLink.Version = utils.String(model.ContentVersion)
+	}
+
+	return runtimeenvironmentpackages.PackageCreateOrUpdateParameters{
+		Properties: runtimeenvironmentpackages.PackageCreateOrUpdateProperties{
+			ContentLink: contentLink,
+		},
+	}
+}
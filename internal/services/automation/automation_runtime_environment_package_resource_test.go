@@ -0,0 +1,131 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runtimeenvironmentpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type RuntimeEnvironmentPackageResource struct{}
+
+func TestAccRuntimeEnvironmentPackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, automation.RuntimeEnvironmentPackageResource{}.ResourceType(), "test")
+	r := RuntimeEnvironmentPackageResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRuntimeEnvironmentPackage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, automation.RuntimeEnvironmentPackageResource{}.ResourceType(), "test")
+	r := RuntimeEnvironmentPackageResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccRuntimeEnvironmentPackage_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, automation.RuntimeEnvironmentPackageResource{}.ResourceType(), "test")
+	r := RuntimeEnvironmentPackageResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (a RuntimeEnvironmentPackageResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := runtimeenvironmentpackages.ParsePackageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Automation.RuntimeEnvironmentPackageClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (a RuntimeEnvironmentPackageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_runtime_environment" "test" {
+  name                  = "acctest-re-%[2]d"
+  automation_account_id = azurerm_automation_account.test.id
+  location              = azurerm_resource_group.test.location
+  language              = "Python"
+  version               = "3.10"
+}
+`, RuntimeEnvironmentResource{}.template(data), data.RandomInteger)
+}
+
+func (a RuntimeEnvironmentPackageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_runtime_environment_package" "test" {
+  name                   = "acctest-pkg-%[2]d"
+  runtime_environment_id = azurerm_automation_runtime_environment.test.id
+  content_uri            = "https://files.pythonhosted.org/packages/source/r/requests/requests-2.31.0.tar.gz"
+  content_version        = "2.31.0"
+}
+`, a.template(data), data.RandomInteger)
+}
+
+func (a RuntimeEnvironmentPackageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_runtime_environment_package" "import" {
+  name                   = azurerm_automation_runtime_environment_package.test.name
+  runtime_environment_id = azurerm_automation_runtime_environment_package.test.runtime_environment_id
+  content_uri            = azurerm_automation_runtime_environment_package.test.content_uri
+  content_version        = azurerm_automation_runtime_environment_package.test.content_version
+}
+`, a.basic(data))
+}
+
+func (a RuntimeEnvironmentPackageResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_runtime_environment_package" "test" {
+  name                   = "acctest-pkg-%[2]d"
+  runtime_environment_id = azurerm_automation_runtime_environment.test.id
+  content_uri            = "https://files.pythonhosted.org/packages/source/r/requests/requests-2.32.0.tar.gz"
+  content_version        = "2.32.0"
+}
+`, a.template(data), data.RandomInteger)
+}
@@ -0,0 +1,269 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runtimeenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RuntimeEnvironmentModel struct {
+	AutomationAccountID string                 `tfschema:"automation_account_id"`
+	Name                string                 `tfschema:"name"`
+	Location            string                 `tfschema:"location"`
+	Language            string                 `tfschema:"language"`
+	Version             string                 `tfschema:"version"`
+	DefaultPackages     map[string]interface{} `tfschema:"default_packages"`
+	Description         string                 `tfschema:"description"`
+	Tags                map[string]interface{} `tfschema:"tags"`
+}
+
+type RuntimeEnvironmentResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*RuntimeEnvironmentResource)(nil)
+
+func (m RuntimeEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"automation_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: runtimeenvironments.ValidateAutomationAccountID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+
+		"language": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"PowerShell",
+				"Python",
+			}, false),
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"default_packages": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (m RuntimeEnvironmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (m RuntimeEnvironmentResource) ModelObject() interface{} {
+	return &RuntimeEnvironmentModel{}
+}
+
+func (m RuntimeEnvironmentResource) ResourceType() string {
+	return "azurerm_automation_runtime_environment"
+}
+
+func (m RuntimeEnvironmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return runtimeenvironments.ValidateRuntimeEnvironmentID
+}
+
+func (m RuntimeEnvironmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Automation.RuntimeEnvironmentClient
+
+			var model RuntimeEnvironmentModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			subscriptionID := meta.Client.Account.SubscriptionId
+			accountID, err := runtimeenvironments.ParseAutomationAccountID(model.AutomationAccountID)
+			if err != nil {
+				return err
+			}
+			id := runtimeenvironments.NewRuntimeEnvironmentID(subscriptionID, accountID.ResourceGroupName, accountID.AutomationAccountName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %v", id, err)
+				}
+				return meta.ResourceRequiresImport(m.ResourceType(), id)
+			}
+
+			param := runtimeenvironments.RuntimeEnvironment{
+				Location:   location.Normalize(model.Location),
+				Properties: expandRuntimeEnvironmentProperties(model),
+			}
+
+			if len(model.Tags) > 0 {
+				tags := expandStringInterfaceMap(model.Tags)
+				param.Tags = &tags
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (m RuntimeEnvironmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			id, err := runtimeenvironments.ParseRuntimeEnvironmentID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := meta.Client.Automation.RuntimeEnvironmentClient
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %v", *id, err)
+			}
+
+			output := RuntimeEnvironmentModel{
+				AutomationAccountID: runtimeenvironments.NewAutomationAccountID(id.SubscriptionId, id.ResourceGroupName, id.AutomationAccountName).ID(),
+				Name:                id.RuntimeEnvironmentName,
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					output.Tags = flattenMap(*model.Tags)
+				}
+
+				if props := model.Properties; props != nil {
+					output.Description = utils.NormalizeNilableString(props.Description)
+
+					if runtime := props.Runtime; runtime != nil {
+						output.Language = utils.NormalizeNilableString(runtime.Language)
+						output.Version = utils.NormalizeNilableString(runtime.Version)
+					}
+
+					if props.DefaultPackages != nil {
+						output.DefaultPackages = flattenMap(*props.DefaultPackages)
+					}
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (m RuntimeEnvironmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Automation.RuntimeEnvironmentClient
+
+			id, err := runtimeenvironments.ParseRuntimeEnvironmentID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model RuntimeEnvironmentModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			param := runtimeenvironments.RuntimeEnvironment{
+				Location:   location.Normalize(model.Location),
+				Properties: expandRuntimeEnvironmentProperties(model),
+			}
+
+			if len(model.Tags) > 0 {
+				tags := expandStringInterfaceMap(model.Tags)
+				param.Tags = &tags
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, param); err != nil {
+				return fmt.Errorf("updating %s: %v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (m RuntimeEnvironmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			id, err := runtimeenvironments.ParseRuntimeEnvironmentID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := meta.Client.Automation.RuntimeEnvironmentClient
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandRuntimeEnvironmentProperties(model RuntimeEnvironmentModel) *runtimeenvironments.RuntimeEnvironmentProperties {
+	props := runtimeenvironments.RuntimeEnvironmentProperties{
+		Runtime: &runtimeenvironments.RuntimeProperties{
+			Language: utils.String(model.Language),
+			Version:  utils.String(model.Version),
+		},
+	}
+
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+
+	if len(model.DefaultPackages) > 0 {
+		defaultPackages := expandStringInterfaceMap(model.DefaultPackages)
+		props.DefaultPackages = &defaultPackages
+	}
+
+	return &props
+}
@@ -0,0 +1,152 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runtimeenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type RuntimeEnvironmentResource struct{}
+
+func TestAccRuntimeEnvironment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, automation.RuntimeEnvironmentResource{}.ResourceType(), "test")
+	r := RuntimeEnvironmentResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("language").HasValue("Python"),
+				check.That(data.ResourceName).Key("version").HasValue("3.10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRuntimeEnvironment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, automation.RuntimeEnvironmentResource{}.ResourceType(), "test")
+	r := RuntimeEnvironmentResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccRuntimeEnvironment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, automation.RuntimeEnvironmentResource{}.ResourceType(), "test")
+	r := RuntimeEnvironmentResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_packages.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (a RuntimeEnvironmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := runtimeenvironments.ParseRuntimeEnvironmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Automation.RuntimeEnvironmentClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (a RuntimeEnvironmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-auto-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (a RuntimeEnvironmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_runtime_environment" "test" {
+  name                  = "acctest-re-%[2]d"
+  automation_account_id = azurerm_automation_account.test.id
+  location              = azurerm_resource_group.test.location
+  language              = "Python"
+  version               = "3.10"
+}
+`, a.template(data), data.RandomInteger)
+}
+
+func (a RuntimeEnvironmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_runtime_environment" "import" {
+  name                  = azurerm_automation_runtime_environment.test.name
+  automation_account_id = azurerm_automation_runtime_environment.test.automation_account_id
+  location              = azurerm_automation_runtime_environment.test.location
+  language              = azurerm_automation_runtime_environment.test.language
+  version               = azurerm_automation_runtime_environment.test.version
+}
+`, a.basic(data))
+}
+
+func (a RuntimeEnvironmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_runtime_environment" "test" {
+  name                  = "acctest-re-%[2]d"
+  automation_account_id = azurerm_automation_account.test.id
+  location              = azurerm_resource_group.test.location
+  language              = "PowerShell"
+  version               = "7.2"
+  description           = "acceptance test runtime environment"
+
+  default_packages = {
+    az = "8.3.0"
+  }
+
+  tags = {
+    env = "Test"
+  }
+}
+`, a.template(data), data.RandomInteger)
+}
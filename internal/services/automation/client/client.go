@@ -21,30 +21,34 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2021-06-22/hybridrunbookworker"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2021-06-22/hybridrunbookworkergroup"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runtimeenvironmentpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runtimeenvironments"
 )
 
 type Client struct {
-	AccountClient               *automationaccount.AutomationAccountClient
-	AgentRegistrationInfoClient *automation.AgentRegistrationInformationClient
-	CertificateClient           *certificate.CertificateClient
-	ConnectionClient            *connection.ConnectionClient
-	ConnectionTypeClient        *connectiontype.ConnectionTypeClient
-	CredentialClient            *credential.CredentialClient
-	DscConfigurationClient      *dscconfiguration.DscConfigurationClient
-	DscNodeConfigurationClient  *dscnodeconfiguration.DscNodeConfigurationClient
-	JobScheduleClient           *jobschedule.JobScheduleClient
-	ModuleClient                *module.ModuleClient
-	RunbookClient               *runbook.RunbookClient
-	RunbookClientHack           *automation.RunbookClient
-	RunbookDraftClient          *automation.RunbookDraftClient
-	RunBookWgClient             *hybridrunbookworkergroup.HybridRunbookWorkerGroupClient
-	RunbookWorkerClient         *hybridrunbookworker.HybridRunbookWorkerClient
-	ScheduleClient              *schedule.ScheduleClient
-	SoftwareUpdateConfigClient  *softwareupdateconfiguration.SoftwareUpdateConfigurationClient
-	SourceControlClient         *sourcecontrol.SourceControlClient
-	VariableClient              *variable.VariableClient
-	WatcherClient               *watcher.WatcherClient
-	WebhookClient               *webhook.WebhookClient
+	AccountClient                   *automationaccount.AutomationAccountClient
+	AgentRegistrationInfoClient     *automation.AgentRegistrationInformationClient
+	CertificateClient               *certificate.CertificateClient
+	ConnectionClient                *connection.ConnectionClient
+	ConnectionTypeClient            *connectiontype.ConnectionTypeClient
+	CredentialClient                *credential.CredentialClient
+	DscConfigurationClient          *dscconfiguration.DscConfigurationClient
+	DscNodeConfigurationClient      *dscnodeconfiguration.DscNodeConfigurationClient
+	JobScheduleClient               *jobschedule.JobScheduleClient
+	ModuleClient                    *module.ModuleClient
+	RunbookClient                   *runbook.RunbookClient
+	RunbookClientHack               *automation.RunbookClient
+	RunbookDraftClient              *automation.RunbookDraftClient
+	RunBookWgClient                 *hybridrunbookworkergroup.HybridRunbookWorkerGroupClient
+	RunbookWorkerClient             *hybridrunbookworker.HybridRunbookWorkerClient
+	RuntimeEnvironmentClient        *runtimeenvironments.RuntimeEnvironmentsClient
+	RuntimeEnvironmentPackageClient *runtimeenvironmentpackages.RuntimeEnvironmentPackagesClient
+	ScheduleClient                  *schedule.ScheduleClient
+	SoftwareUpdateConfigClient      *softwareupdateconfiguration.SoftwareUpdateConfigurationClient
+	SourceControlClient             *sourcecontrol.SourceControlClient
+	VariableClient                  *variable.VariableClient
+	WatcherClient                   *watcher.WatcherClient
+	WebhookClient                   *webhook.WebhookClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -105,6 +109,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	variableClient := variable.NewVariableClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&variableClient.Client, o.ResourceManagerAuthorizer)
 
+	runtimeEnvironmentClient := runtimeenvironments.NewRuntimeEnvironmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&runtimeEnvironmentClient.Client, o.ResourceManagerAuthorizer)
+
+	runtimeEnvironmentPackageClient := runtimeenvironmentpackages.NewRuntimeEnvironmentPackagesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&runtimeEnvironmentPackageClient.Client, o.ResourceManagerAuthorizer)
+
 	watcherClient := watcher.NewWatcherClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&watcherClient.Client, o.ResourceManagerAuthorizer)
 
@@ -112,26 +122,28 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&webhookClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AccountClient:               &accountClient,
-		AgentRegistrationInfoClient: &agentRegistrationInfoClient,
-		CertificateClient:           &certificateClient,
-		ConnectionClient:            &connectionClient,
-		ConnectionTypeClient:        &connectionTypeClient,
-		CredentialClient:            &credentialClient,
-		DscConfigurationClient:      &dscConfigurationClient,
-		DscNodeConfigurationClient:  &dscNodeConfigurationClient,
-		JobScheduleClient:           &jobScheduleClient,
-		ModuleClient:                &moduleClient,
-		RunbookClient:               &runbookClient,
-		RunbookClientHack:           &runbookClient2,
-		RunbookDraftClient:          &runbookDraftClient,
-		RunBookWgClient:             &runbookWgClient,
-		RunbookWorkerClient:         &runbookWorkerClient,
-		ScheduleClient:              &scheduleClient,
-		SoftwareUpdateConfigClient:  &softUpClient,
-		SourceControlClient:         &sourceCtlClient,
-		VariableClient:              &variableClient,
-		WatcherClient:               &watcherClient,
-		WebhookClient:               &webhookClient,
+		AccountClient:                   &accountClient,
+		AgentRegistrationInfoClient:     &agentRegistrationInfoClient,
+		CertificateClient:               &certificateClient,
+		ConnectionClient:                &connectionClient,
+		ConnectionTypeClient:            &connectionTypeClient,
+		CredentialClient:                &credentialClient,
+		DscConfigurationClient:          &dscConfigurationClient,
+		DscNodeConfigurationClient:      &dscNodeConfigurationClient,
+		JobScheduleClient:               &jobScheduleClient,
+		ModuleClient:                    &moduleClient,
+		RunbookClient:                   &runbookClient,
+		RunbookClientHack:               &runbookClient2,
+		RunbookDraftClient:              &runbookDraftClient,
+		RunBookWgClient:                 &runbookWgClient,
+		RunbookWorkerClient:             &runbookWorkerClient,
+		RuntimeEnvironmentClient:        &runtimeEnvironmentClient,
+		RuntimeEnvironmentPackageClient: &runtimeEnvironmentPackageClient,
+		ScheduleClient:                  &scheduleClient,
+		SoftwareUpdateConfigClient:      &softUpClient,
+		SourceControlClient:             &sourceCtlClient,
+		VariableClient:                  &variableClient,
+		WatcherClient:                   &watcherClient,
+		WebhookClient:                   &webhookClient,
 	}
 }
@@ -0,0 +1,18 @@
+package runtimeenvironmentpackages
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RuntimeEnvironmentPackagesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRuntimeEnvironmentPackagesClientWithBaseURI(endpoint string) RuntimeEnvironmentPackagesClient {
+	return RuntimeEnvironmentPackagesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package runtimeenvironmentpackages
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ContentHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
@@ -0,0 +1,12 @@
+package runtimeenvironmentpackages
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PackageProperties struct {
+	ContentLink       *ContentLink `json:"contentLink,omitempty"`
+	Default           *bool        `json:"default,omitempty"`
+	ProvisioningState *string      `json:"provisioningState,omitempty"`
+	SizeInBytes       *int64       `json:"sizeInBytes,omitempty"`
+	Version           *string      `json:"version,omitempty"`
+}
@@ -0,0 +1,12 @@
+package runtimeenvironmentpackages
+
+import "fmt"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2023-05-15-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/runtimeenvironmentpackages/%s", defaultApiVersion)
+}
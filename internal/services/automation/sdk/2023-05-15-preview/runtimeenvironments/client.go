@@ -0,0 +1,18 @@
+package runtimeenvironments
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RuntimeEnvironmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRuntimeEnvironmentsClientWithBaseURI(endpoint string) RuntimeEnvironmentsClient {
+	return RuntimeEnvironmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
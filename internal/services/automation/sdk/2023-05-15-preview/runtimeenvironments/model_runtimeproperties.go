@@ -0,0 +1,9 @@
+package runtimeenvironments
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RuntimeProperties struct {
+	Language *string `json:"language,omitempty"`
+	Version  *string `json:"version,omitempty"`
+}
@@ -7,7 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2022-01-01/pool"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/sdk/2022-01-01/pool"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -782,6 +782,10 @@ func expandBatchPoolVirtualMachineConfig(d *pluginsdk.ResourceData) (*pool.Virtu
 		result.OsDisk = osDisk
 	}
 
+	if securityProfile, securityProfileErr := expandBatchPoolSecurityProfile(d.Get("security_profile").([]interface{})); securityProfileErr == nil {
+		result.SecurityProfile = securityProfile
+	}
+
 	if windowsConfiguration, windowsConfigErr := expandBatchPoolWindowsConfiguration(d.Get("windows").([]interface{})); windowsConfigErr == nil {
 		result.WindowsConfiguration = windowsConfiguration
 	}
@@ -801,6 +805,58 @@ func expandBatchPoolOSDisk(ref interface{}) (*pool.OSDisk, error) {
 	}, nil
 }
 
+func expandBatchPoolSecurityProfile(list []interface{}) (*pool.SecurityProfile, error) {
+	if len(list) == 0 || list[0] == nil {
+		return nil, fmt.Errorf("security_profile is empty")
+	}
+
+	item := list[0].(map[string]interface{})
+	result := pool.SecurityProfile{
+		EncryptionAtHost: utils.Bool(item["host_encryption_enabled"].(bool)),
+		UefiSettings: &pool.UefiSettings{
+			SecureBootEnabled: utils.Bool(item["secure_boot_enabled"].(bool)),
+			VTpmEnabled:       utils.Bool(item["vtpm_enabled"].(bool)),
+		},
+	}
+
+	if securityType := item["security_type"].(string); securityType != "" {
+		result.SecurityType = pointer.To(pool.SecurityTypes(securityType))
+	}
+
+	return &result, nil
+}
+
+func flattenBatchPoolSecurityProfile(input *pool.SecurityProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	securityType := ""
+	if input.SecurityType != nil {
+		securityType = string(*input.SecurityType)
+	}
+
+	secureBootEnabled := false
+	vtpmEnabled := false
+	if settings := input.UefiSettings; settings != nil {
+		if settings.SecureBootEnabled != nil {
+			secureBootEnabled = *settings.SecureBootEnabled
+		}
+		if settings.VTpmEnabled != nil {
+			vtpmEnabled = *settings.VTpmEnabled
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"host_encryption_enabled": input.EncryptionAtHost != nil && *input.EncryptionAtHost,
+			"secure_boot_enabled":     secureBootEnabled,
+			"security_type":           securityType,
+			"vtpm_enabled":            vtpmEnabled,
+		},
+	}
+}
+
 func expandBatchPoolNodeReplacementConfig(list []interface{}) (*pool.NodePlacementConfiguration, error) {
 	if len(list) == 0 || list[0] == nil {
 		return nil, fmt.Errorf("node_placement is empty")
@@ -1107,6 +1163,10 @@ func ExpandBatchPoolNetworkConfiguration(list []interface{}) (*pool.NetworkConfi
 		networkConfiguration.DynamicVNetAssignmentScope = pointer.To(pool.DynamicVNetAssignmentScope(v.(string)))
 	}
 
+	if v, ok := networkConfigValue["accelerated_networking_enabled"]; ok {
+		networkConfiguration.EnableAcceleratedNetworking = utils.Bool(v.(bool))
+	}
+
 	if v, ok := networkConfigValue["subnet_id"]; ok {
 		if value := v.(string); value != "" {
 			networkConfiguration.SubnetId = &value
@@ -1301,8 +1361,14 @@ func flattenBatchPoolNetworkConfiguration(input *pool.NetworkConfiguration) []in
 		dynamicVNetAssignmentScope = string(*input.DynamicVNetAssignmentScope)
 	}
 
+	acceleratedNetworkingEnabled := false
+	if input.EnableAcceleratedNetworking != nil {
+		acceleratedNetworkingEnabled = *input.EnableAcceleratedNetworking
+	}
+
 	return []interface{}{
 		map[string]interface{}{
+			"accelerated_networking_enabled":   acceleratedNetworkingEnabled,
 			"dynamic_vnet_assignment_scope":    dynamicVNetAssignmentScope,
 			"endpoint_configuration":           endpointConfigs,
 			"public_address_provisioning_type": publicAddressProvisioningType,
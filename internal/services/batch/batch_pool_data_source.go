@@ -6,9 +6,9 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2022-01-01/pool"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/sdk/2022-01-01/pool"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -283,6 +283,30 @@ func dataSourceBatchPool() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+			"security_profile": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+						"security_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"secure_boot_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+						"vtpm_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"user_accounts": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -470,6 +494,10 @@ func dataSourceBatchPool() *pluginsdk.Resource {
 				Computed: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
+						"accelerated_networking_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
 						"dynamic_vnet_assignment_scope": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -863,6 +891,11 @@ func dataSourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error
 						osDiskPlacement = string(*config.OsDisk.EphemeralOSDiskSettings.Placement)
 					}
 					d.Set("os_disk_placement", osDiskPlacement)
+
+					if err := d.Set("security_profile", flattenBatchPoolSecurityProfile(config.SecurityProfile)); err != nil {
+						return fmt.Errorf("setting `security_profile`: %+v", err)
+					}
+
 					if config.WindowsConfiguration != nil {
 						windowsConfig := []interface{}{
 							map[string]interface{}{
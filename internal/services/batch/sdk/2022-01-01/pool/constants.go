@@ -577,6 +577,34 @@ func parsePoolProvisioningState(input string) (*PoolProvisioningState, error) {
 	return &out, nil
 }
 
+type SecurityTypes string
+
+const (
+	SecurityTypesConfidentialVM SecurityTypes = "confidentialVM"
+	SecurityTypesTrustedLaunch  SecurityTypes = "trustedLaunch"
+)
+
+func PossibleValuesForSecurityTypes() []string {
+	return []string{
+		string(SecurityTypesConfidentialVM),
+		string(SecurityTypesTrustedLaunch),
+	}
+}
+
+func parseSecurityTypes(input string) (*SecurityTypes, error) {
+	vals := map[string]SecurityTypes{
+		"confidentialvm": SecurityTypesConfidentialVM,
+		"trustedlaunch":  SecurityTypesTrustedLaunch,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SecurityTypes(input)
+	return &out, nil
+}
+
 type StorageAccountType string
 
 const (
@@ -4,6 +4,7 @@ package pool
 // Licensed under the MIT License. See NOTICE.txt in the project root for license information.
 
 type NetworkConfiguration struct {
+	EnableAcceleratedNetworking  *bool                         `json:"enableAcceleratedNetworking,omitempty"`
 	DynamicVNetAssignmentScope   *DynamicVNetAssignmentScope   `json:"dynamicVNetAssignmentScope,omitempty"`
 	EndpointConfiguration        *PoolEndpointConfiguration    `json:"endpointConfiguration,omitempty"`
 	PublicIPAddressConfiguration *PublicIPAddressConfiguration `json:"publicIPAddressConfiguration,omitempty"`
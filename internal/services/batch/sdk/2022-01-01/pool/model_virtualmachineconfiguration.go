@@ -13,5 +13,6 @@ type VirtualMachineConfiguration struct {
 	NodeAgentSkuId              string                       `json:"nodeAgentSkuId"`
 	NodePlacementConfiguration  *NodePlacementConfiguration  `json:"nodePlacementConfiguration,omitempty"`
 	OsDisk                      *OSDisk                      `json:"osDisk,omitempty"`
+	SecurityProfile             *SecurityProfile             `json:"securityProfile,omitempty"`
 	WindowsConfiguration        *WindowsConfiguration        `json:"windowsConfiguration,omitempty"`
 }
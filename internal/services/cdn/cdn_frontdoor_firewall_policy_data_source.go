@@ -4,10 +4,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/frontdoor/mgmt/2020-11-01/frontdoor" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/sdk/2020-11-01/frontdoor" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -51,6 +51,11 @@ func dataSourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"js_challenge_cookie_expiration_in_minutes": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
 			"frontend_endpoint_ids": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -94,6 +99,12 @@ func dataSourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta in
 			d.Set("enabled", policy.EnabledState == frontdoor.PolicyEnabledStateEnabled)
 			d.Set("mode", string(policy.Mode))
 			d.Set("redirect_url", policy.RedirectURL)
+
+			jsChallengeExpirationInMinutes := 0
+			if policy.JavascriptChallengeExpirationInMinutes != nil {
+				jsChallengeExpirationInMinutes = int(*policy.JavascriptChallengeExpirationInMinutes)
+			}
+			d.Set("js_challenge_cookie_expiration_in_minutes", jsChallengeExpirationInMinutes)
 		}
 
 		if err := d.Set("frontend_endpoint_ids", flattenFrontendEndpointLinkSlice(properties.FrontendEndpointLinks)); err != nil {
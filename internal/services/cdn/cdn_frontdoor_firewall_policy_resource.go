@@ -6,13 +6,13 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/frontdoor/mgmt/2020-11-01/frontdoor" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/sdk/2020-11-01/frontdoor" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -80,6 +80,13 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
 			},
 
+			"js_challenge_cookie_expiration_in_minutes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+
 			"custom_block_response_status_code": {
 				Type:     pluginsdk.TypeInt,
 				Optional: true,
@@ -149,11 +156,31 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								string(frontdoor.ActionTypeAllow),
 								string(frontdoor.ActionTypeBlock),
+								string(frontdoor.ActionTypeCAPTCHA),
+								string(frontdoor.ActionTypeJSChallenge),
 								string(frontdoor.ActionTypeLog),
 								string(frontdoor.ActionTypeRedirect),
 							}, false),
 						},
 
+						"custom_block_response_status_code": {
+							Type:     pluginsdk.TypeInt,
+							Optional: true,
+							ValidateFunc: validation.IntInSlice([]int{
+								200,
+								403,
+								405,
+								406,
+								429,
+							}),
+						},
+
+						"custom_block_response_body": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+
 						"match_condition": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
@@ -496,8 +523,9 @@ func resourceCdnFrontDoorFirewallPolicyCreate(d *pluginsdk.ResourceData, meta in
 		},
 		WebApplicationFirewallPolicyProperties: &frontdoor.WebApplicationFirewallPolicyProperties{
 			PolicySettings: &frontdoor.PolicySettings{
-				EnabledState: enabled,
-				Mode:         mode,
+				EnabledState:                           enabled,
+				Mode:                                   mode,
+				JavascriptChallengeExpirationInMinutes: utils.Int32(int32(d.Get("js_challenge_cookie_expiration_in_minutes").(int))),
 			},
 			CustomRules: expandCdnFrontDoorFirewallCustomRules(customRules),
 		},
@@ -558,14 +586,15 @@ func resourceCdnFrontDoorFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta in
 
 	props := *existing.WebApplicationFirewallPolicyProperties
 
-	if d.HasChanges("custom_block_response_body", "custom_block_response_status_code", "enabled", "mode", "redirect_url") {
+	if d.HasChanges("custom_block_response_body", "custom_block_response_status_code", "enabled", "js_challenge_cookie_expiration_in_minutes", "mode", "redirect_url") {
 		enabled := frontdoor.PolicyEnabledStateDisabled
 		if d.Get("enabled").(bool) {
 			enabled = frontdoor.PolicyEnabledStateEnabled
 		}
 		props.PolicySettings = &frontdoor.PolicySettings{
-			EnabledState: enabled,
-			Mode:         frontdoor.PolicyMode(d.Get("mode").(string)),
+			EnabledState:                           enabled,
+			Mode:                                   frontdoor.PolicyMode(d.Get("mode").(string)),
+			JavascriptChallengeExpirationInMinutes: utils.Int32(int32(d.Get("js_challenge_cookie_expiration_in_minutes").(int))),
 		}
 
 		if redirectUrl := d.Get("redirect_url").(string); redirectUrl != "" {
@@ -653,6 +682,12 @@ func resourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("redirect_url", policy.RedirectURL)
 			d.Set("custom_block_response_status_code", policy.CustomBlockResponseStatusCode)
 			d.Set("custom_block_response_body", policy.CustomBlockResponseBody)
+
+			jsChallengeExpirationInMinutes := 30
+			if policy.JavascriptChallengeExpirationInMinutes != nil {
+				jsChallengeExpirationInMinutes = int(*policy.JavascriptChallengeExpirationInMinutes)
+			}
+			d.Set("js_challenge_cookie_expiration_in_minutes", jsChallengeExpirationInMinutes)
 		}
 
 		if err := d.Set("custom_rule", flattenCdnFrontDoorFirewallCustomRules(properties.CustomRules)); err != nil {
@@ -720,7 +755,7 @@ func expandCdnFrontDoorFirewallCustomRules(input []interface{}) *frontdoor.Custo
 		matchConditions := expandCdnFrontDoorFirewallMatchConditions(custom["match_condition"].([]interface{}))
 		action := custom["action"].(string)
 
-		output = append(output, frontdoor.CustomRule{
+		customRule := frontdoor.CustomRule{
 			Name:                       utils.String(name),
 			Priority:                   &priority,
 			EnabledState:               enabled,
@@ -729,7 +764,17 @@ func expandCdnFrontDoorFirewallCustomRules(input []interface{}) *frontdoor.Custo
 			RateLimitThreshold:         utils.Int32(rateLimitThreshold),
 			MatchConditions:            &matchConditions,
 			Action:                     frontdoor.ActionType(action),
-		})
+		}
+
+		if statusCode := custom["custom_block_response_status_code"].(int); statusCode > 0 {
+			customRule.CustomBlockResponseStatusCode = utils.Int32(int32(statusCode))
+		}
+
+		if body := custom["custom_block_response_body"].(string); body != "" {
+			customRule.CustomBlockResponseBody = utils.String(body)
+		}
+
+		output = append(output, customRule)
 	}
 
 	return &frontdoor.CustomRuleList{
@@ -969,15 +1014,27 @@ func flattenCdnFrontDoorFirewallCustomRules(input *frontdoor.CustomRuleList) []i
 			ruleType = string(v.RuleType)
 		}
 
+		customBlockResponseStatusCode := 0
+		if v.CustomBlockResponseStatusCode != nil {
+			customBlockResponseStatusCode = int(*v.CustomBlockResponseStatusCode)
+		}
+
+		customBlockResponseBody := ""
+		if v.CustomBlockResponseBody != nil {
+			customBlockResponseBody = *v.CustomBlockResponseBody
+		}
+
 		results = append(results, map[string]interface{}{
-			"action":                         action,
-			"enabled":                        enabled,
-			"match_condition":                flattenCdnFrontDoorFirewallMatchConditions(v.MatchConditions),
-			"rate_limit_duration_in_minutes": rateLimitDurationInMinutes,
-			"rate_limit_threshold":           rateLimitThreshold,
-			"priority":                       priority,
-			"name":                           name,
-			"type":                           ruleType,
+			"action":                            action,
+			"custom_block_response_body":        customBlockResponseBody,
+			"custom_block_response_status_code": customBlockResponseStatusCode,
+			"enabled":                           enabled,
+			"match_condition":                   flattenCdnFrontDoorFirewallMatchConditions(v.MatchConditions),
+			"rate_limit_duration_in_minutes":    rateLimitDurationInMinutes,
+			"rate_limit_threshold":              rateLimitThreshold,
+			"priority":                          priority,
+			"name":                              name,
+			"type":                              ruleType,
 		})
 	}
 
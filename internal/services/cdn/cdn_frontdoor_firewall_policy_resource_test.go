@@ -86,6 +86,46 @@ func TestAccCdnFrontDoorFirewallPolicy_complete(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorFirewallPolicy_jsChallenge(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_firewall_policy", "test")
+	r := CdnFrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.jsChallenge(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("js_challenge_cookie_expiration_in_minutes").HasValue("30"),
+				check.That(data.ResourceName).Key("custom_rule.0.action").HasValue("JSChallenge"),
+				check.That(data.ResourceName).Key("custom_rule.1.action").HasValue("CAPTCHA"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.jsChallenge(data, 45),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("js_challenge_cookie_expiration_in_minutes").HasValue("45"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCdnFrontDoorFirewallPolicy_perRuleBlockResponse(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_firewall_policy", "test")
+	r := CdnFrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.perRuleBlockResponse(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_rule.0.custom_block_response_status_code").HasValue("429"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccCdnFrontDoorFirewallPolicy_DRSOnePointOh(t *testing.T) {
 	// NOTE: Regression test case for issue #19088
 	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_firewall_policy", "test")
@@ -369,6 +409,85 @@ resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
 `, tmp, data.RandomInteger)
 }
 
+func (r CdnFrontDoorFirewallPolicyResource) jsChallenge(data acceptance.TestData, expirationInMinutes int) string {
+	tmp := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
+  name                                      = "accTestWAF%d"
+  resource_group_name                       = azurerm_resource_group.test.name
+  sku_name                                  = azurerm_cdn_frontdoor_profile.test.sku_name
+  mode                                      = "Prevention"
+  js_challenge_cookie_expiration_in_minutes = %d
+
+  custom_rule {
+    name     = "Rule1"
+    enabled  = true
+    priority = 1
+    type     = "MatchRule"
+    action   = "JSChallenge"
+
+    match_condition {
+      match_variable     = "RemoteAddr"
+      operator           = "IPMatch"
+      negation_condition = false
+      match_values       = ["192.168.1.0/24"]
+    }
+  }
+
+  custom_rule {
+    name     = "Rule2"
+    enabled  = true
+    priority = 2
+    type     = "MatchRule"
+    action   = "CAPTCHA"
+
+    match_condition {
+      match_variable     = "RemoteAddr"
+      operator           = "IPMatch"
+      negation_condition = false
+      match_values       = ["10.0.0.0/24"]
+    }
+  }
+}
+`, tmp, data.RandomInteger, expirationInMinutes)
+}
+
+func (r CdnFrontDoorFirewallPolicyResource) perRuleBlockResponse(data acceptance.TestData) string {
+	tmp := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
+  name                              = "accTestWAF%d"
+  resource_group_name               = azurerm_resource_group.test.name
+  sku_name                          = azurerm_cdn_frontdoor_profile.test.sku_name
+  mode                              = "Prevention"
+  custom_block_response_status_code = 403
+
+  custom_rule {
+    name                              = "Rule1"
+    enabled                           = true
+    priority                          = 1
+    rate_limit_duration_in_minutes    = 1
+    rate_limit_threshold              = 10
+    type                              = "RateLimitRule"
+    action                            = "Block"
+    custom_block_response_status_code = 429
+    custom_block_response_body        = "PGh0bWw+CjxoZWFkZXI+PHRpdGxlPkhlbGxvPC90aXRsZT48L2hlYWRlcj4KPGJvZHk+CkhlbGxvIHdvcmxkCjwvYm9keT4KPC9odG1sPg=="
+
+    match_condition {
+      match_variable     = "RemoteAddr"
+      operator           = "IPMatch"
+      negation_condition = false
+      match_values       = ["192.168.1.0/24"]
+    }
+  }
+}
+`, tmp, data.RandomInteger)
+}
+
 func (r CdnFrontDoorFirewallPolicyResource) complete(data acceptance.TestData) string {
 	tmp := r.template(data)
 	return fmt.Sprintf(`
@@ -14,6 +14,10 @@ const (
 	ActionTypeAllow ActionType = "Allow"
 	// ActionTypeBlock ...
 	ActionTypeBlock ActionType = "Block"
+	// ActionTypeCAPTCHA ...
+	ActionTypeCAPTCHA ActionType = "CAPTCHA"
+	// ActionTypeJSChallenge ...
+	ActionTypeJSChallenge ActionType = "JSChallenge"
 	// ActionTypeLog ...
 	ActionTypeLog ActionType = "Log"
 	// ActionTypeRedirect ...
@@ -22,7 +26,7 @@ const (
 
 // PossibleActionTypeValues returns an array of possible values for the ActionType const type.
 func PossibleActionTypeValues() []ActionType {
-	return []ActionType{ActionTypeAllow, ActionTypeBlock, ActionTypeLog, ActionTypeRedirect}
+	return []ActionType{ActionTypeAllow, ActionTypeBlock, ActionTypeCAPTCHA, ActionTypeJSChallenge, ActionTypeLog, ActionTypeRedirect}
 }
 
 // AggregationInterval enumerates the values for aggregation interval.
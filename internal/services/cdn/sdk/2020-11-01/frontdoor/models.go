@@ -9,16 +9,17 @@ package frontdoor
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/Azure/go-autorest/tracing"
-	"net/http"
 )
 
 // The package's fully qualified name.
-const fqdn = "github.com/Azure/azure-sdk-for-go/services/frontdoor/mgmt/2020-11-01/frontdoor"
+const fqdn = "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/sdk/2020-11-01/frontdoor"
 
 // AzureAsyncOperationResult the response body contains the status of the specified asynchronous operation,
 // indicating whether it has succeeded, is in progress, or has failed. Note that this status is distinct
@@ -380,8 +381,12 @@ type CustomRule struct {
 	RateLimitThreshold *int32 `json:"rateLimitThreshold,omitempty"`
 	// MatchConditions - List of match conditions.
 	MatchConditions *[]MatchCondition `json:"matchConditions,omitempty"`
-	// Action - Describes what action to be applied when rule matches. Possible values include: 'ActionTypeAllow', 'ActionTypeBlock', 'ActionTypeLog', 'ActionTypeRedirect'
+	// Action - Describes what action to be applied when rule matches. Possible values include: 'ActionTypeAllow', 'ActionTypeBlock', 'ActionTypeCAPTCHA', 'ActionTypeJSChallenge', 'ActionTypeLog', 'ActionTypeRedirect'
 	Action ActionType `json:"action,omitempty"`
+	// CustomBlockResponseStatusCode - If the action type is block, this field allows the rule to override the policy level custom response status code.
+	CustomBlockResponseStatusCode *int32 `json:"customBlockResponseStatusCode,omitempty"`
+	// CustomBlockResponseBody - If the action type is block, this field allows the rule to override the policy level custom response body. The body must be specified in base64 encoding.
+	CustomBlockResponseBody *string `json:"customBlockResponseBody,omitempty"`
 }
 
 // CustomRuleList defines contents of custom rules
@@ -2797,6 +2802,8 @@ type PolicySettings struct {
 	CustomBlockResponseBody *string `json:"customBlockResponseBody,omitempty"`
 	// RequestBodyCheck - Describes if policy managed rules will inspect the request body content. Possible values include: 'PolicyRequestBodyCheckDisabled', 'PolicyRequestBodyCheckEnabled'
 	RequestBodyCheck PolicyRequestBodyCheck `json:"requestBodyCheck,omitempty"`
+	// JavascriptChallengeExpirationInMinutes - Defines the JavaScript challenge cookie validity lifetime in minutes. This setting is only applicable to Premium_AzureFrontDoor. Value must be an integer between 5 and 1440 with the default value being 30.
+	JavascriptChallengeExpirationInMinutes *int32 `json:"javascriptChallengeExpirationInMinutes,omitempty"`
 }
 
 // PreconfiguredEndpoint defines the properties of a preconfigured endpoint
@@ -0,0 +1,163 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-04-15-preview/capabilities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-04-15-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ChaosStudioCapabilityModel struct {
+	CapabilityType      string `tfschema:"capability_type"`
+	ChaosStudioTargetId string `tfschema:"chaos_studio_target_id"`
+	Urn                 string `tfschema:"urn"`
+}
+
+type ChaosStudioCapabilityResource struct{}
+
+var _ sdk.Resource = (*ChaosStudioCapabilityResource)(nil)
+
+func (r ChaosStudioCapabilityResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"capability_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9-]+-\d+\.\d+$`),
+				"the capability type must be in the format `<name>-<major>.<minor>`, for example `Shutdown-1.0`",
+			),
+		},
+
+		"chaos_studio_target_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: targets.ValidateScopedTargetID,
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"urn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) ModelObject() interface{} {
+	return &ChaosStudioCapabilityModel{}
+}
+
+func (r ChaosStudioCapabilityResource) ResourceType() string {
+	return "azurerm_chaos_studio_capability"
+}
+
+func (r ChaosStudioCapabilityResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return capabilities.ValidateScopedCapabilityID
+}
+
+func (r ChaosStudioCapabilityResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.CapabilitiesClient
+
+			var model ChaosStudioCapabilityModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			targetId, err := targets.ParseScopedTargetID(model.ChaosStudioTargetId)
+			if err != nil {
+				return err
+			}
+
+			id := capabilities.NewScopedCapabilityID(targetId.Scope, targetId.TargetName, model.CapabilityType)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := capabilities.Capability{
+				Properties: &capabilities.CapabilityProperties{},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.CapabilitiesClient
+
+			id, err := capabilities.ParseScopedCapabilityID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := ChaosStudioCapabilityModel{
+				CapabilityType:      id.CapabilityName,
+				ChaosStudioTargetId: targets.NewScopedTargetID(id.Scope, id.TargetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					output.Urn = pointer.From(props.Urn)
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.CapabilitiesClient
+
+			id, err := capabilities.ParseScopedCapabilityID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,451 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-04-15-preview/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-04-15-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ChaosStudioExperimentModel struct {
+	Name              string                                     `tfschema:"name"`
+	ResourceGroupName string                                     `tfschema:"resource_group_name"`
+	Location          string                                     `tfschema:"location"`
+	Identity          []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Selectors         []ChaosStudioSelectorModel                 `tfschema:"selectors"`
+	Steps             []ChaosStudioStepModel                     `tfschema:"steps"`
+}
+
+type ChaosStudioSelectorModel struct {
+	Name                 string   `tfschema:"name"`
+	ChaosStudioTargetIds []string `tfschema:"chaos_studio_target_ids"`
+}
+
+type ChaosStudioStepModel struct {
+	Name     string                   `tfschema:"name"`
+	Branches []ChaosStudioBranchModel `tfschema:"branch"`
+}
+
+type ChaosStudioBranchModel struct {
+	Name    string                   `tfschema:"name"`
+	Actions []ChaosStudioActionModel `tfschema:"actions"`
+}
+
+type ChaosStudioActionModel struct {
+	ActionType   string            `tfschema:"action_type"`
+	Duration     string            `tfschema:"duration"`
+	Parameters   map[string]string `tfschema:"parameters"`
+	SelectorName string            `tfschema:"selector_name"`
+	Urn          string            `tfschema:"urn"`
+}
+
+type ChaosStudioExperimentResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*ChaosStudioExperimentResource)(nil)
+
+func (r ChaosStudioExperimentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"selectors": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"chaos_studio_target_ids": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: targets.ValidateScopedTargetID,
+						},
+					},
+				},
+			},
+		},
+
+		"steps": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"branch": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"actions": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"action_type": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringInSlice([]string{
+													"continuous",
+													"delay",
+													"discrete",
+												}, false),
+											},
+
+											"duration": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"parameters": {
+												Type:     pluginsdk.TypeMap,
+												Optional: true,
+												Elem: &pluginsdk.Schema{
+													Type: pluginsdk.TypeString,
+												},
+											},
+
+											"selector_name": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"urn": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ChaosStudioExperimentResource) ModelObject() interface{} {
+	return &ChaosStudioExperimentModel{}
+}
+
+func (r ChaosStudioExperimentResource) ResourceType() string {
+	return "azurerm_chaos_studio_experiment"
+}
+
+func (r ChaosStudioExperimentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return experiments.ValidateExperimentID
+}
+
+func (r ChaosStudioExperimentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.ExperimentsClient
+			subscriptionId := meta.Client.Account.SubscriptionId
+
+			var model ChaosStudioExperimentModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			id := experiments.NewExperimentID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := experiments.Experiment{
+				Identity: expandedIdentity,
+				Location: location.Normalize(model.Location),
+				Properties: experiments.ExperimentProperties{
+					Selectors: expandChaosStudioExperimentSelectors(model.Selectors),
+					Steps:     expandChaosStudioExperimentSteps(model.Steps),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := ChaosStudioExperimentModel{
+				Name:              id.ExperimentName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				output.Identity = pointer.From(flattenedIdentity)
+
+				output.Selectors = flattenChaosStudioExperimentSelectors(model.Properties.Selectors)
+				output.Steps = flattenChaosStudioExperimentSteps(model.Properties.Steps)
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ChaosStudioExperimentModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := experiments.Experiment{
+				Identity: expandedIdentity,
+				Location: location.Normalize(model.Location),
+				Properties: experiments.ExperimentProperties{
+					Selectors: expandChaosStudioExperimentSelectors(model.Selectors),
+					Steps:     expandChaosStudioExperimentSteps(model.Steps),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandChaosStudioExperimentSelectors(input []ChaosStudioSelectorModel) []experiments.Selector {
+	selectors := make([]experiments.Selector, 0)
+	for _, item := range input {
+		targetReferences := make([]experiments.TargetReference, 0)
+		for _, targetId := range item.ChaosStudioTargetIds {
+			targetReferences = append(targetReferences, experiments.TargetReference{
+				Id:   targetId,
+				Type: "ChaosTarget",
+			})
+		}
+
+		selectors = append(selectors, experiments.Selector{
+			Id:      item.Name,
+			Targets: targetReferences,
+			Type:    "List",
+		})
+	}
+	return selectors
+}
+
+func flattenChaosStudioExperimentSelectors(input []experiments.Selector) []ChaosStudioSelectorModel {
+	output := make([]ChaosStudioSelectorModel, 0)
+	for _, item := range input {
+		targetIds := make([]string, 0)
+		for _, target := range item.Targets {
+			targetIds = append(targetIds, target.Id)
+		}
+
+		output = append(output, ChaosStudioSelectorModel{
+			Name:                 item.Id,
+			ChaosStudioTargetIds: targetIds,
+		})
+	}
+	return output
+}
+
+func expandChaosStudioExperimentSteps(input []ChaosStudioStepModel) []experiments.Step {
+	steps := make([]experiments.Step, 0)
+	for _, item := range input {
+		branches := make([]experiments.Branch, 0)
+		for _, branch := range item.Branches {
+			actions := make([]experiments.Action, 0)
+			for _, action := range branch.Actions {
+				expandedAction := experiments.Action{
+					Name: action.Urn,
+					Type: action.ActionType,
+				}
+				if action.Duration != "" {
+					expandedAction.Duration = pointer.To(action.Duration)
+				}
+				if action.SelectorName != "" {
+					expandedAction.SelectorId = pointer.To(action.SelectorName)
+				}
+				if len(action.Parameters) > 0 {
+					parameters := make([]experiments.KeyValuePair, 0)
+					for key, value := range action.Parameters {
+						parameters = append(parameters, experiments.KeyValuePair{
+							Key:   key,
+							Value: value,
+						})
+					}
+					expandedAction.Parameters = &parameters
+				}
+				actions = append(actions, expandedAction)
+			}
+
+			branches = append(branches, experiments.Branch{
+				Actions: actions,
+				Name:    branch.Name,
+			})
+		}
+
+		steps = append(steps, experiments.Step{
+			Branches: branches,
+			Name:     item.Name,
+		})
+	}
+	return steps
+}
+
+func flattenChaosStudioExperimentSteps(input []experiments.Step) []ChaosStudioStepModel {
+	output := make([]ChaosStudioStepModel, 0)
+	for _, item := range input {
+		branches := make([]ChaosStudioBranchModel, 0)
+		for _, branch := range item.Branches {
+			actions := make([]ChaosStudioActionModel, 0)
+			for _, action := range branch.Actions {
+				flattenedAction := ChaosStudioActionModel{
+					ActionType:   action.Type,
+					Duration:     pointer.From(action.Duration),
+					SelectorName: pointer.From(action.SelectorId),
+					Urn:          action.Name,
+				}
+				if action.Parameters != nil {
+					parameters := make(map[string]string)
+					for _, parameter := range *action.Parameters {
+						parameters[parameter.Key] = parameter.Value
+					}
+					flattenedAction.Parameters = parameters
+				}
+				actions = append(actions, flattenedAction)
+			}
+
+			branches = append(branches, ChaosStudioBranchModel{
+				Name:    branch.Name,
+				Actions: actions,
+			})
+		}
+
+		output = append(output, ChaosStudioStepModel{
+			Name:     item.Name,
+			Branches: branches,
+		})
+	}
+	return output
+}
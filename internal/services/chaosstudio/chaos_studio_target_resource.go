@@ -0,0 +1,156 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-04-15-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ChaosStudioTargetModel struct {
+	Location         string `tfschema:"location"`
+	TargetResourceId string `tfschema:"target_resource_id"`
+	TargetType       string `tfschema:"target_type"`
+}
+
+type ChaosStudioTargetResource struct{}
+
+var _ sdk.Resource = (*ChaosStudioTargetResource)(nil)
+
+func (r ChaosStudioTargetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"target_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9-]+$`),
+				"the target type must only contain alphanumeric characters and hyphens, for example `Microsoft-VirtualMachine`",
+			),
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ChaosStudioTargetResource) ModelObject() interface{} {
+	return &ChaosStudioTargetModel{}
+}
+
+func (r ChaosStudioTargetResource) ResourceType() string {
+	return "azurerm_chaos_studio_target"
+}
+
+func (r ChaosStudioTargetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return targets.ValidateScopedTargetID
+}
+
+func (r ChaosStudioTargetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.TargetsClient
+
+			var model ChaosStudioTargetModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			id := targets.NewScopedTargetID(model.TargetResourceId, model.TargetType)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := targets.Target{
+				Location:   pointer.To(location.Normalize(model.Location)),
+				Properties: map[string]interface{}{},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.TargetsClient
+
+			id, err := targets.ParseScopedTargetID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := ChaosStudioTargetModel{
+				TargetResourceId: id.Scope,
+				TargetType:       id.TargetName,
+			}
+
+			if model := resp.Model; model != nil && model.Location != nil {
+				output.Location = location.Normalize(*model.Location)
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.ChaosStudio.TargetsClient
+
+			id, err := targets.ParseScopedTargetID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
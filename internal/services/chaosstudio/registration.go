@@ -0,0 +1,37 @@
+package chaosstudio
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type Registration struct{}
+
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/chaos-studio"
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Chaos Studio"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Chaos Studio",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ChaosStudioCapabilityResource{},
+		ChaosStudioExperimentResource{},
+		ChaosStudioTargetResource{},
+	}
+}
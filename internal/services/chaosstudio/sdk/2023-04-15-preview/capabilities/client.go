@@ -0,0 +1,18 @@
+package capabilities
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CapabilitiesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCapabilitiesClientWithBaseURI(endpoint string) CapabilitiesClient {
+	return CapabilitiesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,11 @@
+package capabilities
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Capability struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *CapabilityProperties `json:"properties,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
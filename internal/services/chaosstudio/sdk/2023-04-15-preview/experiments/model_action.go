@@ -0,0 +1,12 @@
+package experiments
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Action struct {
+	Duration   *string         `json:"duration,omitempty"`
+	Name       string          `json:"name"`
+	Parameters *[]KeyValuePair `json:"parameters,omitempty"`
+	SelectorId *string         `json:"selectorId,omitempty"`
+	Type       string          `json:"type"`
+}
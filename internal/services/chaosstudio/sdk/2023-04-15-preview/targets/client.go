@@ -0,0 +1,18 @@
+package targets
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type TargetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTargetsClientWithBaseURI(endpoint string) TargetsClient {
+	return TargetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
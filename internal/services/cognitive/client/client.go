@@ -2,13 +2,15 @@ package client
 
 import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/cognitiveservicesaccounts"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/deployments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/deployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/raipolicies"
 )
 
 type Client struct {
 	AccountsClient    *cognitiveservicesaccounts.CognitiveServicesAccountsClient
 	DeploymentsClient *deployments.DeploymentsClient
+	RaiPoliciesClient *raipolicies.RaiPoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,8 +20,12 @@ func NewClient(o *common.ClientOptions) *Client {
 
 	deploymentsClient := deployments.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
+
+	raiPoliciesClient := raipolicies.NewRaiPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&raiPoliciesClient.Client, o.ResourceManagerAuthorizer)
 	return &Client{
 		AccountsClient:    &accountsClient,
 		DeploymentsClient: &deploymentsClient,
+		RaiPoliciesClient: &raiPoliciesClient,
 	}
 }
@@ -0,0 +1,401 @@
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/raipolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type cognitiveAccountRaiPolicyModel struct {
+	Name               string                     `tfschema:"name"`
+	CognitiveAccountId string                     `tfschema:"cognitive_account_id"`
+	BasePolicyName     string                     `tfschema:"base_policy_name"`
+	ContentFilters     []RaiPolicyContentFilter   `tfschema:"content_filter"`
+	Blocklists         []RaiPolicyCustomBlocklist `tfschema:"blocklist"`
+	Mode               string                     `tfschema:"mode"`
+}
+
+type RaiPolicyContentFilter struct {
+	Name              string `tfschema:"name"`
+	FilterEnabled     bool   `tfschema:"filter_enabled"`
+	BlockEnabled      bool   `tfschema:"block_enabled"`
+	SeverityThreshold string `tfschema:"severity_threshold"`
+	Source            string `tfschema:"source"`
+}
+
+type RaiPolicyCustomBlocklist struct {
+	Name         string `tfschema:"name"`
+	BlockEnabled bool   `tfschema:"block_enabled"`
+	Source       string `tfschema:"source"`
+}
+
+type CognitiveAccountRaiPolicyResource struct{}
+
+var (
+	_ sdk.Resource           = CognitiveAccountRaiPolicyResource{}
+	_ sdk.ResourceWithUpdate = CognitiveAccountRaiPolicyResource{}
+)
+
+func (r CognitiveAccountRaiPolicyResource) ResourceType() string {
+	return "azurerm_cognitive_account_rai_policy"
+}
+
+func (r CognitiveAccountRaiPolicyResource) ModelObject() interface{} {
+	return &cognitiveAccountRaiPolicyModel{}
+}
+
+func (r CognitiveAccountRaiPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return raipolicies.ValidateRaiPolicyID
+}
+
+func (r CognitiveAccountRaiPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cognitive_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cognitiveservicesaccounts.ValidateAccountID,
+		},
+
+		"base_policy_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"filter_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"block_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"severity_threshold": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyContentLevel(), false),
+					},
+
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyContentSource(), false),
+					},
+				},
+			},
+		},
+
+		"blocklist": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"block_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyContentSource(), false),
+					},
+				},
+			},
+		},
+
+		"mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(raipolicies.RaiPolicyModeDefault),
+			ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyMode(), false),
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveAccountRaiPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+			accountId, err := cognitiveservicesaccounts.ParseAccountID(model.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := raipolicies.NewRaiPolicyID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			mode := raipolicies.RaiPolicyMode(model.Mode)
+			policy := raipolicies.RaiPolicy{
+				Properties: &raipolicies.RaiPolicyProperties{
+					BasePolicyName:   &model.BasePolicyName,
+					ContentFilters:   expandRaiPolicyContentFilters(model.ContentFilters),
+					CustomBlocklists: expandRaiPolicyCustomBlocklists(model.Blocklists),
+					Mode:             &mode,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, policy); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model cognitiveAccountRaiPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			policy := resp.Model
+			if policy == nil || policy.Properties == nil {
+				return fmt.Errorf("retrieving %s: properties was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("content_filter") {
+				policy.Properties.ContentFilters = expandRaiPolicyContentFilters(model.ContentFilters)
+			}
+
+			if metadata.ResourceData.HasChange("blocklist") {
+				policy.Properties.CustomBlocklists = expandRaiPolicyCustomBlocklists(model.Blocklists)
+			}
+
+			if metadata.ResourceData.HasChange("mode") {
+				mode := raipolicies.RaiPolicyMode(model.Mode)
+				policy.Properties.Mode = &mode
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *policy); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := cognitiveAccountRaiPolicyModel{
+				Name:               id.RaiPolicyName,
+				CognitiveAccountId: cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID(),
+			}
+
+			if properties := model.Properties; properties != nil {
+				state.BasePolicyName = pointer.From(properties.BasePolicyName)
+				state.ContentFilters = flattenRaiPolicyContentFilters(properties.ContentFilters)
+				state.Blocklists = flattenRaiPolicyCustomBlocklists(properties.CustomBlocklists)
+
+				if v := properties.Mode; v != nil {
+					state.Mode = string(*v)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandRaiPolicyContentFilters(inputList []RaiPolicyContentFilter) *[]raipolicies.RaiPolicyContentFilter {
+	outputList := make([]raipolicies.RaiPolicyContentFilter, 0, len(inputList))
+	for _, input := range inputList {
+		filter := input
+		severityThreshold := raipolicies.RaiPolicyContentLevel(filter.SeverityThreshold)
+		source := raipolicies.RaiPolicyContentSource(filter.Source)
+
+		outputList = append(outputList, raipolicies.RaiPolicyContentFilter{
+			Name:              &filter.Name,
+			Enabled:           &filter.FilterEnabled,
+			Blocking:          &filter.BlockEnabled,
+			SeverityThreshold: &severityThreshold,
+			Source:            &source,
+		})
+	}
+
+	return &outputList
+}
+
+func expandRaiPolicyCustomBlocklists(inputList []RaiPolicyCustomBlocklist) *[]raipolicies.CustomBlocklistConfig {
+	if len(inputList) == 0 {
+		return nil
+	}
+
+	outputList := make([]raipolicies.CustomBlocklistConfig, 0, len(inputList))
+	for _, input := range inputList {
+		blocklist := input
+		source := raipolicies.RaiPolicyContentSource(blocklist.Source)
+
+		outputList = append(outputList, raipolicies.CustomBlocklistConfig{
+			BlocklistName: &blocklist.Name,
+			Blocking:      &blocklist.BlockEnabled,
+			Source:        &source,
+		})
+	}
+
+	return &outputList
+}
+
+func flattenRaiPolicyContentFilters(input *[]raipolicies.RaiPolicyContentFilter) []RaiPolicyContentFilter {
+	outputList := make([]RaiPolicyContentFilter, 0)
+	if input == nil {
+		return outputList
+	}
+
+	for _, filter := range *input {
+		output := RaiPolicyContentFilter{
+			Name:          pointer.From(filter.Name),
+			FilterEnabled: pointer.From(filter.Enabled),
+			BlockEnabled:  pointer.From(filter.Blocking),
+		}
+
+		if v := filter.SeverityThreshold; v != nil {
+			output.SeverityThreshold = string(*v)
+		}
+
+		if v := filter.Source; v != nil {
+			output.Source = string(*v)
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func flattenRaiPolicyCustomBlocklists(input *[]raipolicies.CustomBlocklistConfig) []RaiPolicyCustomBlocklist {
+	outputList := make([]RaiPolicyCustomBlocklist, 0)
+	if input == nil {
+		return outputList
+	}
+
+	for _, blocklist := range *input {
+		output := RaiPolicyCustomBlocklist{
+			Name:         pointer.From(blocklist.BlocklistName),
+			BlockEnabled: pointer.From(blocklist.Blocking),
+		}
+
+		if v := blocklist.Source; v != nil {
+			output.Source = string(*v)
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
@@ -6,13 +6,13 @@ import (
 	"log"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/cognitiveservicesaccounts"
-	search "github.com/hashicorp/go-azure-sdk/resource-manager/search/2020-03-13/services"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -23,6 +23,7 @@ import (
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	search "github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2020-03-13/services"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
@@ -334,6 +335,28 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// before creating check to see if an account with the same name exists in the soft delete state
+	deletedAccountId := cognitiveservicesaccounts.NewDeletedAccountID(id.SubscriptionId, location, id.ResourceGroupName, id.AccountName)
+	softDeleted, err := client.DeletedAccountsGet(ctx, deletedAccountId)
+	if err != nil {
+		if !response.WasNotFound(softDeleted.HttpResponse) && !utils.ResponseWasForbidden(autorest.Response{Response: softDeleted.HttpResponse}) {
+			return fmt.Errorf("checking for the presence of an existing Soft-Deleted Cognitive Account %q (Location %q): %+v", id.AccountName, location, err)
+		}
+	}
+
+	// if so, does the user want us to recover it?
+	restoreSoftDeleted := false
+	if !response.WasNotFound(softDeleted.HttpResponse) && !utils.ResponseWasForbidden(autorest.Response{Response: softDeleted.HttpResponse}) {
+		if !meta.(*clients.Client).Features.CognitiveAccount.RecoverSoftDeleted {
+			// this exists but the users opted out, so they must import this out-of-band
+			return fmt.Errorf(optedOutOfRecoveringSoftDeletedCognitiveAccountErrorFmt(id.AccountName, location))
+		}
+
+		restoreSoftDeleted = true
+	}
+
 	sku, err := expandAccountSkuName(d.Get("sku_name").(string))
 	if err != nil {
 		return fmt.Errorf("expanding sku_name for %s: %v", id, err)
@@ -368,7 +391,7 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 
 	props := cognitiveservicesaccounts.Account{
 		Kind:     utils.String(kind),
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Location: utils.String(location),
 		Sku:      sku,
 		Properties: &cognitiveservicesaccounts.AccountProperties{
 			ApiProperties:                 apiProps,
@@ -391,6 +414,10 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 	props.Identity = identity
 
+	if restoreSoftDeleted {
+		props.Properties.Restore = utils.Bool(true)
+	}
+
 	if _, err := client.AccountsCreate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -893,3 +920,20 @@ func flattenCognitiveAccountCustomerManagedKey(input *cognitiveservicesaccounts.
 		},
 	}, nil
 }
+
+func optedOutOfRecoveringSoftDeletedCognitiveAccountErrorFmt(name, location string) string {
+	return fmt.Sprintf(`
+An existing soft-deleted Cognitive Account exists with the Name %q in the location %q,
+however automatically recovering this Cognitive Account has been disabled via the
+"features" block.
+
+Terraform can automatically recover the soft-deleted Cognitive Account when this
+behaviour is enabled within the "features" block (located within the "provider" block) -
+more information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different name/location.
+`, name, location)
+}
@@ -5,20 +5,22 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/cognitiveservicesaccounts"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/deployments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/deployments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type cognitiveDeploymentModel struct {
-	Name               string                         `tfschema:"name"`
-	CognitiveAccountId string                         `tfschema:"cognitive_account_id"`
-	Model              []DeploymentModelModel         `tfschema:"model"`
-	RaiPolicyName      string                         `tfschema:"rai_policy_name"`
-	ScaleSettings      []DeploymentScaleSettingsModel `tfschema:"scale"`
+	Name                     string                         `tfschema:"name"`
+	CognitiveAccountId       string                         `tfschema:"cognitive_account_id"`
+	DynamicThrottlingEnabled bool                           `tfschema:"dynamic_throttling_enabled"`
+	Model                    []DeploymentModelModel         `tfschema:"model"`
+	RaiPolicyName            string                         `tfschema:"rai_policy_name"`
+	ScaleSettings            []DeploymentScaleSettingsModel `tfschema:"scale"`
 }
 
 type DeploymentModelModel struct {
@@ -28,12 +30,16 @@ type DeploymentModelModel struct {
 }
 
 type DeploymentScaleSettingsModel struct {
+	Capacity  int64                           `tfschema:"capacity"`
 	ScaleType deployments.DeploymentScaleType `tfschema:"type"`
 }
 
 type CognitiveDeploymentResource struct{}
 
-var _ sdk.Resource = CognitiveDeploymentResource{}
+var (
+	_ sdk.Resource           = CognitiveDeploymentResource{}
+	_ sdk.ResourceWithUpdate = CognitiveDeploymentResource{}
+)
 
 func (r CognitiveDeploymentResource) ResourceType() string {
 	return "azurerm_cognitive_deployment"
@@ -97,7 +103,6 @@ func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 		"scale": {
 			Type:     pluginsdk.TypeList,
 			Required: true,
-			ForceNew: true,
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
@@ -109,14 +114,25 @@ func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 							string(deployments.DeploymentScaleTypeStandard),
 						}, false),
 					},
+
+					"capacity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
 				},
 			},
 		},
 
+		"dynamic_throttling_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
 		"rai_policy_name": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
-			ForceNew:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 	}
@@ -157,6 +173,10 @@ func (r CognitiveDeploymentResource) Create() sdk.ResourceFunc {
 
 			properties.Properties.Model = expandDeploymentModelModel(model.Model)
 
+			if model.DynamicThrottlingEnabled {
+				properties.Properties.DynamicThrottlingEnabled = &model.DynamicThrottlingEnabled
+			}
+
 			if model.RaiPolicyName != "" {
 				properties.Properties.RaiPolicyName = &model.RaiPolicyName
 			}
@@ -173,6 +193,53 @@ func (r CognitiveDeploymentResource) Create() sdk.ResourceFunc {
 	}
 }
 
+func (r CognitiveDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.DeploymentsClient
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model cognitiveDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil || properties.Properties == nil {
+				return fmt.Errorf("retrieving %s: properties was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("dynamic_throttling_enabled") {
+				properties.Properties.DynamicThrottlingEnabled = &model.DynamicThrottlingEnabled
+			}
+
+			if metadata.ResourceData.HasChange("rai_policy_name") {
+				properties.Properties.RaiPolicyName = &model.RaiPolicyName
+			}
+
+			if metadata.ResourceData.HasChange("scale.0.capacity") {
+				properties.Properties.ScaleSettings = expandDeploymentScaleSettingsModel(model.ScaleSettings)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r CognitiveDeploymentResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -205,6 +272,8 @@ func (r CognitiveDeploymentResource) Read() sdk.ResourceFunc {
 
 			if properties := model.Properties; properties != nil {
 
+				state.DynamicThrottlingEnabled = pointer.From(properties.DynamicThrottlingEnabled)
+
 				state.Model = flattenDeploymentModelModel(properties.Model)
 
 				state.ScaleSettings = flattenDeploymentScaleSettingsModel(properties.ScaleSettings)
@@ -272,6 +341,10 @@ func expandDeploymentScaleSettingsModel(inputList []DeploymentScaleSettingsModel
 		ScaleType: &input.ScaleType,
 	}
 
+	if input.Capacity != 0 {
+		output.Capacity = &input.Capacity
+	}
+
 	return &output
 }
 
@@ -309,7 +382,9 @@ func flattenDeploymentScaleSettingsModel(input *deployments.DeploymentScaleSetti
 		return outputList
 	}
 
-	output := DeploymentScaleSettingsModel{}
+	output := DeploymentScaleSettingsModel{
+		Capacity: pointer.From(input.Capacity),
+	}
 
 	if input.ScaleType != nil {
 		output.ScaleType = *input.ScaleType
@@ -6,10 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/deployments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/deployments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -24,6 +24,7 @@ func TestAccCognitiveDeploymentSequential(t *testing.T) {
 			"basic":          testAccCognitiveDeployment_basic,
 			"requiresImport": testAccCognitiveDeployment_requiresImport,
 			"complete":       testAccCognitiveDeployment_complete,
+			"update":         testAccCognitiveDeployment_update,
 		},
 	})
 }
@@ -72,6 +73,27 @@ func testAccCognitiveDeployment_complete(t *testing.T) {
 	})
 }
 
+func testAccCognitiveDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_deployment", "test")
+	r := CognitiveDeploymentTestResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r CognitiveDeploymentTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := deployments.ParseDeploymentID(state.ID)
 	if err != nil {
@@ -152,6 +174,32 @@ resource "azurerm_cognitive_deployment" "import" {
 `, config)
 }
 
+func (r CognitiveDeploymentTestResource) update(data acceptance.TestData) string {
+	template := r.template(data, "OpenAI")
+
+	return fmt.Sprintf(`
+
+%s
+
+resource "azurerm_cognitive_deployment" "test" {
+  name                 = "acctest-cd-%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  model {
+    format  = "OpenAI"
+    name    = "text-curie-001"
+    version = "1"
+  }
+
+  scale {
+    type     = "Standard"
+    capacity = 2
+  }
+
+  dynamic_throttling_enabled = true
+}
+`, template, data.RandomInteger)
+}
+
 func (r CognitiveDeploymentTestResource) complete(data acceptance.TestData) string {
 	template := r.template(data, "OpenAI")
 	return fmt.Sprintf(`
@@ -0,0 +1,14 @@
+package deployments
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DeploymentProperties struct {
+	CallRateLimit            *CallRateLimit               `json:"callRateLimit,omitempty"`
+	Capabilities             *map[string]string           `json:"capabilities,omitempty"`
+	DynamicThrottlingEnabled *bool                        `json:"dynamicThrottlingEnabled,omitempty"`
+	Model                    *DeploymentModel             `json:"model,omitempty"`
+	ProvisioningState        *DeploymentProvisioningState `json:"provisioningState,omitempty"`
+	RaiPolicyName            *string                      `json:"raiPolicyName,omitempty"`
+	ScaleSettings            *DeploymentScaleSettings     `json:"scaleSettings,omitempty"`
+}
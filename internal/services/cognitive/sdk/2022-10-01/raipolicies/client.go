@@ -0,0 +1,18 @@
+package raipolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RaiPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRaiPoliciesClientWithBaseURI(endpoint string) RaiPoliciesClient {
+	return RaiPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,124 @@
+package raipolicies
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RaiPolicyContentLevel string
+
+const (
+	RaiPolicyContentLevelHigh   RaiPolicyContentLevel = "High"
+	RaiPolicyContentLevelLow    RaiPolicyContentLevel = "Low"
+	RaiPolicyContentLevelMedium RaiPolicyContentLevel = "Medium"
+)
+
+func PossibleValuesForRaiPolicyContentLevel() []string {
+	return []string{
+		string(RaiPolicyContentLevelHigh),
+		string(RaiPolicyContentLevelLow),
+		string(RaiPolicyContentLevelMedium),
+	}
+}
+
+func parseRaiPolicyContentLevel(input string) (*RaiPolicyContentLevel, error) {
+	vals := map[string]RaiPolicyContentLevel{
+		"high":   RaiPolicyContentLevelHigh,
+		"low":    RaiPolicyContentLevelLow,
+		"medium": RaiPolicyContentLevelMedium,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RaiPolicyContentLevel(input)
+	return &out, nil
+}
+
+type RaiPolicyContentSource string
+
+const (
+	RaiPolicyContentSourceCompletion RaiPolicyContentSource = "Completion"
+	RaiPolicyContentSourcePrompt     RaiPolicyContentSource = "Prompt"
+)
+
+func PossibleValuesForRaiPolicyContentSource() []string {
+	return []string{
+		string(RaiPolicyContentSourceCompletion),
+		string(RaiPolicyContentSourcePrompt),
+	}
+}
+
+func parseRaiPolicyContentSource(input string) (*RaiPolicyContentSource, error) {
+	vals := map[string]RaiPolicyContentSource{
+		"completion": RaiPolicyContentSourceCompletion,
+		"prompt":     RaiPolicyContentSourcePrompt,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RaiPolicyContentSource(input)
+	return &out, nil
+}
+
+type RaiPolicyMode string
+
+const (
+	RaiPolicyModeBlocking RaiPolicyMode = "Blocking"
+	RaiPolicyModeDefault  RaiPolicyMode = "Default"
+	RaiPolicyModeDeferred RaiPolicyMode = "Deferred"
+)
+
+func PossibleValuesForRaiPolicyMode() []string {
+	return []string{
+		string(RaiPolicyModeBlocking),
+		string(RaiPolicyModeDefault),
+		string(RaiPolicyModeDeferred),
+	}
+}
+
+func parseRaiPolicyMode(input string) (*RaiPolicyMode, error) {
+	vals := map[string]RaiPolicyMode{
+		"blocking": RaiPolicyModeBlocking,
+		"default":  RaiPolicyModeDefault,
+		"deferred": RaiPolicyModeDeferred,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RaiPolicyMode(input)
+	return &out, nil
+}
+
+type RaiPolicyType string
+
+const (
+	RaiPolicyTypeSystemManaged RaiPolicyType = "SystemManaged"
+	RaiPolicyTypeUserManaged   RaiPolicyType = "UserManaged"
+)
+
+func PossibleValuesForRaiPolicyType() []string {
+	return []string{
+		string(RaiPolicyTypeSystemManaged),
+		string(RaiPolicyTypeUserManaged),
+	}
+}
+
+func parseRaiPolicyType(input string) (*RaiPolicyType, error) {
+	vals := map[string]RaiPolicyType{
+		"systemmanaged": RaiPolicyTypeSystemManaged,
+		"usermanaged":   RaiPolicyTypeUserManaged,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RaiPolicyType(input)
+	return &out, nil
+}
@@ -0,0 +1,12 @@
+package raipolicies
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RaiPolicy struct {
+	Etag       *string              `json:"etag,omitempty"`
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *RaiPolicyProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
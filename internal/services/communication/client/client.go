@@ -1,19 +1,37 @@
 package client
 
 import (
-	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2020-08-20/communicationservice"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/communicationservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/emailservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/senderusernames"
 )
 
 type Client struct {
-	ServiceClient *communicationservice.CommunicationServiceClient
+	ServiceClient         *communicationservices.CommunicationServicesClient
+	EmailServicesClient   *emailservices.EmailServicesClient
+	DomainsClient         *domains.DomainsClient
+	SenderUsernamesClient *senderusernames.SenderUsernamesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
-	serviceClient := communicationservice.NewCommunicationServiceClientWithBaseURI(o.ResourceManagerEndpoint)
+	serviceClient := communicationservices.NewCommunicationServicesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&serviceClient.Client, o.ResourceManagerAuthorizer)
 
+	emailServicesClient := emailservices.NewEmailServicesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&emailServicesClient.Client, o.ResourceManagerAuthorizer)
+
+	domainsClient := domains.NewDomainsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&domainsClient.Client, o.ResourceManagerAuthorizer)
+
+	senderUsernamesClient := senderusernames.NewSenderUsernamesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&senderUsernamesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ServiceClient: &serviceClient,
+		ServiceClient:         &serviceClient,
+		EmailServicesClient:   &emailServicesClient,
+		DomainsClient:         &domainsClient,
+		SenderUsernamesClient: &senderUsernamesClient,
 	}
 }
@@ -9,10 +9,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2020-08-20/communicationservice"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/communicationservices"
+	communicationDomains "github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -35,7 +36,7 @@ func resourceArmCommunicationService() *pluginsdk.Resource {
 		},
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := communicationservice.ParseCommunicationServiceID(id)
+			_, err := communicationservices.ParseCommunicationServiceID(id)
 			return err
 		}),
 
@@ -79,6 +80,15 @@ func resourceArmCommunicationService() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"linked_email_domain_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: communicationDomains.ValidateDomainID,
+				},
+			},
+
 			"tags": commonschema.Tags(),
 
 			"primary_connection_string": {
@@ -110,7 +120,7 @@ func resourceArmCommunicationServiceCreateUpdate(d *pluginsdk.ResourceData, meta
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := communicationservice.NewCommunicationServiceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := communicationservices.NewCommunicationServiceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id)
 		if err != nil {
@@ -124,11 +134,12 @@ func resourceArmCommunicationServiceCreateUpdate(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	parameter := communicationservice.CommunicationServiceResource{
+	parameter := communicationservices.CommunicationServiceResource{
 		// The location is always `global` from the Azure Portal
 		Location: utils.String(location.Normalize("global")),
-		Properties: &communicationservice.CommunicationServiceProperties{
-			DataLocation: d.Get("data_location").(string),
+		Properties: &communicationservices.CommunicationServiceProperties{
+			DataLocation:  d.Get("data_location").(string),
+			LinkedDomains: utils.ExpandStringSlice(d.Get("linked_email_domain_ids").(*pluginsdk.Set).List()),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -147,7 +158,7 @@ func resourceArmCommunicationServiceRead(d *pluginsdk.ResourceData, meta interfa
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := communicationservice.ParseCommunicationServiceID(d.Id())
+	id, err := communicationservices.ParseCommunicationServiceID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -174,6 +185,10 @@ func resourceArmCommunicationServiceRead(d *pluginsdk.ResourceData, meta interfa
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("data_location", props.DataLocation)
+
+			if err := d.Set("linked_email_domain_ids", utils.FlattenStringSlice(props.LinkedDomains)); err != nil {
+				return fmt.Errorf("setting `linked_email_domain_ids`: %+v", err)
+			}
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -196,7 +211,7 @@ func resourceArmCommunicationServiceDelete(d *pluginsdk.ResourceData, meta inter
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := communicationservice.ParseCommunicationServiceID(d.Id())
+	id, err := communicationservices.ParseCommunicationServiceID(d.Id())
 	if err != nil {
 		return err
 	}
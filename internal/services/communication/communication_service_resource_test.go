@@ -6,10 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2020-08-20/communicationservice"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/communicationservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -164,9 +164,32 @@ func TestAccCommunicationService_update(t *testing.T) {
 	})
 }
 
+func TestAccCommunicationService_linkedEmailDomains(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_communication_service", "test")
+	r := CommunicationServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkedEmailDomains(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_email_domain_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r CommunicationServiceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	clusterClient := client.Communication.ServiceClient
-	id, err := communicationservice.ParseCommunicationServiceID(state.ID)
+	id, err := communicationservices.ParseCommunicationServiceID(state.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -239,6 +262,30 @@ resource "azurerm_communication_service" "test" {
 `, r.template(data), data.RandomInteger, dataLocation)
 }
 
+func (r CommunicationServiceResource) linkedEmailDomains(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service" "test" {
+  name                = "acctest-EmailCommunicationService-%d"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = "AzureManagedDomain"
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "AzureManaged"
+}
+
+resource "azurerm_communication_service" "test" {
+  name                = "acctest-CommunicationService-%d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  linked_email_domain_ids = [azurerm_email_communication_service_domain.test.id]
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
 func (r CommunicationServiceResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,286 @@
+package communication
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceEmailCommunicationServiceDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceEmailCommunicationServiceDomainCreateUpdate,
+		Read:   resourceEmailCommunicationServiceDomainRead,
+		Update: resourceEmailCommunicationServiceDomainCreateUpdate,
+		Delete: resourceEmailCommunicationServiceDomainDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := domains.ParseDomainID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"email_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: domains.ValidateEmailServiceID,
+			},
+
+			"domain_management": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(domains.PossibleValuesForDomainManagement(), false),
+			},
+
+			"user_engagement_tracking_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"from_sender_domain": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"mail_from_sender_domain": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"verification_records": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"domain": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem:     dnsRecordSchema(),
+						},
+
+						"dkim": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem:     dnsRecordSchema(),
+						},
+
+						"dkim2": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem:     dnsRecordSchema(),
+						},
+
+						"dmarc": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem:     dnsRecordSchema(),
+						},
+
+						"spf": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem:     dnsRecordSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dnsRecordSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"ttl": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"value": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEmailCommunicationServiceDomainCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Communication.DomainsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	emailServiceId, err := domains.ParseEmailServiceID(d.Get("email_service_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := domains.NewDomainID(emailServiceId.SubscriptionId, emailServiceId.ResourceGroupName, emailServiceId.EmailServiceName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_email_communication_service_domain", id.ID())
+		}
+	}
+
+	userEngagementTracking := domains.UserEngagementTrackingDisabled
+	if d.Get("user_engagement_tracking_enabled").(bool) {
+		userEngagementTracking = domains.UserEngagementTrackingEnabled
+	}
+
+	parameter := domains.DomainResource{
+		// The location is always `global` from the Azure Portal
+		Location: utils.String(location.Normalize("global")),
+		Properties: &domains.DomainProperties{
+			DomainManagement:       domains.DomainManagement(d.Get("domain_management").(string)),
+			UserEngagementTracking: &userEngagementTracking,
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameter); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceEmailCommunicationServiceDomainRead(d, meta)
+}
+
+func resourceEmailCommunicationServiceDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Communication.DomainsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := domains.ParseDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DomainName)
+	d.Set("email_service_id", domains.NewEmailServiceID(id.SubscriptionId, id.ResourceGroupName, id.EmailServiceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("domain_management", string(props.DomainManagement))
+			d.Set("from_sender_domain", props.FromSenderDomain)
+			d.Set("mail_from_sender_domain", props.MailFromSenderDomain)
+
+			userEngagementTrackingEnabled := false
+			if props.UserEngagementTracking != nil && *props.UserEngagementTracking == domains.UserEngagementTrackingEnabled {
+				userEngagementTrackingEnabled = true
+			}
+			d.Set("user_engagement_tracking_enabled", userEngagementTrackingEnabled)
+
+			if err := d.Set("verification_records", flattenEmailCommunicationServiceDomainVerificationRecords(props.VerificationRecords)); err != nil {
+				return fmt.Errorf("setting `verification_records`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceEmailCommunicationServiceDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Communication.DomainsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := domains.ParseDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenEmailCommunicationServiceDomainVerificationRecords(input *domains.DomainPropertiesVerificationRecords) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"domain": flattenEmailCommunicationServiceDomainDnsRecord(input.Domain),
+			"dkim":   flattenEmailCommunicationServiceDomainDnsRecord(input.DKIM),
+			"dkim2":  flattenEmailCommunicationServiceDomainDnsRecord(input.DKIM2),
+			"dmarc":  flattenEmailCommunicationServiceDomainDnsRecord(input.DMARC),
+			"spf":    flattenEmailCommunicationServiceDomainDnsRecord(input.SPF),
+		},
+	}
+}
+
+func flattenEmailCommunicationServiceDomainDnsRecord(input *domains.DnsRecord) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	ttl := 0
+	if input.Ttl != nil {
+		ttl = int(*input.Ttl)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":  utils.NormalizeNilableString(input.Name),
+			"ttl":   ttl,
+			"type":  utils.NormalizeNilableString(input.Type),
+			"value": utils.NormalizeNilableString(input.Value),
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EmailCommunicationServiceDomainResource struct{}
+
+func TestAccEmailCommunicationServiceDomain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailCommunicationServiceDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("from_sender_domain").Exists(),
+				check.That(data.ResourceName).Key("mail_from_sender_domain").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEmailCommunicationServiceDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailCommunicationServiceDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEmailCommunicationServiceDomain_customerManaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailCommunicationServiceDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customerManaged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("verification_records.#").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEmailCommunicationServiceDomain_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailCommunicationServiceDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.userEngagementTracking(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EmailCommunicationServiceDomainResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := domains.ParseDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Communication.DomainsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EmailCommunicationServiceDomainResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = "AzureManagedDomain"
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "AzureManaged"
+}
+`, r.template(data))
+}
+
+func (r EmailCommunicationServiceDomainResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "import" {
+  name              = azurerm_email_communication_service_domain.test.name
+  email_service_id  = azurerm_email_communication_service_domain.test.email_service_id
+  domain_management = azurerm_email_communication_service_domain.test.domain_management
+}
+`, config)
+}
+
+func (r EmailCommunicationServiceDomainResource) customerManaged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = "acctest%d.com"
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "CustomerManaged"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EmailCommunicationServiceDomainResource) userEngagementTracking(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = "AzureManagedDomain"
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "AzureManaged"
+
+  user_engagement_tracking_enabled = true
+}
+`, r.template(data))
+}
+
+func (r EmailCommunicationServiceDomainResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-communication-%d"
+  location = "%s"
+}
+
+resource "azurerm_email_communication_service" "test" {
+  name                = "acctest-EmailCommunicationService-%d"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
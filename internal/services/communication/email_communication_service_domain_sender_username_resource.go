@@ -0,0 +1,152 @@
+package communication
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/senderusernames"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceEmailCommunicationServiceDomainSenderUsername() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceEmailCommunicationServiceDomainSenderUsernameCreateUpdate,
+		Read:   resourceEmailCommunicationServiceDomainSenderUsernameRead,
+		Update: resourceEmailCommunicationServiceDomainSenderUsernameCreateUpdate,
+		Delete: resourceEmailCommunicationServiceDomainSenderUsernameDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := senderusernames.ParseSenderUsernameID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"email_service_domain_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: senderusernames.ValidateDomainID,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceEmailCommunicationServiceDomainSenderUsernameCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Communication.SenderUsernamesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	domainId, err := senderusernames.ParseDomainID(d.Get("email_service_domain_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := senderusernames.NewSenderUsernameID(domainId.SubscriptionId, domainId.ResourceGroupName, domainId.EmailServiceName, domainId.DomainName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_email_communication_service_domain_sender_username", id.ID())
+		}
+	}
+
+	parameter := senderusernames.SenderUsernameResource{
+		Properties: &senderusernames.SenderUsernameProperties{
+			Username: id.SenderUsernameName,
+		},
+	}
+
+	if displayName := d.Get("display_name").(string); displayName != "" {
+		parameter.Properties.DisplayName = utils.String(displayName)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameter); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceEmailCommunicationServiceDomainSenderUsernameRead(d, meta)
+}
+
+func resourceEmailCommunicationServiceDomainSenderUsernameRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Communication.SenderUsernamesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := senderusernames.ParseSenderUsernameID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SenderUsernameName)
+	d.Set("email_service_domain_id", senderusernames.NewDomainID(id.SubscriptionId, id.ResourceGroupName, id.EmailServiceName, id.DomainName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("display_name", props.DisplayName)
+		}
+	}
+
+	return nil
+}
+
+func resourceEmailCommunicationServiceDomainSenderUsernameDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Communication.SenderUsernamesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := senderusernames.ParseSenderUsernameID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/senderusernames"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EmailCommunicationServiceDomainSenderUsernameResource struct{}
+
+func TestAccEmailCommunicationServiceDomainSenderUsername_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_sender_username", "test")
+	r := EmailCommunicationServiceDomainSenderUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEmailCommunicationServiceDomainSenderUsername_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_sender_username", "test")
+	r := EmailCommunicationServiceDomainSenderUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEmailCommunicationServiceDomainSenderUsername_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_sender_username", "test")
+	r := EmailCommunicationServiceDomainSenderUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.displayName(data, "Donot Reply"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.displayName(data, "No Reply"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := senderusernames.ParseSenderUsernameID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Communication.SenderUsernamesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain_sender_username" "test" {
+  name                    = "donotreply"
+  email_service_domain_id = azurerm_email_communication_service_domain.test.id
+}
+`, r.template(data))
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain_sender_username" "import" {
+  name                    = azurerm_email_communication_service_domain_sender_username.test.name
+  email_service_domain_id = azurerm_email_communication_service_domain_sender_username.test.email_service_domain_id
+}
+`, config)
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) displayName(data acceptance.TestData, displayName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain_sender_username" "test" {
+  name                    = "donotreply"
+  email_service_domain_id = azurerm_email_communication_service_domain.test.id
+  display_name            = "%s"
+}
+`, r.template(data), displayName)
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-communication-%d"
+  location = "%s"
+}
+
+resource "azurerm_email_communication_service" "test" {
+  name                = "acctest-EmailCommunicationService-%d"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = "AzureManagedDomain"
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "AzureManaged"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"log"
 
-	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2020-08-20/communicationservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/communicationservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -66,7 +66,7 @@ func (ServiceV0ToV1) Schema() map[string]*pluginsdk.Schema {
 func (ServiceV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 		oldId := rawState["id"].(string)
-		parsedId, err := communicationservice.ParseCommunicationServiceIDInsensitively(oldId)
+		parsedId, err := communicationservices.ParseCommunicationServiceIDInsensitively(oldId)
 		if err != nil {
 			return nil, err
 		}
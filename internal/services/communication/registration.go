@@ -33,6 +33,9 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_communication_service": resourceArmCommunicationService(),
+		"azurerm_communication_service":                              resourceArmCommunicationService(),
+		"azurerm_email_communication_service":                        resourceEmailCommunicationService(),
+		"azurerm_email_communication_service_domain":                 resourceEmailCommunicationServiceDomain(),
+		"azurerm_email_communication_service_domain_sender_username": resourceEmailCommunicationServiceDomainSenderUsername(),
 	}
 }
@@ -0,0 +1,18 @@
+package communicationservices
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CommunicationServicesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCommunicationServicesClientWithBaseURI(endpoint string) CommunicationServicesClient {
+	return CommunicationServicesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
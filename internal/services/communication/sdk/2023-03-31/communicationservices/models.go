@@ -0,0 +1,30 @@
+package communicationservices
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CommunicationServiceResource struct {
+	Id         *string                         `json:"id,omitempty"`
+	Location   *string                         `json:"location,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties *CommunicationServiceProperties `json:"properties,omitempty"`
+	Tags       *map[string]string              `json:"tags,omitempty"`
+	Type       *string                         `json:"type,omitempty"`
+}
+
+type CommunicationServiceProperties struct {
+	DataLocation        string    `json:"dataLocation"`
+	HostName            *string   `json:"hostName,omitempty"`
+	ImmutableResourceId *string   `json:"immutableResourceId,omitempty"`
+	LinkedDomains       *[]string `json:"linkedDomains,omitempty"`
+	NotificationHubId   *string   `json:"notificationHubId,omitempty"`
+	ProvisioningState   *string   `json:"provisioningState,omitempty"`
+	Version             *string   `json:"version,omitempty"`
+}
+
+type CommunicationServiceKeys struct {
+	PrimaryConnectionString   *string `json:"primaryConnectionString,omitempty"`
+	PrimaryKey                *string `json:"primaryKey,omitempty"`
+	SecondaryConnectionString *string `json:"secondaryConnectionString,omitempty"`
+	SecondaryKey              *string `json:"secondaryKey,omitempty"`
+}
@@ -0,0 +1,18 @@
+package domains
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DomainsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDomainsClientWithBaseURI(endpoint string) DomainsClient {
+	return DomainsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
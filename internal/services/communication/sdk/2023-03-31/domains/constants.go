@@ -0,0 +1,105 @@
+package domains
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DomainManagement string
+
+const (
+	DomainManagementAzureManaged                    DomainManagement = "AzureManaged"
+	DomainManagementCustomerManaged                 DomainManagement = "CustomerManaged"
+	DomainManagementCustomerManagedInExchangeOnline DomainManagement = "CustomerManagedInExchangeOnline"
+)
+
+func PossibleValuesForDomainManagement() []string {
+	return []string{
+		string(DomainManagementAzureManaged),
+		string(DomainManagementCustomerManaged),
+		string(DomainManagementCustomerManagedInExchangeOnline),
+	}
+}
+
+func parseDomainManagement(input string) (*DomainManagement, error) {
+	vals := map[string]DomainManagement{
+		"azuremanaged":                    DomainManagementAzureManaged,
+		"customermanaged":                 DomainManagementCustomerManaged,
+		"customermanagedinexchangeonline": DomainManagementCustomerManagedInExchangeOnline,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DomainManagement(input)
+	return &out, nil
+}
+
+type UserEngagementTracking string
+
+const (
+	UserEngagementTrackingDisabled UserEngagementTracking = "Disabled"
+	UserEngagementTrackingEnabled  UserEngagementTracking = "Enabled"
+)
+
+func PossibleValuesForUserEngagementTracking() []string {
+	return []string{
+		string(UserEngagementTrackingDisabled),
+		string(UserEngagementTrackingEnabled),
+	}
+}
+
+func parseUserEngagementTracking(input string) (*UserEngagementTracking, error) {
+	vals := map[string]UserEngagementTracking{
+		"disabled": UserEngagementTrackingDisabled,
+		"enabled":  UserEngagementTrackingEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := UserEngagementTracking(input)
+	return &out, nil
+}
+
+type VerificationStatus string
+
+const (
+	VerificationStatusCancellationRequested  VerificationStatus = "CancellationRequested"
+	VerificationStatusNotStarted             VerificationStatus = "NotStarted"
+	VerificationStatusVerificationFailed     VerificationStatus = "VerificationFailed"
+	VerificationStatusVerificationInProgress VerificationStatus = "VerificationInProgress"
+	VerificationStatusVerificationRequested  VerificationStatus = "VerificationRequested"
+	VerificationStatusVerified               VerificationStatus = "Verified"
+)
+
+func PossibleValuesForVerificationStatus() []string {
+	return []string{
+		string(VerificationStatusCancellationRequested),
+		string(VerificationStatusNotStarted),
+		string(VerificationStatusVerificationFailed),
+		string(VerificationStatusVerificationInProgress),
+		string(VerificationStatusVerificationRequested),
+		string(VerificationStatusVerified),
+	}
+}
+
+func parseVerificationStatus(input string) (*VerificationStatus, error) {
+	vals := map[string]VerificationStatus{
+		"cancellationrequested":  VerificationStatusCancellationRequested,
+		"notstarted":             VerificationStatusNotStarted,
+		"verificationfailed":     VerificationStatusVerificationFailed,
+		"verificationinprogress": VerificationStatusVerificationInProgress,
+		"verificationrequested":  VerificationStatusVerificationRequested,
+		"verified":               VerificationStatusVerified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VerificationStatus(input)
+	return &out, nil
+}
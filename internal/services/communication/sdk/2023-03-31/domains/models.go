@@ -0,0 +1,52 @@
+package domains
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DomainResource struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   *string            `json:"location,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *DomainProperties  `json:"properties,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
+
+type DomainProperties struct {
+	DataLocation           *string                              `json:"dataLocation,omitempty"`
+	DomainManagement       DomainManagement                     `json:"domainManagement"`
+	FromSenderDomain       *string                              `json:"fromSenderDomain,omitempty"`
+	MailFromSenderDomain   *string                              `json:"mailFromSenderDomain,omitempty"`
+	ProvisioningState      *string                              `json:"provisioningState,omitempty"`
+	UserEngagementTracking *UserEngagementTracking              `json:"userEngagementTracking,omitempty"`
+	VerificationRecords    *DomainPropertiesVerificationRecords `json:"verificationRecords,omitempty"`
+	VerificationStates     *DomainPropertiesVerificationStates  `json:"verificationStates,omitempty"`
+}
+
+type DomainPropertiesVerificationRecords struct {
+	DKIM   *DnsRecord `json:"DKIM,omitempty"`
+	DKIM2  *DnsRecord `json:"DKIM2,omitempty"`
+	DMARC  *DnsRecord `json:"DMARC,omitempty"`
+	Domain *DnsRecord `json:"Domain,omitempty"`
+	SPF    *DnsRecord `json:"SPF,omitempty"`
+}
+
+type DomainPropertiesVerificationStates struct {
+	DKIM   *VerificationStatusRecord `json:"DKIM,omitempty"`
+	DKIM2  *VerificationStatusRecord `json:"DKIM2,omitempty"`
+	DMARC  *VerificationStatusRecord `json:"DMARC,omitempty"`
+	Domain *VerificationStatusRecord `json:"Domain,omitempty"`
+	SPF    *VerificationStatusRecord `json:"SPF,omitempty"`
+}
+
+type DnsRecord struct {
+	Name  *string `json:"name,omitempty"`
+	Ttl   *int64  `json:"ttl,omitempty"`
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type VerificationStatusRecord struct {
+	ErrorCode *string             `json:"errorCode,omitempty"`
+	Status    *VerificationStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,18 @@
+package emailservices
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type EmailServiceResource struct {
+	Id         *string                 `json:"id,omitempty"`
+	Location   *string                 `json:"location,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *EmailServiceProperties `json:"properties,omitempty"`
+	Tags       *map[string]string      `json:"tags,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
+
+type EmailServiceProperties struct {
+	DataLocation      string  `json:"dataLocation"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
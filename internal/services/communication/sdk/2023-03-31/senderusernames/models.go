@@ -0,0 +1,18 @@
+package senderusernames
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type SenderUsernameResource struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *SenderUsernameProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type SenderUsernameProperties struct {
+	DataLocation      *string `json:"dataLocation,omitempty"`
+	DisplayName       *string `json:"displayName,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	Username          string  `json:"username"`
+}
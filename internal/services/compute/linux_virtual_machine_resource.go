@@ -286,7 +286,6 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 			"secure_boot_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"source_image_id": {
@@ -323,7 +322,6 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 			"vtpm_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"platform_fault_domain": {
@@ -1297,6 +1295,19 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChange("secure_boot_enabled") || d.HasChange("vtpm_enabled") {
+		shouldUpdate = true
+		shouldDeallocate = true // the UEFI settings can only be updated when the VM is in a deallocated state
+
+		if update.VirtualMachineProperties.SecurityProfile == nil {
+			update.VirtualMachineProperties.SecurityProfile = &compute.SecurityProfile{}
+		}
+		update.VirtualMachineProperties.SecurityProfile.UefiSettings = &compute.UefiSettings{
+			SecureBootEnabled: utils.Bool(d.Get("secure_boot_enabled").(bool)),
+			VTpmEnabled:       utils.Bool(d.Get("vtpm_enabled").(bool)),
+		}
+	}
+
 	if d.HasChange("user_data") {
 		shouldUpdate = true
 		update.UserData = utils.String(d.Get("user_data").(string))
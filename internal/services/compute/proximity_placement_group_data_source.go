@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -34,6 +35,43 @@ func dataSourceProximityPlacementGroup() *pluginsdk.Resource {
 
 			"location": commonschema.LocationComputed(),
 
+			"allowed_vm_sizes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"zone": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"availability_set_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"virtual_machine_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"virtual_machine_scale_set_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -60,6 +98,30 @@ func dataSourceProximityPlacementGroupRead(d *pluginsdk.ResourceData, meta inter
 
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
+
+		intentVmSizes := make([]string, 0)
+		availabilitySetIds := make([]string, 0)
+		virtualMachineIds := make([]string, 0)
+		virtualMachineScaleSetIds := make([]string, 0)
+		if props := model.Properties; props != nil {
+			if intent := props.Intent; intent != nil && intent.VMSizes != nil {
+				intentVmSizes = *intent.VMSizes
+			}
+			availabilitySetIds = flattenProximityPlacementGroupSubResourceIds(props.AvailabilitySets)
+			virtualMachineIds = flattenProximityPlacementGroupSubResourceIds(props.VirtualMachines)
+			virtualMachineScaleSetIds = flattenProximityPlacementGroupSubResourceIds(props.VirtualMachineScaleSets)
+		}
+		d.Set("allowed_vm_sizes", intentVmSizes)
+		d.Set("availability_set_ids", availabilitySetIds)
+		d.Set("virtual_machine_ids", virtualMachineIds)
+		d.Set("virtual_machine_scale_set_ids", virtualMachineScaleSetIds)
+
+		zone := ""
+		if v := zones.Flatten(model.Zones); len(v) != 0 {
+			zone = v[0]
+		}
+		d.Set("zone", zone)
+
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
@@ -67,3 +129,17 @@ func dataSourceProximityPlacementGroupRead(d *pluginsdk.ResourceData, meta inter
 
 	return nil
 }
+
+func flattenProximityPlacementGroupSubResourceIds(input *[]proximityplacementgroups.SubResourceWithColocationStatus) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		if item.Id != nil {
+			output = append(output, *item.Id)
+		}
+	}
+	return output
+}
@@ -22,6 +22,9 @@ func TestAccProximityPlacementGroupDataSource_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("name").Exists(),
 				check.That(data.ResourceName).Key("resource_group_name").Exists(),
 				check.That(data.ResourceName).Key("tags.%").HasValue("2"),
+				check.That(data.ResourceName).Key("virtual_machine_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("virtual_machine_scale_set_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("availability_set_ids.#").HasValue("0"),
 			),
 		},
 	})
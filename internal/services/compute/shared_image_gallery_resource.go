@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
@@ -54,6 +56,90 @@ func resourceSharedImageGallery() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"sharing": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"permission": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.GallerySharingPermissionTypesCommunity),
+								string(compute.GallerySharingPermissionTypesGroups),
+								string(compute.GallerySharingPermissionTypesPrivate),
+							}, false),
+						},
+
+						"community_gallery": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"eula": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"prefix": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"publisher_email": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"publisher_uri": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"subscription_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsUUID,
+							},
+						},
+
+						"tenant_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsUUID,
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"unique_name": {
@@ -90,10 +176,16 @@ func resourceSharedImageGalleryCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	sharingProfile, err := expandSharedImageGallerySharing(d.Get("sharing").([]interface{}))
+	if err != nil {
+		return err
+	}
+
 	gallery := compute.Gallery{
 		Location: utils.String(location),
 		GalleryProperties: &compute.GalleryProperties{
-			Description: utils.String(description),
+			Description:    utils.String(description),
+			SharingProfile: sharingProfile,
 		},
 		Tags: tags.Expand(t),
 	}
@@ -107,6 +199,12 @@ func resourceSharedImageGalleryCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
 	}
 
+	if d.IsNewResource() && sharingProfile != nil {
+		if err := updateSharedImageGallerySharingProfile(ctx, meta.(*clients.Client).Compute.GallerySharingProfileClient, id, sharingProfile); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceSharedImageGalleryRead(d, meta)
@@ -144,6 +242,10 @@ func resourceSharedImageGalleryRead(d *pluginsdk.ResourceData, meta interface{})
 		if identifier := props.Identifier; identifier != nil {
 			d.Set("unique_name", identifier.UniqueName)
 		}
+
+		if err := d.Set("sharing", flattenSharedImageGallerySharing(props.SharingProfile)); err != nil {
+			return fmt.Errorf("setting `sharing`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -172,3 +274,162 @@ func resourceSharedImageGalleryDelete(d *pluginsdk.ResourceData, meta interface{
 
 	return nil
 }
+
+func expandSharedImageGallerySharing(input []interface{}) (*compute.SharingProfile, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	permission := compute.GallerySharingPermissionTypes(raw["permission"].(string))
+
+	communityGalleryRaw := raw["community_gallery"].([]interface{})
+	if permission == compute.GallerySharingPermissionTypesCommunity && len(communityGalleryRaw) == 0 {
+		return nil, fmt.Errorf("`community_gallery` must be set when `permission` is set to `Community`")
+	}
+	if permission != compute.GallerySharingPermissionTypesCommunity && len(communityGalleryRaw) > 0 {
+		return nil, fmt.Errorf("`community_gallery` can only be set when `permission` is set to `Community`")
+	}
+
+	subscriptionIds := raw["subscription_ids"].(*pluginsdk.Set).List()
+	tenantIds := raw["tenant_ids"].(*pluginsdk.Set).List()
+	if permission != compute.GallerySharingPermissionTypesGroups && (len(subscriptionIds) > 0 || len(tenantIds) > 0) {
+		return nil, fmt.Errorf("`subscription_ids` and `tenant_ids` can only be set when `permission` is set to `Groups`")
+	}
+
+	output := &compute.SharingProfile{
+		Permissions: permission,
+	}
+
+	if len(communityGalleryRaw) > 0 {
+		communityGallery := communityGalleryRaw[0].(map[string]interface{})
+		output.CommunityGalleryInfo = &compute.CommunityGalleryInfo{
+			Eula:             utils.String(communityGallery["eula"].(string)),
+			PublicNamePrefix: utils.String(communityGallery["prefix"].(string)),
+			PublisherContact: utils.String(communityGallery["publisher_email"].(string)),
+			PublisherURI:     utils.String(communityGallery["publisher_uri"].(string)),
+		}
+	}
+
+	// whilst `groups` is read-only on the Gallery itself (it has to be applied via a Sharing Update)
+	// it's stashed here so the create can submit it in a single place
+	groups := make([]compute.SharingProfileGroup, 0)
+	if len(subscriptionIds) > 0 {
+		groups = append(groups, compute.SharingProfileGroup{
+			Type: compute.SharingProfileGroupTypesSubscriptions,
+			Ids:  utils.ExpandStringSlice(subscriptionIds),
+		})
+	}
+	if len(tenantIds) > 0 {
+		groups = append(groups, compute.SharingProfileGroup{
+			Type: compute.SharingProfileGroupTypesAADTenants,
+			Ids:  utils.ExpandStringSlice(tenantIds),
+		})
+	}
+	if len(groups) > 0 {
+		output.Groups = &groups
+	}
+
+	return output, nil
+}
+
+func updateSharedImageGallerySharingProfile(ctx context.Context, client *compute.GallerySharingProfileClient, id parse.SharedImageGalleryId, profile *compute.SharingProfile) error {
+	sharingUpdate := compute.SharingUpdate{}
+
+	switch profile.Permissions {
+	case compute.GallerySharingPermissionTypesCommunity:
+		sharingUpdate.OperationType = compute.SharingUpdateOperationTypesEnableCommunity
+
+	case compute.GallerySharingPermissionTypesGroups:
+		if profile.Groups == nil || len(*profile.Groups) == 0 {
+			return nil
+		}
+		sharingUpdate.OperationType = compute.SharingUpdateOperationTypesAdd
+		sharingUpdate.Groups = profile.Groups
+
+	default:
+		return nil
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.GalleryName, sharingUpdate)
+	if err != nil {
+		return fmt.Errorf("updating sharing profile for %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of sharing profile for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func flattenSharedImageGallerySharing(input *compute.SharingProfile) []interface{} {
+	if input == nil || input.Permissions == "" || input.Permissions == compute.GallerySharingPermissionTypesPrivate {
+		return []interface{}{}
+	}
+
+	communityGallery := make([]interface{}, 0)
+	if info := input.CommunityGalleryInfo; info != nil {
+		eula := ""
+		if info.Eula != nil {
+			eula = *info.Eula
+		}
+
+		prefix := ""
+		if info.PublicNamePrefix != nil {
+			prefix = *info.PublicNamePrefix
+		}
+
+		publisherEmail := ""
+		if info.PublisherContact != nil {
+			publisherEmail = *info.PublisherContact
+		}
+
+		publisherUri := ""
+		if info.PublisherURI != nil {
+			publisherUri = *info.PublisherURI
+		}
+
+		name := ""
+		if info.PublicNames != nil && len(*info.PublicNames) > 0 {
+			name = (*info.PublicNames)[0]
+		}
+
+		communityGallery = append(communityGallery, map[string]interface{}{
+			"eula":            eula,
+			"name":            name,
+			"prefix":          prefix,
+			"publisher_email": publisherEmail,
+			"publisher_uri":   publisherUri,
+		})
+	}
+
+	subscriptionIds := make([]interface{}, 0)
+	tenantIds := make([]interface{}, 0)
+	if input.Groups != nil {
+		for _, group := range *input.Groups {
+			ids := make([]interface{}, 0)
+			if group.Ids != nil {
+				for _, id := range *group.Ids {
+					ids = append(ids, id)
+				}
+			}
+
+			switch group.Type {
+			case compute.SharingProfileGroupTypesSubscriptions:
+				subscriptionIds = append(subscriptionIds, ids...)
+			case compute.SharingProfileGroupTypesAADTenants:
+				tenantIds = append(tenantIds, ids...)
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"permission":        string(input.Permissions),
+			"community_gallery": communityGallery,
+			"subscription_ids":  subscriptionIds,
+			"tenant_ids":        tenantIds,
+		},
+	}
+}
@@ -95,6 +95,24 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 							ValidateFunc: validate.DiskEncryptionSetID,
 						},
 
+						"confidential_vm_encryption_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.ConfidentialVMEncryptionTypeEncryptedVMGuestStateOnlyWithPmk),
+								string(compute.ConfidentialVMEncryptionTypeEncryptedWithPmk),
+								string(compute.ConfidentialVMEncryptionTypeEncryptedWithCmk),
+							}, false),
+						},
+
+						"secure_vm_disk_encryption_set_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.DiskEncryptionSetID,
+						},
+
 						// The Service API doesn't support to update `storage_account_type`. So it has to recreate the resource for updating `storage_account_type`.
 						// However, `ForceNew` cannot be used since resource would be recreated while adding or removing `target_region`.
 						// And `CustomizeDiff` also cannot be used since it doesn't support in a `Set`.
@@ -411,6 +429,8 @@ func expandSharedImageVersionTargetRegions(d *pluginsdk.ResourceData) (*[]comput
 		regionalReplicaCount := input["regional_replica_count"].(int)
 		storageAccountType := input["storage_account_type"].(string)
 		diskEncryptionSetId := input["disk_encryption_set_id"].(string)
+		confidentialVMEncryptionType := input["confidential_vm_encryption_type"].(string)
+		secureVMDiskEncryptionSetId := input["secure_vm_disk_encryption_set_id"].(string)
 
 		output := compute.TargetRegion{
 			Name:                 utils.String(name),
@@ -418,16 +438,39 @@ func expandSharedImageVersionTargetRegions(d *pluginsdk.ResourceData) (*[]comput
 			StorageAccountType:   compute.StorageAccountType(storageAccountType),
 		}
 
-		if diskEncryptionSetId != "" {
+		if diskEncryptionSetId != "" || confidentialVMEncryptionType != "" {
 			if d.Get("replication_mode").(string) == string(compute.ReplicationModeShallow) {
-				return nil, fmt.Errorf("`disk_encryption_set_id` cannot be used when `replication_mode` is `Shallow`")
+				return nil, fmt.Errorf("`disk_encryption_set_id` and `confidential_vm_encryption_type` cannot be used when `replication_mode` is `Shallow`")
+			}
+
+			osDiskImage := &compute.OSDiskImageEncryption{}
+
+			if diskEncryptionSetId != "" {
+				osDiskImage.DiskEncryptionSetID = utils.String(diskEncryptionSetId)
+			}
+
+			if confidentialVMEncryptionType != "" {
+				if secureVMDiskEncryptionSetId != "" && confidentialVMEncryptionType != string(compute.ConfidentialVMEncryptionTypeEncryptedWithCmk) {
+					return nil, fmt.Errorf("`secure_vm_disk_encryption_set_id` can only be set when `confidential_vm_encryption_type` is `EncryptedWithCmk`")
+				}
+				if secureVMDiskEncryptionSetId == "" && confidentialVMEncryptionType == string(compute.ConfidentialVMEncryptionTypeEncryptedWithCmk) {
+					return nil, fmt.Errorf("`secure_vm_disk_encryption_set_id` must be set when `confidential_vm_encryption_type` is `EncryptedWithCmk`")
+				}
+
+				osDiskImage.SecurityProfile = &compute.OSDiskImageSecurityProfile{
+					ConfidentialVMEncryptionType: compute.ConfidentialVMEncryptionType(confidentialVMEncryptionType),
+				}
+
+				if secureVMDiskEncryptionSetId != "" {
+					osDiskImage.SecurityProfile.SecureVMDiskEncryptionSetID = utils.String(secureVMDiskEncryptionSetId)
+				}
 			}
 
 			output.Encryption = &compute.EncryptionImages{
-				OsDiskImage: &compute.OSDiskImageEncryption{
-					DiskEncryptionSetID: utils.String(diskEncryptionSetId),
-				},
+				OsDiskImage: osDiskImage,
 			}
+		} else if secureVMDiskEncryptionSetId != "" {
+			return nil, fmt.Errorf("`secure_vm_disk_encryption_set_id` can only be set when `confidential_vm_encryption_type` is `EncryptedWithCmk`")
 		}
 
 		results = append(results, output)
@@ -454,10 +497,23 @@ func flattenSharedImageVersionTargetRegions(input *[]compute.TargetRegion) []int
 			output["storage_account_type"] = string(v.StorageAccountType)
 
 			diskEncryptionSetId := ""
-			if v.Encryption != nil && v.Encryption.OsDiskImage != nil && v.Encryption.OsDiskImage.DiskEncryptionSetID != nil {
-				diskEncryptionSetId = *v.Encryption.OsDiskImage.DiskEncryptionSetID
+			confidentialVMEncryptionType := ""
+			secureVMDiskEncryptionSetId := ""
+			if v.Encryption != nil && v.Encryption.OsDiskImage != nil {
+				if v.Encryption.OsDiskImage.DiskEncryptionSetID != nil {
+					diskEncryptionSetId = *v.Encryption.OsDiskImage.DiskEncryptionSetID
+				}
+
+				if securityProfile := v.Encryption.OsDiskImage.SecurityProfile; securityProfile != nil {
+					confidentialVMEncryptionType = string(securityProfile.ConfidentialVMEncryptionType)
+					if securityProfile.SecureVMDiskEncryptionSetID != nil {
+						secureVMDiskEncryptionSetId = *securityProfile.SecureVMDiskEncryptionSetID
+					}
+				}
 			}
 			output["disk_encryption_set_id"] = diskEncryptionSetId
+			output["confidential_vm_encryption_type"] = confidentialVMEncryptionType
+			output["secure_vm_disk_encryption_set_id"] = secureVMDiskEncryptionSetId
 
 			results = append(results, output)
 		}
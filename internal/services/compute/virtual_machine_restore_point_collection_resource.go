@@ -0,0 +1,207 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/restorepointcollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineRestorePointCollectionResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualMachineRestorePointCollectionResource{}
+
+type VirtualMachineRestorePointCollectionResourceModel struct {
+	Name                   string            `tfschema:"name"`
+	ResourceGroup          string            `tfschema:"resource_group_name"`
+	Location               string            `tfschema:"location"`
+	SourceVirtualMachineId string            `tfschema:"source_virtual_machine_id"`
+	Tags                   map[string]string `tfschema:"tags"`
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		// NOTE: the Source Virtual Machine can be in a different region to the Restore Point Collection,
+		// in which case Restore Points within this collection are copied cross-region
+		"source_virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) ModelObject() interface{} {
+	return &VirtualMachineRestorePointCollectionResourceModel{}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) ResourceType() string {
+	return "azurerm_virtual_machine_restore_point_collection"
+}
+
+func (r VirtualMachineRestorePointCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return restorepointcollections.ValidateRestorePointCollectionID
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model VirtualMachineRestorePointCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := restorepointcollections.NewRestorePointCollectionID(subscriptionId, model.ResourceGroup, model.Name)
+
+			existing, err := client.Get(ctx, id, restorepointcollections.DefaultGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := restorepointcollections.RestorePointCollection{
+				Location: location.Normalize(model.Location),
+				Properties: &restorepointcollections.RestorePointCollectionProperties{
+					Source: &restorepointcollections.RestorePointCollectionSourceProperties{
+						Id: utils.String(model.SourceVirtualMachineId),
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+
+			id, err := restorepointcollections.ParseRestorePointCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, restorepointcollections.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualMachineRestorePointCollectionResourceModel{
+				Name:          id.RestorePointCollectionName,
+				ResourceGroup: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					if source := props.Source; source != nil && source.Id != nil {
+						state.SourceVirtualMachineId = *source.Id
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+
+			id, err := restorepointcollections.ParseRestorePointCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualMachineRestorePointCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := restorepointcollections.RestorePointCollectionUpdate{
+					Tags: &model.Tags,
+				}
+
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+
+			id, err := restorepointcollections.ParseRestorePointCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
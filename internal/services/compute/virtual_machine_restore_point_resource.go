@@ -0,0 +1,217 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/restorepointcollections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/restorepoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineRestorePointResource struct{}
+
+var _ sdk.Resource = VirtualMachineRestorePointResource{}
+
+type VirtualMachineRestorePointResourceModel struct {
+	Name                                   string   `tfschema:"name"`
+	VirtualMachineRestorePointCollectionId string   `tfschema:"virtual_machine_restore_point_collection_id"`
+	CrashConsistencyModeEnabled            bool     `tfschema:"crash_consistency_mode_enabled"`
+	ExcludedDisks                          []string `tfschema:"excluded_disks"`
+	SourceRestorePointId                   string   `tfschema:"source_restore_point_id"`
+}
+
+func (r VirtualMachineRestorePointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"virtual_machine_restore_point_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: restorepointcollections.ValidateRestorePointCollectionID,
+		},
+
+		"crash_consistency_mode_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"excluded_disks": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		// NOTE: when set the Restore Point is created as a copy of the specified Restore Point,
+		// which allows Restore Points to be replicated into a Restore Point Collection in another region
+		"source_restore_point_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: restorepoints.ValidateRestorePointID,
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualMachineRestorePointResource) ModelObject() interface{} {
+	return &VirtualMachineRestorePointResourceModel{}
+}
+
+func (r VirtualMachineRestorePointResource) ResourceType() string {
+	return "azurerm_virtual_machine_restore_point"
+}
+
+func (r VirtualMachineRestorePointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return restorepoints.ValidateRestorePointID
+}
+
+func (r VirtualMachineRestorePointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+
+			var model VirtualMachineRestorePointResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			collectionId, err := restorepointcollections.ParseRestorePointCollectionID(model.VirtualMachineRestorePointCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := restorepoints.NewRestorePointID(collectionId.SubscriptionId, collectionId.ResourceGroupName, collectionId.RestorePointCollectionName, model.Name)
+
+			existing, err := client.RestorePointsGet(ctx, id, restorepoints.DefaultRestorePointsGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := restorepoints.RestorePoint{
+				Properties: &restorepoints.RestorePointProperties{},
+			}
+
+			if model.CrashConsistencyModeEnabled {
+				consistencyMode := restorepoints.ConsistencyModeTypesCrashConsistent
+				payload.Properties.ConsistencyMode = &consistencyMode
+			}
+
+			if len(model.ExcludedDisks) > 0 {
+				excludedDisks := make([]restorepoints.ApiEntityReference, 0)
+				for _, diskId := range model.ExcludedDisks {
+					excludedDisks = append(excludedDisks, restorepoints.ApiEntityReference{
+						Id: utils.String(diskId),
+					})
+				}
+				payload.Properties.ExcludeDisks = &excludedDisks
+			}
+
+			if model.SourceRestorePointId != "" {
+				payload.Properties.SourceRestorePoint = &restorepoints.ApiEntityReference{
+					Id: utils.String(model.SourceRestorePointId),
+				}
+			}
+
+			if err := client.RestorePointsCreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+
+			id, err := restorepoints.ParseRestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.RestorePointsGet(ctx, *id, restorepoints.DefaultRestorePointsGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualMachineRestorePointResourceModel{
+				Name:                                   id.RestorePointName,
+				VirtualMachineRestorePointCollectionId: restorepointcollections.NewRestorePointCollectionID(id.SubscriptionId, id.ResourceGroupName, id.RestorePointCollectionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.CrashConsistencyModeEnabled = props.ConsistencyMode != nil && *props.ConsistencyMode == restorepoints.ConsistencyModeTypesCrashConsistent
+
+					excludedDisks := make([]string, 0)
+					if props.ExcludeDisks != nil {
+						for _, disk := range *props.ExcludeDisks {
+							if disk.Id != nil {
+								excludedDisks = append(excludedDisks, *disk.Id)
+							}
+						}
+					}
+					state.ExcludedDisks = excludedDisks
+
+					if props.SourceRestorePoint != nil && props.SourceRestorePoint.Id != nil {
+						state.SourceRestorePointId = *props.SourceRestorePoint.Id
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+
+			id, err := restorepoints.ParseRestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.RestorePointsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
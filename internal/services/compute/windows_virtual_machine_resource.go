@@ -303,7 +303,6 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			"secure_boot_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"source_image_id": {
@@ -366,7 +365,6 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			"vtpm_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"winrm_listener": winRmListenerSchema(),
@@ -1354,6 +1352,19 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		update.SecurityProfile.EncryptionAtHost = utils.Bool(d.Get("encryption_at_host_enabled").(bool))
 	}
 
+	if d.HasChange("secure_boot_enabled") || d.HasChange("vtpm_enabled") {
+		shouldUpdate = true
+		shouldDeallocate = true // the UEFI settings can only be updated when the VM is in a deallocated state
+
+		if update.SecurityProfile == nil {
+			update.SecurityProfile = &compute.SecurityProfile{}
+		}
+		update.SecurityProfile.UefiSettings = &compute.UefiSettings{
+			SecureBootEnabled: utils.Bool(d.Get("secure_boot_enabled").(bool)),
+			VTpmEnabled:       utils.Bool(d.Get("vtpm_enabled").(bool)),
+		}
+	}
+
 	if d.HasChange("license_type") {
 		shouldUpdate = true
 
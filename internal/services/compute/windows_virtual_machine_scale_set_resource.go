@@ -255,6 +255,12 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("`health_probe_id` must be set or a health extension must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
 	}
 
+	// otherwise the service returns the error:
+	// Automatic OS Upgrade is not supported for this Virtual Machine Scale Set because a health probe or health extension was not provided.
+	if automaticOSUpgradePolicy != nil && automaticOSUpgradePolicy.EnableAutomaticOSUpgrade != nil && *automaticOSUpgradePolicy.EnableAutomaticOSUpgrade && (healthProbeId == "" && !hasHealthExtension) {
+		return fmt.Errorf("`health_probe_id` must be set or a health extension must be specified when `automatic_os_upgrade_policy.0.enable_automatic_os_upgrade` is set to `true`")
+	}
+
 	enableAutomaticUpdates := d.Get("enable_automatic_updates").(bool)
 	virtualMachineProfile.OsProfile.WindowsConfiguration.EnableAutomaticUpdates = utils.Bool(enableAutomaticUpdates)
 
@@ -728,6 +734,16 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta
 		updateProps.VirtualMachineProfile.SecurityProfile.EncryptionAtHost = utils.Bool(d.Get("encryption_at_host_enabled").(bool))
 	}
 
+	if d.HasChange("secure_boot_enabled") || d.HasChange("vtpm_enabled") {
+		if updateProps.VirtualMachineProfile.SecurityProfile == nil {
+			updateProps.VirtualMachineProfile.SecurityProfile = &compute.SecurityProfile{}
+		}
+		updateProps.VirtualMachineProfile.SecurityProfile.UefiSettings = &compute.UefiSettings{
+			SecureBootEnabled: utils.Bool(d.Get("secure_boot_enabled").(bool)),
+			VTpmEnabled:       utils.Bool(d.Get("vtpm_enabled").(bool)),
+		}
+	}
+
 	if d.HasChange("license_type") {
 		license := d.Get("license_type").(string)
 		if license == "" {
@@ -1396,7 +1412,6 @@ func resourceWindowsVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema
 		"secure_boot_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
-			ForceNew: true,
 		},
 
 		"single_placement_group": {
@@ -1454,7 +1469,6 @@ func resourceWindowsVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema
 		"vtpm_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
-			ForceNew: true,
 		},
 
 		"winrm_listener": winRmListenerSchema(),
@@ -1,14 +1,29 @@
 package client
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
+	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/sdk/2022-05-13/ledgerusers"
 )
 
 type Client struct {
 	ConfidentialLedgerClient *confidentialledger.ConfidentialLedgerClient
 
-	options *common.ClientOptions
+	authorizerFunc      common.ApiAuthorizerFunc
+	configureClientFunc func(c *autorest.Client, authorizer autorest.Authorizer)
+	options             *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -17,6 +32,89 @@ func NewClient(o *common.ClientOptions) *Client {
 
 	return &Client{
 		ConfidentialLedgerClient: &confidentialLedgerClient,
+		authorizerFunc:           o.Authorizers.AuthorizerFunc,
+		configureClientFunc:      o.ConfigureClient,
 		options:                  o,
 	}
 }
+
+// LedgerUsersClient returns a data plane client for managing the users of the given ledger, or
+// nil if the ledger itself no longer exists.
+func (c Client) LedgerUsersClient(ctx context.Context, ledgerId confidentialledger.LedgerId) (*ledgerusers.LedgerUsersClient, error) {
+	ledger, err := c.ConfidentialLedgerClient.LedgerGet(ctx, ledgerId)
+	if err != nil {
+		if response.WasNotFound(ledger.HttpResponse) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", ledgerId, err)
+	}
+
+	if ledger.Model == nil || ledger.Model.Properties == nil || ledger.Model.Properties.LedgerUri == nil || ledger.Model.Properties.IdentityServiceUri == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.ledgerUri` or `properties.identityServiceUri` was nil", ledgerId)
+	}
+
+	certPool, err := ledgerIdentityCertPool(ctx, *ledger.Model.Properties.IdentityServiceUri, ledgerId.LedgerName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the identity certificate for %s: %+v", ledgerId, err)
+	}
+
+	api := environments.NewApiEndpoint("ConfidentialLedger", "https://confidential-ledger.azure.com", nil)
+	ledgerAuth, err := c.authorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %s: %+v", ledgerId, err)
+	}
+
+	client := ledgerusers.NewLedgerUsersClientWithLedgerEndpoint(strings.TrimSuffix(*ledger.Model.Properties.LedgerUri, "/"))
+	c.configureClientFunc(&client.Client, authWrapper.AutorestAuthorizer(ledgerAuth))
+
+	// the ledger nodes serve a TLS certificate issued by the ledger's own identity service
+	// rather than a public CA, so it has to be trusted explicitly
+	client.Client.Sender = &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				RootCAs:    certPool,
+			},
+		},
+	}
+
+	return &client, nil
+}
+
+func ledgerIdentityCertPool(ctx context.Context, identityServiceUri string, ledgerName string) (*x509.CertPool, error) {
+	uri := strings.TrimSuffix(identityServiceUri, "/")
+	if !strings.Contains(uri, "/ledgerIdentity/") {
+		uri = fmt.Sprintf("%s/ledgerIdentity/%s", uri, ledgerName)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the identity service returned status %d", resp.StatusCode)
+	}
+
+	var identity struct {
+		LedgerTlsCertificate string `json:"ledgerTlsCertificate"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&identity); err != nil {
+		return nil, fmt.Errorf("unmarshalling the identity service response: %+v", err)
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM([]byte(identity.LedgerTlsCertificate)) {
+		return nil, fmt.Errorf("parsing `ledgerTlsCertificate` as a PEM certificate")
+	}
+
+	return certPool, nil
+}
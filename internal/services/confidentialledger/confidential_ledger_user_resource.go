@@ -0,0 +1,193 @@
+package confidentialledger
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/sdk/2022-05-13/ledgerusers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceConfidentialLedgerUser() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceConfidentialLedgerUserCreate,
+		Read:   resourceConfidentialLedgerUserRead,
+		Update: resourceConfidentialLedgerUserUpdate,
+		Delete: resourceConfidentialLedgerUserDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LedgerUserID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"confidential_ledger_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: confidentialledger.ValidateLedgerID,
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"ledger_role_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ledgerusers.PossibleValuesForLedgerRoleName(), false),
+			},
+		},
+	}
+}
+
+func resourceConfidentialLedgerUserCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ConfidentialLedger
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	ledgerId, err := confidentialledger.ParseLedgerID(d.Get("confidential_ledger_id").(string))
+	if err != nil {
+		return err
+	}
+
+	usersClient, err := client.LedgerUsersClient(ctx, *ledgerId)
+	if err != nil {
+		return err
+	}
+	if usersClient == nil {
+		return fmt.Errorf("%s was not found", *ledgerId)
+	}
+
+	id := parse.NewLedgerUserID(*ledgerId, d.Get("principal_id").(string))
+	existing, err := usersClient.Get(ctx, id.UserId)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_confidential_ledger_user", id.ID())
+	}
+
+	parameters := ledgerusers.LedgerUser{
+		AssignedRole: ledgerusers.LedgerRoleName(d.Get("ledger_role_name").(string)),
+	}
+	if _, err := usersClient.CreateOrUpdate(ctx, id.UserId, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceConfidentialLedgerUserRead(d, meta)
+}
+
+func resourceConfidentialLedgerUserRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ConfidentialLedger
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LedgerUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	usersClient, err := client.LedgerUsersClient(ctx, id.LedgerId)
+	if err != nil {
+		return err
+	}
+	if usersClient == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", id.LedgerId)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := usersClient.Get(ctx, id.UserId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("confidential_ledger_id", id.LedgerId.ID())
+	d.Set("principal_id", id.UserId)
+
+	if model := resp.Model; model != nil {
+		d.Set("ledger_role_name", string(model.AssignedRole))
+	}
+
+	return nil
+}
+
+func resourceConfidentialLedgerUserUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ConfidentialLedger
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LedgerUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	usersClient, err := client.LedgerUsersClient(ctx, id.LedgerId)
+	if err != nil {
+		return err
+	}
+	if usersClient == nil {
+		return fmt.Errorf("%s was not found", id.LedgerId)
+	}
+
+	parameters := ledgerusers.LedgerUser{
+		AssignedRole: ledgerusers.LedgerRoleName(d.Get("ledger_role_name").(string)),
+	}
+	if _, err := usersClient.CreateOrUpdate(ctx, id.UserId, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceConfidentialLedgerUserRead(d, meta)
+}
+
+func resourceConfidentialLedgerUserDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ConfidentialLedger
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LedgerUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	usersClient, err := client.LedgerUsersClient(ctx, id.LedgerId)
+	if err != nil {
+		return err
+	}
+	if usersClient == nil {
+		return nil
+	}
+
+	if _, err := usersClient.Delete(ctx, id.UserId); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
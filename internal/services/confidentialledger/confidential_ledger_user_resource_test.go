@@ -0,0 +1,146 @@
+package confidentialledger_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ConfidentialLedgerUserResource struct{}
+
+func TestAccConfidentialLedgerUser_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_confidential_ledger_user", "test")
+	r := ConfidentialLedgerUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Reader"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConfidentialLedgerUser_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_confidential_ledger_user", "test")
+	r := ConfidentialLedgerUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Reader"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccConfidentialLedgerUser_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_confidential_ledger_user", "test")
+	r := ConfidentialLedgerUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Reader"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Contributor"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ConfidentialLedgerUserResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LedgerUserID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	usersClient, err := clients.ConfidentialLedger.LedgerUsersClient(ctx, id.LedgerId)
+	if err != nil {
+		return nil, err
+	}
+	if usersClient == nil {
+		return utils.Bool(false), nil
+	}
+
+	resp, err := usersClient.Get(ctx, id.UserId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ConfidentialLedgerUserResource) basic(data acceptance.TestData, role string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_confidential_ledger_user" "test" {
+  confidential_ledger_id = azurerm_confidential_ledger.test.id
+  principal_id           = "11111111-1111-1111-1111-111111111111"
+  ledger_role_name       = "%[2]s"
+}
+`, r.template(data), role)
+}
+
+func (r ConfidentialLedgerUserResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_confidential_ledger_user" "import" {
+  confidential_ledger_id = azurerm_confidential_ledger_user.test.confidential_ledger_id
+  principal_id           = azurerm_confidential_ledger_user.test.principal_id
+  ledger_role_name       = azurerm_confidential_ledger_user.test.ledger_role_name
+}
+`, r.basic(data, "Reader"))
+}
+
+func (ConfidentialLedgerUserResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_confidential_ledger" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  ledger_type         = "Public"
+
+  azuread_based_service_principal {
+    ledger_role_name = "Administrator"
+    principal_id     = data.azurerm_client_config.current.object_id
+    tenant_id        = data.azurerm_client_config.current.tenant_id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
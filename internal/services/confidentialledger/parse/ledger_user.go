@@ -0,0 +1,54 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
+)
+
+var _ resourceids.Id = LedgerUserId{}
+
+// LedgerUserId is a composite ID for an AAD-based user within a Confidential Ledger, since
+// users are managed via the ledger data plane rather than Azure Resource Manager.
+type LedgerUserId struct {
+	LedgerId confidentialledger.LedgerId
+	UserId   string
+}
+
+func NewLedgerUserID(ledgerId confidentialledger.LedgerId, userId string) LedgerUserId {
+	return LedgerUserId{
+		LedgerId: ledgerId,
+		UserId:   userId,
+	}
+}
+
+func (id LedgerUserId) String() string {
+	components := []string{
+		fmt.Sprintf("Ledger %q", id.LedgerId.ID()),
+		fmt.Sprintf("User ID %q", id.UserId),
+	}
+	return fmt.Sprintf("Ledger User %s", strings.Join(components, " / "))
+}
+
+func (id LedgerUserId) ID() string {
+	return fmt.Sprintf("%s|%s", id.LedgerId.ID(), id.UserId)
+}
+
+func LedgerUserID(input string) (*LedgerUserId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{ledgerId}|{userId}` but got %q", input)
+	}
+
+	ledgerId, err := confidentialledger.ParseLedgerID(segments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &LedgerUserId{
+		LedgerId: *ledgerId,
+		UserId:   segments[1],
+	}, nil
+}
@@ -0,0 +1,61 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestLedgerUserID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *LedgerUserId
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing User ID part",
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.ConfidentialLedger/ledgers/ledger1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Ledger ID part",
+			Input:    "11111111-1111-1111-1111-111111111111",
+			Expected: nil,
+		},
+		{
+			Name:     "Ledger ID part is not a Ledger ID",
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1|11111111-1111-1111-1111-111111111111",
+			Expected: nil,
+		},
+		{
+			Name:  "Ledger User ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.ConfidentialLedger/ledgers/ledger1|11111111-1111-1111-1111-111111111111",
+			Expected: &LedgerUserId{
+				UserId: "11111111-1111-1111-1111-111111111111",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := LedgerUserID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if actual.LedgerId.LedgerName != "ledger1" {
+			t.Fatalf("Expected %q but got %q for Ledger Name", "ledger1", actual.LedgerId.LedgerName)
+		}
+
+		if actual.UserId != v.Expected.UserId {
+			t.Fatalf("Expected %q but got %q for User ID", v.Expected.UserId, actual.UserId)
+		}
+	}
+}
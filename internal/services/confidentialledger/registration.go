@@ -29,6 +29,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_confidential_ledger": resourceConfidentialLedger(),
+		"azurerm_confidential_ledger":      resourceConfidentialLedger(),
+		"azurerm_confidential_ledger_user": resourceConfidentialLedgerUser(),
 	}
 }
@@ -0,0 +1,18 @@
+package ledgerusers
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LedgerUsersClient struct {
+	Client         autorest.Client
+	ledgerEndpoint string
+}
+
+func NewLedgerUsersClientWithLedgerEndpoint(ledgerEndpoint string) LedgerUsersClient {
+	return LedgerUsersClient{
+		Client:         autorest.NewClientWithUserAgent(userAgent()),
+		ledgerEndpoint: ledgerEndpoint,
+	}
+}
@@ -0,0 +1,20 @@
+package ledgerusers
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LedgerRoleName string
+
+const (
+	LedgerRoleNameAdministrator LedgerRoleName = "Administrator"
+	LedgerRoleNameContributor   LedgerRoleName = "Contributor"
+	LedgerRoleNameReader        LedgerRoleName = "Reader"
+)
+
+func PossibleValuesForLedgerRoleName() []string {
+	return []string{
+		string(LedgerRoleNameAdministrator),
+		string(LedgerRoleNameContributor),
+		string(LedgerRoleNameReader),
+	}
+}
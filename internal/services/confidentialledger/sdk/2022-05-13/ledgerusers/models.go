@@ -0,0 +1,9 @@
+package ledgerusers
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LedgerUser struct {
+	AssignedRole LedgerRoleName `json:"assignedRole"`
+	UserId       *string        `json:"userId,omitempty"`
+}
@@ -5,9 +5,10 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/containerapps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/containerappsrevisions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/daprcomponents"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironmentsstorages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/jobs"
 )
 
 type Client struct {
@@ -15,6 +16,7 @@ type Client struct {
 	ContainerAppClient         *containerapps.ContainerAppsClient
 	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
 	DaprComponentsClient       *daprcomponents.DaprComponentsClient
+	JobClient                  *jobs.JobsClient
 	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
 	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
 }
@@ -38,11 +40,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	daprComponentClient := daprcomponents.NewDaprComponentsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&daprComponentClient.Client, o.ResourceManagerAuthorizer)
 
+	jobClient := jobs.NewJobsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&jobClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		CertificatesClient:         &certificatesClient,
 		ContainerAppClient:         &containerAppsClient,
 		ContainerAppRevisionClient: &containerAppsRevisionsClient,
 		DaprComponentsClient:       &daprComponentClient,
+		JobClient:                  &jobClient,
 		ManagedEnvironmentClient:   &managedEnvironmentClient,
 		StorageClient:              &managedEnvironmentStoragesClient,
 	}
@@ -10,12 +10,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type ContainerAppEnvironmentResource struct{}
@@ -27,6 +28,7 @@ type ContainerAppEnvironmentModel struct {
 	LogAnalyticsWorkspaceId     string                 `tfschema:"log_analytics_workspace_id"`
 	InfrastructureSubnetId      string                 `tfschema:"infrastructure_subnet_id"`
 	InternalLoadBalancerEnabled bool                   `tfschema:"internal_load_balancer_enabled"`
+	WorkloadProfiles            []WorkloadProfileModel `tfschema:"workload_profile"`
 	Tags                        map[string]interface{} `tfschema:"tags"`
 
 	DefaultDomain         string `tfschema:"default_domain"`
@@ -36,8 +38,33 @@ type ContainerAppEnvironmentModel struct {
 	StaticIP              string `tfschema:"static_ip_address"`
 }
 
+type WorkloadProfileModel struct {
+	Name                string `tfschema:"name"`
+	WorkloadProfileType string `tfschema:"workload_profile_type"`
+	MinimumCount        int64  `tfschema:"minimum_count"`
+	MaximumCount        int64  `tfschema:"maximum_count"`
+}
+
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentResource{}
 
+// consumptionWorkloadProfileType is managed by the service and does not scale, so `minimum_count`
+// and `maximum_count` only apply to the dedicated profile types.
+const consumptionWorkloadProfileType = "Consumption"
+
+func possibleValuesForWorkloadProfileType() []string {
+	return []string{
+		consumptionWorkloadProfileType,
+		"D4",
+		"D8",
+		"D16",
+		"D32",
+		"E4",
+		"E8",
+		"E16",
+		"E32",
+	}
+}
+
 func (r ContainerAppEnvironmentResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentModel{}
 }
@@ -88,6 +115,43 @@ func (r ContainerAppEnvironmentResource) Arguments() map[string]*pluginsdk.Schem
 			Description: "Should the Container Environment operate in Internal Load Balancing Mode? Defaults to `false`. **Note:** can only be set to `true` if `infrastructure_subnet_id` is specified.",
 		},
 
+		"workload_profile": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The name of the workload profile.",
+					},
+
+					"workload_profile_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(possibleValuesForWorkloadProfileType(), false),
+						Description:  "The type of the workload profile - e.g. `D4` or `E8`.",
+					},
+
+					"minimum_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 20),
+						Description:  "The minimum number of instances of this workload profile.",
+					},
+
+					"maximum_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 20),
+						Description:  "The maximum number of instances of this workload profile.",
+					},
+				},
+			},
+			Description: "The workload profiles to provision in this Container App Environment.",
+		},
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -201,6 +265,12 @@ func (r ContainerAppEnvironmentResource) Create() sdk.ResourceFunc {
 				managedEnvironment.Properties.VnetConfiguration.Internal = pointer.To(containerAppEnvironment.InternalLoadBalancerEnabled)
 			}
 
+			workloadProfiles, err := expandWorkloadProfiles(containerAppEnvironment.WorkloadProfiles)
+			if err != nil {
+				return err
+			}
+			managedEnvironment.Properties.WorkloadProfiles = workloadProfiles
+
 			if err := client.CreateOrUpdateThenPoll(ctx, id, managedEnvironment); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
@@ -248,6 +318,7 @@ func (r ContainerAppEnvironmentResource) Read() sdk.ResourceFunc {
 
 					state.StaticIP = pointer.From(props.StaticIP)
 					state.DefaultDomain = pointer.From(props.DefaultDomain)
+					state.WorkloadProfiles = flattenWorkloadProfiles(props.WorkloadProfiles)
 				}
 			}
 
@@ -308,6 +379,14 @@ func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
 				existing.Model.Tags = tags.Expand(state.Tags)
 			}
 
+			if metadata.ResourceData.HasChange("workload_profile") {
+				workloadProfiles, err := expandWorkloadProfiles(state.WorkloadProfiles)
+				if err != nil {
+					return err
+				}
+				existing.Model.Properties.WorkloadProfiles = workloadProfiles
+			}
+
 			// (@jackofallops) This is not updatable and needs to be removed since the read does not return the sensitive Key field.
 			// Whilst not ideal, this means we don't need to try and retrieve it again just to send a no-op.
 			existing.Model.Properties.AppLogsConfiguration = nil
@@ -320,3 +399,56 @@ func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
 		},
 	}
 }
+
+func expandWorkloadProfiles(input []WorkloadProfileModel) (*[]managedenvironments.WorkloadProfile, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	result := make([]managedenvironments.WorkloadProfile, 0)
+
+	for _, v := range input {
+		profile := managedenvironments.WorkloadProfile{
+			Name:                v.Name,
+			WorkloadProfileType: v.WorkloadProfileType,
+		}
+
+		if v.WorkloadProfileType == consumptionWorkloadProfileType {
+			if v.MinimumCount != 0 || v.MaximumCount != 0 {
+				return nil, fmt.Errorf("`minimum_count` and `maximum_count` cannot be set for the workload profile %q as the `Consumption` profile type does not scale", v.Name)
+			}
+		} else {
+			if v.MaximumCount == 0 {
+				return nil, fmt.Errorf("`maximum_count` must be set for the workload profile %q as %q is a dedicated profile type", v.Name, v.WorkloadProfileType)
+			}
+			if v.MaximumCount < v.MinimumCount {
+				return nil, fmt.Errorf("`maximum_count` must be greater than or equal to `minimum_count` for the workload profile %q", v.Name)
+			}
+			profile.MinimumCount = pointer.To(v.MinimumCount)
+			profile.MaximumCount = pointer.To(v.MaximumCount)
+		}
+
+		result = append(result, profile)
+	}
+
+	return &result, nil
+}
+
+func flattenWorkloadProfiles(input *[]managedenvironments.WorkloadProfile) []WorkloadProfileModel {
+	if input == nil || len(*input) == 0 {
+		return []WorkloadProfileModel{}
+	}
+
+	result := make([]WorkloadProfileModel, 0)
+
+	for _, v := range *input {
+		result = append(result, WorkloadProfileModel{
+			Name:                v.Name,
+			WorkloadProfileType: v.WorkloadProfileType,
+			MinimumCount:        pointer.From(v.MinimumCount),
+			MaximumCount:        pointer.From(v.MaximumCount),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,482 @@
+package helpers
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/jobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type JobTemplate struct {
+	Containers []Container       `tfschema:"container"`
+	Volumes    []ContainerVolume `tfschema:"volume"`
+}
+
+func JobTemplateSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		MaxItems: 1,
+		Required: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"container": ContainerAppContainerSchema(),
+
+				"volume": ContainerVolumeSchema(),
+			},
+		},
+	}
+}
+
+func ExpandContainerAppJobTemplate(input []JobTemplate) *jobs.JobTemplate {
+	if len(input) != 1 {
+		return nil
+	}
+
+	config := input[0]
+	return &jobs.JobTemplate{
+		Containers: expandContainerAppContainers(config.Containers),
+		Volumes:    expandContainerAppVolumes(config.Volumes),
+	}
+}
+
+func FlattenContainerAppJobTemplate(input *jobs.JobTemplate) []JobTemplate {
+	if input == nil {
+		return []JobTemplate{}
+	}
+
+	return []JobTemplate{
+		{
+			Containers: flattenContainerAppContainers(input.Containers),
+			Volumes:    flattenContainerAppVolumes(input.Volumes),
+		},
+	}
+}
+
+type JobManualTriggerConfiguration struct {
+	Parallelism            int `tfschema:"parallelism"`
+	ReplicaCompletionCount int `tfschema:"replica_completion_count"`
+}
+
+func JobManualTriggerConfigurationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		ForceNew: true,
+		ExactlyOneOf: []string{
+			"manual_trigger_config",
+			"schedule_trigger_config",
+			"event_trigger_config",
+		},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of replicas which should run in parallel for each execution of this Container App Job.",
+				},
+
+				"replica_completion_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The minimum number of successful replica completions required for each execution of this Container App Job.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandContainerAppJobManualTriggerConfiguration(input []JobManualTriggerConfiguration) *jobs.JobConfigurationManualTriggerConfig {
+	if len(input) != 1 {
+		return nil
+	}
+
+	config := input[0]
+	result := &jobs.JobConfigurationManualTriggerConfig{}
+
+	if config.Parallelism != 0 {
+		result.Parallelism = pointer.To(int64(config.Parallelism))
+	}
+
+	if config.ReplicaCompletionCount != 0 {
+		result.ReplicaCompletionCount = pointer.To(int64(config.ReplicaCompletionCount))
+	}
+
+	return result
+}
+
+func FlattenContainerAppJobManualTriggerConfiguration(input *jobs.JobConfigurationManualTriggerConfig) []JobManualTriggerConfiguration {
+	if input == nil {
+		return []JobManualTriggerConfiguration{}
+	}
+
+	return []JobManualTriggerConfiguration{
+		{
+			Parallelism:            int(pointer.From(input.Parallelism)),
+			ReplicaCompletionCount: int(pointer.From(input.ReplicaCompletionCount)),
+		},
+	}
+}
+
+type JobScheduleTriggerConfiguration struct {
+	CronExpression         string `tfschema:"cron_expression"`
+	Parallelism            int    `tfschema:"parallelism"`
+	ReplicaCompletionCount int    `tfschema:"replica_completion_count"`
+}
+
+func JobScheduleTriggerConfigurationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		ForceNew: true,
+		ExactlyOneOf: []string{
+			"manual_trigger_config",
+			"schedule_trigger_config",
+			"event_trigger_config",
+		},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cron_expression": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The cron expression defining when this Container App Job should be executed.",
+				},
+
+				"parallelism": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of replicas which should run in parallel for each execution of this Container App Job.",
+				},
+
+				"replica_completion_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The minimum number of successful replica completions required for each execution of this Container App Job.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandContainerAppJobScheduleTriggerConfiguration(input []JobScheduleTriggerConfiguration) *jobs.JobConfigurationScheduleTriggerConfig {
+	if len(input) != 1 {
+		return nil
+	}
+
+	config := input[0]
+	result := &jobs.JobConfigurationScheduleTriggerConfig{
+		CronExpression: config.CronExpression,
+	}
+
+	if config.Parallelism != 0 {
+		result.Parallelism = pointer.To(int64(config.Parallelism))
+	}
+
+	if config.ReplicaCompletionCount != 0 {
+		result.ReplicaCompletionCount = pointer.To(int64(config.ReplicaCompletionCount))
+	}
+
+	return result
+}
+
+func FlattenContainerAppJobScheduleTriggerConfiguration(input *jobs.JobConfigurationScheduleTriggerConfig) []JobScheduleTriggerConfiguration {
+	if input == nil {
+		return []JobScheduleTriggerConfiguration{}
+	}
+
+	return []JobScheduleTriggerConfiguration{
+		{
+			CronExpression:         input.CronExpression,
+			Parallelism:            int(pointer.From(input.Parallelism)),
+			ReplicaCompletionCount: int(pointer.From(input.ReplicaCompletionCount)),
+		},
+	}
+}
+
+type JobEventTriggerConfiguration struct {
+	Parallelism            int             `tfschema:"parallelism"`
+	ReplicaCompletionCount int             `tfschema:"replica_completion_count"`
+	Scale                  []JobScaleModel `tfschema:"scale"`
+}
+
+type JobScaleModel struct {
+	MaxExecutions   int                 `tfschema:"max_executions"`
+	MinExecutions   int                 `tfschema:"min_executions"`
+	PollingInterval int                 `tfschema:"polling_interval_in_seconds"`
+	Rules           []JobScaleRuleModel `tfschema:"rules"`
+}
+
+type JobScaleRuleModel struct {
+	Name           string                  `tfschema:"name"`
+	CustomRuleType string                  `tfschema:"custom_rule_type"`
+	Metadata       map[string]interface{}  `tfschema:"metadata"`
+	Authentication []JobScaleRuleAuthModel `tfschema:"authentication"`
+}
+
+type JobScaleRuleAuthModel struct {
+	SecretName       string `tfschema:"secret_name"`
+	TriggerParameter string `tfschema:"trigger_parameter"`
+}
+
+func JobEventTriggerConfigurationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		ForceNew: true,
+		ExactlyOneOf: []string{
+			"manual_trigger_config",
+			"schedule_trigger_config",
+			"event_trigger_config",
+		},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of replicas which should run in parallel for each execution of this Container App Job.",
+				},
+
+				"replica_completion_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The minimum number of successful replica completions required for each execution of this Container App Job.",
+				},
+
+				"scale": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"max_executions": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Default:      100,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The maximum number of executions which can run at the same time.",
+							},
+
+							"min_executions": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Default:      0,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "The minimum number of executions which should run at the same time.",
+							},
+
+							"polling_interval_in_seconds": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Default:      30,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The interval in seconds at which the event source should be polled.",
+							},
+
+							"rules": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"name": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+											Description:  "The name of this Scale Rule.",
+										},
+
+										"custom_rule_type": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+											Description:  "The KEDA Scaler type to use for this Scale Rule, e.g. `azure-queue`.",
+										},
+
+										"metadata": {
+											Type:     pluginsdk.TypeMap,
+											Required: true,
+											Elem: &pluginsdk.Schema{
+												Type: pluginsdk.TypeString,
+											},
+											Description: "The metadata properties to configure the KEDA Scaler with.",
+										},
+
+										"authentication": {
+											Type:     pluginsdk.TypeList,
+											Optional: true,
+											Elem: &pluginsdk.Resource{
+												Schema: map[string]*pluginsdk.Schema{
+													"secret_name": {
+														Type:         pluginsdk.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringIsNotEmpty,
+														Description:  "The name of the Secret to use for this Scale Rule Authentication.",
+													},
+
+													"trigger_parameter": {
+														Type:         pluginsdk.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringIsNotEmpty,
+														Description:  "The Trigger Parameter name to use for this Scale Rule Authentication.",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ExpandContainerAppJobEventTriggerConfiguration(input []JobEventTriggerConfiguration) *jobs.JobConfigurationEventTriggerConfig {
+	if len(input) != 1 {
+		return nil
+	}
+
+	config := input[0]
+	result := &jobs.JobConfigurationEventTriggerConfig{
+		Scale: expandContainerAppJobScale(config.Scale),
+	}
+
+	if config.Parallelism != 0 {
+		result.Parallelism = pointer.To(int64(config.Parallelism))
+	}
+
+	if config.ReplicaCompletionCount != 0 {
+		result.ReplicaCompletionCount = pointer.To(int64(config.ReplicaCompletionCount))
+	}
+
+	return result
+}
+
+func expandContainerAppJobScale(input []JobScaleModel) *jobs.JobScale {
+	if len(input) != 1 {
+		return nil
+	}
+
+	config := input[0]
+	result := &jobs.JobScale{
+		MaxExecutions:   pointer.To(int64(config.MaxExecutions)),
+		MinExecutions:   pointer.To(int64(config.MinExecutions)),
+		PollingInterval: pointer.To(int64(config.PollingInterval)),
+	}
+
+	if len(config.Rules) != 0 {
+		rules := make([]jobs.JobScaleRule, 0)
+		for _, rule := range config.Rules {
+			metadata := make(map[string]string)
+			for k, v := range rule.Metadata {
+				metadata[k] = v.(string)
+			}
+
+			r := jobs.JobScaleRule{
+				Name:     pointer.To(rule.Name),
+				Type:     pointer.To(rule.CustomRuleType),
+				Metadata: pointer.To(metadata),
+			}
+
+			if len(rule.Authentication) != 0 {
+				auth := make([]containerapps.ScaleRuleAuth, 0)
+				for _, a := range rule.Authentication {
+					auth = append(auth, containerapps.ScaleRuleAuth{
+						SecretRef:        pointer.To(a.SecretName),
+						TriggerParameter: pointer.To(a.TriggerParameter),
+					})
+				}
+				r.Auth = &auth
+			}
+
+			rules = append(rules, r)
+		}
+		result.Rules = &rules
+	}
+
+	return result
+}
+
+func FlattenContainerAppJobEventTriggerConfiguration(input *jobs.JobConfigurationEventTriggerConfig) []JobEventTriggerConfiguration {
+	if input == nil {
+		return []JobEventTriggerConfiguration{}
+	}
+
+	return []JobEventTriggerConfiguration{
+		{
+			Parallelism:            int(pointer.From(input.Parallelism)),
+			ReplicaCompletionCount: int(pointer.From(input.ReplicaCompletionCount)),
+			Scale:                  flattenContainerAppJobScale(input.Scale),
+		},
+	}
+}
+
+func flattenContainerAppJobScale(input *jobs.JobScale) []JobScaleModel {
+	if input == nil {
+		return []JobScaleModel{}
+	}
+
+	result := JobScaleModel{
+		MaxExecutions:   int(pointer.From(input.MaxExecutions)),
+		MinExecutions:   int(pointer.From(input.MinExecutions)),
+		PollingInterval: int(pointer.From(input.PollingInterval)),
+	}
+
+	if input.Rules != nil {
+		rules := make([]JobScaleRuleModel, 0)
+		for _, rule := range *input.Rules {
+			metadata := make(map[string]interface{})
+			for k, v := range pointer.From(rule.Metadata) {
+				metadata[k] = v
+			}
+
+			r := JobScaleRuleModel{
+				Name:           pointer.From(rule.Name),
+				CustomRuleType: pointer.From(rule.Type),
+				Metadata:       metadata,
+			}
+
+			if rule.Auth != nil {
+				auth := make([]JobScaleRuleAuthModel, 0)
+				for _, a := range *rule.Auth {
+					auth = append(auth, JobScaleRuleAuthModel{
+						SecretName:       pointer.From(a.SecretRef),
+						TriggerParameter: pointer.From(a.TriggerParameter),
+					})
+				}
+				r.Authentication = auth
+			}
+
+			rules = append(rules, r)
+		}
+		result.Rules = rules
+	}
+
+	return []JobScaleModel{result}
+}
+
+func FlattenContainerAppJobSecrets(input *jobs.JobSecretsCollection) []Secret {
+	if input == nil {
+		return []Secret{}
+	}
+
+	result := make([]Secret, 0)
+	for _, v := range input.Value {
+		result = append(result, Secret{
+			Name:  pointer.From(v.Name),
+			Value: pointer.From(v.Value),
+		})
+	}
+
+	return result
+}
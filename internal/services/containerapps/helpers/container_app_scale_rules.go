@@ -0,0 +1,393 @@
+package helpers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AzureQueueScaleRule struct {
+	Name            string                    `tfschema:"name"`
+	QueueLength     int                       `tfschema:"queue_length"`
+	QueueName       string                    `tfschema:"queue_name"`
+	Authentications []ScaleRuleAuthentication `tfschema:"authentication"`
+}
+
+type CustomScaleRule struct {
+	Name            string                    `tfschema:"name"`
+	Metadata        map[string]interface{}    `tfschema:"metadata"`
+	CustomRuleType  string                    `tfschema:"custom_rule_type"`
+	Authentications []ScaleRuleAuthentication `tfschema:"authentication"`
+}
+
+type HTTPScaleRule struct {
+	Name               string                    `tfschema:"name"`
+	ConcurrentRequests string                    `tfschema:"concurrent_requests"`
+	Authentications    []ScaleRuleAuthentication `tfschema:"authentication"`
+}
+
+type ScaleRuleAuthentication struct {
+	SecretRef        string `tfschema:"secret_name"`
+	TriggerParameter string `tfschema:"trigger_parameter"`
+}
+
+func AzureQueueScaleRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Scaling Rule",
+				},
+
+				"queue_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Azure Queue",
+				},
+
+				"queue_length": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The value of the length of the queue to trigger scaling actions.",
+				},
+
+				"authentication": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"secret_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The name of the Container App Secret to use for this Scale Rule Authentication.",
+							},
+
+							"trigger_parameter": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The Trigger Parameter name to use the supply the value retrieved from the `secret_name`.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func CustomScaleRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Scaling Rule",
+				},
+
+				"custom_rule_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Custom rule type. Possible values include: `activemq`, `artemis-queue`, `kafka`, `pulsar`, `aws-cloudwatch`, `aws-dynamodb`, `aws-dynamodb-streams`, `aws-kinesis-stream`, `aws-sqs-queue`, `azure-app-insights`, `azure-blob`, `azure-data-explorer`, `azure-eventhub`, `azure-log-analytics`, `azure-monitor`, `azure-pipelines`, `azure-servicebus`, `azure-queue`, `cassandra`, `cpu`, `cron`, `datadog`, `elasticsearch`, `external`, `external-push`, `gcp-stackdriver`, `gcp-storage`, `gcp-pubsub`, `graphite`, `http`, `huawei-cloudeye`, `ibmmq`, `influxdb`, `kubernetes-workload`, `liiklus`, `memory`, `metrics-api`, `mongodb`, `mssql`, `mysql`, `nats-jetstream`, `stan`, `tcp`, `new-relic`, `openstack-metric`, `openstack-swift`, `postgresql`, `predictkube`, `prometheus`, `rabbitmq`, `redis`, `redis-cluster`, `redis-sentinel`, `redis-streams`, `redis-cluster-streams`, `redis-sentinel-streams`, `selenium-grid`,`solace-event-queue`, and `github-runner`.",
+				},
+
+				"metadata": {
+					Type:     pluginsdk.TypeMap,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					Description: "A map of string key-value pairs to configure the Custom Scale Rule.",
+				},
+
+				"authentication": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"secret_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The name of the Container App Secret to use for this Scale Rule Authentication.",
+							},
+
+							"trigger_parameter": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The Trigger Parameter name to use the supply the value retrieved from the `secret_name`.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func HTTPScaleRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Scaling Rule",
+				},
+
+				"concurrent_requests": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: ValidateScaleRuleConcurrentRequests,
+				},
+
+				"authentication": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"secret_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The name of the Container App Secret to use for this Scale Rule Authentication.",
+							},
+
+							"trigger_parameter": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The Trigger Parameter name to use the supply the value retrieved from the `secret_name`.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *ContainerTemplate) expandContainerAppScaleRules() []containerapps.ScaleRule {
+	if len(c.AzureQueueScaleRules) == 0 && len(c.CustomScaleRules) == 0 && len(c.HTTPScaleRules) == 0 {
+		return nil
+	}
+	result := make([]containerapps.ScaleRule, 0)
+	for _, v := range c.AzureQueueScaleRules {
+		r := containerapps.ScaleRule{
+			Name: pointer.To(v.Name),
+			AzureQueue: &containerapps.QueueScaleRule{
+				QueueLength: pointer.To(int64(v.QueueLength)),
+				QueueName:   pointer.To(v.QueueName),
+			},
+		}
+
+		auths := make([]containerapps.ScaleRuleAuth, 0)
+		for _, a := range v.Authentications {
+			auth := containerapps.ScaleRuleAuth{
+				SecretRef:        pointer.To(a.SecretRef),
+				TriggerParameter: pointer.To(a.TriggerParameter),
+			}
+
+			auths = append(auths, auth)
+		}
+
+		r.AzureQueue.Auth = pointer.To(auths)
+
+		result = append(result, r)
+	}
+
+	for _, v := range c.CustomScaleRules {
+		r := containerapps.ScaleRule{
+			Name: pointer.To(v.Name),
+			Custom: &containerapps.CustomScaleRule{
+				Type: pointer.To(v.CustomRuleType),
+			},
+		}
+
+		metaData := make(map[string]string, 0)
+		for k, d := range v.Metadata {
+			metaData[k] = d.(string)
+		}
+		r.Custom.Metadata = pointer.To(metaData)
+
+		if len(v.Authentications) != 0 {
+			auths := make([]containerapps.ScaleRuleAuth, 0)
+			for _, a := range v.Authentications {
+				auth := containerapps.ScaleRuleAuth{
+					SecretRef:        pointer.To(a.SecretRef),
+					TriggerParameter: pointer.To(a.TriggerParameter),
+				}
+
+				auths = append(auths, auth)
+			}
+
+			r.Custom.Auth = pointer.To(auths)
+		}
+
+		result = append(result, r)
+	}
+
+	for _, v := range c.HTTPScaleRules {
+		r := containerapps.ScaleRule{
+			Name: pointer.To(v.Name),
+			HTTP: &containerapps.HTTPScaleRule{
+				Metadata: pointer.To(map[string]string{
+					"concurrentRequests": v.ConcurrentRequests,
+				}),
+			},
+		}
+
+		if len(v.Authentications) != 0 {
+			auths := make([]containerapps.ScaleRuleAuth, 0)
+			for _, a := range v.Authentications {
+				auth := containerapps.ScaleRuleAuth{
+					SecretRef:        pointer.To(a.SecretRef),
+					TriggerParameter: pointer.To(a.TriggerParameter),
+				}
+
+				auths = append(auths, auth)
+			}
+
+			r.HTTP.Auth = pointer.To(auths)
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func (c *ContainerTemplate) flattenContainerAppScaleRules(input *[]containerapps.ScaleRule) {
+	if input == nil || len(*input) == 0 {
+		return
+	}
+
+	rules := *input
+	azureQueueScaleRules := make([]AzureQueueScaleRule, 0)
+	customScaleRules := make([]CustomScaleRule, 0)
+	httpScaleRules := make([]HTTPScaleRule, 0)
+	for _, v := range rules {
+		if q := v.AzureQueue; q != nil {
+			rule := AzureQueueScaleRule{
+				Name:        pointer.From(v.Name),
+				QueueLength: int(pointer.From(q.QueueLength)),
+				QueueName:   pointer.From(q.QueueName),
+			}
+
+			authentications := make([]ScaleRuleAuthentication, 0)
+			if auths := q.Auth; auths != nil {
+				for _, a := range *auths {
+					authentications = append(authentications, ScaleRuleAuthentication{
+						SecretRef:        pointer.From(a.SecretRef),
+						TriggerParameter: pointer.From(a.TriggerParameter),
+					})
+				}
+			}
+			rule.Authentications = authentications
+
+			azureQueueScaleRules = append(azureQueueScaleRules, rule)
+			continue
+		}
+
+		if r := v.Custom; r != nil {
+			rule := CustomScaleRule{
+				Name:           pointer.From(v.Name),
+				CustomRuleType: pointer.From(r.Type),
+			}
+
+			metadata := make(map[string]interface{})
+			for k, d := range pointer.From(r.Metadata) {
+				metadata[k] = d
+			}
+			rule.Metadata = metadata
+
+			if auths := r.Auth; auths != nil {
+				authentications := make([]ScaleRuleAuthentication, 0)
+				for _, a := range *auths {
+					authentications = append(authentications, ScaleRuleAuthentication{
+						SecretRef:        pointer.From(a.SecretRef),
+						TriggerParameter: pointer.From(a.TriggerParameter),
+					})
+				}
+				rule.Authentications = authentications
+			}
+
+			customScaleRules = append(customScaleRules, rule)
+			continue
+		}
+
+		if r := v.HTTP; r != nil {
+			metaData := pointer.From(r.Metadata)
+			concurrentReqs := ""
+
+			if m, ok := metaData["concurrentRequests"]; ok {
+				concurrentReqs = m
+			}
+
+			rule := HTTPScaleRule{
+				Name:               pointer.From(v.Name),
+				ConcurrentRequests: concurrentReqs,
+			}
+
+			if auths := r.Auth; auths != nil {
+				authentications := make([]ScaleRuleAuthentication, 0)
+				for _, a := range *auths {
+					authentications = append(authentications, ScaleRuleAuthentication{
+						SecretRef:        pointer.From(a.SecretRef),
+						TriggerParameter: pointer.From(a.TriggerParameter),
+					})
+				}
+				rule.Authentications = authentications
+			}
+
+			httpScaleRules = append(httpScaleRules, rule)
+			continue
+		}
+	}
+
+	c.AzureQueueScaleRules = azureQueueScaleRules
+	c.CustomScaleRules = customScaleRules
+	c.HTTPScaleRules = httpScaleRules
+}
+
+func ValidateScaleRuleConcurrentRequests(i interface{}, k string) (warnings []string, errors []error) {
+	p, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	v, err := strconv.Atoi(p)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a string representation of an integer, got %+v", k, p))
+		return
+	}
+
+	if v < 1 {
+		errors = append(errors, fmt.Errorf("value for %q must be at least `1`, got %d", k, v))
+	}
+
+	return
+}
@@ -7,8 +7,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/containerapps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/daprcomponents"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -526,11 +526,14 @@ func ContainerAppEnvironmentDaprMetadataDataSourceSchema() *pluginsdk.Schema {
 }
 
 type ContainerTemplate struct {
-	Containers  []Container       `tfschema:"container"`
-	Suffix      string            `tfschema:"revision_suffix"`
-	MinReplicas int               `tfschema:"min_replicas"`
-	MaxReplicas int               `tfschema:"max_replicas"`
-	Volumes     []ContainerVolume `tfschema:"volume"`
+	Containers           []Container           `tfschema:"container"`
+	Suffix               string                `tfschema:"revision_suffix"`
+	MinReplicas          int                   `tfschema:"min_replicas"`
+	MaxReplicas          int                   `tfschema:"max_replicas"`
+	AzureQueueScaleRules []AzureQueueScaleRule `tfschema:"azure_queue_scale_rule"`
+	CustomScaleRules     []CustomScaleRule     `tfschema:"custom_scale_rule"`
+	HTTPScaleRules       []HTTPScaleRule       `tfschema:"http_scale_rule"`
+	Volumes              []ContainerVolume     `tfschema:"volume"`
 }
 
 func ContainerTemplateSchema() *pluginsdk.Schema {
@@ -542,6 +545,12 @@ func ContainerTemplateSchema() *pluginsdk.Schema {
 			Schema: map[string]*pluginsdk.Schema{
 				"container": ContainerAppContainerSchema(),
 
+				"azure_queue_scale_rule": AzureQueueScaleRuleSchema(),
+
+				"custom_scale_rule": CustomScaleRuleSchema(),
+
+				"http_scale_rule": HTTPScaleRuleSchema(),
+
 				"min_replicas": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
@@ -596,6 +605,13 @@ func ExpandContainerAppTemplate(input []ContainerTemplate, metadata sdk.Resource
 		template.Scale.MinReplicas = pointer.To(int64(config.MinReplicas))
 	}
 
+	if rules := config.expandContainerAppScaleRules(); len(rules) != 0 {
+		if template.Scale == nil {
+			template.Scale = &containerapps.Scale{}
+		}
+		template.Scale.Rules = pointer.To(rules)
+	}
+
 	if config.Suffix != "" {
 		if metadata.ResourceData.HasChange("template.0.revision_suffix") {
 			template.RevisionSuffix = pointer.To(config.Suffix)
@@ -618,6 +634,7 @@ func FlattenContainerAppTemplate(input *containerapps.Template) []ContainerTempl
 	if scale := input.Scale; scale != nil {
 		result.MaxReplicas = int(pointer.From(scale.MaxReplicas))
 		result.MinReplicas = int(pointer.From(scale.MinReplicas))
+		result.flattenContainerAppScaleRules(scale.Rules)
 	}
 
 	return []ContainerTemplate{result}
@@ -31,6 +31,7 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerAppEnvironmentDaprComponentResource{},
 		ContainerAppEnvironmentResource{},
 		ContainerAppEnvironmentStorageResource{},
+		ContainerAppJobResource{},
 		ContainerAppResource{},
 	}
 }
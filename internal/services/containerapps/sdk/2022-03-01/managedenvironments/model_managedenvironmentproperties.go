@@ -12,5 +12,6 @@ type ManagedEnvironmentProperties struct {
 	ProvisioningState        *EnvironmentProvisioningState `json:"provisioningState,omitempty"`
 	StaticIP                 *string                       `json:"staticIp,omitempty"`
 	VnetConfiguration        *VnetConfiguration            `json:"vnetConfiguration,omitempty"`
+	WorkloadProfiles         *[]WorkloadProfile            `json:"workloadProfiles,omitempty"`
 	ZoneRedundant            *bool                         `json:"zoneRedundant,omitempty"`
 }
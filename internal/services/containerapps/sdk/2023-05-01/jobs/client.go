@@ -0,0 +1,18 @@
+package jobs
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type JobsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewJobsClientWithBaseURI(endpoint string) JobsClient {
+	return JobsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -3,12 +3,17 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2021-08-01-preview/containerregistry" // nolint: staticcheck
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2021-10-01/containerinstance"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2019-08-01/containerservices"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/agentpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/maintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/managedclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/trustedaccess"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/extensions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2021-10-01/containerinstance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-02-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/cacherules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/credentialsets"
 )
 
 type Client struct {
@@ -16,11 +21,16 @@ type Client struct {
 	ContainerRegistryAgentPoolsClient *containerregistry.AgentPoolsClient
 	ContainerInstanceClient           *containerinstance.ContainerInstanceClient
 	KubernetesClustersClient          *managedclusters.ManagedClustersClient
+	KubernetesExtensionsClient        *extensions.ExtensionsClient
 	MaintenanceConfigurationsClient   *maintenanceconfigurations.MaintenanceConfigurationsClient
+	TrustedAccessClient               *trustedaccess.TrustedAccessClient
 	RegistriesClient                  *containerregistry.RegistriesClient
+	RegistriesPreviewClient           *registries.RegistriesClient
 	ReplicationsClient                *containerregistry.ReplicationsClient
 	ServicesClient                    *containerservices.ContainerServicesClient
 	WebhooksClient                    *containerregistry.WebhooksClient
+	CacheRulesClient                  *cacherules.CacheRulesClient
+	CredentialSetsClient              *credentialsets.CredentialSetsClient
 	TokensClient                      *containerregistry.TokensClient
 	ScopeMapsClient                   *containerregistry.ScopeMapsClient
 	TasksClient                       *containerregistry.TasksClient
@@ -34,6 +44,11 @@ func NewContainersClient(o *common.ClientOptions) *Client {
 	registriesClient := containerregistry.NewRegistriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&registriesClient.Client, o.ResourceManagerAuthorizer)
 
+	// NOTE: the preview registries client is only used for the policies which aren't available in
+	// the API version used by the registries client above (e.g. the soft delete policy)
+	registriesPreviewClient := registries.NewRegistriesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&registriesPreviewClient.Client, o.ResourceManagerAuthorizer)
+
 	registryAgentPoolsClient := containerregistry.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&registryAgentPoolsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -65,6 +80,12 @@ func NewContainersClient(o *common.ClientOptions) *Client {
 	agentPoolsClient := agentpools.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&agentPoolsClient.Client, o.ResourceManagerAuthorizer)
 
+	kubernetesExtensionsClient := extensions.NewExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&kubernetesExtensionsClient.Client, o.ResourceManagerAuthorizer)
+
+	trustedAccessClient := trustedaccess.NewTrustedAccessClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&trustedAccessClient.Client, o.ResourceManagerAuthorizer)
+
 	maintenanceConfigurationsClient := maintenanceconfigurations.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -74,13 +95,22 @@ func NewContainersClient(o *common.ClientOptions) *Client {
 	connectedRegistriesClient := containerregistry.NewConnectedRegistriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&connectedRegistriesClient.Client, o.ResourceManagerAuthorizer)
 
+	cacheRulesClient := cacherules.NewCacheRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&cacheRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	credentialSetsClient := credentialsets.NewCredentialSetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&credentialSetsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AgentPoolsClient:                  &agentPoolsClient,
 		ContainerRegistryAgentPoolsClient: &registryAgentPoolsClient,
 		KubernetesClustersClient:          &kubernetesClustersClient,
+		KubernetesExtensionsClient:        &kubernetesExtensionsClient,
 		ContainerInstanceClient:           &containerInstanceClient,
 		MaintenanceConfigurationsClient:   &maintenanceConfigurationsClient,
+		TrustedAccessClient:               &trustedAccessClient,
 		RegistriesClient:                  &registriesClient,
+		RegistriesPreviewClient:           &registriesPreviewClient,
 		WebhooksClient:                    &webhooksClient,
 		ReplicationsClient:                &replicationsClient,
 		ServicesClient:                    &servicesClient,
@@ -90,5 +120,7 @@ func NewContainersClient(o *common.ClientOptions) *Client {
 		TasksClient:                       &tasksClient,
 		RunsClient:                        &runsClient,
 		ConnectedRegistriesClient:         &connectedRegistriesClient,
+		CacheRulesClient:                  &cacheRulesClient,
+		CredentialSetsClient:              &credentialSetsClient,
 	}
 }
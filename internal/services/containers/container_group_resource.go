@@ -15,11 +15,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2021-10-01/containerinstance"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2021-10-01/containerinstance"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -72,6 +72,28 @@ func resourceContainerGroup() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"sku": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(containerinstance.ContainerGroupSkuStandard),
+				ValidateFunc: validation.StringInSlice(containerinstance.PossibleValuesForContainerGroupSku(), false),
+			},
+
+			"confidential_compute_cce_policy": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(containerinstance.PossibleValuesForContainerGroupPriority(), false),
+			},
+
 			"os_type": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
@@ -665,6 +687,7 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	location := location.Normalize(d.Get("location").(string))
 	OSType := d.Get("os_type").(string)
 	IPAddressType := d.Get("ip_address_type").(string)
+	sku := containerinstance.ContainerGroupSku(d.Get("sku").(string))
 	restartPolicy := containerinstance.ContainerGroupRestartPolicy(d.Get("restart_policy").(string))
 	diagnosticsRaw := d.Get("diagnostics").([]interface{})
 	diagnostics := expandContainerGroupDiagnostics(diagnosticsRaw)
@@ -707,10 +730,27 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			ImageRegistryCredentials: expandContainerImageRegistryCredentials(d),
 			DnsConfig:                expandContainerGroupDnsConfig(dnsConfig),
 			SubnetIds:                subnets,
+			Sku:                      &sku,
 		},
 		Zones: &zones,
 	}
 
+	if ccePolicy := d.Get("confidential_compute_cce_policy").(string); ccePolicy != "" {
+		if sku != containerinstance.ContainerGroupSkuConfidential {
+			return fmt.Errorf("`confidential_compute_cce_policy` can only be set when `sku` is set to `Confidential`")
+		}
+		containerGroup.Properties.ConfidentialComputeProperties = &containerinstance.ConfidentialComputeProperties{
+			CcePolicy: pointer.To(ccePolicy),
+		}
+	}
+
+	if priority := d.Get("priority").(string); priority != "" {
+		if priority == string(containerinstance.ContainerGroupPrioritySpot) && IPAddressType != "None" {
+			return fmt.Errorf("`ip_address_type` has to be `None` when `priority` is set to `Spot`")
+		}
+		containerGroup.Properties.Priority = pointer.To(containerinstance.ContainerGroupPriority(priority))
+	}
+
 	// Container Groups with OS Type Windows do not support managed identities but the API also does not accept Identity Type: None
 	// https://github.com/Azure/azure-rest-api-specs/issues/18122
 	if OSType != string(containerinstance.OperatingSystemTypesWindows) {
@@ -864,6 +904,24 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("dns_name_label_reuse_policy", pointer.FromString(string(containerinstance.DnsNameLabelReusePolicyUnsecure)))
 		}
 
+		sku := string(containerinstance.ContainerGroupSkuStandard)
+		if props.Sku != nil {
+			sku = string(*props.Sku)
+		}
+		d.Set("sku", sku)
+
+		ccePolicy := ""
+		if props.ConfidentialComputeProperties != nil {
+			ccePolicy = pointer.From(props.ConfidentialComputeProperties.CcePolicy)
+		}
+		d.Set("confidential_compute_cce_policy", ccePolicy)
+
+		priority := ""
+		if props.Priority != nil {
+			priority = string(*props.Priority)
+		}
+		d.Set("priority", priority)
+
 		restartPolicy := ""
 		if props.RestartPolicy != nil {
 			restartPolicy = string(*props.RestartPolicy)
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-02-01-preview/registries"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -246,6 +247,14 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
 	}
 
+	// the soft delete policy isn't available in the API version used for the other policies, so
+	// it's applied with a separate update against a newer API version
+	if softDeletePolicyRaw := d.Get("soft_delete_policy").([]interface{}); len(softDeletePolicyRaw) > 0 {
+		if err := applySoftDeletePolicy(ctx, meta, id.ResourceGroup, id.Name, softDeletePolicyRaw); err != nil {
+			return fmt.Errorf("applying soft delete policy for %s: %+v", id, err)
+		}
+	}
+
 	// the ACR is being created so no previous geo-replication locations
 	var oldGeoReplicationLocations, newGeoReplicationLocations []containerregistry.Replication
 	newGeoReplicationLocations = expandReplications(geoReplications)
@@ -369,6 +378,12 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("waiting for update of %s: %+v", id, err)
 	}
 
+	if d.HasChange("soft_delete_policy") {
+		if err := applySoftDeletePolicy(ctx, meta, id.ResourceGroup, id.Name, d.Get("soft_delete_policy").([]interface{})); err != nil {
+			return fmt.Errorf("applying soft delete policy for %s: %+v", id, err)
+		}
+	}
+
 	// downgrade to Basic or Standard SKU
 	if skuChange && (isBasicSku || isStandardSku) {
 		if err := applyContainerRegistrySku(d, meta, sku, id.ResourceGroup, id.Name); err != nil {
@@ -615,6 +630,17 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("network_rule_bypass_option", string(properties.NetworkRuleBypassOptions))
 	}
 
+	// the soft delete policy isn't surfaced by the API version used for the other policies
+	softDeleteResp, err := meta.(*clients.Client).Containers.RegistriesPreviewClient.Get(ctx, registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving soft delete policy for %s: %+v", *id, err)
+	}
+	if model := softDeleteResp.Model; model != nil && model.Properties != nil {
+		if err := d.Set("soft_delete_policy", flattenSoftDeletePolicy(model.Properties.Policies)); err != nil {
+			return fmt.Errorf("setting `soft_delete_policy`: %+v", err)
+		}
+	}
+
 	if sku := resp.Sku; sku != nil {
 		d.Set("sku", string(sku.Tier))
 	}
@@ -754,6 +780,40 @@ func expandRetentionPolicy(p []interface{}) *containerregistry.RetentionPolicy {
 	return &retentionPolicy
 }
 
+func expandSoftDeletePolicy(p []interface{}) *registries.SoftDeletePolicy {
+	status := registries.PolicyStatusDisabled
+	softDeletePolicy := registries.SoftDeletePolicy{
+		RetentionDays: utils.Int64(7),
+		Status:        &status,
+	}
+
+	if len(p) > 0 {
+		v := p[0].(map[string]interface{})
+		if v["enabled"].(bool) {
+			status = registries.PolicyStatusEnabled
+		}
+		softDeletePolicy.RetentionDays = utils.Int64(int64(v["retention_days"].(int)))
+	}
+
+	return &softDeletePolicy
+}
+
+func applySoftDeletePolicy(ctx context.Context, meta interface{}, resourceGroup string, name string, input []interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistriesPreviewClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+
+	id := registries.NewRegistryID(subscriptionId, resourceGroup, name)
+	parameters := registries.RegistryUpdateParameters{
+		Properties: &registries.RegistryPropertiesUpdateParameters{
+			Policies: &registries.Policies{
+				SoftDeletePolicy: expandSoftDeletePolicy(input),
+			},
+		},
+	}
+
+	return client.UpdateThenPoll(ctx, id, parameters)
+}
+
 func expandTrustPolicy(p []interface{}) *containerregistry.TrustPolicy {
 	trustPolicy := containerregistry.TrustPolicy{
 		Status: containerregistry.PolicyStatusDisabled,
@@ -964,6 +1024,29 @@ func flattenRetentionPolicy(p *containerregistry.Policies) []interface{} {
 	return []interface{}{retentionPolicy}
 }
 
+func flattenSoftDeletePolicy(p *registries.Policies) []interface{} {
+	if p == nil || p.SoftDeletePolicy == nil {
+		return []interface{}{}
+	}
+
+	retentionDays := 0
+	if p.SoftDeletePolicy.RetentionDays != nil {
+		retentionDays = int(*p.SoftDeletePolicy.RetentionDays)
+	}
+
+	enabled := false
+	if p.SoftDeletePolicy.Status != nil {
+		enabled = *p.SoftDeletePolicy.Status == registries.PolicyStatusEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"retention_days": retentionDays,
+			"enabled":        enabled,
+		},
+	}
+}
+
 func flattenTrustPolicy(p *containerregistry.Policies) []interface{} {
 	if p == nil || p.TrustPolicy == nil {
 		return nil
@@ -1183,6 +1266,30 @@ func resourceContainerRegistrySchema() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"soft_delete_policy": {
+			Type:       pluginsdk.TypeList,
+			MaxItems:   1,
+			Optional:   true,
+			Computed:   true,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"retention_days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntBetween(1, 90),
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
 		"trust_policy": {
 			Type:       pluginsdk.TypeList,
 			MaxItems:   1,
@@ -422,6 +422,40 @@ func TestAccContainerRegistry_policies(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistry_softDeletePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
+	r := ContainerRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.softDeletePolicy(data, 7, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_policy.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("soft_delete_policy.0.retention_days").HasValue("7"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.softDeletePolicy(data, 14, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_policy.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("soft_delete_policy.0.retention_days").HasValue("14"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.softDeletePolicy(data, 14, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_policy.0.enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccContainerRegistry_identity(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
 	r := ContainerRegistryResource{}
@@ -1071,6 +1105,31 @@ resource "azurerm_container_registry" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (ContainerRegistryResource) softDeletePolicy(data acceptance.TestData, days int, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "acctestACR%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+
+  soft_delete_policy {
+    retention_days = %d
+    enabled        = %t
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, days, enabled)
+}
+
 func (ContainerRegistryResource) identity(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,488 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/managedclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesClusterExtensionModel struct {
+	Name                           string                                    `tfschema:"name"`
+	ClusterID                      string                                    `tfschema:"cluster_id"`
+	ConfigurationProtectedSettings map[string]string                         `tfschema:"configuration_protected_settings"`
+	ConfigurationSettings          map[string]string                         `tfschema:"configuration_settings"`
+	ExtensionType                  string                                    `tfschema:"extension_type"`
+	Plan                           []KubernetesClusterExtensionPlanModel     `tfschema:"plan"`
+	ReleaseNamespace               string                                    `tfschema:"release_namespace"`
+	ReleaseTrain                   string                                    `tfschema:"release_train"`
+	TargetNamespace                string                                    `tfschema:"target_namespace"`
+	Version                        string                                    `tfschema:"version"`
+	AksAssignedIdentity            []KubernetesClusterExtensionIdentityModel `tfschema:"aks_assigned_identity"`
+	CurrentVersion                 string                                    `tfschema:"current_version"`
+}
+
+type KubernetesClusterExtensionPlanModel struct {
+	Name          string `tfschema:"name"`
+	Product       string `tfschema:"product"`
+	PromotionCode string `tfschema:"promotion_code"`
+	Publisher     string `tfschema:"publisher"`
+	Version       string `tfschema:"version"`
+}
+
+type KubernetesClusterExtensionIdentityModel struct {
+	PrincipalId string `tfschema:"principal_id"`
+	TenantId    string `tfschema:"tenant_id"`
+	Type        string `tfschema:"type"`
+}
+
+type KubernetesClusterExtensionResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterExtensionResource{}
+
+func (r KubernetesClusterExtensionResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_extension"
+}
+
+func (r KubernetesClusterExtensionResource) ModelObject() interface{} {
+	return &KubernetesClusterExtensionModel{}
+}
+
+func (r KubernetesClusterExtensionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return extensions.ValidateExtensionID
+}
+
+func (r KubernetesClusterExtensionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]{0,251}$"),
+				"name must be between 1 and 252 characters in length, start with a letter or number and may only contain letters, numbers and hyphens",
+			),
+		},
+
+		"cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedclusters.ValidateManagedClusterID,
+		},
+
+		"extension_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"configuration_protected_settings": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"configuration_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"plan": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"product": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"promotion_code": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"release_namespace": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"target_namespace"},
+		},
+
+		"release_train": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"target_namespace": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"release_namespace"},
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"aks_assigned_identity": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"principal_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"current_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KubernetesClusterExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+			clusterId, err := managedclusters.ParseManagedClusterID(model.ClusterID)
+			if err != nil {
+				return err
+			}
+
+			id := extensions.NewExtensionID(clusterId.SubscriptionId, clusterId.ResourceGroupName, "Microsoft.ContainerService", "managedClusters", clusterId.ManagedClusterName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			autoUpgradeMinorVersion := model.Version == ""
+			properties := &extensions.Extension{
+				Plan: expandKubernetesClusterExtensionPlan(model.Plan),
+				Properties: &extensions.ExtensionProperties{
+					AutoUpgradeMinorVersion:        utils.Bool(autoUpgradeMinorVersion),
+					ConfigurationProtectedSettings: &model.ConfigurationProtectedSettings,
+					ConfigurationSettings:          &model.ConfigurationSettings,
+					ExtensionType:                  utils.String(model.ExtensionType),
+				},
+			}
+
+			if model.ReleaseNamespace != "" {
+				properties.Properties.Scope = &extensions.Scope{
+					Cluster: &extensions.ScopeCluster{
+						ReleaseNamespace: utils.String(model.ReleaseNamespace),
+					},
+				}
+			}
+
+			if model.TargetNamespace != "" {
+				properties.Properties.Scope = &extensions.Scope{
+					Namespace: &extensions.ScopeNamespace{
+						TargetNamespace: utils.String(model.TargetNamespace),
+					},
+				}
+			}
+
+			if model.ReleaseTrain != "" {
+				properties.Properties.ReleaseTrain = utils.String(model.ReleaseTrain)
+			}
+
+			if model.Version != "" {
+				properties.Properties.Version = utils.String(model.Version)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, *properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			id, err := extensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := extensions.PatchExtension{
+				Properties: &extensions.PatchExtensionProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("configuration_protected_settings") {
+				properties.Properties.ConfigurationProtectedSettings = &model.ConfigurationProtectedSettings
+			}
+
+			if metadata.ResourceData.HasChange("configuration_settings") {
+				properties.Properties.ConfigurationSettings = &model.ConfigurationSettings
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			id, err := extensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var state KubernetesClusterExtensionModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId := managedclusters.NewManagedClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName)
+			model := KubernetesClusterExtensionModel{
+				Name:      id.ExtensionName,
+				ClusterID: clusterId.ID(),
+
+				// `configuration_protected_settings` is not returned by the API so it's set from the config
+				ConfigurationProtectedSettings: state.ConfigurationProtectedSettings,
+			}
+
+			if resp.Model != nil {
+				model.Plan = flattenKubernetesClusterExtensionPlan(resp.Model.Plan)
+
+				if props := resp.Model.Properties; props != nil {
+					model.AksAssignedIdentity = flattenKubernetesClusterExtensionIdentity(props.AksAssignedIdentity)
+
+					if props.ConfigurationSettings != nil {
+						model.ConfigurationSettings = *props.ConfigurationSettings
+					}
+
+					if props.CurrentVersion != nil {
+						model.CurrentVersion = *props.CurrentVersion
+					}
+
+					if props.ExtensionType != nil {
+						model.ExtensionType = *props.ExtensionType
+					}
+
+					if props.ReleaseTrain != nil {
+						model.ReleaseTrain = *props.ReleaseTrain
+					}
+
+					if props.Scope != nil {
+						if props.Scope.Cluster != nil && props.Scope.Cluster.ReleaseNamespace != nil {
+							model.ReleaseNamespace = *props.Scope.Cluster.ReleaseNamespace
+						}
+
+						if props.Scope.Namespace != nil && props.Scope.Namespace.TargetNamespace != nil {
+							model.TargetNamespace = *props.Scope.Namespace.TargetNamespace
+						}
+					}
+
+					if props.Version != nil {
+						model.Version = *props.Version
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			id, err := extensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, extensions.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesClusterExtensionPlan(input []KubernetesClusterExtensionPlanModel) *extensions.Plan {
+	if len(input) == 0 {
+		return nil
+	}
+
+	plan := input[0]
+	result := extensions.Plan{
+		Name:      plan.Name,
+		Product:   plan.Product,
+		Publisher: plan.Publisher,
+	}
+
+	if plan.PromotionCode != "" {
+		result.PromotionCode = utils.String(plan.PromotionCode)
+	}
+
+	if plan.Version != "" {
+		result.Version = utils.String(plan.Version)
+	}
+
+	return &result
+}
+
+func flattenKubernetesClusterExtensionPlan(input *extensions.Plan) []KubernetesClusterExtensionPlanModel {
+	results := make([]KubernetesClusterExtensionPlanModel, 0)
+	if input == nil {
+		return results
+	}
+
+	plan := KubernetesClusterExtensionPlanModel{
+		Name:      input.Name,
+		Product:   input.Product,
+		Publisher: input.Publisher,
+	}
+
+	if input.PromotionCode != nil {
+		plan.PromotionCode = *input.PromotionCode
+	}
+
+	if input.Version != nil {
+		plan.Version = *input.Version
+	}
+
+	return append(results, plan)
+}
+
+func flattenKubernetesClusterExtensionIdentity(input *extensions.ExtensionPropertiesAksAssignedIdentity) []KubernetesClusterExtensionIdentityModel {
+	results := make([]KubernetesClusterExtensionIdentityModel, 0)
+	if input == nil {
+		return results
+	}
+
+	identity := KubernetesClusterExtensionIdentityModel{}
+	if input.PrincipalId != nil {
+		identity.PrincipalId = *input.PrincipalId
+	}
+
+	if input.TenantId != nil {
+		identity.TenantId = *input.TenantId
+	}
+
+	if input.Type != nil {
+		identity.Type = string(*input.Type)
+	}
+
+	return append(results, identity)
+}
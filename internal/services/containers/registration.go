@@ -58,10 +58,14 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	resources := []sdk.Resource{
+		ContainerRegistryCacheRuleResource{},
+		ContainerRegistryCredentialSetResource{},
 		ContainerRegistryTaskResource{},
 		ContainerRegistryTaskScheduleResource{},
 		ContainerRegistryTokenPasswordResource{},
 		ContainerConnectedRegistryResource{},
+		KubernetesClusterExtensionResource{},
+		KubernetesClusterTrustedAccessRoleBindingResource{},
 	}
 	resources = append(resources, r.autoRegistration.Resources()...)
 	return resources
@@ -0,0 +1,8 @@
+package containerinstance
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ConfidentialComputeProperties struct {
+	CcePolicy *string `json:"ccePolicy,omitempty"`
+}
@@ -0,0 +1,18 @@
+package registries
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RegistriesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRegistriesClientWithBaseURI(endpoint string) RegistriesClient {
+	return RegistriesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
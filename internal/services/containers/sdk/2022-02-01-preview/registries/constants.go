@@ -0,0 +1,87 @@
+package registries
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ExportPolicyStatus string
+
+const (
+	ExportPolicyStatusDisabled ExportPolicyStatus = "disabled"
+	ExportPolicyStatusEnabled  ExportPolicyStatus = "enabled"
+)
+
+func PossibleValuesForExportPolicyStatus() []string {
+	return []string{
+		string(ExportPolicyStatusDisabled),
+		string(ExportPolicyStatusEnabled),
+	}
+}
+
+func parseExportPolicyStatus(input string) (*ExportPolicyStatus, error) {
+	vals := map[string]ExportPolicyStatus{
+		"disabled": ExportPolicyStatusDisabled,
+		"enabled":  ExportPolicyStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExportPolicyStatus(input)
+	return &out, nil
+}
+
+type PolicyStatus string
+
+const (
+	PolicyStatusDisabled PolicyStatus = "disabled"
+	PolicyStatusEnabled  PolicyStatus = "enabled"
+)
+
+func PossibleValuesForPolicyStatus() []string {
+	return []string{
+		string(PolicyStatusDisabled),
+		string(PolicyStatusEnabled),
+	}
+}
+
+func parsePolicyStatus(input string) (*PolicyStatus, error) {
+	vals := map[string]PolicyStatus{
+		"disabled": PolicyStatusDisabled,
+		"enabled":  PolicyStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PolicyStatus(input)
+	return &out, nil
+}
+
+type TrustPolicyType string
+
+const (
+	TrustPolicyTypeNotary TrustPolicyType = "Notary"
+)
+
+func PossibleValuesForTrustPolicyType() []string {
+	return []string{
+		string(TrustPolicyTypeNotary),
+	}
+}
+
+func parseTrustPolicyType(input string) (*TrustPolicyType, error) {
+	vals := map[string]TrustPolicyType{
+		"notary": TrustPolicyTypeNotary,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TrustPolicyType(input)
+	return &out, nil
+}
@@ -0,0 +1,56 @@
+package registries
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Registry struct {
+	Id         *string             `json:"id,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Properties *RegistryProperties `json:"properties,omitempty"`
+	Type       *string             `json:"type,omitempty"`
+}
+
+type RegistryProperties struct {
+	Policies *Policies `json:"policies,omitempty"`
+}
+
+type RegistryUpdateParameters struct {
+	Properties *RegistryPropertiesUpdateParameters `json:"properties,omitempty"`
+}
+
+type RegistryPropertiesUpdateParameters struct {
+	Policies *Policies `json:"policies,omitempty"`
+}
+
+type Policies struct {
+	ExportPolicy     *ExportPolicy     `json:"exportPolicy,omitempty"`
+	QuarantinePolicy *QuarantinePolicy `json:"quarantinePolicy,omitempty"`
+	RetentionPolicy  *RetentionPolicy  `json:"retentionPolicy,omitempty"`
+	SoftDeletePolicy *SoftDeletePolicy `json:"softDeletePolicy,omitempty"`
+	TrustPolicy      *TrustPolicy      `json:"trustPolicy,omitempty"`
+}
+
+type ExportPolicy struct {
+	Status *ExportPolicyStatus `json:"status,omitempty"`
+}
+
+type QuarantinePolicy struct {
+	Status *PolicyStatus `json:"status,omitempty"`
+}
+
+type RetentionPolicy struct {
+	Days            *int64        `json:"days,omitempty"`
+	LastUpdatedTime *string       `json:"lastUpdatedTime,omitempty"`
+	Status          *PolicyStatus `json:"status,omitempty"`
+}
+
+type SoftDeletePolicy struct {
+	LastUpdatedTime *string       `json:"lastUpdatedTime,omitempty"`
+	RetentionDays   *int64        `json:"retentionDays,omitempty"`
+	Status          *PolicyStatus `json:"status,omitempty"`
+}
+
+type TrustPolicy struct {
+	Status *PolicyStatus    `json:"status,omitempty"`
+	Type   *TrustPolicyType `json:"type,omitempty"`
+}
@@ -0,0 +1,18 @@
+package cacherules
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CacheRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCacheRulesClientWithBaseURI(endpoint string) CacheRulesClient {
+	return CacheRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,27 @@
+package cacherules
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CacheRule struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *CacheRuleProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
+
+type CacheRuleProperties struct {
+	CreationDate            *string            `json:"creationDate,omitempty"`
+	CredentialSetResourceId *string            `json:"credentialSetResourceId,omitempty"`
+	ProvisioningState       *ProvisioningState `json:"provisioningState,omitempty"`
+	SourceRepository        *string            `json:"sourceRepository,omitempty"`
+	TargetRepository        *string            `json:"targetRepository,omitempty"`
+}
+
+type CacheRuleUpdateParameters struct {
+	Properties *CacheRuleUpdateProperties `json:"properties,omitempty"`
+}
+
+type CacheRuleUpdateProperties struct {
+	CredentialSetResourceId *string `json:"credentialSetResourceId,omitempty"`
+}
@@ -0,0 +1,18 @@
+package credentialsets
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CredentialSetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCredentialSetsClientWithBaseURI(endpoint string) CredentialSetsClient {
+	return CredentialSetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
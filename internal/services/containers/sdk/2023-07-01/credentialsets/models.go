@@ -0,0 +1,38 @@
+package credentialsets
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CredentialSet struct {
+	Id         *string                  `json:"id,omitempty"`
+	Identity   *identity.SystemAssigned `json:"identity,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *CredentialSetProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
+
+type CredentialSetProperties struct {
+	AuthCredentials   *[]AuthCredential  `json:"authCredentials,omitempty"`
+	CreationDate      *string            `json:"creationDate,omitempty"`
+	LoginServer       *string            `json:"loginServer,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
+
+type AuthCredential struct {
+	Name                     *CredentialName `json:"name,omitempty"`
+	PasswordSecretIdentifier *string         `json:"passwordSecretIdentifier,omitempty"`
+	UsernameSecretIdentifier *string         `json:"usernameSecretIdentifier,omitempty"`
+}
+
+type CredentialSetUpdateParameters struct {
+	Identity   *identity.SystemAssigned       `json:"identity,omitempty"`
+	Properties *CredentialSetUpdateProperties `json:"properties,omitempty"`
+}
+
+type CredentialSetUpdateProperties struct {
+	AuthCredentials *[]AuthCredential `json:"authCredentials,omitempty"`
+}
@@ -1,19 +1,25 @@
 package client
 
 import (
-	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2022-08-01/grafanaresource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/sdk/2022-08-01/grafanaresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/sdk/2023-09-01/managedprivateendpoints"
 )
 
 type Client struct {
-	GrafanaResourceClient *grafanaresource.GrafanaResourceClient
+	GrafanaResourceClient         *grafanaresource.GrafanaResourceClient
+	ManagedPrivateEndpointsClient *managedprivateendpoints.ManagedPrivateEndpointsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	grafanaResourceClient := grafanaresource.NewGrafanaResourceClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&grafanaResourceClient.Client, o.ResourceManagerAuthorizer)
 
+	managedPrivateEndpointsClient := managedprivateendpoints.NewManagedPrivateEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedPrivateEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		GrafanaResourceClient: &grafanaResourceClient,
+		GrafanaResourceClient:         &grafanaResourceClient,
+		ManagedPrivateEndpointsClient: &managedPrivateEndpointsClient,
 	}
 }
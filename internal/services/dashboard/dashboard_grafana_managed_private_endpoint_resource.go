@@ -0,0 +1,277 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/sdk/2023-09-01/managedprivateendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DashboardGrafanaManagedPrivateEndpointModel struct {
+	Name                      string            `tfschema:"name"`
+	GrafanaId                 string            `tfschema:"grafana_id"`
+	Location                  string            `tfschema:"location"`
+	PrivateLinkResourceId     string            `tfschema:"private_link_resource_id"`
+	GroupIds                  []string          `tfschema:"group_ids"`
+	RequestMessage            string            `tfschema:"request_message"`
+	PrivateLinkResourceRegion string            `tfschema:"private_link_resource_region"`
+	Tags                      map[string]string `tfschema:"tags"`
+}
+
+type DashboardGrafanaManagedPrivateEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = DashboardGrafanaManagedPrivateEndpointResource{}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) ResourceType() string {
+	return "azurerm_dashboard_grafana_managed_private_endpoint"
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) ModelObject() interface{} {
+	return &DashboardGrafanaManagedPrivateEndpointModel{}
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedprivateendpoints.ValidateManagedPrivateEndpointID
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"grafana_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedprivateendpoints.ValidateGrafanaID,
+		},
+
+		"location": commonschema.Location(),
+
+		"private_link_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"group_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"private_link_resource_region": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"request_message": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DashboardGrafanaManagedPrivateEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Dashboard.ManagedPrivateEndpointsClient
+
+			grafanaId, err := managedprivateendpoints.ParseGrafanaID(model.GrafanaId)
+			if err != nil {
+				return err
+			}
+
+			id := managedprivateendpoints.NewManagedPrivateEndpointID(grafanaId.SubscriptionId, grafanaId.ResourceGroupName, grafanaId.GrafanaName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := managedprivateendpoints.ManagedPrivateEndpointModel{
+				Location: location.Normalize(model.Location),
+				Properties: &managedprivateendpoints.ManagedPrivateEndpointModelProperties{
+					PrivateLinkResourceId: utils.String(model.PrivateLinkResourceId),
+				},
+				Tags: &model.Tags,
+			}
+
+			if len(model.GroupIds) > 0 {
+				properties.Properties.GroupIds = &model.GroupIds
+			}
+
+			if model.PrivateLinkResourceRegion != "" {
+				properties.Properties.PrivateLinkResourceRegion = utils.String(model.PrivateLinkResourceRegion)
+			}
+
+			if model.RequestMessage != "" {
+				properties.Properties.RequestMessage = utils.String(model.RequestMessage)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.ManagedPrivateEndpointsClient
+
+			id, err := managedprivateendpoints.ParseManagedPrivateEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DashboardGrafanaManagedPrivateEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			if metadata.ResourceData.HasChange("request_message") {
+				if properties.Properties == nil {
+					properties.Properties = &managedprivateendpoints.ManagedPrivateEndpointModelProperties{}
+				}
+
+				if model.RequestMessage != "" {
+					properties.Properties.RequestMessage = utils.String(model.RequestMessage)
+				} else {
+					properties.Properties.RequestMessage = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				properties.Tags = &model.Tags
+			}
+
+			if err := client.CreateThenPoll(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.ManagedPrivateEndpointsClient
+
+			id, err := managedprivateendpoints.ParseManagedPrivateEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := DashboardGrafanaManagedPrivateEndpointModel{
+				Name:      id.ManagedPrivateEndpointName,
+				GrafanaId: managedprivateendpoints.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName).ID(),
+				Location:  location.Normalize(model.Location),
+			}
+
+			if properties := model.Properties; properties != nil {
+				if properties.GroupIds != nil {
+					state.GroupIds = *properties.GroupIds
+				}
+
+				state.PrivateLinkResourceId = pointer.From(properties.PrivateLinkResourceId)
+				state.PrivateLinkResourceRegion = pointer.From(properties.PrivateLinkResourceRegion)
+				state.RequestMessage = pointer.From(properties.RequestMessage)
+			}
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.ManagedPrivateEndpointsClient
+
+			id, err := managedprivateendpoints.ParseManagedPrivateEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
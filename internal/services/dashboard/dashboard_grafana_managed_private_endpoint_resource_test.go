@@ -0,0 +1,158 @@
+package dashboard_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/sdk/2023-09-01/managedprivateendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DashboardGrafanaManagedPrivateEndpointResource struct{}
+
+func TestAccDashboardGrafanaManagedPrivateEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_managed_private_endpoint", "test")
+	r := DashboardGrafanaManagedPrivateEndpointResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDashboardGrafanaManagedPrivateEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_managed_private_endpoint", "test")
+	r := DashboardGrafanaManagedPrivateEndpointResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDashboardGrafanaManagedPrivateEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_managed_private_endpoint", "test")
+	r := DashboardGrafanaManagedPrivateEndpointResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managedprivateendpoints.ParseManagedPrivateEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := clients.Dashboard.ManagedPrivateEndpointsClient
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-rg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dashboard_grafana" "test" {
+  name                          = "a-dg-%[1]d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  public_network_access_enabled = false
+}
+
+resource "azurerm_monitor_private_link_scope" "test" {
+  name                = "acctest-ampls-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+				%s
+
+resource "azurerm_dashboard_grafana_managed_private_endpoint" "test" {
+  name                     = "acctest-mpe-%d"
+  grafana_id               = azurerm_dashboard_grafana.test.id
+  location                 = azurerm_dashboard_grafana.test.location
+  private_link_resource_id = azurerm_monitor_private_link_scope.test.id
+  group_ids                = ["azuremonitor"]
+}
+`, template, data.RandomInteger)
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+	return fmt.Sprintf(`
+			%s
+
+resource "azurerm_dashboard_grafana_managed_private_endpoint" "import" {
+  name                     = azurerm_dashboard_grafana_managed_private_endpoint.test.name
+  grafana_id               = azurerm_dashboard_grafana_managed_private_endpoint.test.grafana_id
+  location                 = azurerm_dashboard_grafana_managed_private_endpoint.test.location
+  private_link_resource_id = azurerm_dashboard_grafana_managed_private_endpoint.test.private_link_resource_id
+  group_ids                = azurerm_dashboard_grafana_managed_private_endpoint.test.group_ids
+}
+`, config)
+}
+
+func (r DashboardGrafanaManagedPrivateEndpointResource) update(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+				%s
+
+resource "azurerm_dashboard_grafana_managed_private_endpoint" "test" {
+  name                     = "acctest-mpe-%d"
+  grafana_id               = azurerm_dashboard_grafana.test.id
+  location                 = azurerm_dashboard_grafana.test.location
+  private_link_resource_id = azurerm_monitor_private_link_scope.test.id
+  group_ids                = ["azuremonitor"]
+  request_message          = "please approve"
+
+  tags = {
+    key = "value"
+  }
+}
+`, template, data.RandomInteger)
+}
@@ -10,8 +10,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2022-08-01/grafanaresource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/sdk/2022-08-01/grafanaresource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -24,9 +24,11 @@ type DashboardGrafanaModel struct {
 	AutoGeneratedDomainNameLabelScope grafanaresource.AutoGeneratedDomainNameLabelScope `tfschema:"auto_generated_domain_name_label_scope"`
 	DeterministicOutboundIPEnabled    bool                                              `tfschema:"deterministic_outbound_ip_enabled"`
 	AzureMonitorWorkspaceIntegrations []AzureMonitorWorkspaceIntegrationModel           `tfschema:"azure_monitor_workspace_integrations"`
+	GrafanaMajorVersion               string                                            `tfschema:"grafana_major_version"`
 	Location                          string                                            `tfschema:"location"`
 	PublicNetworkAccessEnabled        bool                                              `tfschema:"public_network_access_enabled"`
 	Sku                               string                                            `tfschema:"sku"`
+	Smtp                              []SmtpConfigurationModel                          `tfschema:"smtp"`
 	Tags                              map[string]string                                 `tfschema:"tags"`
 	ZoneRedundancyEnabled             bool                                              `tfschema:"zone_redundancy_enabled"`
 	Endpoint                          string                                            `tfschema:"endpoint"`
@@ -38,6 +40,17 @@ type AzureMonitorWorkspaceIntegrationModel struct {
 	ResourceId string `tfschema:"resource_id"`
 }
 
+type SmtpConfigurationModel struct {
+	Enabled                 bool   `tfschema:"enabled"`
+	FromAddress             string `tfschema:"from_address"`
+	FromName                string `tfschema:"from_name"`
+	Host                    string `tfschema:"host"`
+	Password                string `tfschema:"password"`
+	StartTLSPolicy          string `tfschema:"start_tls_policy"`
+	User                    string `tfschema:"user"`
+	VerificationSkipEnabled bool   `tfschema:"verification_skip_enabled"`
+}
+
 type DashboardGrafanaResource struct{}
 
 var _ sdk.ResourceWithUpdate = DashboardGrafanaResource{}
@@ -105,8 +118,76 @@ func (r DashboardGrafanaResource) Arguments() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"grafana_major_version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"9",
+				"10",
+			}, false),
+		},
+
 		"identity": commonschema.SystemAssignedIdentityOptionalForceNew(),
 
+		"smtp": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"host": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"user": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"password": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"start_tls_policy": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(grafanaresource.PossibleValuesForStartTLSPolicy(), false),
+					},
+
+					"from_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"from_name": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  "Azure Managed Grafana Notification",
+					},
+
+					"verification_skip_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
 		"public_network_access_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -206,6 +287,7 @@ func (r DashboardGrafanaResource) Create() sdk.ResourceFunc {
 					ApiKey:                            &apiKey,
 					AutoGeneratedDomainNameLabelScope: &model.AutoGeneratedDomainNameLabelScope,
 					DeterministicOutboundIP:           &deterministicOutboundIP,
+					GrafanaConfigurations:             expandGrafanaConfigurationsModel(model.Smtp),
 					GrafanaIntegrations:               expandGrafanaIntegrationsModel(model.AzureMonitorWorkspaceIntegrations),
 					PublicNetworkAccess:               &publicNetworkAccess,
 					ZoneRedundancy:                    &zoneRedundancy,
@@ -216,6 +298,10 @@ func (r DashboardGrafanaResource) Create() sdk.ResourceFunc {
 				Tags: &model.Tags,
 			}
 
+			if model.GrafanaMajorVersion != "" {
+				properties.Properties.GrafanaMajorVersion = &model.GrafanaMajorVersion
+			}
+
 			if err := client.GrafanaCreateThenPoll(ctx, id, *properties); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
@@ -278,6 +364,14 @@ func (r DashboardGrafanaResource) Update() sdk.ResourceFunc {
 				properties.Properties.GrafanaIntegrations = expandGrafanaIntegrationsModel(model.AzureMonitorWorkspaceIntegrations)
 			}
 
+			if metadata.ResourceData.HasChange("grafana_major_version") {
+				properties.Properties.GrafanaMajorVersion = &model.GrafanaMajorVersion
+			}
+
+			if metadata.ResourceData.HasChange("smtp") {
+				properties.Properties.GrafanaConfigurations = expandGrafanaConfigurationsModel(model.Smtp)
+			}
+
 			if metadata.ResourceData.HasChange("public_network_access_enabled") {
 				publicNetworkAccess := grafanaresource.PublicNetworkAccessDisabled
 				if model.PublicNetworkAccessEnabled {
@@ -368,6 +462,13 @@ func (r DashboardGrafanaResource) Read() sdk.ResourceFunc {
 					state.AzureMonitorWorkspaceIntegrations = flattenAzureMonitorWorkspaceIntegrationModelArray(properties.GrafanaIntegrations.AzureMonitorWorkspaceIntegrations)
 				}
 
+				if properties.GrafanaMajorVersion != nil {
+					state.GrafanaMajorVersion = *properties.GrafanaMajorVersion
+				}
+
+				// the API doesn't return the password so we need to pull it from the config
+				state.Smtp = flattenSmtpConfigurationModel(properties.GrafanaConfigurations, metadata.ResourceData.Get("smtp.0.password").(string))
+
 				if properties.GrafanaVersion != nil {
 					state.GrafanaVersion = *properties.GrafanaVersion
 				}
@@ -450,6 +551,28 @@ func expandAzureMonitorWorkspaceIntegrationModelArray(inputList []AzureMonitorWo
 	return &outputList
 }
 
+func expandGrafanaConfigurationsModel(inputList []SmtpConfigurationModel) *grafanaresource.GrafanaConfigurations {
+	if len(inputList) == 0 {
+		return nil
+	}
+
+	input := inputList[0]
+	startTLSPolicy := grafanaresource.StartTLSPolicy(input.StartTLSPolicy)
+
+	return &grafanaresource.GrafanaConfigurations{
+		Smtp: &grafanaresource.Smtp{
+			Enabled:        &input.Enabled,
+			FromAddress:    &input.FromAddress,
+			FromName:       &input.FromName,
+			Host:           &input.Host,
+			Password:       &input.Password,
+			SkipVerify:     &input.VerificationSkipEnabled,
+			StartTLSPolicy: &startTLSPolicy,
+			User:           &input.User,
+		},
+	}
+}
+
 func expandLegacySystemAndUserAssignedMap(input []interface{}) *identity.LegacySystemAndUserAssignedMap {
 	identityValue, err := identity.ExpandSystemAssigned(input)
 	if err != nil {
@@ -480,6 +603,48 @@ func flattenAzureMonitorWorkspaceIntegrationModelArray(inputList *[]grafanaresou
 	return outputList
 }
 
+func flattenSmtpConfigurationModel(input *grafanaresource.GrafanaConfigurations, password string) []SmtpConfigurationModel {
+	var outputList []SmtpConfigurationModel
+	if input == nil || input.Smtp == nil {
+		return outputList
+	}
+
+	smtp := input.Smtp
+	output := SmtpConfigurationModel{
+		Password: password,
+	}
+
+	if smtp.Enabled != nil {
+		output.Enabled = *smtp.Enabled
+	}
+
+	if smtp.FromAddress != nil {
+		output.FromAddress = *smtp.FromAddress
+	}
+
+	if smtp.FromName != nil {
+		output.FromName = *smtp.FromName
+	}
+
+	if smtp.Host != nil {
+		output.Host = *smtp.Host
+	}
+
+	if smtp.SkipVerify != nil {
+		output.VerificationSkipEnabled = *smtp.SkipVerify
+	}
+
+	if smtp.StartTLSPolicy != nil {
+		output.StartTLSPolicy = string(*smtp.StartTLSPolicy)
+	}
+
+	if smtp.User != nil {
+		output.User = *smtp.User
+	}
+
+	return append(outputList, output)
+}
+
 func flattenLegacySystemAndUserAssignedMap(input *identity.LegacySystemAndUserAssignedMap) *[]interface{} {
 	if input == nil {
 		return &[]interface{}{}
@@ -6,10 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2022-08-01/grafanaresource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/sdk/2022-08-01/grafanaresource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -79,6 +79,20 @@ func TestAccDashboardGrafana_update(t *testing.T) {
 	})
 }
 
+func TestAccDashboardGrafana_smtp(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana", "test")
+	r := DashboardGrafanaResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.smtp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("smtp.0.password"),
+	})
+}
+
 func (r DashboardGrafanaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := grafanaresource.ParseGrafanaID(state.ID)
 	if err != nil {
@@ -146,6 +160,7 @@ resource "azurerm_dashboard_grafana" "test" {
   location                          = azurerm_resource_group.test.location
   api_key_enabled                   = true
   deterministic_outbound_ip_enabled = true
+  grafana_major_version             = "9"
   public_network_access_enabled     = false
 
   identity {
@@ -169,9 +184,10 @@ func (r DashboardGrafanaResource) update(data acceptance.TestData) string {
 			%s
 
 resource "azurerm_dashboard_grafana" "test" {
-  name                = "a-dg-%d"
-  resource_group_name = azurerm_resource_group.test.name
-  location            = azurerm_resource_group.test.location
+  name                  = "a-dg-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  grafana_major_version = "10"
 
   identity {
     type = "SystemAssigned"
@@ -191,3 +207,25 @@ resource "azurerm_dashboard_grafana" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r DashboardGrafanaResource) smtp(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+				%s
+
+resource "azurerm_dashboard_grafana" "test" {
+  name                = "a-dg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  smtp {
+    enabled          = true
+    host             = "localhost:25"
+    user             = "user"
+    password         = "password"
+    start_tls_policy = "OpportunisticStartTLS"
+    from_address     = "admin@grafana.localhost"
+  }
+}
+`, template, data.RandomInteger)
+}
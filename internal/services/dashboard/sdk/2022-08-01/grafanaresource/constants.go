@@ -228,6 +228,37 @@ func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
 	return &out, nil
 }
 
+type StartTLSPolicy string
+
+const (
+	StartTLSPolicyMandatoryStartTLS     StartTLSPolicy = "MandatoryStartTLS"
+	StartTLSPolicyNoStartTLS            StartTLSPolicy = "NoStartTLS"
+	StartTLSPolicyOpportunisticStartTLS StartTLSPolicy = "OpportunisticStartTLS"
+)
+
+func PossibleValuesForStartTLSPolicy() []string {
+	return []string{
+		string(StartTLSPolicyMandatoryStartTLS),
+		string(StartTLSPolicyNoStartTLS),
+		string(StartTLSPolicyOpportunisticStartTLS),
+	}
+}
+
+func parseStartTLSPolicy(input string) (*StartTLSPolicy, error) {
+	vals := map[string]StartTLSPolicy{
+		"mandatorystarttls":     StartTLSPolicyMandatoryStartTLS,
+		"nostarttls":            StartTLSPolicyNoStartTLS,
+		"opportunisticstarttls": StartTLSPolicyOpportunisticStartTLS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StartTLSPolicy(input)
+	return &out, nil
+}
+
 type ZoneRedundancy string
 
 const (
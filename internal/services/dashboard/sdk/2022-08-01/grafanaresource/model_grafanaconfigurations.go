@@ -0,0 +1,8 @@
+package grafanaresource
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type GrafanaConfigurations struct {
+	Smtp *Smtp `json:"smtp,omitempty"`
+}
@@ -8,7 +8,9 @@ type ManagedGrafanaProperties struct {
 	AutoGeneratedDomainNameLabelScope *AutoGeneratedDomainNameLabelScope `json:"autoGeneratedDomainNameLabelScope,omitempty"`
 	DeterministicOutboundIP           *DeterministicOutboundIP           `json:"deterministicOutboundIP,omitempty"`
 	Endpoint                          *string                            `json:"endpoint,omitempty"`
+	GrafanaConfigurations             *GrafanaConfigurations             `json:"grafanaConfigurations,omitempty"`
 	GrafanaIntegrations               *GrafanaIntegrations               `json:"grafanaIntegrations,omitempty"`
+	GrafanaMajorVersion               *string                            `json:"grafanaMajorVersion,omitempty"`
 	GrafanaVersion                    *string                            `json:"grafanaVersion,omitempty"`
 	OutboundIPs                       *[]string                          `json:"outboundIPs,omitempty"`
 	PrivateEndpointConnections        *[]PrivateEndpointConnection       `json:"privateEndpointConnections,omitempty"`
@@ -6,7 +6,9 @@ package grafanaresource
 type ManagedGrafanaPropertiesUpdateParameters struct {
 	ApiKey                  *ApiKey                  `json:"apiKey,omitempty"`
 	DeterministicOutboundIP *DeterministicOutboundIP `json:"deterministicOutboundIP,omitempty"`
+	GrafanaConfigurations   *GrafanaConfigurations   `json:"grafanaConfigurations,omitempty"`
 	GrafanaIntegrations     *GrafanaIntegrations     `json:"grafanaIntegrations,omitempty"`
+	GrafanaMajorVersion     *string                  `json:"grafanaMajorVersion,omitempty"`
 	PublicNetworkAccess     *PublicNetworkAccess     `json:"publicNetworkAccess,omitempty"`
 	ZoneRedundancy          *ZoneRedundancy          `json:"zoneRedundancy,omitempty"`
 }
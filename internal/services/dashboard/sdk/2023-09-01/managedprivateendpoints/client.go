@@ -0,0 +1,18 @@
+package managedprivateendpoints
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ManagedPrivateEndpointsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewManagedPrivateEndpointsClientWithBaseURI(endpoint string) ManagedPrivateEndpointsClient {
+	return ManagedPrivateEndpointsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,40 @@
+package managedprivateendpoints
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ManagedPrivateEndpointConnectionStatus string
+
+const (
+	ManagedPrivateEndpointConnectionStatusApproved     ManagedPrivateEndpointConnectionStatus = "Approved"
+	ManagedPrivateEndpointConnectionStatusDisconnected ManagedPrivateEndpointConnectionStatus = "Disconnected"
+	ManagedPrivateEndpointConnectionStatusPending      ManagedPrivateEndpointConnectionStatus = "Pending"
+	ManagedPrivateEndpointConnectionStatusRejected     ManagedPrivateEndpointConnectionStatus = "Rejected"
+)
+
+func PossibleValuesForManagedPrivateEndpointConnectionStatus() []string {
+	return []string{
+		string(ManagedPrivateEndpointConnectionStatusApproved),
+		string(ManagedPrivateEndpointConnectionStatusDisconnected),
+		string(ManagedPrivateEndpointConnectionStatusPending),
+		string(ManagedPrivateEndpointConnectionStatusRejected),
+	}
+}
+
+func parseManagedPrivateEndpointConnectionStatus(input string) (*ManagedPrivateEndpointConnectionStatus, error) {
+	vals := map[string]ManagedPrivateEndpointConnectionStatus{
+		"approved":     ManagedPrivateEndpointConnectionStatusApproved,
+		"disconnected": ManagedPrivateEndpointConnectionStatusDisconnected,
+		"pending":      ManagedPrivateEndpointConnectionStatusPending,
+		"rejected":     ManagedPrivateEndpointConnectionStatusRejected,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedPrivateEndpointConnectionStatus(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package managedprivateendpoints
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ManagedPrivateEndpointConnectionState struct {
+	Description *string                                 `json:"description,omitempty"`
+	Status      *ManagedPrivateEndpointConnectionStatus `json:"status,omitempty"`
+}
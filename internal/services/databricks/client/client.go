@@ -1,14 +1,34 @@
 package client
 
 import (
-	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-04-01-preview/accessconnector"
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2023-02-01/workspaces"
+	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2.1/unitycatalog"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2022-04-01-preview/accessconnector"
 )
 
 type Client struct {
 	AccessConnectorClient *accessconnector.AccessConnectorClient
 	WorkspacesClient      *workspaces.WorkspacesClient
+	authorizerFunc        common.ApiAuthorizerFunc
+	configureClientFunc   func(c *autorest.Client, authorizer autorest.Authorizer)
+}
+
+func (c Client) MetastoreAssignmentsClient(workspaceUrl string, api environments.Api) (*unitycatalog.MetastoreAssignmentsClient, error) {
+	databricksAuth, err := c.authorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", workspaceUrl, err)
+	}
+
+	metastoreAssignmentsClient := unitycatalog.NewMetastoreAssignmentsClient(fmt.Sprintf("https://%s", workspaceUrl))
+	c.configureClientFunc(&metastoreAssignmentsClient.Client, authWrapper.AutorestAuthorizer(databricksAuth))
+
+	return &metastoreAssignmentsClient, nil
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -20,5 +40,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		AccessConnectorClient: &AccessConnectorClient,
 		WorkspacesClient:      &WorkspacesClient,
+		authorizerFunc:        o.Authorizers.AuthorizerFunc,
+		configureClientFunc:   o.ConfigureClient,
 	}
 }
@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-04-01-preview/accessconnector"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2022-04-01-preview/accessconnector"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -22,11 +23,11 @@ type AccessConnectorResource struct {
 var _ sdk.ResourceWithUpdate = AccessConnectorResource{}
 
 type AccessConnectorResourceModel struct {
-	Name          string                         `tfschema:"name"`
-	ResourceGroup string                         `tfschema:"resource_group_name"`
-	Location      string                         `tfschema:"location"`
-	Tags          map[string]string              `tfschema:"tags"`
-	Identity      []identity.ModelSystemAssigned `tfschema:"identity"`
+	Name          string                                     `tfschema:"name"`
+	ResourceGroup string                                     `tfschema:"resource_group_name"`
+	Location      string                                     `tfschema:"location"`
+	Tags          map[string]string                          `tfschema:"tags"`
+	Identity      []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
 }
 
 func (r AccessConnectorResource) Arguments() map[string]*pluginsdk.Schema {
@@ -42,7 +43,7 @@ func (r AccessConnectorResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"resource_group_name": commonschema.ResourceGroupName(),
 
-		"identity": commonschema.SystemAssignedIdentityOptional(),
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 		"tags": commonschema.Tags(),
 	}
@@ -84,7 +85,7 @@ func (r AccessConnectorResource) Create() sdk.ResourceFunc {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
-			expandedIdentity, err := identity.ExpandSystemAssignedFromModel(model.Identity)
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
 			if err != nil {
 				return fmt.Errorf("expanding `identity`: %+v", err)
 			}
@@ -93,7 +94,7 @@ func (r AccessConnectorResource) Create() sdk.ResourceFunc {
 				Name:     &model.Name,
 				Location: model.Location,
 				Tags:     &model.Tags,
-				Identity: expandedIdentity,
+				Identity: pointer.To(identity.LegacySystemAndUserAssignedMap(*expandedIdentity)),
 			}
 
 			if err = client.CreateOrUpdateThenPoll(ctx, id, accessConnector); err != nil {
@@ -130,6 +131,14 @@ func (r AccessConnectorResource) Update() sdk.ResourceFunc {
 				existing.Model.Tags = &state.Tags
 			}
 
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(state.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				existing.Model.Identity = pointer.To(identity.LegacySystemAndUserAssignedMap(*expandedIdentity))
+			}
+
 			if err = client.CreateOrUpdateThenPoll(ctx, *id, *existing.Model); err != nil {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
@@ -170,7 +179,11 @@ func (r AccessConnectorResource) Read() sdk.ResourceFunc {
 					state.Tags = *model.Tags
 				}
 				if model.Identity != nil {
-					state.Identity = identity.FlattenSystemAssignedToModel(model.Identity)
+					flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To(identity.SystemAndUserAssignedMap(*model.Identity)))
+					if err != nil {
+						return fmt.Errorf("flattening `identity`: %+v", err)
+					}
+					state.Identity = *flattenedIdentity
 				}
 			}
 			return metadata.Encode(&state)
@@ -0,0 +1,236 @@
+package databricks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2023-02-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2.1/unitycatalog"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WorkspaceMetastoreAssignmentResource struct {
+}
+
+var _ sdk.ResourceWithUpdate = WorkspaceMetastoreAssignmentResource{}
+
+type WorkspaceMetastoreAssignmentResourceModel struct {
+	WorkspaceId        string `tfschema:"workspace_id"`
+	MetastoreId        string `tfschema:"metastore_id"`
+	DefaultCatalogName string `tfschema:"default_catalog_name"`
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"metastore_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"default_catalog_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) ModelObject() interface{} {
+	return &WorkspaceMetastoreAssignmentResourceModel{}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) ResourceType() string {
+	return "azurerm_databricks_workspace_metastore_assignment"
+}
+
+func (r WorkspaceMetastoreAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspaces.ValidateWorkspaceID
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model WorkspaceMetastoreAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			client, workspaceId, err := metastoreAssignmentsClientForWorkspace(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetCurrent(ctx)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing metastore assignment for %s: %+v", id, err)
+			}
+			if existing.Model != nil && existing.Model.MetastoreId != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			assignment := unitycatalog.MetastoreAssignment{
+				MetastoreId: &model.MetastoreId,
+			}
+			if model.DefaultCatalogName != "" {
+				assignment.DefaultCatalogName = &model.DefaultCatalogName
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, workspaceId, assignment); err != nil {
+				return fmt.Errorf("creating metastore assignment for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkspaceMetastoreAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, workspaceId, err := metastoreAssignmentsClientForWorkspace(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			assignment := unitycatalog.MetastoreAssignment{
+				MetastoreId: &model.MetastoreId,
+			}
+			if model.DefaultCatalogName != "" {
+				assignment.DefaultCatalogName = &model.DefaultCatalogName
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, workspaceId, assignment); err != nil {
+				return fmt.Errorf("updating metastore assignment for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, _, err := metastoreAssignmentsClientForWorkspace(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetCurrent(ctx)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving metastore assignment for %s: %+v", id, err)
+			}
+			if resp.Model == nil || resp.Model.MetastoreId == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := WorkspaceMetastoreAssignmentResourceModel{
+				WorkspaceId:        id.ID(),
+				MetastoreId:        pointer.From(resp.Model.MetastoreId),
+				DefaultCatalogName: pointer.From(resp.Model.DefaultCatalogName),
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkspaceMetastoreAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, workspaceId, err := metastoreAssignmentsClientForWorkspace(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, workspaceId, model.MetastoreId); err != nil {
+				return fmt.Errorf("deleting metastore assignment for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+// metastoreAssignmentsClientForWorkspace resolves the workspace URL and numeric workspace ID
+// needed to talk to the Unity Catalog API of the given workspace.
+func metastoreAssignmentsClientForWorkspace(ctx context.Context, metadata sdk.ResourceMetaData, id workspaces.WorkspaceId) (*unitycatalog.MetastoreAssignmentsClient, int64, error) {
+	workspace, err := metadata.Client.DataBricks.WorkspacesClient.Get(ctx, id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if workspace.Model == nil || workspace.Model.Properties.WorkspaceUrl == nil || workspace.Model.Properties.WorkspaceId == nil {
+		return nil, 0, fmt.Errorf("retrieving %s: `workspaceUrl` or `workspaceId` was nil", id)
+	}
+
+	workspaceId, err := strconv.ParseInt(*workspace.Model.Properties.WorkspaceId, 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("parsing workspace ID %q for %s: %+v", *workspace.Model.Properties.WorkspaceId, id, err)
+	}
+
+	var api environments.Api = metadata.Client.Account.Environment.DataBricks
+	client, err := metadata.Client.DataBricks.MetastoreAssignmentsClient(*workspace.Model.Properties.WorkspaceUrl, api)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return client, workspaceId, nil
+}
@@ -0,0 +1,160 @@
+package databricks_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2023-02-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DatabricksWorkspaceMetastoreAssignmentResource struct{}
+
+// a metastore cannot be created through the Azure API so an existing one must be
+// supplied for these tests to run
+func databricksMetastoreId(t *testing.T) string {
+	metastoreId := os.Getenv("ARM_TEST_DATABRICKS_METASTORE_ID")
+	if metastoreId == "" {
+		t.Skip("Skipping as `ARM_TEST_DATABRICKS_METASTORE_ID` is not specified")
+	}
+	return metastoreId
+}
+
+func TestAccDatabricksWorkspaceMetastoreAssignment_basic(t *testing.T) {
+	metastoreId := databricksMetastoreId(t)
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace_metastore_assignment", "test")
+	r := DatabricksWorkspaceMetastoreAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, metastoreId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDatabricksWorkspaceMetastoreAssignment_requiresImport(t *testing.T) {
+	metastoreId := databricksMetastoreId(t)
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace_metastore_assignment", "test")
+	r := DatabricksWorkspaceMetastoreAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, metastoreId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, metastoreId)
+		}),
+	})
+}
+
+func TestAccDatabricksWorkspaceMetastoreAssignment_complete(t *testing.T) {
+	metastoreId := databricksMetastoreId(t)
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace_metastore_assignment", "test")
+	r := DatabricksWorkspaceMetastoreAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, metastoreId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DatabricksWorkspaceMetastoreAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspaces.ParseWorkspaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := clients.DataBricks.WorkspacesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if workspace.Model == nil || workspace.Model.Properties.WorkspaceUrl == nil {
+		return nil, fmt.Errorf("retrieving %s: `workspaceUrl` was nil", id)
+	}
+
+	var api environments.Api = clients.Account.Environment.DataBricks
+	client, err := clients.DataBricks.MetastoreAssignmentsClient(*workspace.Model.Properties.WorkspaceUrl, api)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetCurrent(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving metastore assignment for %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil && resp.Model.MetastoreId != nil), nil
+}
+
+func (DatabricksWorkspaceMetastoreAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-databricks-%d"
+  location = "%s"
+}
+
+resource "azurerm_databricks_workspace" "test" {
+  name                = "acctestDBW-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "premium"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DatabricksWorkspaceMetastoreAssignmentResource) basic(data acceptance.TestData, metastoreId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace_metastore_assignment" "test" {
+  workspace_id = azurerm_databricks_workspace.test.id
+  metastore_id = "%s"
+}
+`, r.template(data), metastoreId)
+}
+
+func (r DatabricksWorkspaceMetastoreAssignmentResource) requiresImport(data acceptance.TestData, metastoreId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace_metastore_assignment" "import" {
+  workspace_id = azurerm_databricks_workspace_metastore_assignment.test.workspace_id
+  metastore_id = azurerm_databricks_workspace_metastore_assignment.test.metastore_id
+}
+`, r.basic(data, metastoreId))
+}
+
+func (r DatabricksWorkspaceMetastoreAssignmentResource) complete(data acceptance.TestData, metastoreId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace_metastore_assignment" "test" {
+  workspace_id         = azurerm_databricks_workspace.test.id
+  metastore_id         = "%s"
+  default_catalog_name = "main"
+}
+`, r.template(data), metastoreId)
+}
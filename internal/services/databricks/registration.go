@@ -53,5 +53,6 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AccessConnectorResource{},
+		WorkspaceMetastoreAssignmentResource{},
 	}
 }
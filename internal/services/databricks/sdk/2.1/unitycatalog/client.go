@@ -0,0 +1,18 @@
+package unitycatalog
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type MetastoreAssignmentsClient struct {
+	Client   autorest.Client
+	endpoint string
+}
+
+func NewMetastoreAssignmentsClient(endpoint string) MetastoreAssignmentsClient {
+	return MetastoreAssignmentsClient{
+		Client:   autorest.NewClientWithUserAgent(userAgent()),
+		endpoint: endpoint,
+	}
+}
@@ -0,0 +1,66 @@
+package unitycatalog
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdate assigns a metastore to the given workspace.
+func (c MetastoreAssignmentsClient) CreateOrUpdate(ctx context.Context, workspaceId int64, input MetastoreAssignment) (result CreateOrUpdateOperationResponse, err error) {
+	req, err := c.preparerForCreateOrUpdate(ctx, workspaceId, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.MetastoreAssignmentsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.MetastoreAssignmentsClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.MetastoreAssignmentsClient", "CreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreateOrUpdate prepares the CreateOrUpdate request.
+func (c MetastoreAssignmentsClient) preparerForCreateOrUpdate(ctx context.Context, workspaceId int64, input MetastoreAssignment) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"workspaceId": autorest.Encode("path", workspaceId),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.endpoint),
+		autorest.WithPathParameters("/api/2.1/unity-catalog/workspaces/{workspaceId}/metastore", pathParameters),
+		autorest.WithJSON(input))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreateOrUpdate handles the response to the CreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c MetastoreAssignmentsClient) responderForCreateOrUpdate(resp *http.Response) (result CreateOrUpdateOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
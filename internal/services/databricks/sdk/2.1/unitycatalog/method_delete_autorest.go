@@ -0,0 +1,70 @@
+package unitycatalog
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DeleteOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// Delete removes the metastore assignment from the given workspace.
+func (c MetastoreAssignmentsClient) Delete(ctx context.Context, workspaceId int64, metastoreId string) (result DeleteOperationResponse, err error) {
+	req, err := c.preparerForDelete(ctx, workspaceId, metastoreId)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.MetastoreAssignmentsClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.MetastoreAssignmentsClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.MetastoreAssignmentsClient", "Delete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDelete prepares the Delete request.
+func (c MetastoreAssignmentsClient) preparerForDelete(ctx context.Context, workspaceId int64, metastoreId string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"workspaceId": autorest.Encode("path", workspaceId),
+	}
+
+	queryParameters := map[string]interface{}{
+		"metastore_id": autorest.Encode("query", metastoreId),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.endpoint),
+		autorest.WithPathParameters("/api/2.1/unity-catalog/workspaces/{workspaceId}/metastore", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDelete handles the response to the Delete request. The method always
+// closes the http.Response Body.
+func (c MetastoreAssignmentsClient) responderForDelete(resp *http.Response) (result DeleteOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
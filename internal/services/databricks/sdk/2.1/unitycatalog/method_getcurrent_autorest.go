@@ -0,0 +1,63 @@
+package unitycatalog
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type GetCurrentOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *MetastoreAssignment
+}
+
+// GetCurrent retrieves the metastore assignment for the workspace served by the endpoint.
+func (c MetastoreAssignmentsClient) GetCurrent(ctx context.Context) (result GetCurrentOperationResponse, err error) {
+	req, err := c.preparerForGetCurrent(ctx)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.MetastoreAssignmentsClient", "GetCurrent", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.MetastoreAssignmentsClient", "GetCurrent", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetCurrent(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.MetastoreAssignmentsClient", "GetCurrent", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetCurrent prepares the GetCurrent request.
+func (c MetastoreAssignmentsClient) preparerForGetCurrent(ctx context.Context) (*http.Request, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.endpoint),
+		autorest.WithPath("/api/2.1/unity-catalog/current-metastore-assignment"))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetCurrent handles the response to the GetCurrent request. The method always
+// closes the http.Response Body.
+func (c MetastoreAssignmentsClient) responderForGetCurrent(resp *http.Response) (result GetCurrentOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
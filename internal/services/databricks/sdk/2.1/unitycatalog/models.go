@@ -0,0 +1,10 @@
+package unitycatalog
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type MetastoreAssignment struct {
+	MetastoreId        *string `json:"metastore_id,omitempty"`
+	DefaultCatalogName *string `json:"default_catalog_name,omitempty"`
+	WorkspaceId        *int64  `json:"workspace_id,omitempty"`
+}
@@ -0,0 +1,242 @@
+package datafactory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataFactoryGlobalParameters() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryGlobalParametersCreateUpdate,
+		Read:   resourceDataFactoryGlobalParametersRead,
+		Update: resourceDataFactoryGlobalParametersCreateUpdate,
+		Delete: resourceDataFactoryGlobalParametersDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.GlobalParametersID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"parameter": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Array",
+								"Bool",
+								"Float",
+								"Int",
+								"Object",
+								"String",
+							}, false),
+						},
+
+						"value": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"ignored_parameter_names": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryGlobalParametersCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewGlobalParametersID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, "default")
+
+	factory, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(factory.Response) {
+			return fmt.Errorf("%s was not found", dataFactoryId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", dataFactoryId, err)
+	}
+	if factory.FactoryProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", dataFactoryId)
+	}
+
+	ignoredNames := expandGlobalParameterIgnoredNames(d.Get("ignored_parameter_names").(*pluginsdk.Set).List())
+
+	if d.IsNewResource() {
+		for name := range factory.FactoryProperties.GlobalParameters {
+			if _, ok := ignoredNames[name]; !ok {
+				return tf.ImportAsExistsError("azurerm_data_factory_global_parameters", id.ID())
+			}
+		}
+	}
+
+	parameters, err := expandDataFactoryGlobalParameters(d.Get("parameter").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+	for name := range parameters {
+		if _, ok := ignoredNames[name]; ok {
+			return fmt.Errorf("the parameter %q cannot be listed in both `parameter` and `ignored_parameter_names`", name)
+		}
+	}
+
+	// parameters in the ignore list are merged back in from the service so that
+	// anything published by ADF CI/CD pipelines outside of Terraform is preserved
+	for name, existing := range factory.FactoryProperties.GlobalParameters {
+		if _, ok := ignoredNames[name]; ok {
+			parameters[name] = existing
+		}
+	}
+
+	factory.FactoryProperties.GlobalParameters = parameters
+
+	// passing the ETag from the Get ensures a publish pipeline which has modified the
+	// factory in the meantime fails this update rather than being silently overwritten
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, factory, pointer.From(factory.ETag)); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryGlobalParametersRead(d, meta)
+}
+
+func resourceDataFactoryGlobalParametersRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GlobalParametersID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("data_factory_id", parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName).ID())
+
+	ignoredNames := expandGlobalParameterIgnoredNames(d.Get("ignored_parameter_names").(*pluginsdk.Set).List())
+
+	parameters := make(map[string]*datafactory.GlobalParameterSpecification)
+	if props := resp.FactoryProperties; props != nil {
+		for name, parameter := range props.GlobalParameters {
+			if _, ok := ignoredNames[name]; !ok {
+				parameters[name] = parameter
+			}
+		}
+	}
+
+	if err := d.Set("parameter", flattenDataFactoryGlobalParameters(parameters)); err != nil {
+		return fmt.Errorf("setting `parameter`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceDataFactoryGlobalParametersDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GlobalParametersID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	factory, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(factory.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if factory.FactoryProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	ignoredNames := expandGlobalParameterIgnoredNames(d.Get("ignored_parameter_names").(*pluginsdk.Set).List())
+
+	// only remove the parameters managed by this resource - anything in the ignore
+	// list remains on the factory
+	remaining := make(map[string]*datafactory.GlobalParameterSpecification)
+	for name, parameter := range factory.FactoryProperties.GlobalParameters {
+		if _, ok := ignoredNames[name]; ok {
+			remaining[name] = parameter
+		}
+	}
+
+	factory.FactoryProperties.GlobalParameters = remaining
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, factory, pointer.From(factory.ETag)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandGlobalParameterIgnoredNames(input []interface{}) map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, v := range input {
+		result[v.(string)] = struct{}{}
+	}
+	return result
+}
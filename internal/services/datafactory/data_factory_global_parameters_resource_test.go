@@ -0,0 +1,194 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type GlobalParametersResource struct{}
+
+func TestAccDataFactoryGlobalParameters_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_global_parameters", "test")
+	r := GlobalParametersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryGlobalParameters_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_global_parameters", "test")
+	r := GlobalParametersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryGlobalParameters_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_global_parameters", "test")
+	r := GlobalParametersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryGlobalParameters_ignoredParameterNames(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_global_parameters", "test")
+	r := GlobalParametersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ignoredParameterNames(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("ignored_parameter_names"),
+	})
+}
+
+func (r GlobalParametersResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.GlobalParametersID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DataFactory.FactoriesClient.Get(ctx, id.ResourceGroup, id.FactoryName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	exists := resp.FactoryProperties != nil && len(resp.FactoryProperties.GlobalParameters) > 0
+
+	return utils.Bool(exists), nil
+}
+
+func (r GlobalParametersResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r GlobalParametersResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_global_parameters" "test" {
+  data_factory_id = azurerm_data_factory.test.id
+
+  parameter {
+    name  = "environment"
+    type  = "String"
+    value = "test"
+  }
+
+  parameter {
+    name  = "maxRetries"
+    type  = "Int"
+    value = "3"
+  }
+}
+`, r.template(data))
+}
+
+func (r GlobalParametersResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_global_parameters" "import" {
+  data_factory_id = azurerm_data_factory_global_parameters.test.data_factory_id
+
+  parameter {
+    name  = "environment"
+    type  = "String"
+    value = "test"
+  }
+}
+`, r.basic(data))
+}
+
+func (r GlobalParametersResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_global_parameters" "test" {
+  data_factory_id = azurerm_data_factory.test.id
+
+  parameter {
+    name  = "environment"
+    type  = "String"
+    value = "production"
+  }
+}
+`, r.template(data))
+}
+
+func (r GlobalParametersResource) ignoredParameterNames(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_global_parameters" "test" {
+  data_factory_id = azurerm_data_factory.test.id
+
+  parameter {
+    name  = "environment"
+    type  = "String"
+    value = "test"
+  }
+
+  ignored_parameter_names = ["deployedByCICD"]
+}
+`, r.template(data))
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type GlobalParametersId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	FactoryName         string
+	GlobalParameterName string
+}
+
+func NewGlobalParametersID(subscriptionId, resourceGroup, factoryName, globalParameterName string) GlobalParametersId {
+	return GlobalParametersId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		FactoryName:         factoryName,
+		GlobalParameterName: globalParameterName,
+	}
+}
+
+func (id GlobalParametersId) String() string {
+	segments := []string{
+		fmt.Sprintf("Global Parameter Name %q", id.GlobalParameterName),
+		fmt.Sprintf("Factory Name %q", id.FactoryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Global Parameters", segmentsStr)
+}
+
+func (id GlobalParametersId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/globalParameters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.GlobalParameterName)
+}
+
+// GlobalParametersID parses a GlobalParameters ID into an GlobalParametersId struct
+func GlobalParametersID(input string) (*GlobalParametersId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := GlobalParametersId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+		return nil, err
+	}
+	if resourceId.GlobalParameterName, err = id.PopSegment("globalParameters"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,145 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2022-04-01/backupinstances"
+)
+
+// Protecting an AKS cluster requires the `backupDatasourceParametersList` policy parameter,
+// which only exists in newer API versions than the one exposed by the generated SDK - so the
+// Get and CreateOrUpdate methods are re-implemented here against that newer API version using
+// models which include it.
+
+const backupInstancesApiVersion = "2023-05-01"
+
+type BackupInstancesWorkaroundClient struct {
+	sdkClient *backupinstances.BackupInstancesClient
+	baseUri   string
+}
+
+func NewBackupInstancesWorkaroundClient(client *backupinstances.BackupInstancesClient, endpoint string) BackupInstancesWorkaroundClient {
+	return BackupInstancesWorkaroundClient{
+		sdkClient: client,
+		baseUri:   endpoint,
+	}
+}
+
+type BackupInstanceResource struct {
+	Id         *string         `json:"id,omitempty"`
+	Name       *string         `json:"name,omitempty"`
+	Properties *BackupInstance `json:"properties,omitempty"`
+	Type       *string         `json:"type,omitempty"`
+}
+
+type BackupInstance struct {
+	CurrentProtectionState *backupinstances.CurrentProtectionState `json:"currentProtectionState,omitempty"`
+	DataSourceInfo         backupinstances.Datasource              `json:"dataSourceInfo"`
+	DataSourceSetInfo      *backupinstances.DatasourceSet          `json:"dataSourceSetInfo,omitempty"`
+	FriendlyName           *string                                 `json:"friendlyName,omitempty"`
+	ObjectType             string                                  `json:"objectType"`
+	PolicyInfo             PolicyInfo                              `json:"policyInfo"`
+	ProvisioningState      *string                                 `json:"provisioningState,omitempty"`
+}
+
+type PolicyInfo struct {
+	PolicyId         string            `json:"policyId"`
+	PolicyParameters *PolicyParameters `json:"policyParameters,omitempty"`
+	PolicyVersion    *string           `json:"policyVersion,omitempty"`
+}
+
+type PolicyParameters struct {
+	BackupDatasourceParametersList *[]BackupDatasourceParameters `json:"backupDatasourceParametersList,omitempty"`
+	DataStoreParametersList        *[]DataStoreParameters        `json:"dataStoreParametersList,omitempty"`
+}
+
+// DataStoreParameters is a discriminated union in the API - the only supported implementation
+// is `AzureOperationalStoreParameters` so it's modelled as a flat struct here.
+type DataStoreParameters struct {
+	ObjectType      string                         `json:"objectType"`
+	DataStoreType   backupinstances.DataStoreTypes `json:"dataStoreType"`
+	ResourceGroupId *string                        `json:"resourceGroupId,omitempty"`
+}
+
+// BackupDatasourceParameters is a discriminated union in the API - for AKS clusters the
+// implementation is `KubernetesClusterBackupDatasourceParameters` which is modelled as a
+// flat struct here.
+type BackupDatasourceParameters struct {
+	ObjectType                   string    `json:"objectType"`
+	ExcludedNamespaces           *[]string `json:"excludedNamespaces,omitempty"`
+	ExcludedResourceTypes        *[]string `json:"excludedResourceTypes,omitempty"`
+	IncludeClusterScopeResources bool      `json:"includeClusterScopeResources"`
+	IncludedNamespaces           *[]string `json:"includedNamespaces,omitempty"`
+	IncludedResourceTypes        *[]string `json:"includedResourceTypes,omitempty"`
+	LabelSelectors               *[]string `json:"labelSelectors,omitempty"`
+	SnapshotVolumes              bool      `json:"snapshotVolumes"`
+}
+
+type GetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *BackupInstanceResource
+}
+
+func (c BackupInstancesWorkaroundClient) Get(ctx context.Context, id backupinstances.BackupInstanceId) (result GetOperationResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.BackupInstancesWorkaroundClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.sdkClient.Client.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.BackupInstancesWorkaroundClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.BackupInstancesWorkaroundClient", "Get", result.HttpResponse, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c BackupInstancesWorkaroundClient) CreateOrUpdateThenPoll(ctx context.Context, id backupinstances.BackupInstanceId, input BackupInstanceResource) error {
+	req, err := c.preparer(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuresdkhacks.BackupInstancesWorkaroundClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.sdkClient.Client.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuresdkhacks.BackupInstancesWorkaroundClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.sdkClient.Client, "PUT")
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuresdkhacks.BackupInstancesWorkaroundClient", "CreateOrUpdate", resp, "Failure creating poller")
+	}
+
+	return poller.PollUntilDone()
+}
+
+func (c BackupInstancesWorkaroundClient) preparer(ctx context.Context, id backupinstances.BackupInstanceId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": backupInstancesApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
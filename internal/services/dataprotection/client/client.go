@@ -6,13 +6,15 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2022-04-01/backupvaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2022-04-01/resourceguards"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/azuresdkhacks"
 )
 
 type Client struct {
-	BackupVaultClient    *backupvaults.BackupVaultsClient
-	BackupPolicyClient   *backuppolicies.BackupPoliciesClient
-	BackupInstanceClient *backupinstances.BackupInstancesClient
-	ResourceGuardClient  *resourceguards.ResourceGuardsClient
+	BackupVaultClient        *backupvaults.BackupVaultsClient
+	BackupPolicyClient       *backuppolicies.BackupPoliciesClient
+	BackupInstanceClient     *backupinstances.BackupInstancesClient
+	BackupInstanceHackClient *azuresdkhacks.BackupInstancesWorkaroundClient
+	ResourceGuardClient      *resourceguards.ResourceGuardsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -25,13 +27,16 @@ func NewClient(o *common.ClientOptions) *Client {
 	backupInstanceClient := backupinstances.NewBackupInstancesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&backupInstanceClient.Client, o.ResourceManagerAuthorizer)
 
+	backupInstanceHackClient := azuresdkhacks.NewBackupInstancesWorkaroundClient(&backupInstanceClient, o.ResourceManagerEndpoint)
+
 	resourceGuardClient := resourceguards.NewResourceGuardsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&resourceGuardClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		BackupVaultClient:    &backupVaultClient,
-		BackupPolicyClient:   &backupPolicyClient,
-		BackupInstanceClient: &backupInstanceClient,
-		ResourceGuardClient:  &resourceGuardClient,
+		BackupVaultClient:        &backupVaultClient,
+		BackupPolicyClient:       &backupPolicyClient,
+		BackupInstanceClient:     &backupInstanceClient,
+		BackupInstanceHackClient: &backupInstanceHackClient,
+		ResourceGuardClient:      &resourceGuardClient,
 	}
 }
@@ -0,0 +1,349 @@
+package dataprotection
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/managedclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2022-04-01/backupinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2022-04-01/backuppolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/azuresdkhacks"
+	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataProtectionBackupInstanceKubernetesCluster() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataProtectionBackupInstanceKubernetesClusterCreate,
+		Read:   resourceDataProtectionBackupInstanceKubernetesClusterRead,
+		Delete: resourceDataProtectionBackupInstanceKubernetesClusterDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := backupinstances.ParseBackupInstanceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": commonschema.Location(),
+
+			"vault_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: backupinstances.ValidateBackupVaultID,
+			},
+
+			"kubernetes_cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: managedclusters.ValidateManagedClusterID,
+			},
+
+			"snapshot_resource_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourcegroups.ValidateName,
+			},
+
+			"backup_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: backuppolicies.ValidateBackupPolicyID,
+			},
+
+			"backup_datasource_parameters": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cluster_scoped_resources_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+							ForceNew: true,
+						},
+
+						"excluded_namespaces": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"excluded_resource_types": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"included_namespaces": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"included_resource_types": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"label_selectors": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"volume_snapshot_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataProtectionBackupInstanceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).DataProtection.BackupInstanceClient
+	workaroundClient := meta.(*clients.Client).DataProtection.BackupInstanceHackClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	vaultId, _ := backupinstances.ParseBackupVaultID(d.Get("vault_id").(string))
+	id := backupinstances.NewBackupInstanceID(subscriptionId, vaultId.ResourceGroupName, vaultId.BackupVaultName, name)
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for existing DataProtection BackupInstance (%q): %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_data_protection_backup_instance_kubernetes_cluster", id.ID())
+	}
+
+	clusterId, _ := managedclusters.ParseManagedClusterID(d.Get("kubernetes_cluster_id").(string))
+	snapshotResourceGroupId := resourceParse.NewResourceGroupID(subscriptionId, d.Get("snapshot_resource_group_name").(string))
+	location := location.Normalize(d.Get("location").(string))
+	policyId, _ := backuppolicies.ParseBackupPolicyID(d.Get("backup_policy_id").(string))
+
+	parameters := azuresdkhacks.BackupInstanceResource{
+		Properties: &azuresdkhacks.BackupInstance{
+			DataSourceInfo: backupinstances.Datasource{
+				DatasourceType:   utils.String("Microsoft.ContainerService/managedClusters"),
+				ObjectType:       utils.String("Datasource"),
+				ResourceID:       clusterId.ID(),
+				ResourceLocation: utils.String(location),
+				ResourceName:     utils.String(clusterId.ManagedClusterName),
+				ResourceType:     utils.String("Microsoft.ContainerService/managedClusters"),
+				ResourceUri:      utils.String(clusterId.ID()),
+			},
+			DataSourceSetInfo: &backupinstances.DatasourceSet{
+				DatasourceType:   utils.String("Microsoft.ContainerService/managedClusters"),
+				ObjectType:       utils.String("DatasourceSet"),
+				ResourceID:       clusterId.ID(),
+				ResourceLocation: utils.String(location),
+				ResourceName:     utils.String(clusterId.ManagedClusterName),
+				ResourceType:     utils.String("Microsoft.ContainerService/managedClusters"),
+				ResourceUri:      utils.String(clusterId.ID()),
+			},
+			FriendlyName: utils.String(id.BackupInstanceName),
+			ObjectType:   "BackupInstance",
+			PolicyInfo: azuresdkhacks.PolicyInfo{
+				PolicyId: policyId.ID(),
+				PolicyParameters: &azuresdkhacks.PolicyParameters{
+					BackupDatasourceParametersList: expandBackupInstanceKubernetesClusterBackupDatasourceParameters(d.Get("backup_datasource_parameters").([]interface{})),
+					DataStoreParametersList: &[]azuresdkhacks.DataStoreParameters{
+						{
+							ObjectType:      "AzureOperationalStoreParameters",
+							DataStoreType:   backupinstances.DataStoreTypesOperationalStore,
+							ResourceGroupId: utils.String(snapshotResourceGroupId.ID()),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := workaroundClient.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating DataProtection BackupInstance (%q): %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(backupinstances.StatusConfiguringProtection), "UpdatingProtection"},
+		Target:     []string{string(backupinstances.StatusProtectionConfigured)},
+		Refresh:    policyProtectionStateRefreshFunc(ctx, client, id),
+		MinTimeout: 1 * time.Minute,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for BackupInstance(%q) policy protection to be completed: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDataProtectionBackupInstanceKubernetesClusterRead(d, meta)
+}
+
+func resourceDataProtectionBackupInstanceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	workaroundClient := meta.(*clients.Client).DataProtection.BackupInstanceHackClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := backupinstances.ParseBackupInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := workaroundClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] dataprotection %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving DataProtection BackupInstance (%q): %+v", id, err)
+	}
+	vaultId := backupinstances.NewBackupVaultID(id.SubscriptionId, id.ResourceGroupName, id.BackupVaultName)
+	d.Set("name", id.BackupInstanceName)
+	d.Set("vault_id", vaultId.ID())
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("kubernetes_cluster_id", props.DataSourceInfo.ResourceID)
+			d.Set("location", location.NormalizeNilable(props.DataSourceInfo.ResourceLocation))
+			d.Set("backup_policy_id", props.PolicyInfo.PolicyId)
+
+			snapshotResourceGroupName := ""
+			if policyParameters := props.PolicyInfo.PolicyParameters; policyParameters != nil {
+				if policyParameters.DataStoreParametersList != nil {
+					for _, parameter := range *policyParameters.DataStoreParametersList {
+						if parameter.ResourceGroupId != nil {
+							if resourceGroupId, err := resourceParse.ResourceGroupID(*parameter.ResourceGroupId); err == nil {
+								snapshotResourceGroupName = resourceGroupId.ResourceGroup
+							}
+						}
+					}
+				}
+
+				if err := d.Set("backup_datasource_parameters", flattenBackupInstanceKubernetesClusterBackupDatasourceParameters(policyParameters.BackupDatasourceParametersList)); err != nil {
+					return fmt.Errorf("setting `backup_datasource_parameters`: %+v", err)
+				}
+			}
+			d.Set("snapshot_resource_group_name", snapshotResourceGroupName)
+		}
+	}
+	return nil
+}
+
+func resourceDataProtectionBackupInstanceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataProtection.BackupInstanceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := backupinstances.ParseBackupInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandBackupInstanceKubernetesClusterBackupDatasourceParameters(input []interface{}) *[]azuresdkhacks.BackupDatasourceParameters {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &[]azuresdkhacks.BackupDatasourceParameters{
+		{
+			ObjectType:                   "KubernetesClusterBackupDatasourceParameters",
+			ExcludedNamespaces:           utils.ExpandStringSlice(v["excluded_namespaces"].([]interface{})),
+			ExcludedResourceTypes:        utils.ExpandStringSlice(v["excluded_resource_types"].([]interface{})),
+			IncludeClusterScopeResources: v["cluster_scoped_resources_enabled"].(bool),
+			IncludedNamespaces:           utils.ExpandStringSlice(v["included_namespaces"].([]interface{})),
+			IncludedResourceTypes:        utils.ExpandStringSlice(v["included_resource_types"].([]interface{})),
+			LabelSelectors:               utils.ExpandStringSlice(v["label_selectors"].([]interface{})),
+			SnapshotVolumes:              v["volume_snapshot_enabled"].(bool),
+		},
+	}
+}
+
+func flattenBackupInstanceKubernetesClusterBackupDatasourceParameters(input *[]azuresdkhacks.BackupDatasourceParameters) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ObjectType != "KubernetesClusterBackupDatasourceParameters" {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"cluster_scoped_resources_enabled": item.IncludeClusterScopeResources,
+			"excluded_namespaces":              utils.FlattenStringSlice(item.ExcludedNamespaces),
+			"excluded_resource_types":          utils.FlattenStringSlice(item.ExcludedResourceTypes),
+			"included_namespaces":              utils.FlattenStringSlice(item.IncludedNamespaces),
+			"included_resource_types":          utils.FlattenStringSlice(item.IncludedResourceTypes),
+			"label_selectors":                  utils.FlattenStringSlice(item.LabelSelectors),
+			"volume_snapshot_enabled":          item.SnapshotVolumes,
+		})
+	}
+	return results
+}
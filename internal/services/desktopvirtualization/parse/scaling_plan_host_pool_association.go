@@ -0,0 +1,60 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/hostpool"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/sdk/2022-02-10-preview/scalingplan"
+)
+
+var _ resourceids.Id = ScalingPlanHostPoolAssociationId{}
+
+type ScalingPlanHostPoolAssociationId struct {
+	ScalingPlan scalingplan.ScalingPlanId
+	HostPool    hostpool.HostPoolId
+}
+
+func (id ScalingPlanHostPoolAssociationId) String() string {
+	components := []string{
+		fmt.Sprintf("Scaling Plan %s", id.ScalingPlan.String()),
+		fmt.Sprintf("Host Pool %s", id.HostPool.String()),
+	}
+	return fmt.Sprintf("Scaling Plan Host Pool Association %s", strings.Join(components, " / "))
+}
+
+func (id ScalingPlanHostPoolAssociationId) ID() string {
+	scalingPlanId := id.ScalingPlan.ID()
+	hostPoolId := id.HostPool.ID()
+	return fmt.Sprintf("%s|%s", scalingPlanId, hostPoolId)
+}
+
+func NewScalingPlanHostPoolAssociationId(scalingPlan scalingplan.ScalingPlanId, hostPool hostpool.HostPoolId) ScalingPlanHostPoolAssociationId {
+	return ScalingPlanHostPoolAssociationId{
+		ScalingPlan: scalingPlan,
+		HostPool:    hostPool,
+	}
+}
+
+func ScalingPlanHostPoolAssociationID(input string) (*ScalingPlanHostPoolAssociationId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format {scalingPlanID}|{hostPoolID} but got %q", input)
+	}
+
+	scalingPlanId, err := scalingplan.ParseScalingPlanID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Scaling Plan ID for Scaling Plan/Host Pool Association %q: %+v", segments[0], err)
+	}
+
+	hostPoolId, err := hostpool.ParseHostPoolID(segments[1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Host Pool ID for Scaling Plan/Host Pool Association %q: %+v", segments[1], err)
+	}
+
+	return &ScalingPlanHostPoolAssociationId{
+		ScalingPlan: *scalingPlanId,
+		HostPool:    *hostPoolId,
+	}, nil
+}
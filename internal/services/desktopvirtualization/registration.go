@@ -37,9 +37,11 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_desktop_workspace":                               resourceArmDesktopVirtualizationWorkspace(),
 		"azurerm_virtual_desktop_host_pool":                               resourceVirtualDesktopHostPool(),
 		"azurerm_virtual_desktop_scaling_plan":                            resourceVirtualDesktopScalingPlan(),
+		"azurerm_virtual_desktop_scaling_plan_host_pool_association":      resourceVirtualDesktopScalingPlanHostPoolAssociation(),
 		"azurerm_virtual_desktop_application_group":                       resourceVirtualDesktopApplicationGroup(),
 		"azurerm_virtual_desktop_application":                             resourceVirtualDesktopApplication(),
 		"azurerm_virtual_desktop_workspace_application_group_association": resourceVirtualDesktopWorkspaceApplicationGroupAssociation(),
 		"azurerm_virtual_desktop_host_pool_registration_info":             resourceVirtualDesktopHostPoolRegistrationInfo(),
+		"azurerm_virtual_desktop_session_host":                            resourceVirtualDesktopSessionHost(),
 	}
 }
@@ -51,18 +51,21 @@ func parseDaysOfWeek(input string) (*DaysOfWeek, error) {
 type ScalingHostPoolType string
 
 const (
-	ScalingHostPoolTypePooled ScalingHostPoolType = "Pooled"
+	ScalingHostPoolTypePersonal ScalingHostPoolType = "Personal"
+	ScalingHostPoolTypePooled   ScalingHostPoolType = "Pooled"
 )
 
 func PossibleValuesForScalingHostPoolType() []string {
 	return []string{
+		string(ScalingHostPoolTypePersonal),
 		string(ScalingHostPoolTypePooled),
 	}
 }
 
 func parseScalingHostPoolType(input string) (*ScalingHostPoolType, error) {
 	vals := map[string]ScalingHostPoolType{
-		"pooled": ScalingHostPoolTypePooled,
+		"personal": ScalingHostPoolTypePersonal,
+		"pooled":   ScalingHostPoolTypePooled,
 	}
 	if v, ok := vals[strings.ToLower(input)]; ok {
 		return &v, nil
@@ -73,6 +76,37 @@ func parseScalingHostPoolType(input string) (*ScalingHostPoolType, error) {
 	return &out, nil
 }
 
+type SessionHandlingOperation string
+
+const (
+	SessionHandlingOperationDeallocate SessionHandlingOperation = "Deallocate"
+	SessionHandlingOperationHibernate  SessionHandlingOperation = "Hibernate"
+	SessionHandlingOperationNone       SessionHandlingOperation = "None"
+)
+
+func PossibleValuesForSessionHandlingOperation() []string {
+	return []string{
+		string(SessionHandlingOperationDeallocate),
+		string(SessionHandlingOperationHibernate),
+		string(SessionHandlingOperationNone),
+	}
+}
+
+func parseSessionHandlingOperation(input string) (*SessionHandlingOperation, error) {
+	vals := map[string]SessionHandlingOperation{
+		"deallocate": SessionHandlingOperationDeallocate,
+		"hibernate":  SessionHandlingOperationHibernate,
+		"none":       SessionHandlingOperationNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SessionHandlingOperation(input)
+	return &out, nil
+}
+
 type SessionHostLoadBalancingAlgorithm string
 
 const (
@@ -101,6 +135,34 @@ func parseSessionHostLoadBalancingAlgorithm(input string) (*SessionHostLoadBalan
 	return &out, nil
 }
 
+type SetStartVMOnConnect string
+
+const (
+	SetStartVMOnConnectDisable SetStartVMOnConnect = "Disable"
+	SetStartVMOnConnectEnable  SetStartVMOnConnect = "Enable"
+)
+
+func PossibleValuesForSetStartVMOnConnect() []string {
+	return []string{
+		string(SetStartVMOnConnectDisable),
+		string(SetStartVMOnConnectEnable),
+	}
+}
+
+func parseSetStartVMOnConnect(input string) (*SetStartVMOnConnect, error) {
+	vals := map[string]SetStartVMOnConnect{
+		"disable": SetStartVMOnConnectDisable,
+		"enable":  SetStartVMOnConnectEnable,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SetStartVMOnConnect(input)
+	return &out, nil
+}
+
 type SkuTier string
 
 const (
@@ -135,6 +197,37 @@ func parseSkuTier(input string) (*SkuTier, error) {
 	return &out, nil
 }
 
+type StartupBehavior string
+
+const (
+	StartupBehaviorAll              StartupBehavior = "All"
+	StartupBehaviorNone             StartupBehavior = "None"
+	StartupBehaviorWithAssignedUser StartupBehavior = "WithAssignedUser"
+)
+
+func PossibleValuesForStartupBehavior() []string {
+	return []string{
+		string(StartupBehaviorAll),
+		string(StartupBehaviorNone),
+		string(StartupBehaviorWithAssignedUser),
+	}
+}
+
+func parseStartupBehavior(input string) (*StartupBehavior, error) {
+	vals := map[string]StartupBehavior{
+		"all":              StartupBehaviorAll,
+		"none":             StartupBehaviorNone,
+		"withassigneduser": StartupBehaviorWithAssignedUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StartupBehavior(input)
+	return &out, nil
+}
+
 type StopHostsWhen string
 
 const (
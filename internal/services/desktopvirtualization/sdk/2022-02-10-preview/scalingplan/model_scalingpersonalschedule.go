@@ -0,0 +1,21 @@
+package scalingplan
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ScalingPersonalSchedule struct {
+	DaysOfWeek                        *[]DaysOfWeek             `json:"daysOfWeek,omitempty"`
+	Name                              *string                   `json:"name,omitempty"`
+	OffPeakActionOnDisconnect         *SessionHandlingOperation `json:"offPeakActionOnDisconnect,omitempty"`
+	OffPeakMinutesToWaitOnDisconnect  *int64                    `json:"offPeakMinutesToWaitOnDisconnect,omitempty"`
+	OffPeakStartTime                  *Time                     `json:"offPeakStartTime,omitempty"`
+	PeakActionOnDisconnect            *SessionHandlingOperation `json:"peakActionOnDisconnect,omitempty"`
+	PeakMinutesToWaitOnDisconnect     *int64                    `json:"peakMinutesToWaitOnDisconnect,omitempty"`
+	PeakStartTime                     *Time                     `json:"peakStartTime,omitempty"`
+	RampDownActionOnDisconnect        *SessionHandlingOperation `json:"rampDownActionOnDisconnect,omitempty"`
+	RampDownMinutesToWaitOnDisconnect *int64                    `json:"rampDownMinutesToWaitOnDisconnect,omitempty"`
+	RampDownStartTime                 *Time                     `json:"rampDownStartTime,omitempty"`
+	RampUpAutoStartHosts              *StartupBehavior          `json:"rampUpAutoStartHosts,omitempty"`
+	RampUpStartTime                   *Time                     `json:"rampUpStartTime,omitempty"`
+	RampUpStartVMOnConnect            *SetStartVMOnConnect      `json:"rampUpStartVMOnConnect,omitempty"`
+}
@@ -8,6 +8,7 @@ type ScalingPlanPatchProperties struct {
 	ExclusionTag       *string                     `json:"exclusionTag,omitempty"`
 	FriendlyName       *string                     `json:"friendlyName,omitempty"`
 	HostPoolReferences *[]ScalingHostPoolReference `json:"hostPoolReferences,omitempty"`
+	PersonalSchedules  *[]ScalingPersonalSchedule  `json:"personalSchedules,omitempty"`
 	Schedules          *[]ScalingSchedule          `json:"schedules,omitempty"`
 	TimeZone           *string                     `json:"timeZone,omitempty"`
 }
@@ -10,6 +10,7 @@ type ScalingPlanProperties struct {
 	HostPoolReferences *[]ScalingHostPoolReference `json:"hostPoolReferences,omitempty"`
 	HostPoolType       *ScalingHostPoolType        `json:"hostPoolType,omitempty"`
 	ObjectId           *string                     `json:"objectId,omitempty"`
+	PersonalSchedules  *[]ScalingPersonalSchedule  `json:"personalSchedules,omitempty"`
 	Schedules          *[]ScalingSchedule          `json:"schedules,omitempty"`
 	TimeZone           *string                     `json:"timeZone,omitempty"`
 }
@@ -142,6 +142,15 @@ func resourceVirtualDesktopHostPoolRegistrationInfoRead(d *pluginsdk.ResourceDat
 		return nil
 	}
 
+	// once the token has expired it can no longer be used, so treat it as gone - this allows a
+	// fresh token to be issued on the next apply when the expiration date is derived from the
+	// current timestamp, rather than leaving an expired token in state indefinitely
+	if expirationTime, err := time.Parse(time.RFC3339, *resp.Model.ExpirationTime); err == nil && expirationTime.Before(time.Now()) {
+		log.Printf("[DEBUG] Registration Token for %s expired at %s - removing from state so it can be rotated!", hostPoolId, *resp.Model.ExpirationTime)
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("hostpool_id", hostPoolId.ID())
 	d.Set("expiration_date", resp.Model.ExpirationTime)
 	d.Set("token", resp.Model.Token)
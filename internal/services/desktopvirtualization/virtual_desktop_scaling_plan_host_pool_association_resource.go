@@ -0,0 +1,289 @@
+package desktopvirtualization
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/hostpool"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/sdk/2022-02-10-preview/scalingplan"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualDesktopScalingPlanHostPoolAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualDesktopScalingPlanHostPoolAssociationCreate,
+		Read:   resourceVirtualDesktopScalingPlanHostPoolAssociationRead,
+		Update: resourceVirtualDesktopScalingPlanHostPoolAssociationUpdate,
+		Delete: resourceVirtualDesktopScalingPlanHostPoolAssociationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ScalingPlanHostPoolAssociationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"scaling_plan_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: scalingplan.ValidateScalingPlanID,
+			},
+
+			"host_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: hostpool.ValidateHostPoolID,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceVirtualDesktopScalingPlanHostPoolAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DesktopVirtualization.ScalingPlansClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Virtual Desktop Scaling Plan <-> Host Pool Association creation.")
+	scalingPlanId, err := scalingplan.ParseScalingPlanID(d.Get("scaling_plan_id").(string))
+	if err != nil {
+		return err
+	}
+	hostPoolId, err := hostpool.ParseHostPoolID(d.Get("host_pool_id").(string))
+	if err != nil {
+		return err
+	}
+	associationId := parse.NewScalingPlanHostPoolAssociationId(*scalingPlanId, *hostPoolId).ID()
+
+	locks.ByName(scalingPlanId.ScalingPlanName, scalingPlanResourceType)
+	defer locks.UnlockByName(scalingPlanId.ScalingPlanName, scalingPlanResourceType)
+
+	locks.ByName(hostPoolId.HostPoolName, hostPoolResourceType)
+	defer locks.UnlockByName(hostPoolId.HostPoolName, hostPoolResourceType)
+
+	existing, err := client.Get(ctx, *scalingPlanId)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("%s was not found", *scalingPlanId)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *scalingPlanId, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", *scalingPlanId)
+	}
+	model := *existing.Model
+
+	hostPoolReferences := []scalingplan.ScalingHostPoolReference{}
+	if props := model.Properties; props != nil && props.HostPoolReferences != nil {
+		hostPoolReferences = *props.HostPoolReferences
+	}
+
+	hostPoolIdStr := hostPoolId.ID()
+	if hostPoolReferenceExists(model.Properties, hostPoolIdStr) {
+		return tf.ImportAsExistsError("azurerm_virtual_desktop_scaling_plan_host_pool_association", associationId)
+	}
+	hostPoolReferences = append(hostPoolReferences, scalingplan.ScalingHostPoolReference{
+		HostPoolArmPath:    utils.String(hostPoolIdStr),
+		ScalingPlanEnabled: utils.Bool(d.Get("enabled").(bool)),
+	})
+
+	payload := scalingplan.ScalingPlanPatch{
+		Properties: &scalingplan.ScalingPlanPatchProperties{
+			HostPoolReferences: &hostPoolReferences,
+		},
+	}
+	if _, err = client.Update(ctx, *scalingPlanId, payload); err != nil {
+		return fmt.Errorf("creating association between %s and %s: %+v", *scalingPlanId, *hostPoolId, err)
+	}
+
+	d.SetId(associationId)
+	return resourceVirtualDesktopScalingPlanHostPoolAssociationRead(d, meta)
+}
+
+func resourceVirtualDesktopScalingPlanHostPoolAssociationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DesktopVirtualization.ScalingPlansClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ScalingPlanHostPoolAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.ScalingPlan.ScalingPlanName, scalingPlanResourceType)
+	defer locks.UnlockByName(id.ScalingPlan.ScalingPlanName, scalingPlanResourceType)
+
+	locks.ByName(id.HostPool.HostPoolName, hostPoolResourceType)
+	defer locks.UnlockByName(id.HostPool.HostPoolName, hostPoolResourceType)
+
+	existing, err := client.Get(ctx, id.ScalingPlan)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("%s was not found", id.ScalingPlan)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id.ScalingPlan, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", id.ScalingPlan)
+	}
+	model := *existing.Model
+
+	hostPoolReferences := []scalingplan.ScalingHostPoolReference{}
+	hostPoolIdStr := id.HostPool.ID()
+	if props := model.Properties; props != nil && props.HostPoolReferences != nil {
+		hostPoolReferences = *props.HostPoolReferences
+	}
+	for i, reference := range hostPoolReferences {
+		if reference.HostPoolArmPath != nil && strings.EqualFold(*reference.HostPoolArmPath, hostPoolIdStr) {
+			hostPoolReferences[i].ScalingPlanEnabled = utils.Bool(d.Get("enabled").(bool))
+		}
+	}
+
+	payload := scalingplan.ScalingPlanPatch{
+		Properties: &scalingplan.ScalingPlanPatchProperties{
+			HostPoolReferences: &hostPoolReferences,
+		},
+	}
+	if _, err = client.Update(ctx, id.ScalingPlan, payload); err != nil {
+		return fmt.Errorf("updating association between %s and %s: %+v", id.ScalingPlan, id.HostPool, err)
+	}
+
+	return resourceVirtualDesktopScalingPlanHostPoolAssociationRead(d, meta)
+}
+
+func resourceVirtualDesktopScalingPlanHostPoolAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DesktopVirtualization.ScalingPlansClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ScalingPlanHostPoolAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ScalingPlan)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id.ScalingPlan)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id.ScalingPlan, err)
+	}
+	if model := resp.Model; model != nil {
+		hostPoolIdStr := id.HostPool.ID()
+		if !hostPoolReferenceExists(model.Properties, hostPoolIdStr) {
+			log.Printf("[DEBUG] Association between %s and %s was not found - removing from state!", id.ScalingPlan, id.HostPool)
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("scaling_plan_id", id.ScalingPlan.ID())
+		d.Set("host_pool_id", hostPoolIdStr)
+
+		if props := model.Properties; props != nil && props.HostPoolReferences != nil {
+			for _, reference := range *props.HostPoolReferences {
+				if reference.HostPoolArmPath != nil && strings.EqualFold(*reference.HostPoolArmPath, hostPoolIdStr) {
+					enabled := false
+					if reference.ScalingPlanEnabled != nil {
+						enabled = *reference.ScalingPlanEnabled
+					}
+					d.Set("enabled", enabled)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualDesktopScalingPlanHostPoolAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DesktopVirtualization.ScalingPlansClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ScalingPlanHostPoolAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.ScalingPlan.ScalingPlanName, scalingPlanResourceType)
+	defer locks.UnlockByName(id.ScalingPlan.ScalingPlanName, scalingPlanResourceType)
+
+	locks.ByName(id.HostPool.HostPoolName, hostPoolResourceType)
+	defer locks.UnlockByName(id.HostPool.HostPoolName, hostPoolResourceType)
+
+	existing, err := client.Get(ctx, id.ScalingPlan)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("%s was not found", id.ScalingPlan)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id.ScalingPlan, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", id.ScalingPlan)
+	}
+	model := *existing.Model
+
+	hostPoolReferences := []scalingplan.ScalingHostPoolReference{}
+	hostPoolIdStr := id.HostPool.ID()
+	if props := model.Properties; props != nil && props.HostPoolReferences != nil {
+		for _, reference := range *props.HostPoolReferences {
+			if reference.HostPoolArmPath != nil && strings.EqualFold(*reference.HostPoolArmPath, hostPoolIdStr) {
+				continue
+			}
+
+			hostPoolReferences = append(hostPoolReferences, reference)
+		}
+	}
+
+	payload := scalingplan.ScalingPlanPatch{
+		Properties: &scalingplan.ScalingPlanPatchProperties{
+			HostPoolReferences: &hostPoolReferences,
+		},
+	}
+	if _, err = client.Update(ctx, id.ScalingPlan, payload); err != nil {
+		return fmt.Errorf("removing association between %s and %s: %+v", id.ScalingPlan, id.HostPool, err)
+	}
+
+	return nil
+}
+
+func hostPoolReferenceExists(props *scalingplan.ScalingPlanProperties, hostPoolId string) bool {
+	if props == nil || props.HostPoolReferences == nil {
+		return false
+	}
+
+	for _, reference := range *props.HostPoolReferences {
+		if reference.HostPoolArmPath != nil && strings.EqualFold(*reference.HostPoolArmPath, hostPoolId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,245 @@
+package desktopvirtualization_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualDesktopScalingPlanHostPoolAssociationResource struct{}
+
+func TestAccVirtualDesktopScalingPlanHostPoolAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan_host_pool_association", "test")
+	r := VirtualDesktopScalingPlanHostPoolAssociationResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualDesktopScalingPlanHostPoolAssociation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan_host_pool_association", "test")
+	r := VirtualDesktopScalingPlanHostPoolAssociationResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		{
+			Config: r.disabled(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		{
+			Config: r.basic(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccVirtualDesktopScalingPlanHostPoolAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan_host_pool_association", "test")
+	r := VirtualDesktopScalingPlanHostPoolAssociationResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data, roleAssignmentId),
+			ExpectError: acceptance.RequiresImportError("azurerm_virtual_desktop_scaling_plan_host_pool_association"),
+		},
+	})
+}
+
+func (VirtualDesktopScalingPlanHostPoolAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ScalingPlanHostPoolAssociationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DesktopVirtualization.ScalingPlansClient.Get(ctx, id.ScalingPlan)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id.ScalingPlan, err)
+	}
+
+	exists := false
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.HostPoolReferences != nil {
+		hostPoolId := id.HostPool.ID()
+		for _, reference := range *model.Properties.HostPoolReferences {
+			if reference.HostPoolArmPath != nil && strings.EqualFold(*reference.HostPoolArmPath, hostPoolId) {
+				exists = true
+			}
+		}
+	}
+
+	return utils.Bool(exists), nil
+}
+
+func (r VirtualDesktopScalingPlanHostPoolAssociationResource) basic(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan_host_pool_association" "test" {
+  scaling_plan_id = azurerm_virtual_desktop_scaling_plan.test.id
+  host_pool_id    = azurerm_virtual_desktop_host_pool.test.id
+  enabled         = true
+}
+`, r.template(data, roleAssignmentId))
+}
+
+func (r VirtualDesktopScalingPlanHostPoolAssociationResource) disabled(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan_host_pool_association" "test" {
+  scaling_plan_id = azurerm_virtual_desktop_scaling_plan.test.id
+  host_pool_id    = azurerm_virtual_desktop_host_pool.test.id
+  enabled         = false
+}
+`, r.template(data, roleAssignmentId))
+}
+
+func (r VirtualDesktopScalingPlanHostPoolAssociationResource) requiresImport(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan_host_pool_association" "import" {
+  scaling_plan_id = azurerm_virtual_desktop_scaling_plan_host_pool_association.test.scaling_plan_id
+  host_pool_id    = azurerm_virtual_desktop_scaling_plan_host_pool_association.test.host_pool_id
+  enabled         = azurerm_virtual_desktop_scaling_plan_host_pool_association.test.enabled
+}
+`, r.basic(data, roleAssignmentId))
+}
+
+func (VirtualDesktopScalingPlanHostPoolAssociationResource) template(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vdesktop-%d"
+  location = "westeurope"
+}
+
+resource "azurerm_role_definition" "test" {
+  name        = "AVD-AutoScale%s"
+  scope       = azurerm_resource_group.test.id
+  description = "AVD AutoScale Role"
+
+  permissions {
+    actions = [
+      "Microsoft.Insights/eventtypes/values/read",
+      "Microsoft.Compute/virtualMachines/deallocate/action",
+      "Microsoft.Compute/virtualMachines/restart/action",
+      "Microsoft.Compute/virtualMachines/powerOff/action",
+      "Microsoft.Compute/virtualMachines/start/action",
+      "Microsoft.Compute/virtualMachines/read",
+      "Microsoft.DesktopVirtualization/hostpools/read",
+      "Microsoft.DesktopVirtualization/hostpools/write",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/read",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/write",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/usersessions/delete",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/usersessions/read",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/usersessions/sendMessage/action",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/usersessions/read"
+    ]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    azurerm_resource_group.test.id,
+  ]
+
+  depends_on = [azurerm_resource_group.test]
+}
+
+data "azuread_service_principal" "test" {
+  display_name = "Windows Virtual Desktop"
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                             = "%s"
+  scope                            = azurerm_resource_group.test.id
+  role_definition_id               = azurerm_role_definition.test.role_definition_resource_id
+  principal_id                     = data.azuread_service_principal.test.application_id
+  skip_service_principal_aad_check = true
+
+  depends_on = [azurerm_role_definition.test]
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                 = "acctestHP%s"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  type                 = "Pooled"
+  validate_environment = true
+  load_balancer_type   = "BreadthFirst"
+}
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "scalingPlan%x"
+  location            = "westeurope"
+  resource_group_name = azurerm_resource_group.test.name
+  friendly_name       = "Scaling Plan Test"
+  description         = "Test Scaling Plan"
+  time_zone           = "GMT Standard Time"
+  schedule {
+    name                                 = "Weekdays"
+    days_of_week                         = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time                   = "06:00"
+    ramp_up_load_balancing_algorithm     = "BreadthFirst"
+    ramp_up_minimum_hosts_percent        = 20
+    ramp_up_capacity_threshold_percent   = 10
+    peak_start_time                      = "09:00"
+    peak_load_balancing_algorithm        = "BreadthFirst"
+    ramp_down_start_time                 = "18:00"
+    ramp_down_load_balancing_algorithm   = "DepthFirst"
+    ramp_down_minimum_hosts_percent      = 10
+    ramp_down_force_logoff_users         = false
+    ramp_down_wait_time_minutes          = 45
+    ramp_down_notification_message       = "Please log of in the next 45 minutes..."
+    ramp_down_capacity_threshold_percent = 5
+    ramp_down_stop_hosts_when            = "ZeroSessions"
+    off_peak_start_time                  = "22:00"
+    off_peak_load_balancing_algorithm    = "DepthFirst"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.RandomString, roleAssignmentId, data.RandomString, data.RandomString)
+}
@@ -13,15 +13,17 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/hostpool"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/scalingplan"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/sdk/2022-02-10-preview/scalingplan"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var scalingPlanResourceType = "azurerm_virtual_desktop_scaling_plan"
+
 func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualDesktopScalingPlanCreate,
@@ -75,10 +77,22 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"host_pool_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(scalingplan.ScalingHostPoolTypePooled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(scalingplan.ScalingHostPoolTypePersonal),
+					string(scalingplan.ScalingHostPoolTypePooled),
+				}, false),
+			},
+
 			"schedule": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MinItems: 1,
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				AtLeastOneOf: []string{"schedule", "personal_schedule"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -215,6 +229,134 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 				},
 			},
 
+			"personal_schedule": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				AtLeastOneOf: []string{"schedule", "personal_schedule"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"days_of_week": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(scalingplan.DaysOfWeekMonday),
+									string(scalingplan.DaysOfWeekTuesday),
+									string(scalingplan.DaysOfWeekWednesday),
+									string(scalingplan.DaysOfWeekThursday),
+									string(scalingplan.DaysOfWeekFriday),
+									string(scalingplan.DaysOfWeekSaturday),
+									string(scalingplan.DaysOfWeekSunday),
+								}, false),
+							},
+						},
+
+						"ramp_up_start_time": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validateTime(),
+						},
+
+						"ramp_up_auto_start_hosts": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(scalingplan.StartupBehaviorNone),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scalingplan.StartupBehaviorAll),
+								string(scalingplan.StartupBehaviorNone),
+								string(scalingplan.StartupBehaviorWithAssignedUser),
+							}, false),
+						},
+
+						"ramp_up_start_vm_on_connect_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"peak_start_time": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validateTime(),
+						},
+
+						"peak_action_on_disconnect": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(scalingplan.SessionHandlingOperationNone),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scalingplan.SessionHandlingOperationDeallocate),
+								string(scalingplan.SessionHandlingOperationHibernate),
+								string(scalingplan.SessionHandlingOperationNone),
+							}, false),
+						},
+
+						"peak_minutes_to_wait_on_disconnect": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"ramp_down_start_time": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validateTime(),
+						},
+
+						"ramp_down_action_on_disconnect": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(scalingplan.SessionHandlingOperationNone),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scalingplan.SessionHandlingOperationDeallocate),
+								string(scalingplan.SessionHandlingOperationHibernate),
+								string(scalingplan.SessionHandlingOperationNone),
+							}, false),
+						},
+
+						"ramp_down_minutes_to_wait_on_disconnect": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"off_peak_start_time": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validateTime(),
+						},
+
+						"off_peak_action_on_disconnect": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(scalingplan.SessionHandlingOperationNone),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scalingplan.SessionHandlingOperationDeallocate),
+								string(scalingplan.SessionHandlingOperationHibernate),
+								string(scalingplan.SessionHandlingOperationNone),
+							}, false),
+						},
+
+						"off_peak_minutes_to_wait_on_disconnect": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
 			"host_pool": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -267,7 +409,25 @@ func resourceVirtualDesktopScalingPlanCreate(d *pluginsdk.ResourceData, meta int
 	location := location.Normalize(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	hostPoolType := scalingplan.ScalingHostPoolTypePooled // Only one possible value for this
+	hostPoolType := scalingplan.ScalingHostPoolType(d.Get("host_pool_type").(string))
+	schedules := d.Get("schedule").([]interface{})
+	personalSchedules := d.Get("personal_schedule").([]interface{})
+	if hostPoolType == scalingplan.ScalingHostPoolTypePersonal {
+		if len(personalSchedules) == 0 {
+			return fmt.Errorf("at least one `personal_schedule` block must be specified when `host_pool_type` is `Personal`")
+		}
+		if len(schedules) > 0 {
+			return fmt.Errorf("`schedule` blocks cannot be specified when `host_pool_type` is `Personal` - use `personal_schedule` instead")
+		}
+	} else {
+		if len(schedules) == 0 {
+			return fmt.Errorf("at least one `schedule` block must be specified when `host_pool_type` is `Pooled`")
+		}
+		if len(personalSchedules) > 0 {
+			return fmt.Errorf("`personal_schedule` blocks cannot be specified when `host_pool_type` is `Pooled` - use `schedule` instead")
+		}
+	}
+
 	payload := scalingplan.ScalingPlan{
 		Name:     utils.String(d.Get("name").(string)),
 		Location: &location,
@@ -278,7 +438,8 @@ func resourceVirtualDesktopScalingPlanCreate(d *pluginsdk.ResourceData, meta int
 			TimeZone:           utils.String(d.Get("time_zone").(string)),
 			HostPoolType:       &hostPoolType,
 			ExclusionTag:       utils.String(d.Get("exclusion_tag").(string)),
-			Schedules:          expandScalingPlanSchedule(d.Get("schedule").([]interface{})),
+			Schedules:          expandScalingPlanSchedule(schedules),
+			PersonalSchedules:  expandScalingPlanPersonalSchedule(personalSchedules),
 			HostPoolReferences: expandScalingPlanHostpoolReference(d.Get("host_pool").([]interface{})),
 		},
 	}
@@ -314,6 +475,7 @@ func resourceVirtualDesktopScalingPlanUpdate(d *pluginsdk.ResourceData, meta int
 			TimeZone:           utils.String(d.Get("time_zone").(string)),
 			ExclusionTag:       utils.String(d.Get("exclusion_tag").(string)),
 			Schedules:          expandScalingPlanSchedule(d.Get("schedule").([]interface{})),
+			PersonalSchedules:  expandScalingPlanPersonalSchedule(d.Get("personal_schedule").([]interface{})),
 			HostPoolReferences: expandScalingPlanHostpoolReference(d.Get("host_pool").([]interface{})),
 		},
 	}
@@ -356,7 +518,15 @@ func resourceVirtualDesktopScalingPlanRead(d *pluginsdk.ResourceData, meta inter
 			d.Set("friendly_name", props.FriendlyName)
 			d.Set("time_zone", props.TimeZone)
 			d.Set("exclusion_tag", props.ExclusionTag)
+
+			hostPoolType := string(scalingplan.ScalingHostPoolTypePooled)
+			if props.HostPoolType != nil {
+				hostPoolType = string(*props.HostPoolType)
+			}
+			d.Set("host_pool_type", hostPoolType)
+
 			d.Set("schedule", flattenScalingPlanSchedule(props.Schedules))
+			d.Set("personal_schedule", flattenScalingPlanPersonalSchedule(props.PersonalSchedules))
 			d.Set("host_pool", flattenScalingHostpoolReference(props.HostPoolReferences))
 		}
 
@@ -446,6 +616,54 @@ func expandScalingPlanSchedule(input []interface{}) *[]scalingplan.ScalingSchedu
 	return &results
 }
 
+func expandScalingPlanPersonalSchedule(input []interface{}) *[]scalingplan.ScalingPersonalSchedule {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]scalingplan.ScalingPersonalSchedule, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		daysOfWeekRaw := v["days_of_week"].(*pluginsdk.Set).List()
+		daysOfWeek := make([]scalingplan.DaysOfWeek, 0)
+		for _, weekday := range daysOfWeekRaw {
+			daysOfWeek = append(daysOfWeek, scalingplan.DaysOfWeek(weekday.(string)))
+		}
+
+		rampUpAutoStartHosts := scalingplan.StartupBehavior(v["ramp_up_auto_start_hosts"].(string))
+		rampUpStartVMOnConnect := scalingplan.SetStartVMOnConnectDisable
+		if v["ramp_up_start_vm_on_connect_enabled"].(bool) {
+			rampUpStartVMOnConnect = scalingplan.SetStartVMOnConnectEnable
+		}
+		peakActionOnDisconnect := scalingplan.SessionHandlingOperation(v["peak_action_on_disconnect"].(string))
+		rampDownActionOnDisconnect := scalingplan.SessionHandlingOperation(v["ramp_down_action_on_disconnect"].(string))
+		offPeakActionOnDisconnect := scalingplan.SessionHandlingOperation(v["off_peak_action_on_disconnect"].(string))
+
+		results = append(results, scalingplan.ScalingPersonalSchedule{
+			Name:                              utils.String(v["name"].(string)),
+			DaysOfWeek:                        &daysOfWeek,
+			RampUpStartTime:                   expandScalingPlanScheduleTime(v["ramp_up_start_time"].(string)),
+			RampUpAutoStartHosts:              &rampUpAutoStartHosts,
+			RampUpStartVMOnConnect:            &rampUpStartVMOnConnect,
+			PeakStartTime:                     expandScalingPlanScheduleTime(v["peak_start_time"].(string)),
+			PeakActionOnDisconnect:            &peakActionOnDisconnect,
+			PeakMinutesToWaitOnDisconnect:     utils.Int64(int64(v["peak_minutes_to_wait_on_disconnect"].(int))),
+			RampDownStartTime:                 expandScalingPlanScheduleTime(v["ramp_down_start_time"].(string)),
+			RampDownActionOnDisconnect:        &rampDownActionOnDisconnect,
+			RampDownMinutesToWaitOnDisconnect: utils.Int64(int64(v["ramp_down_minutes_to_wait_on_disconnect"].(int))),
+			OffPeakStartTime:                  expandScalingPlanScheduleTime(v["off_peak_start_time"].(string)),
+			OffPeakActionOnDisconnect:         &offPeakActionOnDisconnect,
+			OffPeakMinutesToWaitOnDisconnect:  utils.Int64(int64(v["off_peak_minutes_to_wait_on_disconnect"].(int))),
+		})
+	}
+
+	return &results
+}
+
 func expandScalingPlanScheduleTime(input string) *scalingplan.Time {
 	if len(input) == 0 {
 		return nil
@@ -570,6 +788,89 @@ func flattenScalingPlanSchedule(input *[]scalingplan.ScalingSchedule) []interfac
 	return results
 }
 
+func flattenScalingPlanPersonalSchedule(input *[]scalingplan.ScalingPersonalSchedule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+		daysOfWeek := make([]string, 0)
+		if item.DaysOfWeek != nil {
+			for _, weekday := range *item.DaysOfWeek {
+				daysOfWeek = append(daysOfWeek, string(weekday))
+			}
+		}
+		rampUpStartTime := ""
+		if item.RampUpStartTime != nil {
+			rampUpStartTime = fmt.Sprintf("%02d:%02d", item.RampUpStartTime.Hour, item.RampUpStartTime.Minute)
+		}
+		rampUpAutoStartHosts := string(scalingplan.StartupBehaviorNone)
+		if item.RampUpAutoStartHosts != nil {
+			rampUpAutoStartHosts = string(*item.RampUpAutoStartHosts)
+		}
+		rampUpStartVMOnConnectEnabled := item.RampUpStartVMOnConnect != nil && *item.RampUpStartVMOnConnect == scalingplan.SetStartVMOnConnectEnable
+		peakStartTime := ""
+		if item.PeakStartTime != nil {
+			peakStartTime = fmt.Sprintf("%02d:%02d", item.PeakStartTime.Hour, item.PeakStartTime.Minute)
+		}
+		peakActionOnDisconnect := string(scalingplan.SessionHandlingOperationNone)
+		if item.PeakActionOnDisconnect != nil {
+			peakActionOnDisconnect = string(*item.PeakActionOnDisconnect)
+		}
+		peakMinutesToWaitOnDisconnect := int64(0)
+		if item.PeakMinutesToWaitOnDisconnect != nil {
+			peakMinutesToWaitOnDisconnect = *item.PeakMinutesToWaitOnDisconnect
+		}
+		rampDownStartTime := ""
+		if item.RampDownStartTime != nil {
+			rampDownStartTime = fmt.Sprintf("%02d:%02d", item.RampDownStartTime.Hour, item.RampDownStartTime.Minute)
+		}
+		rampDownActionOnDisconnect := string(scalingplan.SessionHandlingOperationNone)
+		if item.RampDownActionOnDisconnect != nil {
+			rampDownActionOnDisconnect = string(*item.RampDownActionOnDisconnect)
+		}
+		rampDownMinutesToWaitOnDisconnect := int64(0)
+		if item.RampDownMinutesToWaitOnDisconnect != nil {
+			rampDownMinutesToWaitOnDisconnect = *item.RampDownMinutesToWaitOnDisconnect
+		}
+		offPeakStartTime := ""
+		if item.OffPeakStartTime != nil {
+			offPeakStartTime = fmt.Sprintf("%02d:%02d", item.OffPeakStartTime.Hour, item.OffPeakStartTime.Minute)
+		}
+		offPeakActionOnDisconnect := string(scalingplan.SessionHandlingOperationNone)
+		if item.OffPeakActionOnDisconnect != nil {
+			offPeakActionOnDisconnect = string(*item.OffPeakActionOnDisconnect)
+		}
+		offPeakMinutesToWaitOnDisconnect := int64(0)
+		if item.OffPeakMinutesToWaitOnDisconnect != nil {
+			offPeakMinutesToWaitOnDisconnect = *item.OffPeakMinutesToWaitOnDisconnect
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                                    name,
+			"days_of_week":                            daysOfWeek,
+			"ramp_up_start_time":                      rampUpStartTime,
+			"ramp_up_auto_start_hosts":                rampUpAutoStartHosts,
+			"ramp_up_start_vm_on_connect_enabled":     rampUpStartVMOnConnectEnabled,
+			"peak_start_time":                         peakStartTime,
+			"peak_action_on_disconnect":               peakActionOnDisconnect,
+			"peak_minutes_to_wait_on_disconnect":      peakMinutesToWaitOnDisconnect,
+			"ramp_down_start_time":                    rampDownStartTime,
+			"ramp_down_action_on_disconnect":          rampDownActionOnDisconnect,
+			"ramp_down_minutes_to_wait_on_disconnect": rampDownMinutesToWaitOnDisconnect,
+			"off_peak_start_time":                     offPeakStartTime,
+			"off_peak_action_on_disconnect":           offPeakActionOnDisconnect,
+			"off_peak_minutes_to_wait_on_disconnect":  offPeakMinutesToWaitOnDisconnect,
+		})
+	}
+	return results
+}
+
 func flattenScalingHostpoolReference(input *[]scalingplan.ScalingHostPoolReference) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
@@ -6,10 +6,10 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/scalingplan"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/sdk/2022-02-10-preview/scalingplan"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -97,6 +97,22 @@ func TestAccVirtualDesktopScalingPlan_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccVirtualDesktopScalingPlan_personal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan", "test")
+	r := VirtualDesktopScalingPlanResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.personal(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("host_pool_type").HasValue("Personal"),
+			),
+		},
+	})
+}
+
 func (VirtualDesktopScalingPlanResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := scalingplan.ParseScalingPlanID(state.ID)
 	if err != nil {
@@ -379,3 +395,106 @@ resource "azurerm_virtual_desktop_scaling_plan" "import" {
 }
 `, r.basic(data, roleAssignmentId))
 }
+
+func (VirtualDesktopScalingPlanResource) personal(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vdesktop-%d"
+  location = "westeurope"
+}
+
+resource "azurerm_role_definition" "test" {
+  name        = "AVD-AutoScale%s"
+  scope       = azurerm_resource_group.test.id
+  description = "AVD AutoScale Role"
+
+  permissions {
+    actions = [
+      "Microsoft.Insights/eventtypes/values/read",
+      "Microsoft.Compute/virtualMachines/deallocate/action",
+      "Microsoft.Compute/virtualMachines/restart/action",
+      "Microsoft.Compute/virtualMachines/powerOff/action",
+      "Microsoft.Compute/virtualMachines/start/action",
+      "Microsoft.Compute/virtualMachines/read",
+      "Microsoft.DesktopVirtualization/hostpools/read",
+      "Microsoft.DesktopVirtualization/hostpools/write",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/read",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/write",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/usersessions/delete",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/usersessions/read",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/usersessions/sendMessage/action",
+      "Microsoft.DesktopVirtualization/hostpools/sessionhosts/usersessions/read"
+    ]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    azurerm_resource_group.test.id,
+  ]
+
+  depends_on = [azurerm_resource_group.test]
+}
+
+data "azuread_service_principal" "test" {
+  display_name = "Windows Virtual Desktop"
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                             = "%s"
+  scope                            = azurerm_resource_group.test.id
+  role_definition_id               = azurerm_role_definition.test.role_definition_resource_id
+  principal_id                     = data.azuread_service_principal.test.application_id
+  skip_service_principal_aad_check = true
+
+  depends_on = [azurerm_role_definition.test]
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                             = "acctestHP%s"
+  location                         = azurerm_resource_group.test.location
+  resource_group_name              = azurerm_resource_group.test.name
+  type                             = "Personal"
+  personal_desktop_assignment_type = "Automatic"
+  validate_environment             = true
+  load_balancer_type               = "Persistent"
+}
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "scalingPlan%x"
+  location            = "westeurope"
+  resource_group_name = azurerm_resource_group.test.name
+  friendly_name       = "Personal Scaling Plan Test"
+  description         = "Test Personal Scaling Plan"
+  time_zone           = "GMT Standard Time"
+  host_pool_type      = "Personal"
+  personal_schedule {
+    name                                    = "Weekdays"
+    days_of_week                            = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time                      = "06:00"
+    ramp_up_auto_start_hosts                = "WithAssignedUser"
+    peak_start_time                         = "09:00"
+    peak_action_on_disconnect               = "Deallocate"
+    peak_minutes_to_wait_on_disconnect      = 10
+    ramp_down_start_time                    = "18:00"
+    ramp_down_action_on_disconnect          = "Deallocate"
+    ramp_down_minutes_to_wait_on_disconnect = 10
+    off_peak_start_time                     = "22:00"
+    off_peak_action_on_disconnect           = "Deallocate"
+    off_peak_minutes_to_wait_on_disconnect  = 5
+  }
+
+  host_pool {
+    hostpool_id          = azurerm_virtual_desktop_host_pool.test.id
+    scaling_plan_enabled = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.RandomString, roleAssignmentId, data.RandomString, data.RandomString)
+}
@@ -0,0 +1,223 @@
+package desktopvirtualization
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/sessionhost"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualDesktopSessionHost() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualDesktopSessionHostCreate,
+		Read:   resourceVirtualDesktopSessionHostRead,
+		Update: resourceVirtualDesktopSessionHostUpdate,
+		Delete: resourceVirtualDesktopSessionHostDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := sessionhost.ParseSessionHostID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"host_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: sessionhost.ValidateHostPoolID,
+			},
+
+			"drain_mode_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"assigned_user": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"agent_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"virtual_machine_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVirtualDesktopSessionHostCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DesktopVirtualization.SessionHostsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Virtual Desktop Session Host create")
+
+	hostPoolId, err := sessionhost.ParseHostPoolID(d.Get("host_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(hostPoolId.HostPoolName, hostPoolResourceType)
+	defer locks.UnlockByName(hostPoolId.HostPoolName, hostPoolResourceType)
+
+	id := sessionhost.NewSessionHostID(hostPoolId.SubscriptionId, hostPoolId.ResourceGroupName, hostPoolId.HostPoolName, d.Get("name").(string))
+
+	// Session Hosts are registered into the Host Pool by the Virtual Desktop Agent running on the
+	// Virtual Machine, so this resource manages an existing registration rather than creating one
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("%s was not found - Session Hosts are registered by the Virtual Desktop Agent, ensure the agent on the Virtual Machine has registered with %s before managing it", id, hostPoolId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	payload := sessionhost.SessionHostPatch{
+		Properties: &sessionhost.SessionHostPatchProperties{
+			AllowNewSession: utils.Bool(!d.Get("drain_mode_enabled").(bool)),
+		},
+	}
+	if assignedUser := d.Get("assigned_user").(string); assignedUser != "" {
+		payload.Properties.AssignedUser = utils.String(assignedUser)
+	}
+
+	if _, err := client.Update(ctx, id, payload, sessionhost.DefaultUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualDesktopSessionHostRead(d, meta)
+}
+
+func resourceVirtualDesktopSessionHostUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DesktopVirtualization.SessionHostsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Virtual Desktop Session Host update")
+
+	id, err := sessionhost.ParseSessionHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.HostPoolName, hostPoolResourceType)
+	defer locks.UnlockByName(id.HostPoolName, hostPoolResourceType)
+
+	payload := sessionhost.SessionHostPatch{
+		Properties: &sessionhost.SessionHostPatchProperties{},
+	}
+	if d.HasChange("drain_mode_enabled") {
+		payload.Properties.AllowNewSession = utils.Bool(!d.Get("drain_mode_enabled").(bool))
+	}
+	if d.HasChange("assigned_user") {
+		payload.Properties.AssignedUser = utils.String(d.Get("assigned_user").(string))
+	}
+
+	if _, err := client.Update(ctx, *id, payload, sessionhost.DefaultUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVirtualDesktopSessionHostRead(d, meta)
+}
+
+func resourceVirtualDesktopSessionHostRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DesktopVirtualization.SessionHostsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sessionhost.ParseSessionHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SessionHostName)
+	d.Set("host_pool_id", sessionhost.NewHostPoolID(id.SubscriptionId, id.ResourceGroupName, id.HostPoolName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			drainModeEnabled := false
+			if props.AllowNewSession != nil {
+				drainModeEnabled = !*props.AllowNewSession
+			}
+			d.Set("drain_mode_enabled", drainModeEnabled)
+			d.Set("assigned_user", props.AssignedUser)
+			d.Set("agent_version", props.AgentVersion)
+
+			status := ""
+			if props.Status != nil {
+				status = string(*props.Status)
+			}
+			d.Set("status", status)
+			d.Set("virtual_machine_id", props.VirtualMachineId)
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualDesktopSessionHostDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DesktopVirtualization.SessionHostsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sessionhost.ParseSessionHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.HostPoolName, hostPoolResourceType)
+	defer locks.UnlockByName(id.HostPoolName, hostPoolResourceType)
+
+	// this removes the Session Host registration from the Host Pool - the underlying Virtual Machine is not touched
+	if _, err := client.Delete(ctx, *id, sessionhost.DefaultDeleteOperationOptions()); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package desktopvirtualization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/sessionhost"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualDesktopSessionHostResource struct{}
+
+func TestAccVirtualDesktopSessionHost_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_session_host", "test")
+	r := VirtualDesktopSessionHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("drain_mode_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualDesktopSessionHost_drainMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_session_host", "test")
+	r := VirtualDesktopSessionHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("drain_mode_enabled").HasValue("false"),
+			),
+		},
+		{
+			Config: r.drainMode(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("drain_mode_enabled").HasValue("true"),
+			),
+		},
+		{
+			Config: r.drainMode(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("drain_mode_enabled").HasValue("false"),
+			),
+		},
+	})
+}
+
+func (VirtualDesktopSessionHostResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sessionhost.ParseSessionHostID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DesktopVirtualization.SessionHostsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VirtualDesktopSessionHostResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_session_host" "test" {
+  name         = azurerm_windows_virtual_machine.test.name
+  host_pool_id = azurerm_virtual_desktop_host_pool.test.id
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, r.template(data))
+}
+
+func (r VirtualDesktopSessionHostResource) drainMode(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_session_host" "test" {
+  name               = azurerm_windows_virtual_machine.test.name
+  host_pool_id       = azurerm_virtual_desktop_host_pool.test.id
+  drain_mode_enabled = %t
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, r.template(data), enabled)
+}
+
+func (VirtualDesktopSessionHostResource) template(data acceptance.TestData) string {
+	expirationTime := time.Now().UTC().AddDate(0, 0, 1).Format(time.RFC3339)
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vdesktop-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                = "acctestHP%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "Pooled"
+  load_balancer_type  = "BreadthFirst"
+}
+
+resource "azurerm_virtual_desktop_host_pool_registration_info" "test" {
+  hostpool_id     = azurerm_virtual_desktop_host_pool.test.id
+  expiration_date = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = "acctsh%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  size                = "Standard_D2s_v3"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsDesktop"
+    offer     = "Windows-10"
+    sku       = "win10-22h2-avd"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_virtual_machine_extension" "test" {
+  name                       = "register-session-host"
+  virtual_machine_id         = azurerm_windows_virtual_machine.test.id
+  publisher                  = "Microsoft.Powershell"
+  type                       = "DSC"
+  type_handler_version       = "2.73"
+  auto_upgrade_minor_version = true
+
+  settings = <<-SETTINGS
+    {
+      "modulesUrl": "https://wvdportalstorageblob.blob.core.windows.net/galleryartifacts/Configuration.zip",
+      "configurationFunction": "Configuration.ps1\\AddSessionHost",
+      "properties": {
+        "HostPoolName": "${azurerm_virtual_desktop_host_pool.test.name}"
+      }
+    }
+  SETTINGS
+
+  protected_settings = <<-PROTECTED_SETTINGS
+    {
+      "properties": {
+        "registrationInfoToken": "${azurerm_virtual_desktop_host_pool_registration_info.test.token}"
+      }
+    }
+  PROTECTED_SETTINGS
+}
+`, data.RandomInteger, data.Locations.Secondary, data.RandomString, expirationTime, data.RandomInteger, data.RandomInteger, data.RandomString)
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/catalogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devcenters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/environmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/schedules"
+)
+
+type Client struct {
+	CatalogsClient           *catalogs.CatalogsClient
+	DevBoxDefinitionsClient  *devboxdefinitions.DevBoxDefinitionsClient
+	DevCentersClient         *devcenters.DevCentersClient
+	EnvironmentTypesClient   *environmenttypes.EnvironmentTypesClient
+	NetworkConnectionsClient *networkconnections.NetworkConnectionsClient
+	PoolsClient              *pools.PoolsClient
+	ProjectsClient           *projects.ProjectsClient
+	SchedulesClient          *schedules.SchedulesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	catalogsClient := catalogs.NewCatalogsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&catalogsClient.Client, o.ResourceManagerAuthorizer)
+
+	devBoxDefinitionsClient := devboxdefinitions.NewDevBoxDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&devBoxDefinitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	devCentersClient := devcenters.NewDevCentersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&devCentersClient.Client, o.ResourceManagerAuthorizer)
+
+	environmentTypesClient := environmenttypes.NewEnvironmentTypesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&environmentTypesClient.Client, o.ResourceManagerAuthorizer)
+
+	networkConnectionsClient := networkconnections.NewNetworkConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&networkConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	poolsClient := pools.NewPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&poolsClient.Client, o.ResourceManagerAuthorizer)
+
+	projectsClient := projects.NewProjectsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&projectsClient.Client, o.ResourceManagerAuthorizer)
+
+	schedulesClient := schedules.NewSchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&schedulesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		CatalogsClient:           &catalogsClient,
+		DevBoxDefinitionsClient:  &devBoxDefinitionsClient,
+		DevCentersClient:         &devCentersClient,
+		EnvironmentTypesClient:   &environmentTypesClient,
+		NetworkConnectionsClient: &networkConnectionsClient,
+		PoolsClient:              &poolsClient,
+		ProjectsClient:           &projectsClient,
+		SchedulesClient:          &schedulesClient,
+	}
+}
@@ -0,0 +1,272 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/catalogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterCatalogModel struct {
+	Name          string                    `tfschema:"name"`
+	DevCenterId   string                    `tfschema:"dev_center_id"`
+	CatalogGitHub []DevCenterCatalogGitRepo `tfschema:"catalog_github"`
+	CatalogAdoGit []DevCenterCatalogGitRepo `tfschema:"catalog_adogit"`
+}
+
+type DevCenterCatalogGitRepo struct {
+	URI            string `tfschema:"uri"`
+	Branch         string `tfschema:"branch"`
+	Path           string `tfschema:"path"`
+	KeyVaultKeyUrl string `tfschema:"key_vault_key_url"`
+}
+
+type DevCenterCatalogResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*DevCenterCatalogResource)(nil)
+
+func devCenterCatalogGitRepoSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"uri": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"branch": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"path": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"key_vault_key_url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r DevCenterCatalogResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: catalogs.ValidateDevCenterID,
+		},
+
+		"catalog_github": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"catalog_github", "catalog_adogit"},
+			Elem:         devCenterCatalogGitRepoSchema(),
+		},
+
+		"catalog_adogit": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"catalog_github", "catalog_adogit"},
+			Elem:         devCenterCatalogGitRepoSchema(),
+		},
+	}
+}
+
+func (r DevCenterCatalogResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterCatalogResource) ModelObject() interface{} {
+	return &DevCenterCatalogModel{}
+}
+
+func (r DevCenterCatalogResource) ResourceType() string {
+	return "azurerm_dev_center_catalog"
+}
+
+func (r DevCenterCatalogResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return catalogs.ValidateCatalogID
+}
+
+func (r DevCenterCatalogResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.CatalogsClient
+
+			var model DevCenterCatalogModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			devCenterId, err := catalogs.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := catalogs.NewCatalogID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := catalogs.Catalog{
+				Properties: &catalogs.CatalogProperties{
+					GitHub: expandDevCenterCatalogGitRepo(model.CatalogGitHub),
+					AdoGit: expandDevCenterCatalogGitRepo(model.CatalogAdoGit),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterCatalogResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.CatalogsClient
+
+			id, err := catalogs.ParseCatalogID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := DevCenterCatalogModel{
+				Name:        id.CatalogName,
+				DevCenterId: catalogs.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					output.CatalogGitHub = flattenDevCenterCatalogGitRepo(props.GitHub)
+					output.CatalogAdoGit = flattenDevCenterCatalogGitRepo(props.AdoGit)
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r DevCenterCatalogResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.CatalogsClient
+
+			id, err := catalogs.ParseCatalogID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterCatalogModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := catalogs.Catalog{
+				Properties: &catalogs.CatalogProperties{
+					GitHub: expandDevCenterCatalogGitRepo(model.CatalogGitHub),
+					AdoGit: expandDevCenterCatalogGitRepo(model.CatalogAdoGit),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterCatalogResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.CatalogsClient
+
+			id, err := catalogs.ParseCatalogID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterCatalogGitRepo(input []DevCenterCatalogGitRepo) *catalogs.GitCatalog {
+	if len(input) == 0 {
+		return nil
+	}
+
+	repo := input[0]
+	return &catalogs.GitCatalog{
+		Uri:              utils.String(repo.URI),
+		Branch:           utils.String(repo.Branch),
+		Path:             utils.String(repo.Path),
+		SecretIdentifier: utils.String(repo.KeyVaultKeyUrl),
+	}
+}
+
+func flattenDevCenterCatalogGitRepo(input *catalogs.GitCatalog) []DevCenterCatalogGitRepo {
+	if input == nil {
+		return []DevCenterCatalogGitRepo{}
+	}
+
+	return []DevCenterCatalogGitRepo{
+		{
+			URI:            utils.NormalizeNilableString(input.Uri),
+			Branch:         utils.NormalizeNilableString(input.Branch),
+			Path:           utils.NormalizeNilableString(input.Path),
+			KeyVaultKeyUrl: utils.NormalizeNilableString(input.SecretIdentifier),
+		},
+	}
+}
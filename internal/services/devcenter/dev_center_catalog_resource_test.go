@@ -0,0 +1,151 @@
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/catalogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DevCenterCatalogResource struct{}
+
+func TestAccDevCenterCatalog_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_catalog", "test")
+	r := DevCenterCatalogResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterCatalog_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_catalog", "test")
+	r := DevCenterCatalogResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (DevCenterCatalogResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := catalogs.ParseCatalogID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.DevCenter.CatalogsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (DevCenterCatalogResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-devcenter-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dev_center" "test" {
+  name                = "acctest-dc-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctest-kv-%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault_access_policy" "test" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = data.azurerm_client_config.current.tenant_id
+  object_id    = data.azurerm_client_config.current.object_id
+
+  secret_permissions = ["Get", "Set", "Delete", "Purge"]
+}
+
+resource "azurerm_key_vault_access_policy" "devcenter" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = data.azurerm_client_config.current.tenant_id
+  object_id    = azurerm_dev_center.test.identity[0].principal_id
+
+  secret_permissions = ["Get"]
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "acctest-secret-%[1]d"
+  value        = "example"
+  key_vault_id = azurerm_key_vault.test.id
+
+  depends_on = [azurerm_key_vault_access_policy.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r DevCenterCatalogResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_catalog" "test" {
+  name          = "acctest-cat-%[2]d"
+  dev_center_id = azurerm_dev_center.test.id
+
+  catalog_github {
+    uri               = "https://github.com/microsoft/devcenter-catalog.git"
+    branch            = "main"
+    path              = "Environment-Definitions"
+    key_vault_key_url = azurerm_key_vault_secret.test.id
+  }
+
+  depends_on = [azurerm_key_vault_access_policy.devcenter]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DevCenterCatalogResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_catalog" "import" {
+  name          = azurerm_dev_center_catalog.test.name
+  dev_center_id = azurerm_dev_center_catalog.test.dev_center_id
+
+  catalog_github {
+    uri               = "https://github.com/microsoft/devcenter-catalog.git"
+    branch            = "main"
+    path              = "Environment-Definitions"
+    key_vault_key_url = azurerm_key_vault_secret.test.id
+  }
+}
+`, r.basic(data))
+}
@@ -0,0 +1,236 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterDevBoxDefinitionModel struct {
+	Name             string            `tfschema:"name"`
+	DevCenterId      string            `tfschema:"dev_center_id"`
+	Location         string            `tfschema:"location"`
+	ImageReferenceId string            `tfschema:"image_reference_id"`
+	SkuName          string            `tfschema:"sku_name"`
+	Tags             map[string]string `tfschema:"tags"`
+}
+
+type DevCenterDevBoxDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*DevCenterDevBoxDefinitionResource)(nil)
+
+func (r DevCenterDevBoxDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: devboxdefinitions.ValidateDevCenterID,
+		},
+
+		"location": commonschema.Location(),
+
+		"image_reference_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterDevBoxDefinitionResource) ModelObject() interface{} {
+	return &DevCenterDevBoxDefinitionModel{}
+}
+
+func (r DevCenterDevBoxDefinitionResource) ResourceType() string {
+	return "azurerm_dev_center_dev_box_definition"
+}
+
+func (r DevCenterDevBoxDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return devboxdefinitions.ValidateDevBoxDefinitionID
+}
+
+func (r DevCenterDevBoxDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.DevBoxDefinitionsClient
+
+			var model DevCenterDevBoxDefinitionModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			devCenterId, err := devboxdefinitions.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := devboxdefinitions.NewDevBoxDefinitionID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := devboxdefinitions.DevBoxDefinition{
+				Location: location.Normalize(model.Location),
+				Properties: &devboxdefinitions.DevBoxDefinitionProperties{
+					ImageReference: &devboxdefinitions.ImageReference{
+						Id: utils.String(model.ImageReferenceId),
+					},
+					Sku: &devboxdefinitions.Sku{
+						Name: model.SkuName,
+					},
+					HibernateSupport: pointer.To(devboxdefinitions.HibernateSupportDisabled),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.DevBoxDefinitionsClient
+
+			id, err := devboxdefinitions.ParseDevBoxDefinitionID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := DevCenterDevBoxDefinitionModel{
+				Name:        id.DevBoxDefinitionName,
+				DevCenterId: devboxdefinitions.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if imageReference := props.ImageReference; imageReference != nil {
+						output.ImageReferenceId = utils.NormalizeNilableString(imageReference.Id)
+					}
+
+					if sku := props.Sku; sku != nil {
+						output.SkuName = sku.Name
+					}
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.DevBoxDefinitionsClient
+
+			id, err := devboxdefinitions.ParseDevBoxDefinitionID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterDevBoxDefinitionModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := devboxdefinitions.DevBoxDefinition{
+				Location: location.Normalize(model.Location),
+				Properties: &devboxdefinitions.DevBoxDefinitionProperties{
+					ImageReference: &devboxdefinitions.ImageReference{
+						Id: utils.String(model.ImageReferenceId),
+					},
+					Sku: &devboxdefinitions.Sku{
+						Name: model.SkuName,
+					},
+					HibernateSupport: pointer.To(devboxdefinitions.HibernateSupportDisabled),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.DevBoxDefinitionsClient
+
+			id, err := devboxdefinitions.ParseDevBoxDefinitionID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,184 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/environmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DevCenterEnvironmentTypeModel struct {
+	Name        string            `tfschema:"name"`
+	DevCenterId string            `tfschema:"dev_center_id"`
+	Tags        map[string]string `tfschema:"tags"`
+}
+
+type DevCenterEnvironmentTypeResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*DevCenterEnvironmentTypeResource)(nil)
+
+func (r DevCenterEnvironmentTypeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: environmenttypes.ValidateDevCenterID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterEnvironmentTypeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterEnvironmentTypeResource) ModelObject() interface{} {
+	return &DevCenterEnvironmentTypeModel{}
+}
+
+func (r DevCenterEnvironmentTypeResource) ResourceType() string {
+	return "azurerm_dev_center_environment_type"
+}
+
+func (r DevCenterEnvironmentTypeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return environmenttypes.ValidateEnvironmentTypeID
+}
+
+func (r DevCenterEnvironmentTypeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.EnvironmentTypesClient
+
+			var model DevCenterEnvironmentTypeModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			devCenterId, err := environmenttypes.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := environmenttypes.NewEnvironmentTypeID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := environmenttypes.EnvironmentType{
+				Tags: &model.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterEnvironmentTypeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.EnvironmentTypesClient
+
+			id, err := environmenttypes.ParseEnvironmentTypeID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := DevCenterEnvironmentTypeModel{
+				Name:        id.EnvironmentTypeName,
+				DevCenterId: environmenttypes.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r DevCenterEnvironmentTypeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.EnvironmentTypesClient
+
+			id, err := environmenttypes.ParseEnvironmentTypeID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterEnvironmentTypeModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := environmenttypes.EnvironmentType{
+				Tags: &model.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterEnvironmentTypeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.EnvironmentTypesClient
+
+			id, err := environmenttypes.ParseEnvironmentTypeID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
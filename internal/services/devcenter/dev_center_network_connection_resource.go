@@ -0,0 +1,266 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/networkconnections"
+	commonValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterNetworkConnectionModel struct {
+	Name             string            `tfschema:"name"`
+	ResourceGroup    string            `tfschema:"resource_group_name"`
+	Location         string            `tfschema:"location"`
+	SubnetId         string            `tfschema:"subnet_id"`
+	DomainJoinType   string            `tfschema:"domain_join_type"`
+	DomainName       string            `tfschema:"domain_name"`
+	DomainUsername   string            `tfschema:"domain_username"`
+	DomainPassword   string            `tfschema:"domain_password"`
+	OrganizationUnit string            `tfschema:"organization_unit"`
+	Tags             map[string]string `tfschema:"tags"`
+}
+
+type DevCenterNetworkConnectionResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*DevCenterNetworkConnectionResource)(nil)
+
+func (r DevCenterNetworkConnectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonValidate.SubnetID,
+		},
+
+		"domain_join_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networkconnections.PossibleValuesForDomainJoinType(), false),
+		},
+
+		"domain_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"domain_username": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"domain_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"organization_unit": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterNetworkConnectionResource) ModelObject() interface{} {
+	return &DevCenterNetworkConnectionModel{}
+}
+
+func (r DevCenterNetworkConnectionResource) ResourceType() string {
+	return "azurerm_dev_center_network_connection"
+}
+
+func (r DevCenterNetworkConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkconnections.ValidateNetworkConnectionID
+}
+
+func (r DevCenterNetworkConnectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.NetworkConnectionsClient
+
+			var model DevCenterNetworkConnectionModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			id := networkconnections.NewNetworkConnectionID(meta.Client.Account.SubscriptionId, model.ResourceGroup, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networkconnections.NetworkConnection{
+				Location:   location.Normalize(model.Location),
+				Properties: expandDevCenterNetworkConnectionProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.NetworkConnectionsClient
+
+			id, err := networkconnections.ParseNetworkConnectionID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := DevCenterNetworkConnectionModel{
+				Name:          id.NetworkConnectionName,
+				ResourceGroup: id.ResourceGroupName,
+				// `domainPassword` is not returned by the API so is always taken from the config
+				DomainPassword: meta.ResourceData.Get("domain_password").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					output.SubnetId = utils.NormalizeNilableString(props.SubnetId)
+					output.DomainJoinType = string(props.DomainJoinType)
+					output.DomainName = utils.NormalizeNilableString(props.DomainName)
+					output.DomainUsername = utils.NormalizeNilableString(props.DomainUsername)
+					output.OrganizationUnit = utils.NormalizeNilableString(props.OrganizationUnit)
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.NetworkConnectionsClient
+
+			id, err := networkconnections.ParseNetworkConnectionID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterNetworkConnectionModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := networkconnections.NetworkConnection{
+				Location:   location.Normalize(model.Location),
+				Properties: expandDevCenterNetworkConnectionProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.NetworkConnectionsClient
+
+			id, err := networkconnections.ParseNetworkConnectionID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterNetworkConnectionProperties(model DevCenterNetworkConnectionModel) *networkconnections.NetworkProperties {
+	props := networkconnections.NetworkProperties{
+		DomainJoinType: networkconnections.DomainJoinType(model.DomainJoinType),
+		SubnetId:       utils.String(model.SubnetId),
+	}
+
+	if model.DomainName != "" {
+		props.DomainName = utils.String(model.DomainName)
+	}
+
+	if model.DomainUsername != "" {
+		props.DomainUsername = utils.String(model.DomainUsername)
+	}
+
+	if model.DomainPassword != "" {
+		props.DomainPassword = utils.String(model.DomainPassword)
+	}
+
+	if model.OrganizationUnit != "" {
+		props.OrganizationUnit = utils.String(model.OrganizationUnit)
+	}
+
+	return &props
+}
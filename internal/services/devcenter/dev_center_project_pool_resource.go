@@ -0,0 +1,263 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterProjectPoolModel struct {
+	Name                               string            `tfschema:"name"`
+	DevCenterProjectId                 string            `tfschema:"dev_center_project_id"`
+	Location                           string            `tfschema:"location"`
+	DevBoxDefinitionName               string            `tfschema:"dev_box_definition_name"`
+	NetworkConnectionName              string            `tfschema:"network_connection_name"`
+	LocalAdministratorEnabled          bool              `tfschema:"local_administrator_enabled"`
+	StopOnDisconnectGracePeriodMinutes int64             `tfschema:"stop_on_disconnect_grace_period_minutes"`
+	Tags                               map[string]string `tfschema:"tags"`
+}
+
+type DevCenterProjectPoolResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*DevCenterProjectPoolResource)(nil)
+
+func (r DevCenterProjectPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dev_center_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: pools.ValidateProjectID,
+		},
+
+		"location": commonschema.Location(),
+
+		"dev_box_definition_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_connection_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"local_administrator_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"stop_on_disconnect_grace_period_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(60, 480),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterProjectPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterProjectPoolResource) ModelObject() interface{} {
+	return &DevCenterProjectPoolModel{}
+}
+
+func (r DevCenterProjectPoolResource) ResourceType() string {
+	return "azurerm_dev_center_project_pool"
+}
+
+func (r DevCenterProjectPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return pools.ValidatePoolID
+}
+
+func (r DevCenterProjectPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.PoolsClient
+
+			var model DevCenterProjectPoolModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			projectId, err := pools.ParseProjectID(model.DevCenterProjectId)
+			if err != nil {
+				return err
+			}
+
+			id := pools.NewPoolID(projectId.SubscriptionId, projectId.ResourceGroupName, projectId.ProjectName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := pools.Pool{
+				Location:   location.Normalize(model.Location),
+				Properties: expandDevCenterProjectPoolProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.PoolsClient
+
+			id, err := pools.ParsePoolID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := DevCenterProjectPoolModel{
+				Name:               id.PoolName,
+				DevCenterProjectId: pools.NewProjectID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					output.DevBoxDefinitionName = utils.NormalizeNilableString(props.DevBoxDefinitionName)
+					output.NetworkConnectionName = utils.NormalizeNilableString(props.NetworkConnectionName)
+
+					if props.LocalAdministrator != nil {
+						output.LocalAdministratorEnabled = *props.LocalAdministrator == pools.LocalAdminStatusEnabled
+					}
+
+					if stopOnDisconnect := props.StopOnDisconnect; stopOnDisconnect != nil && stopOnDisconnect.Status != nil && *stopOnDisconnect.Status == pools.StopOnDisconnectEnableStatusEnabled {
+						if stopOnDisconnect.GracePeriodMinutes != nil {
+							output.StopOnDisconnectGracePeriodMinutes = *stopOnDisconnect.GracePeriodMinutes
+						}
+					}
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r DevCenterProjectPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.PoolsClient
+
+			id, err := pools.ParsePoolID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectPoolModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := pools.Pool{
+				Location:   location.Normalize(model.Location),
+				Properties: expandDevCenterProjectPoolProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.PoolsClient
+
+			id, err := pools.ParsePoolID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterProjectPoolProperties(model DevCenterProjectPoolModel) *pools.PoolProperties {
+	localAdministrator := pools.LocalAdminStatusDisabled
+	if model.LocalAdministratorEnabled {
+		localAdministrator = pools.LocalAdminStatusEnabled
+	}
+
+	props := pools.PoolProperties{
+		DevBoxDefinitionName:  utils.String(model.DevBoxDefinitionName),
+		NetworkConnectionName: utils.String(model.NetworkConnectionName),
+		LicenseType:           pointer.To(pools.LicenseTypeWindowsClient),
+		LocalAdministrator:    pointer.To(localAdministrator),
+	}
+
+	stopOnDisconnect := pools.StopOnDisconnectConfiguration{
+		Status: pointer.To(pools.StopOnDisconnectEnableStatusDisabled),
+	}
+	if model.StopOnDisconnectGracePeriodMinutes > 0 {
+		stopOnDisconnect.Status = pointer.To(pools.StopOnDisconnectEnableStatusEnabled)
+		stopOnDisconnect.GracePeriodMinutes = pointer.To(model.StopOnDisconnectGracePeriodMinutes)
+	}
+	props.StopOnDisconnect = &stopOnDisconnect
+
+	return &props
+}
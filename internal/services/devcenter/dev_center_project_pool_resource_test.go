@@ -0,0 +1,184 @@
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DevCenterProjectPoolResource struct{}
+
+func TestAccDevCenterProjectPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_pool", "test")
+	r := DevCenterProjectPoolResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterProjectPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_pool", "test")
+	r := DevCenterProjectPoolResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevCenterProjectPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_pool", "test")
+	r := DevCenterProjectPoolResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("stop_on_disconnect_grace_period_minutes").HasValue("60"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DevCenterProjectPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := pools.ParsePoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.DevCenter.PoolsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (DevCenterProjectPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-devcenter-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dev_center" "test" {
+  name                = "acctest-dc-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_dev_center_project" "test" {
+  name                = "acctest-dcp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  dev_center_id       = azurerm_dev_center.test.id
+}
+
+resource "azurerm_dev_center_dev_box_definition" "test" {
+  name               = "acctest-dbd-%[1]d"
+  dev_center_id      = azurerm_dev_center.test.id
+  location           = azurerm_resource_group.test.location
+  image_reference_id = "${azurerm_dev_center.test.id}/galleries/default/images/microsoftwindowsdesktop_windows-ent-cpc_win11-22h2-ent-cpc-os"
+  sku_name           = "general_i_8c32gb256ssd_v2"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_dev_center_network_connection" "test" {
+  name                = "acctest-dcnc-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  subnet_id           = azurerm_subnet.test.id
+  domain_join_type    = "AzureADJoin"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DevCenterProjectPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_pool" "test" {
+  name                        = "acctest-pool-%[2]d"
+  dev_center_project_id       = azurerm_dev_center_project.test.id
+  location                    = azurerm_resource_group.test.location
+  dev_box_definition_name     = azurerm_dev_center_dev_box_definition.test.name
+  network_connection_name     = azurerm_dev_center_network_connection.test.name
+  local_administrator_enabled = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DevCenterProjectPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_pool" "import" {
+  name                        = azurerm_dev_center_project_pool.test.name
+  dev_center_project_id       = azurerm_dev_center_project_pool.test.dev_center_project_id
+  location                    = azurerm_dev_center_project_pool.test.location
+  dev_box_definition_name     = azurerm_dev_center_project_pool.test.dev_box_definition_name
+  network_connection_name     = azurerm_dev_center_project_pool.test.network_connection_name
+  local_administrator_enabled = azurerm_dev_center_project_pool.test.local_administrator_enabled
+}
+`, r.basic(data))
+}
+
+func (r DevCenterProjectPoolResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_pool" "test" {
+  name                                    = "acctest-pool-%[2]d"
+  dev_center_project_id                   = azurerm_dev_center_project.test.id
+  location                                = azurerm_resource_group.test.location
+  dev_box_definition_name                 = azurerm_dev_center_dev_box_definition.test.name
+  network_connection_name                 = azurerm_dev_center_network_connection.test.name
+  local_administrator_enabled             = false
+  stop_on_disconnect_grace_period_minutes = 60
+
+  tags = {
+    env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,216 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/schedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterProjectPoolScheduleModel struct {
+	DevCenterProjectPoolId string `tfschema:"dev_center_project_pool_id"`
+	Time                   string `tfschema:"time"`
+	TimeZone               string `tfschema:"time_zone"`
+	Enabled                bool   `tfschema:"enabled"`
+}
+
+type DevCenterProjectPoolScheduleResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*DevCenterProjectPoolScheduleResource)(nil)
+
+func (r DevCenterProjectPoolScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dev_center_project_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: schedules.ValidatePoolID,
+		},
+
+		"time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "`time` must be in 24 hour `HH:mm` format, e.g. `19:00`"),
+		},
+
+		"time_zone": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterProjectPoolScheduleResource) ModelObject() interface{} {
+	return &DevCenterProjectPoolScheduleModel{}
+}
+
+func (r DevCenterProjectPoolScheduleResource) ResourceType() string {
+	return "azurerm_dev_center_project_pool_schedule"
+}
+
+func (r DevCenterProjectPoolScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return schedules.ValidateScheduleID
+}
+
+func (r DevCenterProjectPoolScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.SchedulesClient
+
+			var model DevCenterProjectPoolScheduleModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			poolId, err := schedules.ParsePoolID(model.DevCenterProjectPoolId)
+			if err != nil {
+				return err
+			}
+
+			// the API only supports a single Stop Dev Box schedule per Pool, which must be named `default`
+			id := schedules.NewScheduleID(poolId.SubscriptionId, poolId.ResourceGroupName, poolId.ProjectName, poolId.PoolName, "default")
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := schedules.Schedule{
+				Properties: expandDevCenterProjectPoolScheduleProperties(model),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.SchedulesClient
+
+			id, err := schedules.ParseScheduleID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := DevCenterProjectPoolScheduleModel{
+				DevCenterProjectPoolId: schedules.NewPoolID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName, id.PoolName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					output.Time = utils.NormalizeNilableString(props.Time)
+					output.TimeZone = utils.NormalizeNilableString(props.TimeZone)
+
+					if props.State != nil {
+						output.Enabled = *props.State == schedules.ScheduleEnableStateEnabled
+					}
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.SchedulesClient
+
+			id, err := schedules.ParseScheduleID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectPoolScheduleModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := schedules.Schedule{
+				Properties: expandDevCenterProjectPoolScheduleProperties(model),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.SchedulesClient
+
+			id, err := schedules.ParseScheduleID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterProjectPoolScheduleProperties(model DevCenterProjectPoolScheduleModel) *schedules.ScheduleProperties {
+	state := schedules.ScheduleEnableStateDisabled
+	if model.Enabled {
+		state = schedules.ScheduleEnableStateEnabled
+	}
+
+	return &schedules.ScheduleProperties{
+		Frequency: pointer.To(schedules.ScheduledFrequencyDaily),
+		State:     pointer.To(state),
+		Time:      utils.String(model.Time),
+		TimeZone:  utils.String(model.TimeZone),
+		Type:      pointer.To(schedules.ScheduledTypeStopDevBox),
+	}
+}
@@ -0,0 +1,249 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devcenters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterProjectModel struct {
+	Name                   string            `tfschema:"name"`
+	ResourceGroup          string            `tfschema:"resource_group_name"`
+	Location               string            `tfschema:"location"`
+	DevCenterId            string            `tfschema:"dev_center_id"`
+	Description            string            `tfschema:"description"`
+	MaximumDevBoxesPerUser int64             `tfschema:"maximum_dev_boxes_per_user"`
+	Tags                   map[string]string `tfschema:"tags"`
+	DevCenterUri           string            `tfschema:"dev_center_uri"`
+}
+
+type DevCenterProjectResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*DevCenterProjectResource)(nil)
+
+func (r DevCenterProjectResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: devcenters.ValidateDevCenterID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"maximum_dev_boxes_per_user": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterProjectResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dev_center_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DevCenterProjectResource) ModelObject() interface{} {
+	return &DevCenterProjectModel{}
+}
+
+func (r DevCenterProjectResource) ResourceType() string {
+	return "azurerm_dev_center_project"
+}
+
+func (r DevCenterProjectResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return projects.ValidateProjectID
+}
+
+func (r DevCenterProjectResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.ProjectsClient
+
+			var model DevCenterProjectModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			id := projects.NewProjectID(meta.Client.Account.SubscriptionId, model.ResourceGroup, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := projects.Project{
+				Location:   location.Normalize(model.Location),
+				Properties: expandDevCenterProjectProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.ProjectsClient
+
+			id, err := projects.ParseProjectID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := DevCenterProjectModel{
+				Name:          id.ProjectName,
+				ResourceGroup: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					output.Description = utils.NormalizeNilableString(props.Description)
+					output.DevCenterUri = utils.NormalizeNilableString(props.DevCenterUri)
+
+					if props.DevCenterId != nil {
+						devCenterId, err := devcenters.ParseDevCenterIDInsensitively(*props.DevCenterId)
+						if err != nil {
+							return fmt.Errorf("parsing `dev_center_id`: %+v", err)
+						}
+						output.DevCenterId = devCenterId.ID()
+					}
+
+					if props.MaxDevBoxesPerUser != nil {
+						output.MaximumDevBoxesPerUser = *props.MaxDevBoxesPerUser
+					}
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r DevCenterProjectResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.ProjectsClient
+
+			id, err := projects.ParseProjectID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := projects.Project{
+				Location:   location.Normalize(model.Location),
+				Properties: expandDevCenterProjectProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.ProjectsClient
+
+			id, err := projects.ParseProjectID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterProjectProperties(model DevCenterProjectModel) *projects.ProjectProperties {
+	props := projects.ProjectProperties{
+		DevCenterId: utils.String(model.DevCenterId),
+	}
+
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+
+	if model.MaximumDevBoxesPerUser > 0 {
+		props.MaxDevBoxesPerUser = pointer.To(model.MaximumDevBoxesPerUser)
+	}
+
+	return &props
+}
@@ -0,0 +1,213 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devcenters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterModel struct {
+	Name          string                                     `tfschema:"name"`
+	ResourceGroup string                                     `tfschema:"resource_group_name"`
+	Location      string                                     `tfschema:"location"`
+	Identity      []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Tags          map[string]string                          `tfschema:"tags"`
+	DevCenterUri  string                                     `tfschema:"dev_center_uri"`
+}
+
+type DevCenterResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*DevCenterResource)(nil)
+
+func (r DevCenterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dev_center_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DevCenterResource) ModelObject() interface{} {
+	return &DevCenterModel{}
+}
+
+func (r DevCenterResource) ResourceType() string {
+	return "azurerm_dev_center"
+}
+
+func (r DevCenterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return devcenters.ValidateDevCenterID
+}
+
+func (r DevCenterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.DevCentersClient
+
+			var model DevCenterModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			id := devcenters.NewDevCenterID(meta.Client.Account.SubscriptionId, model.ResourceGroup, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := devcenters.DevCenter{
+				Location: location.Normalize(model.Location),
+				Tags:     &model.Tags,
+			}
+
+			payload.Identity, err = identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.DevCentersClient
+
+			id, err := devcenters.ParseDevCenterID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := DevCenterModel{
+				Name:          id.DevCenterName,
+				ResourceGroup: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				output.Identity = *flattenedIdentity
+
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					output.DevCenterUri = utils.NormalizeNilableString(props.DevCenterUri)
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r DevCenterResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.DevCentersClient
+
+			id, err := devcenters.ParseDevCenterID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := devcenters.DevCenter{
+				Location: location.Normalize(model.Location),
+				Tags:     &model.Tags,
+			}
+
+			payload.Identity, err = identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevCenter.DevCentersClient
+
+			id, err := devcenters.ParseDevCenterID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package catalogs
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CatalogsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCatalogsClientWithBaseURI(endpoint string) CatalogsClient {
+	return CatalogsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,11 @@
+package catalogs
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Catalog struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *CatalogProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
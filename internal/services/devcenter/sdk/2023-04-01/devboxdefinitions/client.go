@@ -0,0 +1,18 @@
+package devboxdefinitions
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DevBoxDefinitionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDevBoxDefinitionsClientWithBaseURI(endpoint string) DevBoxDefinitionsClient {
+	return DevBoxDefinitionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,34 @@
+package devboxdefinitions
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type HibernateSupport string
+
+const (
+	HibernateSupportDisabled HibernateSupport = "Disabled"
+	HibernateSupportEnabled  HibernateSupport = "Enabled"
+)
+
+func PossibleValuesForHibernateSupport() []string {
+	return []string{
+		string(HibernateSupportDisabled),
+		string(HibernateSupportEnabled),
+	}
+}
+
+func parseHibernateSupport(input string) (*HibernateSupport, error) {
+	vals := map[string]HibernateSupport{
+		"disabled": HibernateSupportDisabled,
+		"enabled":  HibernateSupportEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HibernateSupport(input)
+	return &out, nil
+}
@@ -0,0 +1,140 @@
+package devboxdefinitions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+var _ resourceids.ResourceId = DevBoxDefinitionId{}
+
+// DevBoxDefinitionId is a struct representing the Resource ID for a Dev Box Definition
+type DevBoxDefinitionId struct {
+	SubscriptionId       string
+	ResourceGroupName    string
+	DevCenterName        string
+	DevBoxDefinitionName string
+}
+
+// NewDevBoxDefinitionID returns a new DevBoxDefinitionId struct
+func NewDevBoxDefinitionID(subscriptionId string, resourceGroupName string, devCenterName string, devBoxDefinitionName string) DevBoxDefinitionId {
+	return DevBoxDefinitionId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroupName:    resourceGroupName,
+		DevCenterName:        devCenterName,
+		DevBoxDefinitionName: devBoxDefinitionName,
+	}
+}
+
+// ParseDevBoxDefinitionID parses 'input' into a DevBoxDefinitionId
+func ParseDevBoxDefinitionID(input string) (*DevBoxDefinitionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DevBoxDefinitionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DevBoxDefinitionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DevCenterName, ok = parsed.Parsed["devCenterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'devCenterName' was not found in the resource id %q", input)
+	}
+
+	if id.DevBoxDefinitionName, ok = parsed.Parsed["devBoxDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'devBoxDefinitionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseDevBoxDefinitionIDInsensitively parses 'input' case-insensitively into a DevBoxDefinitionId
+// note: this method should only be used for API response data and not user input
+func ParseDevBoxDefinitionIDInsensitively(input string) (*DevBoxDefinitionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DevBoxDefinitionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DevBoxDefinitionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DevCenterName, ok = parsed.Parsed["devCenterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'devCenterName' was not found in the resource id %q", input)
+	}
+
+	if id.DevBoxDefinitionName, ok = parsed.Parsed["devBoxDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'devBoxDefinitionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateDevBoxDefinitionID checks that 'input' can be parsed as a Dev Box Definition ID
+func ValidateDevBoxDefinitionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseDevBoxDefinitionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Dev Box Definition ID
+func (id DevBoxDefinitionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevCenter/devCenters/%s/devboxdefinitions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.DevCenterName, id.DevBoxDefinitionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Dev Box Definition ID
+func (id DevBoxDefinitionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevCenter", "Microsoft.DevCenter", "Microsoft.DevCenter"),
+		resourceids.StaticSegment("staticDevCenters", "devCenters", "devCenters"),
+		resourceids.UserSpecifiedSegment("devCenterName", "devCenterValue"),
+		resourceids.StaticSegment("staticDevBoxDefinitions", "devboxdefinitions", "devboxdefinitions"),
+		resourceids.UserSpecifiedSegment("devBoxDefinitionName", "devBoxDefinitionValue"),
+	}
+}
+
+// String returns a human-readable description of this Dev Box Definition ID
+func (id DevBoxDefinitionId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription Id: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Dev Center Name: %q", id.DevCenterName),
+		fmt.Sprintf("Dev Box Definition Name: %q", id.DevBoxDefinitionName),
+	}
+	return fmt.Sprintf("Dev Box Definition (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,12 @@
+package devboxdefinitions
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DevBoxDefinitionProperties struct {
+	HibernateSupport  *HibernateSupport `json:"hibernateSupport,omitempty"`
+	ImageReference    *ImageReference   `json:"imageReference,omitempty"`
+	OsStorageType     *string           `json:"osStorageType,omitempty"`
+	ProvisioningState *string           `json:"provisioningState,omitempty"`
+	Sku               *Sku              `json:"sku,omitempty"`
+}
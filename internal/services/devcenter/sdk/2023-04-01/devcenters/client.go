@@ -0,0 +1,18 @@
+package devcenters
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DevCentersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDevCentersClientWithBaseURI(endpoint string) DevCentersClient {
+	return DevCentersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,18 @@
+package environmenttypes
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type EnvironmentTypesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewEnvironmentTypesClientWithBaseURI(endpoint string) EnvironmentTypesClient {
+	return EnvironmentTypesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,140 @@
+package environmenttypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+var _ resourceids.ResourceId = EnvironmentTypeId{}
+
+// EnvironmentTypeId is a struct representing the Resource ID for a Environment Type
+type EnvironmentTypeId struct {
+	SubscriptionId      string
+	ResourceGroupName   string
+	DevCenterName       string
+	EnvironmentTypeName string
+}
+
+// NewEnvironmentTypeID returns a new EnvironmentTypeId struct
+func NewEnvironmentTypeID(subscriptionId string, resourceGroupName string, devCenterName string, environmentTypeName string) EnvironmentTypeId {
+	return EnvironmentTypeId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroupName:   resourceGroupName,
+		DevCenterName:       devCenterName,
+		EnvironmentTypeName: environmentTypeName,
+	}
+}
+
+// ParseEnvironmentTypeID parses 'input' into a EnvironmentTypeId
+func ParseEnvironmentTypeID(input string) (*EnvironmentTypeId, error) {
+	parser := resourceids.NewParserFromResourceIdType(EnvironmentTypeId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := EnvironmentTypeId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DevCenterName, ok = parsed.Parsed["devCenterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'devCenterName' was not found in the resource id %q", input)
+	}
+
+	if id.EnvironmentTypeName, ok = parsed.Parsed["environmentTypeName"]; !ok {
+		return nil, fmt.Errorf("the segment 'environmentTypeName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseEnvironmentTypeIDInsensitively parses 'input' case-insensitively into a EnvironmentTypeId
+// note: this method should only be used for API response data and not user input
+func ParseEnvironmentTypeIDInsensitively(input string) (*EnvironmentTypeId, error) {
+	parser := resourceids.NewParserFromResourceIdType(EnvironmentTypeId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := EnvironmentTypeId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DevCenterName, ok = parsed.Parsed["devCenterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'devCenterName' was not found in the resource id %q", input)
+	}
+
+	if id.EnvironmentTypeName, ok = parsed.Parsed["environmentTypeName"]; !ok {
+		return nil, fmt.Errorf("the segment 'environmentTypeName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateEnvironmentTypeID checks that 'input' can be parsed as a Environment Type ID
+func ValidateEnvironmentTypeID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseEnvironmentTypeID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Environment Type ID
+func (id EnvironmentTypeId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevCenter/devCenters/%s/environmentTypes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.DevCenterName, id.EnvironmentTypeName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Environment Type ID
+func (id EnvironmentTypeId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevCenter", "Microsoft.DevCenter", "Microsoft.DevCenter"),
+		resourceids.StaticSegment("staticDevCenters", "devCenters", "devCenters"),
+		resourceids.UserSpecifiedSegment("devCenterName", "devCenterValue"),
+		resourceids.StaticSegment("staticEnvironmentTypes", "environmentTypes", "environmentTypes"),
+		resourceids.UserSpecifiedSegment("environmentTypeName", "environmentTypeValue"),
+	}
+}
+
+// String returns a human-readable description of this Environment Type ID
+func (id EnvironmentTypeId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription Id: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Dev Center Name: %q", id.DevCenterName),
+		fmt.Sprintf("Environment Type Name: %q", id.EnvironmentTypeName),
+	}
+	return fmt.Sprintf("Environment Type (%s)", strings.Join(components, "\n"))
+}
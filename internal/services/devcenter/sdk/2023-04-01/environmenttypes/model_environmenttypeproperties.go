@@ -0,0 +1,8 @@
+package environmenttypes
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type EnvironmentTypeProperties struct {
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
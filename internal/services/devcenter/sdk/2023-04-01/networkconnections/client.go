@@ -0,0 +1,18 @@
+package networkconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type NetworkConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkConnectionsClientWithBaseURI(endpoint string) NetworkConnectionsClient {
+	return NetworkConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,34 @@
+package networkconnections
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DomainJoinType string
+
+const (
+	DomainJoinTypeAzureADJoin       DomainJoinType = "AzureADJoin"
+	DomainJoinTypeHybridAzureADJoin DomainJoinType = "HybridAzureADJoin"
+)
+
+func PossibleValuesForDomainJoinType() []string {
+	return []string{
+		string(DomainJoinTypeAzureADJoin),
+		string(DomainJoinTypeHybridAzureADJoin),
+	}
+}
+
+func parseDomainJoinType(input string) (*DomainJoinType, error) {
+	vals := map[string]DomainJoinType{
+		"azureadjoin":       DomainJoinTypeAzureADJoin,
+		"hybridazureadjoin": DomainJoinTypeHybridAzureADJoin,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DomainJoinType(input)
+	return &out, nil
+}
@@ -0,0 +1,127 @@
+package networkconnections
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+var _ resourceids.ResourceId = NetworkConnectionId{}
+
+// NetworkConnectionId is a struct representing the Resource ID for a Network Connection
+type NetworkConnectionId struct {
+	SubscriptionId        string
+	ResourceGroupName     string
+	NetworkConnectionName string
+}
+
+// NewNetworkConnectionID returns a new NetworkConnectionId struct
+func NewNetworkConnectionID(subscriptionId string, resourceGroupName string, networkConnectionName string) NetworkConnectionId {
+	return NetworkConnectionId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroupName:     resourceGroupName,
+		NetworkConnectionName: networkConnectionName,
+	}
+}
+
+// ParseNetworkConnectionID parses 'input' into a NetworkConnectionId
+func ParseNetworkConnectionID(input string) (*NetworkConnectionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(NetworkConnectionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := NetworkConnectionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkConnectionName, ok = parsed.Parsed["networkConnectionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkConnectionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseNetworkConnectionIDInsensitively parses 'input' case-insensitively into a NetworkConnectionId
+// note: this method should only be used for API response data and not user input
+func ParseNetworkConnectionIDInsensitively(input string) (*NetworkConnectionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(NetworkConnectionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := NetworkConnectionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkConnectionName, ok = parsed.Parsed["networkConnectionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkConnectionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateNetworkConnectionID checks that 'input' can be parsed as a Network Connection ID
+func ValidateNetworkConnectionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseNetworkConnectionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Network Connection ID
+func (id NetworkConnectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevCenter/networkConnections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NetworkConnectionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Network Connection ID
+func (id NetworkConnectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevCenter", "Microsoft.DevCenter", "Microsoft.DevCenter"),
+		resourceids.StaticSegment("staticNetworkConnections", "networkConnections", "networkConnections"),
+		resourceids.UserSpecifiedSegment("networkConnectionName", "networkConnectionValue"),
+	}
+}
+
+// String returns a human-readable description of this Network Connection ID
+func (id NetworkConnectionId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription Id: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Network Connection Name: %q", id.NetworkConnectionName),
+	}
+	return fmt.Sprintf("Network Connection (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,87 @@
+package pools
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LicenseType string
+
+const (
+	LicenseTypeWindowsClient LicenseType = "Windows_Client"
+)
+
+func PossibleValuesForLicenseType() []string {
+	return []string{
+		string(LicenseTypeWindowsClient),
+	}
+}
+
+func parseLicenseType(input string) (*LicenseType, error) {
+	vals := map[string]LicenseType{
+		"windows_client": LicenseTypeWindowsClient,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LicenseType(input)
+	return &out, nil
+}
+
+type LocalAdminStatus string
+
+const (
+	LocalAdminStatusDisabled LocalAdminStatus = "Disabled"
+	LocalAdminStatusEnabled  LocalAdminStatus = "Enabled"
+)
+
+func PossibleValuesForLocalAdminStatus() []string {
+	return []string{
+		string(LocalAdminStatusDisabled),
+		string(LocalAdminStatusEnabled),
+	}
+}
+
+func parseLocalAdminStatus(input string) (*LocalAdminStatus, error) {
+	vals := map[string]LocalAdminStatus{
+		"disabled": LocalAdminStatusDisabled,
+		"enabled":  LocalAdminStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LocalAdminStatus(input)
+	return &out, nil
+}
+
+type StopOnDisconnectEnableStatus string
+
+const (
+	StopOnDisconnectEnableStatusDisabled StopOnDisconnectEnableStatus = "Disabled"
+	StopOnDisconnectEnableStatusEnabled  StopOnDisconnectEnableStatus = "Enabled"
+)
+
+func PossibleValuesForStopOnDisconnectEnableStatus() []string {
+	return []string{
+		string(StopOnDisconnectEnableStatusDisabled),
+		string(StopOnDisconnectEnableStatusEnabled),
+	}
+}
+
+func parseStopOnDisconnectEnableStatus(input string) (*StopOnDisconnectEnableStatus, error) {
+	vals := map[string]StopOnDisconnectEnableStatus{
+		"disabled": StopOnDisconnectEnableStatusDisabled,
+		"enabled":  StopOnDisconnectEnableStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StopOnDisconnectEnableStatus(input)
+	return &out, nil
+}
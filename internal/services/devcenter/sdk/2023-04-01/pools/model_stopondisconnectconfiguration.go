@@ -0,0 +1,9 @@
+package pools
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type StopOnDisconnectConfiguration struct {
+	GracePeriodMinutes *int64                        `json:"gracePeriodMinutes,omitempty"`
+	Status             *StopOnDisconnectEnableStatus `json:"status,omitempty"`
+}
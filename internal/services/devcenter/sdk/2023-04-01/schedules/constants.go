@@ -0,0 +1,84 @@
+package schedules
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ScheduleEnableState string
+
+const (
+	ScheduleEnableStateDisabled ScheduleEnableState = "Disabled"
+	ScheduleEnableStateEnabled  ScheduleEnableState = "Enabled"
+)
+
+func PossibleValuesForScheduleEnableState() []string {
+	return []string{
+		string(ScheduleEnableStateDisabled),
+		string(ScheduleEnableStateEnabled),
+	}
+}
+
+func parseScheduleEnableState(input string) (*ScheduleEnableState, error) {
+	vals := map[string]ScheduleEnableState{
+		"disabled": ScheduleEnableStateDisabled,
+		"enabled":  ScheduleEnableStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ScheduleEnableState(input)
+	return &out, nil
+}
+
+type ScheduledFrequency string
+
+const (
+	ScheduledFrequencyDaily ScheduledFrequency = "Daily"
+)
+
+func PossibleValuesForScheduledFrequency() []string {
+	return []string{
+		string(ScheduledFrequencyDaily),
+	}
+}
+
+func parseScheduledFrequency(input string) (*ScheduledFrequency, error) {
+	vals := map[string]ScheduledFrequency{
+		"daily": ScheduledFrequencyDaily,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ScheduledFrequency(input)
+	return &out, nil
+}
+
+type ScheduledType string
+
+const (
+	ScheduledTypeStopDevBox ScheduledType = "StopDevBox"
+)
+
+func PossibleValuesForScheduledType() []string {
+	return []string{
+		string(ScheduledTypeStopDevBox),
+	}
+}
+
+func parseScheduledType(input string) (*ScheduledType, error) {
+	vals := map[string]ScheduledType{
+		"stopdevbox": ScheduledTypeStopDevBox,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ScheduledType(input)
+	return &out, nil
+}
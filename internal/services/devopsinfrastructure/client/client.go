@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devopsinfrastructure/sdk/2024-10-19/pools"
+)
+
+type Client struct {
+	PoolsClient *pools.PoolsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	poolsClient := pools.NewPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&poolsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		PoolsClient: &poolsClient,
+	}
+}
@@ -0,0 +1,620 @@
+package devopsinfrastructure
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devopsinfrastructure/sdk/2024-10-19/pools"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DevOpsPoolModel struct {
+	Name                string                     `tfschema:"name"`
+	ResourceGroupName   string                     `tfschema:"resource_group_name"`
+	Location            string                     `tfschema:"location"`
+	AgentProfile        []AgentProfileModel        `tfschema:"agent_profile"`
+	DevCenterProjectId  string                     `tfschema:"dev_center_project_id"`
+	FabricProfile       []FabricProfileModel       `tfschema:"fabric_profile"`
+	MaximumConcurrency  int64                      `tfschema:"maximum_concurrency"`
+	OrganizationProfile []OrganizationProfileModel `tfschema:"organization_profile"`
+	Tags                map[string]string          `tfschema:"tags"`
+}
+
+type AgentProfileModel struct {
+	Kind                string `tfschema:"kind"`
+	GracePeriodTimeSpan string `tfschema:"grace_period_time_span"`
+	MaxAgentLifetime    string `tfschema:"max_agent_lifetime"`
+}
+
+type FabricProfileModel struct {
+	Images                   []FabricProfileImageModel    `tfschema:"image"`
+	SkuName                  string                       `tfschema:"sku_name"`
+	DataDisks                []FabricProfileDataDiskModel `tfschema:"data_disk"`
+	OsDiskStorageAccountType string                       `tfschema:"os_disk_storage_account_type"`
+	SubnetId                 string                       `tfschema:"subnet_id"`
+}
+
+type FabricProfileImageModel struct {
+	Aliases            []string `tfschema:"aliases"`
+	Buffer             string   `tfschema:"buffer"`
+	ResourceId         string   `tfschema:"resource_id"`
+	WellKnownImageName string   `tfschema:"well_known_image_name"`
+}
+
+type FabricProfileDataDiskModel struct {
+	Caching            string `tfschema:"caching"`
+	DiskSizeGb         int64  `tfschema:"disk_size_gb"`
+	DriveLetter        string `tfschema:"drive_letter"`
+	StorageAccountType string `tfschema:"storage_account_type"`
+}
+
+type OrganizationProfileModel struct {
+	Organizations []OrganizationModel `tfschema:"organization"`
+}
+
+type OrganizationModel struct {
+	Url         string   `tfschema:"url"`
+	Projects    []string `tfschema:"projects"`
+	Parallelism int64    `tfschema:"parallelism"`
+}
+
+type DevOpsPoolResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*DevOpsPoolResource)(nil)
+
+func (r DevOpsPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-.]{1,42}[a-zA-Z0-9]$`),
+				"the name must be between 3 and 44 characters, begin and end with an alphanumeric character and may only contain alphanumeric characters, dashes and periods",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"agent_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"kind": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Stateful",
+							"Stateless",
+						}, false),
+					},
+
+					"grace_period_time_span": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"max_agent_lifetime": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"dev_center_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: projects.ValidateProjectID,
+		},
+
+		"fabric_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"image": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"aliases": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"buffer": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      "*",
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"resource_id": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"well_known_image_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"sku_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"data_disk": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"caching": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(pools.CachingTypeNone),
+									ValidateFunc: validation.StringInSlice(pools.PossibleValuesForCachingType(), false),
+								},
+
+								"disk_size_gb": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      1024,
+									ValidateFunc: validation.IntBetween(1, 32767),
+								},
+
+								"drive_letter": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Z]$`), "the drive letter must be a single uppercase letter"),
+								},
+
+								"storage_account_type": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(pools.StorageAccountTypePremiumZRS),
+									ValidateFunc: validation.StringInSlice(pools.PossibleValuesForStorageAccountType(), false),
+								},
+							},
+						},
+					},
+
+					"os_disk_storage_account_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(pools.OsDiskStorageAccountTypePremium),
+						ValidateFunc: validation.StringInSlice(pools.PossibleValuesForOsDiskStorageAccountType(), false),
+					},
+
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+				},
+			},
+		},
+
+		"maximum_concurrency": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+
+		"organization_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"organization": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"url": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsURLWithHTTPS,
+								},
+
+								"projects": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"parallelism": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(1, 10000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevOpsPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevOpsPoolResource) ModelObject() interface{} {
+	return &DevOpsPoolModel{}
+}
+
+func (r DevOpsPoolResource) ResourceType() string {
+	return "azurerm_dev_ops_pool"
+}
+
+func (r DevOpsPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return pools.ValidatePoolID
+}
+
+func (r DevOpsPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevOpsInfrastructure.PoolsClient
+			subscriptionId := meta.Client.Account.SubscriptionId
+
+			var model DevOpsPoolModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			id := pools.NewPoolID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := pools.Pool{
+				Location:   location.Normalize(model.Location),
+				Properties: expandDevOpsPoolProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevOpsPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevOpsInfrastructure.PoolsClient
+
+			id, err := pools.ParsePoolID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := DevOpsPoolModel{
+				Name:              id.PoolName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					output.AgentProfile = flattenDevOpsPoolAgentProfile(props.AgentProfile)
+					output.DevCenterProjectId = props.DevCenterProjectResourceId
+					output.FabricProfile = flattenDevOpsPoolFabricProfile(props.FabricProfile)
+					output.MaximumConcurrency = props.MaximumConcurrency
+					output.OrganizationProfile = flattenDevOpsPoolOrganizationProfile(props.OrganizationProfile)
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r DevOpsPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevOpsInfrastructure.PoolsClient
+
+			id, err := pools.ParsePoolID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevOpsPoolModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := pools.Pool{
+				Location:   location.Normalize(model.Location),
+				Properties: expandDevOpsPoolProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevOpsPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.DevOpsInfrastructure.PoolsClient
+
+			id, err := pools.ParsePoolID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevOpsPoolProperties(model DevOpsPoolModel) *pools.PoolProperties {
+	return &pools.PoolProperties{
+		AgentProfile:               expandDevOpsPoolAgentProfile(model.AgentProfile),
+		DevCenterProjectResourceId: model.DevCenterProjectId,
+		FabricProfile:              expandDevOpsPoolFabricProfile(model.FabricProfile),
+		MaximumConcurrency:         model.MaximumConcurrency,
+		OrganizationProfile:        expandDevOpsPoolOrganizationProfile(model.OrganizationProfile),
+	}
+}
+
+func expandDevOpsPoolAgentProfile(input []AgentProfileModel) pools.AgentProfile {
+	if len(input) == 0 {
+		return pools.AgentProfile{}
+	}
+
+	profile := pools.AgentProfile{
+		Kind: input[0].Kind,
+	}
+	if input[0].GracePeriodTimeSpan != "" {
+		profile.GracePeriodTimeSpan = pointer.To(input[0].GracePeriodTimeSpan)
+	}
+	if input[0].MaxAgentLifetime != "" {
+		profile.MaxAgentLifetime = pointer.To(input[0].MaxAgentLifetime)
+	}
+	return profile
+}
+
+func flattenDevOpsPoolAgentProfile(input pools.AgentProfile) []AgentProfileModel {
+	return []AgentProfileModel{
+		{
+			Kind:                input.Kind,
+			GracePeriodTimeSpan: pointer.From(input.GracePeriodTimeSpan),
+			MaxAgentLifetime:    pointer.From(input.MaxAgentLifetime),
+		},
+	}
+}
+
+func expandDevOpsPoolFabricProfile(input []FabricProfileModel) pools.FabricProfile {
+	if len(input) == 0 {
+		return pools.FabricProfile{}
+	}
+
+	profile := pools.FabricProfile{
+		Kind:   "Vmss",
+		Images: expandDevOpsPoolImages(input[0].Images),
+		Sku: pools.DevOpsAzureSku{
+			Name: input[0].SkuName,
+		},
+		StorageProfile: &pools.StorageProfile{
+			DataDisks:                expandDevOpsPoolDataDisks(input[0].DataDisks),
+			OsDiskStorageAccountType: pointer.To(pools.OsDiskStorageAccountType(input[0].OsDiskStorageAccountType)),
+		},
+	}
+	if input[0].SubnetId != "" {
+		profile.NetworkProfile = &pools.NetworkProfile{
+			SubnetId: input[0].SubnetId,
+		}
+	}
+	return profile
+}
+
+func flattenDevOpsPoolFabricProfile(input pools.FabricProfile) []FabricProfileModel {
+	output := FabricProfileModel{
+		Images:  flattenDevOpsPoolImages(input.Images),
+		SkuName: input.Sku.Name,
+	}
+	if storageProfile := input.StorageProfile; storageProfile != nil {
+		output.DataDisks = flattenDevOpsPoolDataDisks(storageProfile.DataDisks)
+		if storageProfile.OsDiskStorageAccountType != nil {
+			output.OsDiskStorageAccountType = string(*storageProfile.OsDiskStorageAccountType)
+		}
+	}
+	if networkProfile := input.NetworkProfile; networkProfile != nil {
+		output.SubnetId = networkProfile.SubnetId
+	}
+	return []FabricProfileModel{output}
+}
+
+func expandDevOpsPoolImages(input []FabricProfileImageModel) []pools.PoolImage {
+	images := make([]pools.PoolImage, 0)
+	for _, item := range input {
+		image := pools.PoolImage{
+			Buffer: pointer.To(item.Buffer),
+		}
+		if len(item.Aliases) > 0 {
+			image.Aliases = pointer.To(item.Aliases)
+		}
+		if item.ResourceId != "" {
+			image.ResourceId = pointer.To(item.ResourceId)
+		}
+		if item.WellKnownImageName != "" {
+			image.WellKnownImageName = pointer.To(item.WellKnownImageName)
+		}
+		images = append(images, image)
+	}
+	return images
+}
+
+func flattenDevOpsPoolImages(input []pools.PoolImage) []FabricProfileImageModel {
+	output := make([]FabricProfileImageModel, 0)
+	for _, item := range input {
+		output = append(output, FabricProfileImageModel{
+			Aliases:            pointer.From(item.Aliases),
+			Buffer:             pointer.From(item.Buffer),
+			ResourceId:         pointer.From(item.ResourceId),
+			WellKnownImageName: pointer.From(item.WellKnownImageName),
+		})
+	}
+	return output
+}
+
+func expandDevOpsPoolDataDisks(input []FabricProfileDataDiskModel) *[]pools.DataDisk {
+	if len(input) == 0 {
+		return nil
+	}
+
+	dataDisks := make([]pools.DataDisk, 0)
+	for _, item := range input {
+		dataDisk := pools.DataDisk{
+			Caching:            pointer.To(pools.CachingType(item.Caching)),
+			DiskSizeGiB:        pointer.To(item.DiskSizeGb),
+			StorageAccountType: pointer.To(pools.StorageAccountType(item.StorageAccountType)),
+		}
+		if item.DriveLetter != "" {
+			dataDisk.DriveLetter = pointer.To(item.DriveLetter)
+		}
+		dataDisks = append(dataDisks, dataDisk)
+	}
+	return &dataDisks
+}
+
+func flattenDevOpsPoolDataDisks(input *[]pools.DataDisk) []FabricProfileDataDiskModel {
+	output := make([]FabricProfileDataDiskModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		dataDisk := FabricProfileDataDiskModel{
+			DriveLetter: pointer.From(item.DriveLetter),
+			DiskSizeGb:  pointer.From(item.DiskSizeGiB),
+		}
+		if item.Caching != nil {
+			dataDisk.Caching = string(*item.Caching)
+		}
+		if item.StorageAccountType != nil {
+			dataDisk.StorageAccountType = string(*item.StorageAccountType)
+		}
+		output = append(output, dataDisk)
+	}
+	return output
+}
+
+func expandDevOpsPoolOrganizationProfile(input []OrganizationProfileModel) pools.OrganizationProfile {
+	if len(input) == 0 {
+		return pools.OrganizationProfile{}
+	}
+
+	organizations := make([]pools.Organization, 0)
+	for _, item := range input[0].Organizations {
+		organization := pools.Organization{
+			Url: item.Url,
+		}
+		if len(item.Projects) > 0 {
+			organization.Projects = pointer.To(item.Projects)
+		}
+		if item.Parallelism > 0 {
+			organization.Parallelism = pointer.To(item.Parallelism)
+		}
+		organizations = append(organizations, organization)
+	}
+
+	return pools.OrganizationProfile{
+		Kind:          "AzureDevOps",
+		Organizations: organizations,
+	}
+}
+
+func flattenDevOpsPoolOrganizationProfile(input pools.OrganizationProfile) []OrganizationProfileModel {
+	organizations := make([]OrganizationModel, 0)
+	for _, item := range input.Organizations {
+		organizations = append(organizations, OrganizationModel{
+			Url:         item.Url,
+			Projects:    pointer.From(item.Projects),
+			Parallelism: pointer.From(item.Parallelism),
+		})
+	}
+
+	return []OrganizationProfileModel{
+		{
+			Organizations: organizations,
+		},
+	}
+}
@@ -0,0 +1,262 @@
+package devopsinfrastructure_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devopsinfrastructure/sdk/2024-10-19/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevOpsPoolResource struct{}
+
+func TestAccDevOpsPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_ops_pool", "test")
+	r := DevOpsPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevOpsPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_ops_pool", "test")
+	r := DevOpsPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevOpsPool_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_ops_pool", "test")
+	r := DevOpsPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevOpsPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_ops_pool", "test")
+	r := DevOpsPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DevOpsPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := pools.ParsePoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevOpsInfrastructure.PoolsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevOpsPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_ops_pool" "test" {
+  name                  = "acctest-pool-%[2]d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  dev_center_project_id = azurerm_dev_center_project.test.id
+  maximum_concurrency   = 1
+
+  agent_profile {
+    kind = "Stateless"
+  }
+
+  fabric_profile {
+    sku_name = "Standard_D2ads_v5"
+
+    image {
+      well_known_image_name = "ubuntu-22.04/latest"
+    }
+  }
+
+  organization_profile {
+    organization {
+      url = "https://dev.azure.com/example"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DevOpsPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[2]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_dev_ops_pool" "test" {
+  name                  = "acctest-pool-%[2]d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  dev_center_project_id = azurerm_dev_center_project.test.id
+  maximum_concurrency   = 2
+
+  agent_profile {
+    kind                   = "Stateful"
+    max_agent_lifetime     = "7.00:00:00"
+    grace_period_time_span = "00:30:00"
+  }
+
+  fabric_profile {
+    sku_name                     = "Standard_D4ads_v5"
+    os_disk_storage_account_type = "StandardSSD"
+    subnet_id                    = azurerm_subnet.test.id
+
+    image {
+      well_known_image_name = "ubuntu-22.04/latest"
+      buffer                = "100"
+    }
+
+    data_disk {
+      caching              = "ReadWrite"
+      disk_size_gb         = 256
+      drive_letter         = "F"
+      storage_account_type = "Standard_LRS"
+    }
+  }
+
+  organization_profile {
+    organization {
+      url         = "https://dev.azure.com/example"
+      projects    = ["exampleProject"]
+      parallelism = 2
+    }
+  }
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DevOpsPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_ops_pool" "import" {
+  name                  = azurerm_dev_ops_pool.test.name
+  resource_group_name   = azurerm_dev_ops_pool.test.resource_group_name
+  location              = azurerm_dev_ops_pool.test.location
+  dev_center_project_id = azurerm_dev_ops_pool.test.dev_center_project_id
+  maximum_concurrency   = azurerm_dev_ops_pool.test.maximum_concurrency
+
+  agent_profile {
+    kind = "Stateless"
+  }
+
+  fabric_profile {
+    sku_name = "Standard_D2ads_v5"
+
+    image {
+      well_known_image_name = "ubuntu-22.04/latest"
+    }
+  }
+
+  organization_profile {
+    organization {
+      url = "https://dev.azure.com/example"
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (DevOpsPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-devopspool-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dev_center" "test" {
+  name                = "acctest-dc-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_dev_center_project" "test" {
+  name                = "acctest-dcp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  dev_center_id       = azurerm_dev_center.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
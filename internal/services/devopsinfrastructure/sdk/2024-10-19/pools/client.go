@@ -0,0 +1,18 @@
+package pools
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PoolsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPoolsClientWithBaseURI(endpoint string) PoolsClient {
+	return PoolsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
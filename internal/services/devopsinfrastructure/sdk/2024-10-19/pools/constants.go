@@ -0,0 +1,179 @@
+package pools
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AzureDevOpsPermissionType string
+
+const (
+	AzureDevOpsPermissionTypeCreatorOnly      AzureDevOpsPermissionType = "CreatorOnly"
+	AzureDevOpsPermissionTypeInherit          AzureDevOpsPermissionType = "Inherit"
+	AzureDevOpsPermissionTypeSpecificAccounts AzureDevOpsPermissionType = "SpecificAccounts"
+)
+
+func PossibleValuesForAzureDevOpsPermissionType() []string {
+	return []string{
+		string(AzureDevOpsPermissionTypeCreatorOnly),
+		string(AzureDevOpsPermissionTypeInherit),
+		string(AzureDevOpsPermissionTypeSpecificAccounts),
+	}
+}
+
+func parseAzureDevOpsPermissionType(input string) (*AzureDevOpsPermissionType, error) {
+	vals := map[string]AzureDevOpsPermissionType{
+		"creatoronly":      AzureDevOpsPermissionTypeCreatorOnly,
+		"inherit":          AzureDevOpsPermissionTypeInherit,
+		"specificaccounts": AzureDevOpsPermissionTypeSpecificAccounts,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AzureDevOpsPermissionType(input)
+	return &out, nil
+}
+
+type CachingType string
+
+const (
+	CachingTypeNone      CachingType = "None"
+	CachingTypeReadOnly  CachingType = "ReadOnly"
+	CachingTypeReadWrite CachingType = "ReadWrite"
+)
+
+func PossibleValuesForCachingType() []string {
+	return []string{
+		string(CachingTypeNone),
+		string(CachingTypeReadOnly),
+		string(CachingTypeReadWrite),
+	}
+}
+
+func parseCachingType(input string) (*CachingType, error) {
+	vals := map[string]CachingType{
+		"none":      CachingTypeNone,
+		"readonly":  CachingTypeReadOnly,
+		"readwrite": CachingTypeReadWrite,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CachingType(input)
+	return &out, nil
+}
+
+type OsDiskStorageAccountType string
+
+const (
+	OsDiskStorageAccountTypePremium     OsDiskStorageAccountType = "Premium"
+	OsDiskStorageAccountTypeStandard    OsDiskStorageAccountType = "Standard"
+	OsDiskStorageAccountTypeStandardSSD OsDiskStorageAccountType = "StandardSSD"
+)
+
+func PossibleValuesForOsDiskStorageAccountType() []string {
+	return []string{
+		string(OsDiskStorageAccountTypePremium),
+		string(OsDiskStorageAccountTypeStandard),
+		string(OsDiskStorageAccountTypeStandardSSD),
+	}
+}
+
+func parseOsDiskStorageAccountType(input string) (*OsDiskStorageAccountType, error) {
+	vals := map[string]OsDiskStorageAccountType{
+		"premium":     OsDiskStorageAccountTypePremium,
+		"standard":    OsDiskStorageAccountTypeStandard,
+		"standardssd": OsDiskStorageAccountTypeStandardSSD,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OsDiskStorageAccountType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted     ProvisioningState = "Accepted"
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateProvisioning ProvisioningState = "Provisioning"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateProvisioning),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":     ProvisioningStateAccepted,
+		"canceled":     ProvisioningStateCanceled,
+		"deleting":     ProvisioningStateDeleting,
+		"failed":       ProvisioningStateFailed,
+		"provisioning": ProvisioningStateProvisioning,
+		"succeeded":    ProvisioningStateSucceeded,
+		"updating":     ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type StorageAccountType string
+
+const (
+	StorageAccountTypePremiumLRS     StorageAccountType = "Premium_LRS"
+	StorageAccountTypePremiumZRS     StorageAccountType = "Premium_ZRS"
+	StorageAccountTypeStandardLRS    StorageAccountType = "Standard_LRS"
+	StorageAccountTypeStandardSSDLRS StorageAccountType = "StandardSSD_LRS"
+	StorageAccountTypeStandardSSDZRS StorageAccountType = "StandardSSD_ZRS"
+)
+
+func PossibleValuesForStorageAccountType() []string {
+	return []string{
+		string(StorageAccountTypePremiumLRS),
+		string(StorageAccountTypePremiumZRS),
+		string(StorageAccountTypeStandardLRS),
+		string(StorageAccountTypeStandardSSDLRS),
+		string(StorageAccountTypeStandardSSDZRS),
+	}
+}
+
+func parseStorageAccountType(input string) (*StorageAccountType, error) {
+	vals := map[string]StorageAccountType{
+		"premium_lrs":     StorageAccountTypePremiumLRS,
+		"premium_zrs":     StorageAccountTypePremiumZRS,
+		"standard_lrs":    StorageAccountTypeStandardLRS,
+		"standardssd_lrs": StorageAccountTypeStandardSSDLRS,
+		"standardssd_zrs": StorageAccountTypeStandardSSDZRS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StorageAccountType(input)
+	return &out, nil
+}
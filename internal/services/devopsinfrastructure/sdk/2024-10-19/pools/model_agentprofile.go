@@ -0,0 +1,10 @@
+package pools
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AgentProfile struct {
+	GracePeriodTimeSpan *string `json:"gracePeriodTimeSpan,omitempty"`
+	Kind                string  `json:"kind"`
+	MaxAgentLifetime    *string `json:"maxAgentLifetime,omitempty"`
+}
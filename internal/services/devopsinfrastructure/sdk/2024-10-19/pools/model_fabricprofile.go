@@ -0,0 +1,12 @@
+package pools
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type FabricProfile struct {
+	Images         []PoolImage     `json:"images"`
+	Kind           string          `json:"kind"`
+	NetworkProfile *NetworkProfile `json:"networkProfile,omitempty"`
+	Sku            DevOpsAzureSku  `json:"sku"`
+	StorageProfile *StorageProfile `json:"storageProfile,omitempty"`
+}
@@ -0,0 +1,8 @@
+package pools
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type NetworkProfile struct {
+	SubnetId string `json:"subnetId"`
+}
@@ -0,0 +1,10 @@
+package pools
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type OrganizationProfile struct {
+	Kind              string                        `json:"kind"`
+	Organizations     []Organization                `json:"organizations"`
+	PermissionProfile *AzureDevOpsPermissionProfile `json:"permissionProfile,omitempty"`
+}
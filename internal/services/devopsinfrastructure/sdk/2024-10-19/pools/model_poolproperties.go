@@ -0,0 +1,13 @@
+package pools
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PoolProperties struct {
+	AgentProfile               AgentProfile        `json:"agentProfile"`
+	DevCenterProjectResourceId string              `json:"devCenterProjectResourceId"`
+	FabricProfile              FabricProfile       `json:"fabricProfile"`
+	MaximumConcurrency         int64               `json:"maximumConcurrency"`
+	OrganizationProfile        OrganizationProfile `json:"organizationProfile"`
+	ProvisioningState          *ProvisioningState  `json:"provisioningState,omitempty"`
+}
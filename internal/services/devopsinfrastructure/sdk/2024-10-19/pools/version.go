@@ -0,0 +1,12 @@
+package pools
+
+import "fmt"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2024-10-19"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/pools/%s", defaultApiVersion)
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2021-11-20-preview/elasticsans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2021-11-20-preview/volumegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2021-11-20-preview/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	ElasticSansClient  *elasticsans.ElasticSansClient
+	VolumeGroupsClient *volumegroups.VolumeGroupsClient
+	VolumesClient      *volumes.VolumesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	elasticSansClient := elasticsans.NewElasticSansClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&elasticSansClient.Client, o.ResourceManagerAuthorizer)
+
+	volumeGroupsClient := volumegroups.NewVolumeGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&volumeGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	volumesClient := volumes.NewVolumesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&volumesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ElasticSansClient:  &elasticSansClient,
+		VolumeGroupsClient: &volumeGroupsClient,
+		VolumesClient:      &volumesClient,
+	}
+}
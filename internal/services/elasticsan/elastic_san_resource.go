@@ -0,0 +1,342 @@
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2021-11-20-preview/elasticsans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSANResource struct{}
+
+var _ sdk.ResourceWithUpdate = ElasticSANResource{}
+
+type ElasticSANResourceModel struct {
+	BaseSizeInTiB        int64                `tfschema:"base_size_in_tib"`
+	ExtendedSizeInTiB    int64                `tfschema:"extended_size_in_tib"`
+	Location             string               `tfschema:"location"`
+	Name                 string               `tfschema:"name"`
+	ResourceGroupName    string               `tfschema:"resource_group_name"`
+	Sku                  []ElasticSANSkuModel `tfschema:"sku"`
+	Tags                 map[string]string    `tfschema:"tags"`
+	TotalIops            int64                `tfschema:"total_iops"`
+	TotalMBps            int64                `tfschema:"total_mbps"`
+	TotalSizeInTiB       int64                `tfschema:"total_size_in_tib"`
+	TotalVolumeSizeInGiB int64                `tfschema:"total_volume_size_in_gib"`
+	VolumeGroupCount     int64                `tfschema:"volume_group_count"`
+	Zones                []string             `tfschema:"zones"`
+}
+
+type ElasticSANSkuModel struct {
+	Name string `tfschema:"name"`
+	Tier string `tfschema:"tier"`
+}
+
+func (r ElasticSANResource) ModelObject() interface{} {
+	return &ElasticSANResourceModel{}
+}
+
+func (r ElasticSANResource) ResourceType() string {
+	return "azurerm_elastic_san"
+}
+
+func (r ElasticSANResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return elasticsans.ValidateElasticSanID
+}
+
+func (r ElasticSANResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-z0-9]{3,24}$"),
+				"The name must be between 3 and 24 characters long and may contain only lowercase letters and numbers.",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"base_size_in_tib": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+
+		"extended_size_in_tib": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+
+		"sku": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(elasticsans.PossibleValuesForSkuName(), false),
+					},
+
+					"tier": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(elasticsans.SkuTierPremium),
+						ValidateFunc: validation.StringInSlice(elasticsans.PossibleValuesForSkuTier(), false),
+					},
+				},
+			},
+		},
+
+		"zones": commonschema.ZonesMultipleOptionalForceNew(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ElasticSANResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"total_iops": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_mbps": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_size_in_tib": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_volume_size_in_gib": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"volume_group_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r ElasticSANResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.ElasticSansClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config ElasticSANResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := elasticsans.NewElasticSanID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := elasticsans.ElasticSan{
+				Location: utils.String(location.Normalize(config.Location)),
+				Properties: elasticsans.ElasticSanProperties{
+					BaseSizeTiB:             config.BaseSizeInTiB,
+					ExtendedCapacitySizeTiB: config.ExtendedSizeInTiB,
+					Sku:                     expandElasticSANSku(config.Sku),
+				},
+				Tags: &config.Tags,
+			}
+
+			if len(config.Zones) > 0 {
+				zones := config.Zones
+				input.Properties.AvailabilityZones = &zones
+			}
+
+			if err := client.CreateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.ElasticSansClient
+
+			id, err := elasticsans.ParseElasticSanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ElasticSANResourceModel{
+				Name:              id.ElasticSanName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				state.BaseSizeInTiB = props.BaseSizeTiB
+				state.ExtendedSizeInTiB = props.ExtendedCapacitySizeTiB
+				state.Sku = flattenElasticSANSku(props.Sku)
+
+				if props.AvailabilityZones != nil {
+					state.Zones = *props.AvailabilityZones
+				}
+
+				if props.TotalIops != nil {
+					state.TotalIops = *props.TotalIops
+				}
+
+				if props.TotalMBps != nil {
+					state.TotalMBps = *props.TotalMBps
+				}
+
+				if props.TotalSizeTiB != nil {
+					state.TotalSizeInTiB = *props.TotalSizeTiB
+				}
+
+				if props.TotalVolumeSizeGiB != nil {
+					state.TotalVolumeSizeInGiB = *props.TotalVolumeSizeGiB
+				}
+
+				if props.VolumeGroupCount != nil {
+					state.VolumeGroupCount = *props.VolumeGroupCount
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ElasticSANResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.ElasticSansClient
+
+			id, err := elasticsans.ParseElasticSanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ElasticSANResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := elasticsans.ElasticSanUpdate{}
+
+			if metadata.ResourceData.HasChange("base_size_in_tib") || metadata.ResourceData.HasChange("extended_size_in_tib") {
+				parameters.Properties = &elasticsans.ElasticSanUpdateProperties{
+					BaseSizeTiB:             utils.Int64(config.BaseSizeInTiB),
+					ExtendedCapacitySizeTiB: utils.Int64(config.ExtendedSizeInTiB),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = &config.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.ElasticSansClient
+
+			id, err := elasticsans.ParseElasticSanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandElasticSANSku(input []ElasticSANSkuModel) elasticsans.Sku {
+	output := elasticsans.Sku{}
+
+	if len(input) == 0 {
+		return output
+	}
+
+	output.Name = elasticsans.SkuName(input[0].Name)
+
+	if input[0].Tier != "" {
+		tier := elasticsans.SkuTier(input[0].Tier)
+		output.Tier = &tier
+	}
+
+	return output
+}
+
+func flattenElasticSANSku(input elasticsans.Sku) []ElasticSANSkuModel {
+	output := ElasticSANSkuModel{
+		Name: string(input.Name),
+	}
+
+	if input.Tier != nil {
+		output.Tier = string(*input.Tier)
+	}
+
+	return []ElasticSANSkuModel{output}
+}
@@ -0,0 +1,297 @@
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2021-11-20-preview/elasticsans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2021-11-20-preview/volumegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ElasticSANVolumeGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = ElasticSANVolumeGroupResource{}
+
+type ElasticSANVolumeGroupResourceModel struct {
+	ElasticSanId   string                             `tfschema:"elastic_san_id"`
+	EncryptionType string                             `tfschema:"encryption_type"`
+	Name           string                             `tfschema:"name"`
+	NetworkRule    []ElasticSANVolumeGroupNetworkRule `tfschema:"network_rule"`
+	ProtocolType   string                             `tfschema:"protocol_type"`
+}
+
+type ElasticSANVolumeGroupNetworkRule struct {
+	SubnetId string `tfschema:"subnet_id"`
+	Action   string `tfschema:"action"`
+}
+
+func (r ElasticSANVolumeGroupResource) ModelObject() interface{} {
+	return &ElasticSANVolumeGroupResourceModel{}
+}
+
+func (r ElasticSANVolumeGroupResource) ResourceType() string {
+	return "azurerm_elastic_san_volume_group"
+}
+
+func (r ElasticSANVolumeGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return volumegroups.ValidateVolumeGroupID
+}
+
+func (r ElasticSANVolumeGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-z0-9]+(-[a-z0-9]+)*$"),
+				"The name can only contain lowercase letters, numbers and hyphens, and must begin and end with a letter or a number.",
+			),
+		},
+
+		"elastic_san_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: elasticsans.ValidateElasticSanID,
+		},
+
+		"encryption_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(volumegroups.EncryptionTypeEncryptionAtRestWithPlatformKey),
+			ValidateFunc: validation.StringInSlice(volumegroups.PossibleValuesForEncryptionType(), false),
+		},
+
+		"protocol_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(volumegroups.StorageTargetTypeIscsi),
+			ValidateFunc: validation.StringInSlice(volumegroups.PossibleValuesForStorageTargetType(), false),
+		},
+
+		"network_rule": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+
+					"action": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(volumegroups.ActionAllow),
+						ValidateFunc: validation.StringInSlice(volumegroups.PossibleValuesForAction(), false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ElasticSANVolumeGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ElasticSANVolumeGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumeGroupsClient
+
+			var config ElasticSANVolumeGroupResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			elasticSanId, err := elasticsans.ParseElasticSanID(config.ElasticSanId)
+			if err != nil {
+				return err
+			}
+
+			id := volumegroups.NewVolumeGroupID(elasticSanId.SubscriptionId, elasticSanId.ResourceGroupName, elasticSanId.ElasticSanName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			encryptionType := volumegroups.EncryptionType(config.EncryptionType)
+			protocolType := volumegroups.StorageTargetType(config.ProtocolType)
+			input := volumegroups.VolumeGroup{
+				Properties: &volumegroups.VolumeGroupProperties{
+					Encryption:   &encryptionType,
+					NetworkAcls:  expandElasticSANVolumeGroupNetworkRules(config.NetworkRule),
+					ProtocolType: &protocolType,
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANVolumeGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumeGroupsClient
+
+			id, err := volumegroups.ParseVolumeGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ElasticSANVolumeGroupResourceModel{
+				Name:         id.VolumeGroupName,
+				ElasticSanId: elasticsans.NewElasticSanID(id.SubscriptionId, id.ResourceGroupName, id.ElasticSanName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if model.Properties.Encryption != nil {
+					state.EncryptionType = string(*model.Properties.Encryption)
+				}
+
+				if model.Properties.ProtocolType != nil {
+					state.ProtocolType = string(*model.Properties.ProtocolType)
+				}
+
+				state.NetworkRule = flattenElasticSANVolumeGroupNetworkRules(model.Properties.NetworkAcls)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ElasticSANVolumeGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumeGroupsClient
+
+			id, err := volumegroups.ParseVolumeGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ElasticSANVolumeGroupResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := volumegroups.VolumeGroupUpdate{
+				Properties: &volumegroups.VolumeGroupUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("encryption_type") {
+				encryptionType := volumegroups.EncryptionType(config.EncryptionType)
+				parameters.Properties.Encryption = &encryptionType
+			}
+
+			if metadata.ResourceData.HasChange("protocol_type") {
+				protocolType := volumegroups.StorageTargetType(config.ProtocolType)
+				parameters.Properties.ProtocolType = &protocolType
+			}
+
+			if metadata.ResourceData.HasChange("network_rule") {
+				parameters.Properties.NetworkAcls = expandElasticSANVolumeGroupNetworkRules(config.NetworkRule)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANVolumeGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumeGroupsClient
+
+			id, err := volumegroups.ParseVolumeGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandElasticSANVolumeGroupNetworkRules(input []ElasticSANVolumeGroupNetworkRule) *volumegroups.NetworkRuleSet {
+	if len(input) == 0 {
+		return nil
+	}
+
+	rules := make([]volumegroups.VirtualNetworkRule, 0)
+	for _, rule := range input {
+		action := volumegroups.Action(rule.Action)
+		rules = append(rules, volumegroups.VirtualNetworkRule{
+			Id:     rule.SubnetId,
+			Action: &action,
+		})
+	}
+
+	return &volumegroups.NetworkRuleSet{
+		VirtualNetworkRules: &rules,
+	}
+}
+
+func flattenElasticSANVolumeGroupNetworkRules(input *volumegroups.NetworkRuleSet) []ElasticSANVolumeGroupNetworkRule {
+	output := make([]ElasticSANVolumeGroupNetworkRule, 0)
+	if input == nil || input.VirtualNetworkRules == nil {
+		return output
+	}
+
+	for _, rule := range *input.VirtualNetworkRules {
+		action := string(volumegroups.ActionAllow)
+		if rule.Action != nil {
+			action = string(*rule.Action)
+		}
+
+		output = append(output, ElasticSANVolumeGroupNetworkRule{
+			SubnetId: rule.Id,
+			Action:   action,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,312 @@
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2021-11-20-preview/volumegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2021-11-20-preview/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSANVolumeResource struct{}
+
+var _ sdk.ResourceWithUpdate = ElasticSANVolumeResource{}
+
+type ElasticSANVolumeResourceModel struct {
+	CreateSource         []ElasticSANVolumeCreateSource `tfschema:"create_source"`
+	Name                 string                         `tfschema:"name"`
+	SizeInGiB            int64                          `tfschema:"size_in_gib"`
+	TargetIqn            string                         `tfschema:"target_iqn"`
+	TargetPortalHostname string                         `tfschema:"target_portal_hostname"`
+	TargetPortalPort     int64                          `tfschema:"target_portal_port"`
+	VolumeGroupId        string                         `tfschema:"volume_group_id"`
+	VolumeId             string                         `tfschema:"volume_id"`
+}
+
+type ElasticSANVolumeCreateSource struct {
+	SourceType string `tfschema:"source_type"`
+	SourceId   string `tfschema:"source_id"`
+}
+
+func (r ElasticSANVolumeResource) ModelObject() interface{} {
+	return &ElasticSANVolumeResourceModel{}
+}
+
+func (r ElasticSANVolumeResource) ResourceType() string {
+	return "azurerm_elastic_san_volume"
+}
+
+func (r ElasticSANVolumeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return volumes.ValidateVolumeID
+}
+
+func (r ElasticSANVolumeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-z0-9]+(-[a-z0-9]+)*$"),
+				"The name can only contain lowercase letters, numbers and hyphens, and must begin and end with a letter or a number.",
+			),
+		},
+
+		"volume_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: volumegroups.ValidateVolumeGroupID,
+		},
+
+		"size_in_gib": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 65536),
+		},
+
+		"create_source": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Disk",
+							"DiskRestorePoint",
+							"DiskSnapshot",
+							"VolumeSnapshot",
+						}, false),
+					},
+
+					"source_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_iqn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"target_portal_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"target_portal_port": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"volume_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			var config ElasticSANVolumeResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			volumeGroupId, err := volumegroups.ParseVolumeGroupID(config.VolumeGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := volumes.NewVolumeID(volumeGroupId.SubscriptionId, volumeGroupId.ResourceGroupName, volumeGroupId.ElasticSanName, volumeGroupId.VolumeGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := volumes.Volume{
+				Properties: &volumes.VolumeProperties{
+					CreationData: expandElasticSANVolumeCreateSource(config.CreateSource),
+					SizeGiB:      utils.Int64(config.SizeInGiB),
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ElasticSANVolumeResourceModel{
+				Name:          id.VolumeName,
+				VolumeGroupId: volumegroups.NewVolumeGroupID(id.SubscriptionId, id.ResourceGroupName, id.ElasticSanName, id.VolumeGroupName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.CreateSource = flattenElasticSANVolumeCreateSource(props.CreationData)
+
+				if props.SizeGiB != nil {
+					state.SizeInGiB = *props.SizeGiB
+				}
+
+				if props.VolumeId != nil {
+					state.VolumeId = *props.VolumeId
+				}
+
+				if target := props.StorageTarget; target != nil {
+					if target.TargetIqn != nil {
+						state.TargetIqn = *target.TargetIqn
+					}
+
+					if target.TargetPortalHostname != nil {
+						state.TargetPortalHostname = *target.TargetPortalHostname
+					}
+
+					if target.TargetPortalPort != nil {
+						state.TargetPortalPort = *target.TargetPortalPort
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ElasticSANVolumeResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := volumes.VolumeUpdate{
+				Properties: &volumes.VolumeUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("size_in_gib") {
+				parameters.Properties.SizeGiB = utils.Int64(config.SizeInGiB)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandElasticSANVolumeCreateSource(input []ElasticSANVolumeCreateSource) *volumes.SourceCreationData {
+	if len(input) == 0 {
+		return nil
+	}
+
+	createSource := volumes.VolumeCreateOption(input[0].SourceType)
+	return &volumes.SourceCreationData{
+		CreateSource: &createSource,
+		SourceUri:    utils.String(input[0].SourceId),
+	}
+}
+
+func flattenElasticSANVolumeCreateSource(input *volumes.SourceCreationData) []ElasticSANVolumeCreateSource {
+	output := make([]ElasticSANVolumeCreateSource, 0)
+	if input == nil || input.CreateSource == nil || *input.CreateSource == volumes.VolumeCreateOptionNone {
+		return output
+	}
+
+	sourceId := ""
+	if input.SourceUri != nil {
+		sourceId = *input.SourceUri
+	}
+
+	return append(output, ElasticSANVolumeCreateSource{
+		SourceType: string(*input.CreateSource),
+		SourceId:   sourceId,
+	})
+}
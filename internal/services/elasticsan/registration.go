@@ -0,0 +1,35 @@
+package elasticsan
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+type Registration struct{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/elastic-san"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Elastic SAN",
+	}
+}
+
+func (r Registration) Name() string {
+	return "Elastic SAN"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ElasticSANResource{},
+		ElasticSANVolumeGroupResource{},
+		ElasticSANVolumeResource{},
+	}
+}
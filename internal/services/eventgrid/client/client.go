@@ -3,6 +3,16 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2021-12-01/eventgrid" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/channels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnerconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnertopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/cacertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/clientgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/permissionbindings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/topicspaces"
 )
 
 type Client struct {
@@ -12,6 +22,18 @@ type Client struct {
 	TopicsClient                        *eventgrid.TopicsClient
 	SystemTopicsClient                  *eventgrid.SystemTopicsClient
 	SystemTopicEventSubscriptionsClient *eventgrid.SystemTopicEventSubscriptionsClient
+
+	NamespacesClient         *namespaces.NamespacesClient
+	NamespaceTopicsClient    *namespacetopics.NamespaceTopicsClient
+	ClientsClient            *clients.ClientsClient
+	ClientGroupsClient       *clientgroups.ClientGroupsClient
+	TopicSpacesClient        *topicspaces.TopicSpacesClient
+	PermissionBindingsClient *permissionbindings.PermissionBindingsClient
+	CaCertificatesClient     *cacertificates.CaCertificatesClient
+
+	PartnerConfigurationsClient *partnerconfigurations.PartnerConfigurationsClient
+	PartnerTopicsClient         *partnertopics.PartnerTopicsClient
+	ChannelsClient              *channels.ChannelsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -33,6 +55,36 @@ func NewClient(o *common.ClientOptions) *Client {
 	SystemTopicEventSubscriptionsClient := eventgrid.NewSystemTopicEventSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SystemTopicEventSubscriptionsClient.Client, o.ResourceManagerAuthorizer)
 
+	NamespacesClient := namespaces.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceTopicsClient := namespacetopics.NewNamespaceTopicsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceTopicsClient.Client, o.ResourceManagerAuthorizer)
+
+	ClientsClient := clients.NewClientsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ClientsClient.Client, o.ResourceManagerAuthorizer)
+
+	ClientGroupsClient := clientgroups.NewClientGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ClientGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	TopicSpacesClient := topicspaces.NewTopicSpacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&TopicSpacesClient.Client, o.ResourceManagerAuthorizer)
+
+	PermissionBindingsClient := permissionbindings.NewPermissionBindingsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PermissionBindingsClient.Client, o.ResourceManagerAuthorizer)
+
+	CaCertificatesClient := cacertificates.NewCaCertificatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&CaCertificatesClient.Client, o.ResourceManagerAuthorizer)
+
+	PartnerConfigurationsClient := partnerconfigurations.NewPartnerConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PartnerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	PartnerTopicsClient := partnertopics.NewPartnerTopicsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PartnerTopicsClient.Client, o.ResourceManagerAuthorizer)
+
+	ChannelsClient := channels.NewChannelsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ChannelsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		DomainsClient:                       &DomainsClient,
 		EventSubscriptionsClient:            &EventSubscriptionsClient,
@@ -40,5 +92,17 @@ func NewClient(o *common.ClientOptions) *Client {
 		TopicsClient:                        &TopicsClient,
 		SystemTopicsClient:                  &SystemTopicsClient,
 		SystemTopicEventSubscriptionsClient: &SystemTopicEventSubscriptionsClient,
+
+		NamespacesClient:         &NamespacesClient,
+		NamespaceTopicsClient:    &NamespaceTopicsClient,
+		ClientsClient:            &ClientsClient,
+		ClientGroupsClient:       &ClientGroupsClient,
+		TopicSpacesClient:        &TopicSpacesClient,
+		PermissionBindingsClient: &PermissionBindingsClient,
+		CaCertificatesClient:     &CaCertificatesClient,
+
+		PartnerConfigurationsClient: &PartnerConfigurationsClient,
+		PartnerTopicsClient:         &PartnerTopicsClient,
+		ChannelsClient:              &ChannelsClient,
 	}
 }
@@ -0,0 +1,128 @@
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/cacertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventGridNamespaceCaCertificateResource struct{}
+
+func TestAccEventGridNamespaceCaCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiry_time_in_utc").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceCaCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (EventGridNamespaceCaCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := cacertificates.ParseCaCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.CaCertificatesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (EventGridNamespaceCaCertificateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventgrid_namespace" "test" {
+  name                = "acctest-egn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  topic_spaces_configuration {}
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r EventGridNamespaceCaCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "test" {
+  name         = "acctest-egca-%d"
+  namespace_id = azurerm_eventgrid_namespace.test.id
+
+  encoded_certificate = <<CERT
+-----BEGIN CERTIFICATE-----
+MIIDFTCCAf2gAwIBAgIUCX5D9zUodzQaNz5Kswqpt2PxgAIwDQYJKoZIhvcNAQEL
+BQAwGjEYMBYGA1UEAwwPYWNjdGVzdC1tcXR0LWNhMB4XDTI2MDgzMTA4NTAyOFoX
+DTM2MDgyODA4NTAyOFowGjEYMBYGA1UEAwwPYWNjdGVzdC1tcXR0LWNhMIIBIjAN
+BgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAkuMOBl2r/jl1OIkZ9lGaw3gDWOca
+Owpkqn1S0gTfD4pyvNSGMF5F5Is3mvK+dSvSsJkAkej7AIVlOR5Qr2+0Cr/oAmPE
+EAItYYgTKZ9RmthSiFkzroXagmU+AmSyM5F1sMo3Y/LTmrBGV768GjtZbilXhFQO
++iKgL1SM5NY0hzPx5F71jM/4q8HYHvbVnKW6nal5jDyOT8+iY62irrPOczlCzj9F
+ZhU1qRL4C54Q7ksqHrShVFIne5Oin7dDooXw7RWU2f8VApxBdaDzFBBau2NBijBo
+lJl5G4OjiB0rvuxvlCGB2AYvj9JsZHeoXdIuxQpXWCQMrd9zD4KZKxeHqwIDAQAB
+o1MwUTAdBgNVHQ4EFgQUpJWnEK1YZDIlKNhHx/AaraPnOgMwHwYDVR0jBBgwFoAU
+pJWnEK1YZDIlKNhHx/AaraPnOgMwDwYDVR0TAQH/BAUwAwEB/zANBgkqhkiG9w0B
+AQsFAAOCAQEAfyMSORMXUk8oikx0CYFN2O74/IRuV7UAUYFs48oCydS8rWOFyrc8
+gtbvo07lbCws0hldsdr3CC1nHDDqmBkZa8kO06pQFfuNJa21h4XwDJwUmNDbuzpc
+Ohs7NE44WWofgLf6qS5ZmE3ma/CSkeN37x7oL1xMgS87Rx0r1A/qDJQCb/hY2KSy
+FvrUDOZVtH5NXMPS2+H7LGa020QcQAPQ6jjSAe8LFhLhoj6u0F+7UjijtSucIG5w
+afZC/8alx7pccrIF0LUkgk8w7/tT7GZuU12R3x5rk2qbIpP4KoKXYHMHkkDKdEcd
+1LqouOjdoQbcWO9leXcihhEzwWx3wCUV9A==
+-----END CERTIFICATE-----
+CERT
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceCaCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "import" {
+  name         = azurerm_eventgrid_namespace_ca_certificate.test.name
+  namespace_id = azurerm_eventgrid_namespace_ca_certificate.test.namespace_id
+
+  encoded_certificate = azurerm_eventgrid_namespace_ca_certificate.test.encoded_certificate
+}
+`, r.basic(data))
+}
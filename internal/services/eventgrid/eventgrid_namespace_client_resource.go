@@ -0,0 +1,248 @@
+package eventgrid
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	namespaceclients "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceEventGridNamespaceClient() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceEventGridNamespaceClientCreateUpdate,
+		Read:   resourceEventGridNamespaceClientRead,
+		Update: resourceEventGridNamespaceClientCreateUpdate,
+		Delete: resourceEventGridNamespaceClientDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := namespaceclients.ParseClientID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9-._:]{1,128}$`),
+					"EventGrid Namespace Client name must be 1 - 128 characters long, contain only letters, numbers, hyphens, dots, underscores and colons.",
+				),
+			},
+
+			"namespace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: namespaces.ValidateNamespaceID,
+			},
+
+			"authentication_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"certificate_validation_scheme": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(namespaceclients.ClientCertificateValidationSchemeSubjectMatchesAuthenticationName),
+				ValidateFunc: validation.StringInSlice(namespaceclients.PossibleValuesForClientCertificateValidationScheme(), false),
+			},
+
+			"allowed_thumbprints": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"attributes": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceEventGridNamespaceClientCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.ClientsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	namespaceId, err := namespaces.ParseNamespaceID(d.Get("namespace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := namespaceclients.NewClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_eventgrid_namespace_client", id.ID())
+		}
+	}
+
+	state := namespaceclients.ClientStateEnabled
+	if !d.Get("enabled").(bool) {
+		state = namespaceclients.ClientStateDisabled
+	}
+
+	validationScheme := namespaceclients.ClientCertificateValidationScheme(d.Get("certificate_validation_scheme").(string))
+
+	props := namespaceclients.ClientProperties{
+		ClientCertificateAuthentication: &namespaceclients.ClientCertificateAuthentication{
+			ValidationScheme:   &validationScheme,
+			AllowedThumbprints: utils.ExpandStringSlice(d.Get("allowed_thumbprints").([]interface{})),
+		},
+		State: &state,
+	}
+
+	if v := d.Get("authentication_name").(string); v != "" {
+		props.AuthenticationName = utils.String(v)
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		props.Description = utils.String(v)
+	}
+
+	if attributesRaw := d.Get("attributes").(map[string]interface{}); len(attributesRaw) > 0 {
+		attributes := make(map[string]interface{})
+		for k, v := range attributesRaw {
+			attributes[k] = v
+		}
+		props.Attributes = &attributes
+	}
+
+	parameters := namespaceclients.Client{
+		Properties: &props,
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceEventGridNamespaceClientRead(d, meta)
+}
+
+func resourceEventGridNamespaceClientRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.ClientsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaceclients.ParseClientID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ClientName)
+	d.Set("namespace_id", namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("authentication_name", props.AuthenticationName)
+			d.Set("description", props.Description)
+			d.Set("enabled", props.State == nil || *props.State == namespaceclients.ClientStateEnabled)
+
+			validationScheme := string(namespaceclients.ClientCertificateValidationSchemeSubjectMatchesAuthenticationName)
+			allowedThumbprints := make([]interface{}, 0)
+			if auth := props.ClientCertificateAuthentication; auth != nil {
+				if auth.ValidationScheme != nil {
+					validationScheme = string(*auth.ValidationScheme)
+				}
+				if auth.AllowedThumbprints != nil {
+					for _, thumbprint := range *auth.AllowedThumbprints {
+						allowedThumbprints = append(allowedThumbprints, thumbprint)
+					}
+				}
+			}
+			d.Set("certificate_validation_scheme", validationScheme)
+			if err := d.Set("allowed_thumbprints", allowedThumbprints); err != nil {
+				return fmt.Errorf("setting `allowed_thumbprints`: %+v", err)
+			}
+
+			attributes := make(map[string]interface{})
+			if props.Attributes != nil {
+				for k, v := range *props.Attributes {
+					attributes[k] = fmt.Sprintf("%v", v)
+				}
+			}
+			if err := d.Set("attributes", attributes); err != nil {
+				return fmt.Errorf("setting `attributes`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceEventGridNamespaceClientDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.ClientsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaceclients.ParseClientID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,300 @@
+package eventgrid
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceEventGridNamespace() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceEventGridNamespaceCreateUpdate,
+		Read:   resourceEventGridNamespaceRead,
+		Update: resourceEventGridNamespaceCreateUpdate,
+		Delete: resourceEventGridNamespaceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := namespaces.ParseNamespaceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z0-9-]{3,50}$"),
+					"EventGrid Namespace name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"location": commonschema.Location(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(namespaces.SkuNameStandard),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(namespaces.SkuNameStandard),
+				}, false),
+			},
+
+			"capacity": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 40),
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"topic_spaces_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"maximum_client_sessions_per_authentication_name": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"maximum_session_expiry_in_hours": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 8),
+						},
+
+						"route_topic_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: namespacetopics.ValidateNamespaceTopicID,
+						},
+
+						"hostname": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceEventGridNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.NamespacesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := namespaces.NewNamespaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_eventgrid_namespace", id.ID())
+		}
+	}
+
+	publicNetworkAccess := namespaces.PublicNetworkAccessEnabled
+	if !d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = namespaces.PublicNetworkAccessDisabled
+	}
+
+	skuName := namespaces.SkuName(d.Get("sku_name").(string))
+
+	parameters := namespaces.Namespace{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &namespaces.NamespaceProperties{
+			PublicNetworkAccess:      &publicNetworkAccess,
+			TopicSpacesConfiguration: expandEventGridNamespaceTopicSpacesConfiguration(d.Get("topic_spaces_configuration").([]interface{})),
+		},
+		Sku: &namespaces.NamespaceSku{
+			Name:     &skuName,
+			Capacity: utils.Int64(int64(d.Get("capacity").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceEventGridNamespaceRead(d, meta)
+}
+
+func resourceEventGridNamespaceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.NamespacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.NamespaceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if sku := model.Sku; sku != nil {
+			skuName := ""
+			if sku.Name != nil {
+				skuName = string(*sku.Name)
+			}
+			d.Set("sku_name", skuName)
+
+			capacity := int64(1)
+			if sku.Capacity != nil {
+				capacity = *sku.Capacity
+			}
+			d.Set("capacity", capacity)
+		}
+
+		if props := model.Properties; props != nil {
+			publicNetworkAccessEnabled := true
+			if props.PublicNetworkAccess != nil && *props.PublicNetworkAccess == namespaces.PublicNetworkAccessDisabled {
+				publicNetworkAccessEnabled = false
+			}
+			d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+			if err := d.Set("topic_spaces_configuration", flattenEventGridNamespaceTopicSpacesConfiguration(props.TopicSpacesConfiguration)); err != nil {
+				return fmt.Errorf("setting `topic_spaces_configuration`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceEventGridNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.NamespacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandEventGridNamespaceTopicSpacesConfiguration(input []interface{}) *namespaces.TopicSpacesConfiguration {
+	if len(input) == 0 {
+		state := namespaces.TopicSpacesConfigurationStateDisabled
+		return &namespaces.TopicSpacesConfiguration{
+			State: &state,
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	state := namespaces.TopicSpacesConfigurationStateEnabled
+	result := namespaces.TopicSpacesConfiguration{
+		State: &state,
+		MaximumClientSessionsPerAuthenticationName: utils.Int64(int64(raw["maximum_client_sessions_per_authentication_name"].(int))),
+		MaximumSessionExpiryInHours:                utils.Int64(int64(raw["maximum_session_expiry_in_hours"].(int))),
+	}
+
+	if v := raw["route_topic_id"].(string); v != "" {
+		result.RouteTopicResourceId = utils.String(v)
+	}
+
+	return &result
+}
+
+func flattenEventGridNamespaceTopicSpacesConfiguration(input *namespaces.TopicSpacesConfiguration) []interface{} {
+	if input == nil || input.State == nil || *input.State != namespaces.TopicSpacesConfigurationStateEnabled {
+		return []interface{}{}
+	}
+
+	maximumClientSessions := int64(1)
+	if input.MaximumClientSessionsPerAuthenticationName != nil {
+		maximumClientSessions = *input.MaximumClientSessionsPerAuthenticationName
+	}
+
+	maximumSessionExpiry := int64(1)
+	if input.MaximumSessionExpiryInHours != nil {
+		maximumSessionExpiry = *input.MaximumSessionExpiryInHours
+	}
+
+	routeTopicId := ""
+	if input.RouteTopicResourceId != nil {
+		routeTopicId = *input.RouteTopicResourceId
+	}
+
+	hostname := ""
+	if input.Hostname != nil {
+		hostname = *input.Hostname
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"maximum_client_sessions_per_authentication_name": maximumClientSessions,
+			"maximum_session_expiry_in_hours":                 maximumSessionExpiry,
+			"route_topic_id":                                  routeTopicId,
+			"hostname":                                        hostname,
+		},
+	}
+}
@@ -0,0 +1,237 @@
+package eventgrid
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnerconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceEventGridPartnerConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceEventGridPartnerConfigurationCreateUpdate,
+		Read:   resourceEventGridPartnerConfigurationRead,
+		Update: resourceEventGridPartnerConfigurationCreateUpdate,
+		Delete: resourceEventGridPartnerConfigurationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := partnerconfigurations.ParsePartnerConfigurationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"default_maximum_expiration_time_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 365),
+			},
+
+			"partner_authorization": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"partner_registration_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"partner_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"authorization_expiration_time_in_utc": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceEventGridPartnerConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.PartnerConfigurationsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := partnerconfigurations.NewPartnerConfigurationID(subscriptionId, d.Get("resource_group_name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_eventgrid_partner_configuration", id.ID())
+		}
+	}
+
+	parameters := partnerconfigurations.PartnerConfiguration{
+		Location: utils.String("global"),
+		Properties: &partnerconfigurations.PartnerConfigurationProperties{
+			PartnerAuthorization: &partnerconfigurations.PartnerAuthorization{
+				AuthorizedPartnersList:             expandEventGridPartnerConfigurationAuthorizedPartners(d.Get("partner_authorization").(*pluginsdk.Set).List()),
+				DefaultMaximumExpirationTimeInDays: utils.Int64(int64(d.Get("default_maximum_expiration_time_in_days").(int))),
+			},
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceEventGridPartnerConfigurationRead(d, meta)
+}
+
+func resourceEventGridPartnerConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.PartnerConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := partnerconfigurations.ParsePartnerConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			defaultMaximumExpirationTimeInDays := int64(7)
+			authorizedPartners := make([]interface{}, 0)
+			if authorization := props.PartnerAuthorization; authorization != nil {
+				if authorization.DefaultMaximumExpirationTimeInDays != nil {
+					defaultMaximumExpirationTimeInDays = *authorization.DefaultMaximumExpirationTimeInDays
+				}
+				authorizedPartners = flattenEventGridPartnerConfigurationAuthorizedPartners(authorization.AuthorizedPartnersList)
+			}
+			d.Set("default_maximum_expiration_time_in_days", defaultMaximumExpirationTimeInDays)
+			if err := d.Set("partner_authorization", authorizedPartners); err != nil {
+				return fmt.Errorf("setting `partner_authorization`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceEventGridPartnerConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.PartnerConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := partnerconfigurations.ParsePartnerConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandEventGridPartnerConfigurationAuthorizedPartners(input []interface{}) *[]partnerconfigurations.Partner {
+	results := make([]partnerconfigurations.Partner, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		partner := partnerconfigurations.Partner{
+			PartnerRegistrationImmutableId: utils.String(v["partner_registration_id"].(string)),
+		}
+
+		if name := v["partner_name"].(string); name != "" {
+			partner.PartnerName = utils.String(name)
+		}
+
+		if expiration := v["authorization_expiration_time_in_utc"].(string); expiration != "" {
+			partner.AuthorizationExpirationTimeInUtc = utils.String(expiration)
+		}
+
+		results = append(results, partner)
+	}
+
+	return &results
+}
+
+func flattenEventGridPartnerConfigurationAuthorizedPartners(input *[]partnerconfigurations.Partner) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, partner := range *input {
+		partnerRegistrationId := ""
+		if partner.PartnerRegistrationImmutableId != nil {
+			partnerRegistrationId = *partner.PartnerRegistrationImmutableId
+		}
+
+		partnerName := ""
+		if partner.PartnerName != nil {
+			partnerName = *partner.PartnerName
+		}
+
+		authorizationExpirationTime := ""
+		if partner.AuthorizationExpirationTimeInUtc != nil {
+			authorizationExpirationTime = *partner.AuthorizationExpirationTimeInUtc
+		}
+
+		results = append(results, map[string]interface{}{
+			"partner_registration_id":              partnerRegistrationId,
+			"partner_name":                         partnerName,
+			"authorization_expiration_time_in_utc": authorizationExpirationTime,
+		})
+	}
+
+	return results
+}
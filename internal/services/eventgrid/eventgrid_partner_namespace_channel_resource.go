@@ -0,0 +1,274 @@
+package eventgrid
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/channels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceEventGridPartnerNamespaceChannel() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceEventGridPartnerNamespaceChannelCreateUpdate,
+		Read:   resourceEventGridPartnerNamespaceChannelRead,
+		Update: resourceEventGridPartnerNamespaceChannelCreateUpdate,
+		Delete: resourceEventGridPartnerNamespaceChannelDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := channels.ParseChannelID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z0-9-]{3,50}$"),
+					"EventGrid Channel name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"partner_namespace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: channels.ValidatePartnerNamespaceID,
+			},
+
+			"partner_topic": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"resource_group_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"source": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"subscription_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+
+			"expiration_time_if_not_activated_utc": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"message_for_activation": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"readiness_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEventGridPartnerNamespaceChannelCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.ChannelsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	partnerNamespaceId, err := channels.ParsePartnerNamespaceID(d.Get("partner_namespace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := channels.NewChannelID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.PartnerNamespaceName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_eventgrid_partner_namespace_channel", id.ID())
+		}
+	}
+
+	channelType := channels.ChannelTypePartnerTopic
+	props := channels.ChannelProperties{
+		ChannelType:      &channelType,
+		PartnerTopicInfo: expandEventGridPartnerNamespaceChannelPartnerTopic(d.Get("partner_topic").([]interface{})),
+	}
+
+	if v := d.Get("expiration_time_if_not_activated_utc").(string); v != "" {
+		props.ExpirationTimeIfNotActivatedUtc = utils.String(v)
+	}
+
+	if v := d.Get("message_for_activation").(string); v != "" {
+		props.MessageForActivation = utils.String(v)
+	}
+
+	parameters := channels.Channel{
+		Properties: &props,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceEventGridPartnerNamespaceChannelRead(d, meta)
+}
+
+func resourceEventGridPartnerNamespaceChannelRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.ChannelsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := channels.ParseChannelID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ChannelName)
+	d.Set("partner_namespace_id", channels.NewPartnerNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.PartnerNamespaceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			if err := d.Set("partner_topic", flattenEventGridPartnerNamespaceChannelPartnerTopic(props.PartnerTopicInfo)); err != nil {
+				return fmt.Errorf("setting `partner_topic`: %+v", err)
+			}
+
+			d.Set("expiration_time_if_not_activated_utc", props.ExpirationTimeIfNotActivatedUtc)
+			d.Set("message_for_activation", props.MessageForActivation)
+
+			readinessState := ""
+			if props.ReadinessState != nil {
+				readinessState = string(*props.ReadinessState)
+			}
+			d.Set("readiness_state", readinessState)
+		}
+	}
+
+	return nil
+}
+
+func resourceEventGridPartnerNamespaceChannelDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.ChannelsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := channels.ParseChannelID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandEventGridPartnerNamespaceChannelPartnerTopic(input []interface{}) *channels.PartnerTopicInfo {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &channels.PartnerTopicInfo{
+		AzureSubscriptionId: utils.String(v["subscription_id"].(string)),
+		Name:                utils.String(v["name"].(string)),
+		ResourceGroupName:   utils.String(v["resource_group_name"].(string)),
+		Source:              utils.String(v["source"].(string)),
+	}
+}
+
+func flattenEventGridPartnerNamespaceChannelPartnerTopic(input *channels.PartnerTopicInfo) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	resourceGroupName := ""
+	if input.ResourceGroupName != nil {
+		resourceGroupName = *input.ResourceGroupName
+	}
+
+	source := ""
+	if input.Source != nil {
+		source = *input.Source
+	}
+
+	subscriptionId := ""
+	if input.AzureSubscriptionId != nil {
+		subscriptionId = *input.AzureSubscriptionId
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                name,
+			"resource_group_name": resourceGroupName,
+			"source":              source,
+			"subscription_id":     subscriptionId,
+		},
+	}
+}
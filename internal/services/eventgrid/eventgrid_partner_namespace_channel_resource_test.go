@@ -0,0 +1,163 @@
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/channels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventGridPartnerNamespaceChannelResource struct{}
+
+// a channel can only be created within a Partner Namespace, which in turn requires a
+// verified Partner Registration, so an existing Partner Namespace must be supplied
+// for these tests to run
+func eventGridPartnerNamespaceId(t *testing.T) string {
+	partnerNamespaceId := os.Getenv("ARM_TEST_EVENTGRID_PARTNER_NAMESPACE_ID")
+	if partnerNamespaceId == "" {
+		t.Skip("Skipping as `ARM_TEST_EVENTGRID_PARTNER_NAMESPACE_ID` is not specified")
+	}
+	return partnerNamespaceId
+}
+
+func TestAccEventGridPartnerNamespaceChannel_basic(t *testing.T) {
+	partnerNamespaceId := eventGridPartnerNamespaceId(t)
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_namespace_channel", "test")
+	r := EventGridPartnerNamespaceChannelResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, partnerNamespaceId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridPartnerNamespaceChannel_requiresImport(t *testing.T) {
+	partnerNamespaceId := eventGridPartnerNamespaceId(t)
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_namespace_channel", "test")
+	r := EventGridPartnerNamespaceChannelResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, partnerNamespaceId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, partnerNamespaceId)
+		}),
+	})
+}
+
+func TestAccEventGridPartnerNamespaceChannel_complete(t *testing.T) {
+	partnerNamespaceId := eventGridPartnerNamespaceId(t)
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_namespace_channel", "test")
+	r := EventGridPartnerNamespaceChannelResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, partnerNamespaceId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridPartnerNamespaceChannelResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := channels.ParseChannelID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.ChannelsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (EventGridPartnerNamespaceChannelResource) basic(data acceptance.TestData, partnerNamespaceId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventgrid_partner_namespace_channel" "test" {
+  name                 = "acctest-egpnc-%d"
+  partner_namespace_id = "%s"
+
+  partner_topic {
+    name                = "acctest-egpt-%d"
+    resource_group_name = azurerm_resource_group.test.name
+    source              = "acctest-source-%d"
+    subscription_id     = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, partnerNamespaceId, data.RandomInteger, data.RandomInteger, data.Subscriptions.Primary)
+}
+
+func (r EventGridPartnerNamespaceChannelResource) requiresImport(data acceptance.TestData, partnerNamespaceId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_namespace_channel" "import" {
+  name                 = azurerm_eventgrid_partner_namespace_channel.test.name
+  partner_namespace_id = azurerm_eventgrid_partner_namespace_channel.test.partner_namespace_id
+
+  partner_topic {
+    name                = azurerm_eventgrid_partner_namespace_channel.test.partner_topic.0.name
+    resource_group_name = azurerm_eventgrid_partner_namespace_channel.test.partner_topic.0.resource_group_name
+    source              = azurerm_eventgrid_partner_namespace_channel.test.partner_topic.0.source
+    subscription_id     = azurerm_eventgrid_partner_namespace_channel.test.partner_topic.0.subscription_id
+  }
+}
+`, r.basic(data, partnerNamespaceId))
+}
+
+func (EventGridPartnerNamespaceChannelResource) complete(data acceptance.TestData, partnerNamespaceId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventgrid_partner_namespace_channel" "test" {
+  name                 = "acctest-egpnc-%d"
+  partner_namespace_id = "%s"
+
+  partner_topic {
+    name                = "acctest-egpt-%d"
+    resource_group_name = azurerm_resource_group.test.name
+    source              = "acctest-source-%d"
+    subscription_id     = "%s"
+  }
+
+  expiration_time_if_not_activated_utc = "2037-01-01T00:00:00Z"
+  message_for_activation               = "Please activate the partner topic to start receiving events"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, partnerNamespaceId, data.RandomInteger, data.RandomInteger, data.Subscriptions.Primary)
+}
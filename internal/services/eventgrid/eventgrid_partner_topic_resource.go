@@ -0,0 +1,203 @@
+package eventgrid
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnertopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceEventGridPartnerTopic() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceEventGridPartnerTopicCreate,
+		Read:   resourceEventGridPartnerTopicRead,
+		Update: resourceEventGridPartnerTopicUpdate,
+		Delete: resourceEventGridPartnerTopicDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := partnertopics.ParsePartnerTopicID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z0-9-]{3,50}$"),
+					"EventGrid Partner Topic name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"activation_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"expiration_time_if_not_activated_utc": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"friendly_description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"partner_registration_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceEventGridPartnerTopicCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.PartnerTopicsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := partnertopics.NewPartnerTopicID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	// Partner Topics are created by the partner when an event channel is established - this
+	// resource adopts the existing Partner Topic and activates it, so it must already exist
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("%s was not found - Partner Topics are created by the partner and must exist before they can be activated", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	activationState := partnertopics.PartnerTopicActivationStateNeverActivated
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.ActivationState != nil {
+		activationState = *model.Properties.ActivationState
+	}
+
+	if activationState != partnertopics.PartnerTopicActivationStateActivated {
+		if _, err := client.Activate(ctx, id); err != nil {
+			return fmt.Errorf("activating %s: %+v", id, err)
+		}
+	}
+
+	if tagsRaw := d.Get("tags").(map[string]interface{}); len(tagsRaw) > 0 {
+		parameters := partnertopics.PartnerTopicUpdateParameters{
+			Tags: tags.Expand(tagsRaw),
+		}
+		if _, err := client.Update(ctx, id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+	return resourceEventGridPartnerTopicRead(d, meta)
+}
+
+func resourceEventGridPartnerTopicUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.PartnerTopicsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := partnertopics.ParsePartnerTopicID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := partnertopics.PartnerTopicUpdateParameters{
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.Update(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceEventGridPartnerTopicRead(d, meta)
+}
+
+func resourceEventGridPartnerTopicRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.PartnerTopicsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := partnertopics.ParsePartnerTopicID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.PartnerTopicName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			activationState := ""
+			if props.ActivationState != nil {
+				activationState = string(*props.ActivationState)
+			}
+			d.Set("activation_state", activationState)
+			d.Set("expiration_time_if_not_activated_utc", props.ExpirationTimeIfNotActivatedUtc)
+			d.Set("friendly_description", props.PartnerTopicFriendlyDescription)
+			d.Set("partner_registration_id", props.PartnerRegistrationImmutableId)
+			d.Set("source", props.Source)
+		}
+
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceEventGridPartnerTopicDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventGrid.PartnerTopicsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := partnertopics.ParsePartnerTopicID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnertopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventGridPartnerTopicResource struct{}
+
+func TestAccEventGridPartnerTopic_basic(t *testing.T) {
+	partnerNamespaceId := eventGridPartnerNamespaceId(t)
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic", "test")
+	r := EventGridPartnerTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, partnerNamespaceId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridPartnerTopic_requiresImport(t *testing.T) {
+	partnerNamespaceId := eventGridPartnerNamespaceId(t)
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic", "test")
+	r := EventGridPartnerTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, partnerNamespaceId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, partnerNamespaceId)
+		}),
+	})
+}
+
+func TestAccEventGridPartnerTopic_complete(t *testing.T) {
+	partnerNamespaceId := eventGridPartnerNamespaceId(t)
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic", "test")
+	r := EventGridPartnerTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, partnerNamespaceId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridPartnerTopicResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := partnertopics.ParsePartnerTopicID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.PartnerTopicsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+// the Partner Topic is materialized by creating a channel pointing at it, since Partner
+// Topics cannot be created directly - the resource under test then adopts it
+func (EventGridPartnerTopicResource) template(data acceptance.TestData, partnerNamespaceId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventgrid_partner_namespace_channel" "test" {
+  name                 = "acctest-egpnc-%d"
+  partner_namespace_id = "%s"
+
+  partner_topic {
+    name                = "acctest-egpt-%d"
+    resource_group_name = azurerm_resource_group.test.name
+    source              = "acctest-source-%d"
+    subscription_id     = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, partnerNamespaceId, data.RandomInteger, data.RandomInteger, data.Subscriptions.Primary)
+}
+
+func (r EventGridPartnerTopicResource) basic(data acceptance.TestData, partnerNamespaceId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic" "test" {
+  name                = "acctest-egpt-%d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_eventgrid_partner_namespace_channel.test]
+}
+`, r.template(data, partnerNamespaceId), data.RandomInteger)
+}
+
+func (r EventGridPartnerTopicResource) requiresImport(data acceptance.TestData, partnerNamespaceId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic" "import" {
+  name                = azurerm_eventgrid_partner_topic.test.name
+  resource_group_name = azurerm_eventgrid_partner_topic.test.resource_group_name
+}
+`, r.basic(data, partnerNamespaceId))
+}
+
+func (r EventGridPartnerTopicResource) complete(data acceptance.TestData, partnerNamespaceId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic" "test" {
+  name                = "acctest-egpt-%d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  tags = {
+    environment = "Test"
+  }
+
+  depends_on = [azurerm_eventgrid_partner_namespace_channel.test]
+}
+`, r.template(data, partnerNamespaceId), data.RandomInteger)
+}
@@ -41,6 +41,16 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_eventgrid_domain":                          resourceEventGridDomain(),
 		"azurerm_eventgrid_domain_topic":                    resourceEventGridDomainTopic(),
 		"azurerm_eventgrid_event_subscription":              resourceEventGridEventSubscription(),
+		"azurerm_eventgrid_namespace":                       resourceEventGridNamespace(),
+		"azurerm_eventgrid_namespace_ca_certificate":        resourceEventGridNamespaceCaCertificate(),
+		"azurerm_eventgrid_namespace_client":                resourceEventGridNamespaceClient(),
+		"azurerm_eventgrid_namespace_client_group":          resourceEventGridNamespaceClientGroup(),
+		"azurerm_eventgrid_namespace_permission_binding":    resourceEventGridNamespacePermissionBinding(),
+		"azurerm_eventgrid_namespace_topic":                 resourceEventGridNamespaceTopic(),
+		"azurerm_eventgrid_namespace_topic_space":           resourceEventGridNamespaceTopicSpace(),
+		"azurerm_eventgrid_partner_configuration":           resourceEventGridPartnerConfiguration(),
+		"azurerm_eventgrid_partner_namespace_channel":       resourceEventGridPartnerNamespaceChannel(),
+		"azurerm_eventgrid_partner_topic":                   resourceEventGridPartnerTopic(),
 		"azurerm_eventgrid_topic":                           resourceEventGridTopic(),
 		"azurerm_eventgrid_system_topic":                    resourceEventGridSystemTopic(),
 		"azurerm_eventgrid_system_topic_event_subscription": resourceEventGridSystemTopicEventSubscription(),
@@ -0,0 +1,18 @@
+package channels
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ChannelsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewChannelsClientWithBaseURI(endpoint string) ChannelsClient {
+	return ChannelsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
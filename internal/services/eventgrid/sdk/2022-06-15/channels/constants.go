@@ -0,0 +1,59 @@
+package channels
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ChannelType string
+
+const (
+	ChannelTypePartnerTopic ChannelType = "PartnerTopic"
+)
+
+func PossibleValuesForChannelType() []string {
+	return []string{
+		string(ChannelTypePartnerTopic),
+	}
+}
+
+func parseChannelType(input string) (*ChannelType, error) {
+	vals := map[string]ChannelType{
+		"partnertopic": ChannelTypePartnerTopic,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ChannelType(input)
+	return &out, nil
+}
+
+type ReadinessState string
+
+const (
+	ReadinessStateActivated      ReadinessState = "Activated"
+	ReadinessStateNeverActivated ReadinessState = "NeverActivated"
+)
+
+func PossibleValuesForReadinessState() []string {
+	return []string{
+		string(ReadinessStateActivated),
+		string(ReadinessStateNeverActivated),
+	}
+}
+
+func parseReadinessState(input string) (*ReadinessState, error) {
+	vals := map[string]ReadinessState{
+		"activated":      ReadinessStateActivated,
+		"neveractivated": ReadinessStateNeverActivated,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReadinessState(input)
+	return &out, nil
+}
@@ -0,0 +1,27 @@
+package channels
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Channel struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *ChannelProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
+
+type ChannelProperties struct {
+	ChannelType                     *ChannelType      `json:"channelType,omitempty"`
+	ExpirationTimeIfNotActivatedUtc *string           `json:"expirationTimeIfNotActivatedUtc,omitempty"`
+	MessageForActivation            *string           `json:"messageForActivation,omitempty"`
+	PartnerTopicInfo                *PartnerTopicInfo `json:"partnerTopicInfo,omitempty"`
+	ProvisioningState               *string           `json:"provisioningState,omitempty"`
+	ReadinessState                  *ReadinessState   `json:"readinessState,omitempty"`
+}
+
+type PartnerTopicInfo struct {
+	AzureSubscriptionId *string `json:"azureSubscriptionId,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	ResourceGroupName   *string `json:"resourceGroupName,omitempty"`
+	Source              *string `json:"source,omitempty"`
+}
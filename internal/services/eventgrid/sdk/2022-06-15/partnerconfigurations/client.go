@@ -0,0 +1,18 @@
+package partnerconfigurations
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PartnerConfigurationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPartnerConfigurationsClientWithBaseURI(endpoint string) PartnerConfigurationsClient {
+	return PartnerConfigurationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
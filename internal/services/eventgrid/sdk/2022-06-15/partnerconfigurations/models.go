@@ -0,0 +1,29 @@
+package partnerconfigurations
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PartnerConfiguration struct {
+	Id         *string                         `json:"id,omitempty"`
+	Location   *string                         `json:"location,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties *PartnerConfigurationProperties `json:"properties,omitempty"`
+	Tags       *map[string]string              `json:"tags,omitempty"`
+	Type       *string                         `json:"type,omitempty"`
+}
+
+type PartnerConfigurationProperties struct {
+	PartnerAuthorization *PartnerAuthorization `json:"partnerAuthorization,omitempty"`
+	ProvisioningState    *string               `json:"provisioningState,omitempty"`
+}
+
+type PartnerAuthorization struct {
+	AuthorizedPartnersList             *[]Partner `json:"authorizedPartnersList,omitempty"`
+	DefaultMaximumExpirationTimeInDays *int64     `json:"defaultMaximumExpirationTimeInDays,omitempty"`
+}
+
+type Partner struct {
+	AuthorizationExpirationTimeInUtc *string `json:"authorizationExpirationTimeInUtc,omitempty"`
+	PartnerName                      *string `json:"partnerName,omitempty"`
+	PartnerRegistrationImmutableId   *string `json:"partnerRegistrationImmutableId,omitempty"`
+}
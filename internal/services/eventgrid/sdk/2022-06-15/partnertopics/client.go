@@ -0,0 +1,18 @@
+package partnertopics
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PartnerTopicsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPartnerTopicsClientWithBaseURI(endpoint string) PartnerTopicsClient {
+	return PartnerTopicsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
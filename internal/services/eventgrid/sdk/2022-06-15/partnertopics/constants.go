@@ -0,0 +1,37 @@
+package partnertopics
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PartnerTopicActivationState string
+
+const (
+	PartnerTopicActivationStateActivated      PartnerTopicActivationState = "Activated"
+	PartnerTopicActivationStateDeactivated    PartnerTopicActivationState = "Deactivated"
+	PartnerTopicActivationStateNeverActivated PartnerTopicActivationState = "NeverActivated"
+)
+
+func PossibleValuesForPartnerTopicActivationState() []string {
+	return []string{
+		string(PartnerTopicActivationStateActivated),
+		string(PartnerTopicActivationStateDeactivated),
+		string(PartnerTopicActivationStateNeverActivated),
+	}
+}
+
+func parsePartnerTopicActivationState(input string) (*PartnerTopicActivationState, error) {
+	vals := map[string]PartnerTopicActivationState{
+		"activated":      PartnerTopicActivationStateActivated,
+		"deactivated":    PartnerTopicActivationStateDeactivated,
+		"neveractivated": PartnerTopicActivationStateNeverActivated,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PartnerTopicActivationState(input)
+	return &out, nil
+}
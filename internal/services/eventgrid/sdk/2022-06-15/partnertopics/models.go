@@ -0,0 +1,27 @@
+package partnertopics
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PartnerTopic struct {
+	Id         *string                 `json:"id,omitempty"`
+	Location   string                  `json:"location"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *PartnerTopicProperties `json:"properties,omitempty"`
+	Tags       *map[string]string      `json:"tags,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
+
+type PartnerTopicProperties struct {
+	ActivationState                 *PartnerTopicActivationState `json:"activationState,omitempty"`
+	ExpirationTimeIfNotActivatedUtc *string                      `json:"expirationTimeIfNotActivatedUtc,omitempty"`
+	MessageForActivation            *string                      `json:"messageForActivation,omitempty"`
+	PartnerRegistrationImmutableId  *string                      `json:"partnerRegistrationImmutableId,omitempty"`
+	PartnerTopicFriendlyDescription *string                      `json:"partnerTopicFriendlyDescription,omitempty"`
+	ProvisioningState               *string                      `json:"provisioningState,omitempty"`
+	Source                          *string                      `json:"source,omitempty"`
+}
+
+type PartnerTopicUpdateParameters struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
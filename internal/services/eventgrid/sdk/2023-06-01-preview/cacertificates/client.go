@@ -0,0 +1,18 @@
+package cacertificates
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CaCertificatesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCaCertificatesClientWithBaseURI(endpoint string) CaCertificatesClient {
+	return CaCertificatesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,19 @@
+package cacertificates
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CaCertificate struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *CaCertificateProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
+
+type CaCertificateProperties struct {
+	Description        *string `json:"description,omitempty"`
+	EncodedCertificate *string `json:"encodedCertificate,omitempty"`
+	ExpiryTimeInUtc    *string `json:"expiryTimeInUtc,omitempty"`
+	IssueTimeInUtc     *string `json:"issueTimeInUtc,omitempty"`
+	ProvisioningState  *string `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,18 @@
+package clientgroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ClientGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewClientGroupsClientWithBaseURI(endpoint string) ClientGroupsClient {
+	return ClientGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
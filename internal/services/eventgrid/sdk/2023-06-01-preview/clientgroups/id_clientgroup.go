@@ -0,0 +1,140 @@
+package clientgroups
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+var _ resourceids.ResourceId = ClientGroupId{}
+
+// ClientGroupId is a struct representing the Resource ID for a Client Group
+type ClientGroupId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	NamespaceName     string
+	ClientGroupName   string
+}
+
+// NewClientGroupID returns a new ClientGroupId struct
+func NewClientGroupID(subscriptionId string, resourceGroupName string, namespaceName string, clientGroupName string) ClientGroupId {
+	return ClientGroupId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		NamespaceName:     namespaceName,
+		ClientGroupName:   clientGroupName,
+	}
+}
+
+// ParseClientGroupID parses 'input' into a ClientGroupId
+func ParseClientGroupID(input string) (*ClientGroupId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ClientGroupId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ClientGroupId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NamespaceName, ok = parsed.Parsed["namespaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'namespaceName' was not found in the resource id %q", input)
+	}
+
+	if id.ClientGroupName, ok = parsed.Parsed["clientGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clientGroupName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseClientGroupIDInsensitively parses 'input' case-insensitively into a ClientGroupId
+// note: this method should only be used for API response data and not user input
+func ParseClientGroupIDInsensitively(input string) (*ClientGroupId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ClientGroupId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ClientGroupId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NamespaceName, ok = parsed.Parsed["namespaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'namespaceName' was not found in the resource id %q", input)
+	}
+
+	if id.ClientGroupName, ok = parsed.Parsed["clientGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clientGroupName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateClientGroupID checks that 'input' can be parsed as a Client Group ID
+func ValidateClientGroupID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseClientGroupID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Client Group ID
+func (id ClientGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/namespaces/%s/clientGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.ClientGroupName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Client Group ID
+func (id ClientGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftEventGrid", "Microsoft.EventGrid", "Microsoft.EventGrid"),
+		resourceids.StaticSegment("staticNamespaces", "namespaces", "namespaces"),
+		resourceids.UserSpecifiedSegment("namespaceName", "namespaceValue"),
+		resourceids.StaticSegment("staticClientGroups", "clientGroups", "clientGroups"),
+		resourceids.UserSpecifiedSegment("clientGroupName", "clientGroupValue"),
+	}
+}
+
+// String returns a human-readable description of this Client Group ID
+func (id ClientGroupId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Namespace Name: %q", id.NamespaceName),
+		fmt.Sprintf("Client Group Name: %q", id.ClientGroupName),
+	}
+	return fmt.Sprintf("Client Group (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,18 @@
+package clients
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ClientsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewClientsClientWithBaseURI(endpoint string) ClientsClient {
+	return ClientsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
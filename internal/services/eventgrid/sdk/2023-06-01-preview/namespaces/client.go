@@ -0,0 +1,18 @@
+package namespaces
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type NamespacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNamespacesClientWithBaseURI(endpoint string) NamespacesClient {
+	return NamespacesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
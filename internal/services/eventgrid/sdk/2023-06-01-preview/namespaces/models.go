@@ -0,0 +1,39 @@
+package namespaces
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Namespace struct {
+	Id         *string              `json:"id,omitempty"`
+	Location   string               `json:"location"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *NamespaceProperties `json:"properties,omitempty"`
+	Sku        *NamespaceSku        `json:"sku,omitempty"`
+	Tags       *map[string]string   `json:"tags,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
+
+type NamespaceProperties struct {
+	IsZoneRedundant          *bool                     `json:"isZoneRedundant,omitempty"`
+	ProvisioningState        *string                   `json:"provisioningState,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess      `json:"publicNetworkAccess,omitempty"`
+	TopicSpacesConfiguration *TopicSpacesConfiguration `json:"topicSpacesConfiguration,omitempty"`
+	TopicsConfiguration      *TopicsConfiguration      `json:"topicsConfiguration,omitempty"`
+}
+
+type NamespaceSku struct {
+	Capacity *int64   `json:"capacity,omitempty"`
+	Name     *SkuName `json:"name,omitempty"`
+}
+
+type TopicsConfiguration struct {
+	Hostname *string `json:"hostname,omitempty"`
+}
+
+type TopicSpacesConfiguration struct {
+	Hostname                                   *string                        `json:"hostname,omitempty"`
+	MaximumClientSessionsPerAuthenticationName *int64                         `json:"maximumClientSessionsPerAuthenticationName,omitempty"`
+	MaximumSessionExpiryInHours                *int64                         `json:"maximumSessionExpiryInHours,omitempty"`
+	RouteTopicResourceId                       *string                        `json:"routeTopicResourceId,omitempty"`
+	State                                      *TopicSpacesConfigurationState `json:"state,omitempty"`
+}
@@ -0,0 +1,18 @@
+package namespacetopics
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type NamespaceTopicsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNamespaceTopicsClientWithBaseURI(endpoint string) NamespaceTopicsClient {
+	return NamespaceTopicsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,31 @@
+package namespacetopics
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PublisherType string
+
+const (
+	PublisherTypeCustom PublisherType = "Custom"
+)
+
+func PossibleValuesForPublisherType() []string {
+	return []string{
+		string(PublisherTypeCustom),
+	}
+}
+
+func parsePublisherType(input string) (*PublisherType, error) {
+	vals := map[string]PublisherType{
+		"custom": PublisherTypeCustom,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublisherType(input)
+	return &out, nil
+}
@@ -0,0 +1,17 @@
+package namespacetopics
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type NamespaceTopic struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *NamespaceTopicProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type NamespaceTopicProperties struct {
+	EventRetentionInDays *int64         `json:"eventRetentionInDays,omitempty"`
+	ProvisioningState    *string        `json:"provisioningState,omitempty"`
+	PublisherType        *PublisherType `json:"publisherType,omitempty"`
+}
@@ -0,0 +1,18 @@
+package permissionbindings
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PermissionBindingsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPermissionBindingsClientWithBaseURI(endpoint string) PermissionBindingsClient {
+	return PermissionBindingsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,19 @@
+package permissionbindings
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PermissionBinding struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *PermissionBindingProperties `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type PermissionBindingProperties struct {
+	ClientGroupName   *string         `json:"clientGroupName,omitempty"`
+	Description       *string         `json:"description,omitempty"`
+	Permission        *PermissionType `json:"permission,omitempty"`
+	ProvisioningState *string         `json:"provisioningState,omitempty"`
+	TopicSpaceName    *string         `json:"topicSpaceName,omitempty"`
+}
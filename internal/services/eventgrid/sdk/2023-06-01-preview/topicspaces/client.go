@@ -0,0 +1,18 @@
+package topicspaces
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type TopicSpacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTopicSpacesClientWithBaseURI(endpoint string) TopicSpacesClient {
+	return TopicSpacesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,17 @@
+package topicspaces
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type TopicSpace struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *TopicSpaceProperties `json:"properties,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
+
+type TopicSpaceProperties struct {
+	Description       *string   `json:"description,omitempty"`
+	ProvisioningState *string   `json:"provisioningState,omitempty"`
+	TopicTemplates    *[]string `json:"topicTemplates,omitempty"`
+}
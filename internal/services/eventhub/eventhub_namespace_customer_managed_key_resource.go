@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2022-01-01-preview/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -74,6 +75,18 @@ func resourceEventHubNamespaceCustomerManagedKey() *pluginsdk.Resource {
 					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 				},
 			},
+
+			"infrastructure_encryption_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"user_assigned_identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			},
 		},
 	}
 }
@@ -109,13 +122,24 @@ func resourceEventHubNamespaceCustomerManagedKeyCreateUpdate(d *pluginsdk.Resour
 
 	keySource := namespaces.KeySourceMicrosoftPointKeyVault
 	namespace.Properties.Encryption = &namespaces.Encryption{
-		KeySource: &keySource,
+		KeySource:                       &keySource,
+		RequireInfrastructureEncryption: utils.Bool(d.Get("infrastructure_encryption_enabled").(bool)),
 	}
 
 	keyVaultProps, err := expandEventHubNamespaceKeyVaultKeyIds(d.Get("key_vault_key_ids").(*pluginsdk.Set).List())
 	if err != nil {
 		return err
 	}
+
+	if keyVaultProps != nil {
+		if identityId := d.Get("user_assigned_identity_id").(string); identityId != "" {
+			for i := 0; i < len(*keyVaultProps); i++ {
+				(*keyVaultProps)[i].Identity = &namespaces.UserAssignedIdentityProperties{
+					UserAssignedIdentity: utils.String(identityId),
+				}
+			}
+		}
+	}
 	namespace.Properties.Encryption.KeyVaultProperties = keyVaultProps
 
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, *namespace); err != nil {
@@ -162,6 +186,21 @@ func resourceEventHubNamespaceCustomerManagedKeyRead(d *pluginsdk.ResourceData,
 		}
 
 		d.Set("key_vault_key_ids", keyVaultKeyIds)
+
+		infrastructureEncryptionEnabled := false
+		userAssignedIdentityId := ""
+		if encryption := props.Encryption; encryption != nil {
+			if encryption.RequireInfrastructureEncryption != nil {
+				infrastructureEncryptionEnabled = *encryption.RequireInfrastructureEncryption
+			}
+			if encryption.KeyVaultProperties != nil && len(*encryption.KeyVaultProperties) > 0 {
+				if identity := (*encryption.KeyVaultProperties)[0].Identity; identity != nil && identity.UserAssignedIdentity != nil {
+					userAssignedIdentityId = *identity.UserAssignedIdentity
+				}
+			}
+		}
+		d.Set("infrastructure_encryption_enabled", infrastructureEncryptionEnabled)
+		d.Set("user_assigned_identity_id", userAssignedIdentityId)
 	}
 
 	return nil
@@ -10,11 +10,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
 
 func firewallDataSource() *pluginsdk.Resource {
@@ -114,6 +114,11 @@ func firewallDataSource() *pluginsdk.Resource {
 				},
 			},
 
+			"dns_proxy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"virtual_hub": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -144,6 +149,11 @@ func firewallDataSource() *pluginsdk.Resource {
 
 			"zones": commonschema.ZonesMultipleComputed(),
 
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -187,11 +197,14 @@ func firewallDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 
 		d.Set("threat_intel_mode", string(props.ThreatIntelMode))
+		d.Set("provisioning_state", string(props.ProvisioningState))
 
 		if err := d.Set("dns_servers", flattenFirewallDNSServers(props.AdditionalProperties)); err != nil {
 			return fmt.Errorf("setting `dns_servers`: %+v", err)
 		}
 
+		d.Set("dns_proxy_enabled", flattenFirewallDNSProxyEnabled(props.AdditionalProperties))
+
 		if policy := props.FirewallPolicy; policy != nil {
 			d.Set("firewall_policy_id", policy.ID)
 		}
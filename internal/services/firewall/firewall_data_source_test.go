@@ -20,6 +20,7 @@ func TestAccFirewallDataSource_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("ip_configuration.0.name").HasValue("configuration"),
 				check.That(data.ResourceName).Key("ip_configuration.0.private_ip_address").Exists(),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
 			),
 		},
 	})
@@ -3,6 +3,7 @@ package firewall
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,13 +19,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/network"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
 
 var AzureFirewallResourceName = "azurerm_firewall"
@@ -87,6 +88,26 @@ func resourceFirewall() *pluginsdk.Resource {
 				ValidateFunc: validate.FirewallPolicyID,
 			},
 
+			"autoscale_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"min_capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(2),
+						},
+						"max_capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(2),
+						},
+					},
+				},
+			},
+
 			"ip_configuration": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -168,6 +189,12 @@ func resourceFirewall() *pluginsdk.Resource {
 				},
 			},
 
+			"dns_proxy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
 			"private_ip_ranges": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
@@ -213,6 +240,11 @@ func resourceFirewall() *pluginsdk.Resource {
 
 			"zones": commonschema.ZonesMultipleOptionalForceNew(),
 
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -295,6 +327,8 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.AzureFirewallPropertiesFormat.FirewallPolicy = &network.SubResource{ID: &policyId}
 	}
 
+	parameters.AzureFirewallPropertiesFormat.AutoscaleConfiguration = expandFirewallAutoscaleConfiguration(d.Get("autoscale_configuration").([]interface{}))
+
 	vhub, hubIpAddresses, ok := expandFirewallVirtualHubSetting(existing, d.Get("virtual_hub").([]interface{}))
 	if ok {
 		parameters.AzureFirewallPropertiesFormat.VirtualHub = vhub
@@ -315,7 +349,16 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.Sku.Tier = network.AzureFirewallSkuTier(skuTier)
 	}
 
-	if dnsServerSetting := expandFirewallDNSServers(d.Get("dns_servers").([]interface{})); dnsServerSetting != nil {
+	dnsServers := d.Get("dns_servers").([]interface{})
+	dnsProxyEnabled := len(dnsServers) > 0
+	// `dns_proxy_enabled` is Optional+Computed so the raw config is checked to determine whether it was explicitly set
+	if v := d.GetRawConfig().AsValueMap()["dns_proxy_enabled"]; !v.IsNull() {
+		dnsProxyEnabled = v.True()
+	}
+	if len(dnsServers) > 0 && !dnsProxyEnabled {
+		return fmt.Errorf("`dns_proxy_enabled` must be enabled when `dns_servers` is set")
+	}
+	if dnsServerSetting := expandFirewallDNSServers(dnsServers, dnsProxyEnabled); dnsServerSetting != nil {
 		for k, v := range dnsServerSetting {
 			parameters.AdditionalProperties[k] = v
 		}
@@ -414,11 +457,14 @@ func resourceFirewallRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 
 		d.Set("threat_intel_mode", string(props.ThreatIntelMode))
+		d.Set("provisioning_state", string(props.ProvisioningState))
 
 		if err := d.Set("dns_servers", flattenFirewallDNSServers(props.AdditionalProperties)); err != nil {
 			return fmt.Errorf("setting `dns_servers`: %+v", err)
 		}
 
+		d.Set("dns_proxy_enabled", flattenFirewallDNSProxyEnabled(props.AdditionalProperties))
+
 		if err := d.Set("private_ip_ranges", flattenFirewallPrivateIpRange(props.AdditionalProperties)); err != nil {
 			return fmt.Errorf("setting `private_ip_ranges`: %+v", err)
 		}
@@ -435,6 +481,10 @@ func resourceFirewallRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		if err := d.Set("virtual_hub", flattenFirewallVirtualHubSetting(props)); err != nil {
 			return fmt.Errorf("setting `virtual_hub`: %+v", err)
 		}
+
+		if err := d.Set("autoscale_configuration", flattenFirewallAutoscaleConfiguration(props.AutoscaleConfiguration)); err != nil {
+			return fmt.Errorf("setting `autoscale_configuration`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, read.Tags)
@@ -612,8 +662,8 @@ func flattenFirewallIPConfigurations(input *[]network.AzureFirewallIPConfigurati
 	return result
 }
 
-func expandFirewallDNSServers(input []interface{}) map[string]*string {
-	if len(input) == 0 {
+func expandFirewallDNSServers(input []interface{}, dnsProxyEnabled bool) map[string]*string {
+	if len(input) == 0 && !dnsProxyEnabled {
 		return nil
 	}
 
@@ -624,7 +674,7 @@ func expandFirewallDNSServers(input []interface{}) map[string]*string {
 
 	// Swagger issue asking finalize these properties: https://github.com/Azure/azure-rest-api-specs/issues/11278
 	return map[string]*string{
-		"Network.DNS.EnableProxy": utils.String("true"),
+		"Network.DNS.EnableProxy": utils.String(strconv.FormatBool(dnsProxyEnabled)),
 		"Network.DNS.Servers":     utils.String(strings.Join(servers, ",")),
 	}
 }
@@ -634,20 +684,22 @@ func flattenFirewallDNSServers(input map[string]*string) []interface{} {
 		return nil
 	}
 
-	enabled := false
-	if enabledPtr := input["Network.DNS.EnableProxy"]; enabledPtr != nil {
-		enabled = *enabledPtr == "true"
+	servers := []string{}
+	if serversPtr := input["Network.DNS.Servers"]; serversPtr != nil && *serversPtr != "" {
+		servers = strings.Split(*serversPtr, ",")
 	}
+	return utils.FlattenStringSlice(&servers)
+}
 
-	if !enabled {
-		return nil
+func flattenFirewallDNSProxyEnabled(input map[string]*string) bool {
+	if len(input) == 0 {
+		return false
 	}
 
-	servers := []string{}
-	if serversPtr := input["Network.DNS.Servers"]; serversPtr != nil {
-		servers = strings.Split(*serversPtr, ",")
+	if enabledPtr := input["Network.DNS.EnableProxy"]; enabledPtr != nil {
+		return *enabledPtr == "true"
 	}
-	return utils.FlattenStringSlice(&servers)
+	return false
 }
 
 func expandFirewallPrivateIpRange(input []interface{}) map[string]*string {
@@ -678,6 +730,46 @@ func flattenFirewallPrivateIpRange(input map[string]*string) []interface{} {
 	return utils.FlattenStringSlice(&rangeSlice)
 }
 
+func expandFirewallAutoscaleConfiguration(input []interface{}) *network.AzureFirewallAutoscaleConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	result := network.AzureFirewallAutoscaleConfiguration{}
+	if minCapacity := v["min_capacity"].(int); minCapacity != 0 {
+		result.MinCapacity = utils.Int32(int32(minCapacity))
+	}
+	if maxCapacity := v["max_capacity"].(int); maxCapacity != 0 {
+		result.MaxCapacity = utils.Int32(int32(maxCapacity))
+	}
+	return &result
+}
+
+func flattenFirewallAutoscaleConfiguration(input *network.AzureFirewallAutoscaleConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	minCapacity := 0
+	if input.MinCapacity != nil {
+		minCapacity = int(*input.MinCapacity)
+	}
+
+	maxCapacity := 0
+	if input.MaxCapacity != nil {
+		maxCapacity = int(*input.MaxCapacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"min_capacity": minCapacity,
+			"max_capacity": maxCapacity,
+		},
+	}
+}
+
 func expandFirewallVirtualHubSetting(existing network.AzureFirewall, input []interface{}) (vhub *network.SubResource, ipAddresses *network.HubIPAddresses, ok bool) {
 	if len(input) == 0 {
 		return nil, nil, false
@@ -1,7 +1,7 @@
 package firewall
 
 import (
-	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/network"
 )
 
 func flattenNetworkSubResourceID(input *[]network.SubResource) []interface{} {
@@ -35,6 +35,8 @@ var hdInsightKafkaClusterWorkerNodeDefinition = HDInsightNodeDefinition{
 	MinInstanceCount:        1,
 	CanSpecifyDisks:         true,
 	MaxNumberOfDisksPerNode: utils.Int(8),
+	CanAutoScaleByCapacity:  true,
+	CanAutoScaleOnSchedule:  true,
 }
 
 var hdInsightKafkaClusterZookeeperNodeDefinition = HDInsightNodeDefinition{
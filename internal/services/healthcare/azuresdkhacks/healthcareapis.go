@@ -0,0 +1,201 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the Fhir Service `importConfiguration` and the Dicom Service `storageConfiguration` and
+// `corsConfiguration` properties only exist in newer versions of the HealthcareApis API
+// than the vendored 2021-11-01 SDK exposes, so these are implemented by hand until the
+// provider is migrated onto a newer version of the SDK
+const healthcareApisApiVersion = "2023-11-01"
+
+// FhirService is a version of healthcareapis.FhirService which surfaces the
+// `importConfiguration` property missing from the vendored SDK
+type FhirService struct {
+	autorest.Response `json:"-"`
+	Kind              healthcareapis.FhirServiceKind                 `json:"kind,omitempty"`
+	Identity          *healthcareapis.ServiceManagedIdentityIdentity `json:"identity,omitempty"`
+	Location          *string                                        `json:"location,omitempty"`
+	Tags              map[string]*string                             `json:"tags"`
+	Properties        *FhirServiceProperties                         `json:"properties,omitempty"`
+}
+
+type FhirServiceProperties struct {
+	AccessPolicies              *[]healthcareapis.FhirServiceAccessPolicyEntry         `json:"accessPolicies,omitempty"`
+	AcrConfiguration            *healthcareapis.FhirServiceAcrConfiguration            `json:"acrConfiguration,omitempty"`
+	AuthenticationConfiguration *healthcareapis.FhirServiceAuthenticationConfiguration `json:"authenticationConfiguration,omitempty"`
+	CorsConfiguration           *healthcareapis.FhirServiceCorsConfiguration           `json:"corsConfiguration,omitempty"`
+	ExportConfiguration         *healthcareapis.FhirServiceExportConfiguration         `json:"exportConfiguration,omitempty"`
+	ImportConfiguration         *FhirServiceImportConfiguration                        `json:"importConfiguration,omitempty"`
+	PublicNetworkAccess         healthcareapis.PublicNetworkAccess                     `json:"publicNetworkAccess,omitempty"`
+}
+
+type FhirServiceImportConfiguration struct {
+	Enabled              *bool   `json:"enabled,omitempty"`
+	InitialImportMode    *bool   `json:"initialImportMode,omitempty"`
+	IntegrationDataStore *string `json:"integrationDataStore,omitempty"`
+}
+
+// DicomService is a version of healthcareapis.DicomService which surfaces the
+// `storageConfiguration` and `corsConfiguration` properties missing from the vendored SDK
+type DicomService struct {
+	autorest.Response `json:"-"`
+	Identity          *healthcareapis.ServiceManagedIdentityIdentity `json:"identity,omitempty"`
+	Location          *string                                        `json:"location,omitempty"`
+	Tags              map[string]*string                             `json:"tags"`
+	Properties        *DicomServiceProperties                        `json:"properties,omitempty"`
+}
+
+type DicomServiceProperties struct {
+	AuthenticationConfiguration *healthcareapis.DicomServiceAuthenticationConfiguration `json:"authenticationConfiguration,omitempty"`
+	CorsConfiguration           *DicomServiceCorsConfiguration                          `json:"corsConfiguration,omitempty"`
+	StorageConfiguration        *DicomStorageConfiguration                              `json:"storageConfiguration,omitempty"`
+	PrivateEndpointConnections  *[]healthcareapis.PrivateEndpointConnection             `json:"privateEndpointConnections,omitempty"`
+	PublicNetworkAccess         healthcareapis.PublicNetworkAccess                      `json:"publicNetworkAccess,omitempty"`
+	ServiceURL                  *string                                                 `json:"serviceUrl,omitempty"`
+}
+
+type DicomServiceCorsConfiguration struct {
+	Origins          *[]string `json:"origins,omitempty"`
+	Headers          *[]string `json:"headers,omitempty"`
+	Methods          *[]string `json:"methods,omitempty"`
+	MaxAge           *int32    `json:"maxAge,omitempty"`
+	AllowCredentials *bool     `json:"allowCredentials,omitempty"`
+}
+
+type DicomStorageConfiguration struct {
+	AccountName    *string `json:"accountName,omitempty"`
+	FileSystemName *string `json:"fileSystemName,omitempty"`
+}
+
+// CreateOrUpdateFhirService is a version of healthcareapis.FhirServicesClient.CreateOrUpdate
+// which is able to set the `importConfiguration` property
+func CreateOrUpdateFhirService(ctx context.Context, client *healthcareapis.FhirServicesClient, resourceGroupName string, workspaceName string, fhirServiceName string, fhirservice FhirService) (healthcareapis.FhirServicesCreateOrUpdateFuture, error) {
+	req, err := fhirServicePreparer(ctx, client, resourceGroupName, workspaceName, fhirServiceName, http.MethodPut, fhirservice)
+	if err != nil {
+		return healthcareapis.FhirServicesCreateOrUpdateFuture{}, autorest.NewErrorWithError(err, "healthcareapis.FhirServicesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	return client.CreateOrUpdateSender(req)
+}
+
+// GetFhirService is a version of healthcareapis.FhirServicesClient.Get which surfaces the
+// `importConfiguration` property
+func GetFhirService(ctx context.Context, client *healthcareapis.FhirServicesClient, resourceGroupName string, workspaceName string, fhirServiceName string) (result FhirService, err error) {
+	req, err := fhirServicePreparer(ctx, client, resourceGroupName, workspaceName, fhirServiceName, http.MethodGet, nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "healthcareapis.FhirServicesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "healthcareapis.FhirServicesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "healthcareapis.FhirServicesClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
+
+// CreateOrUpdateDicomService is a version of healthcareapis.DicomServicesClient.CreateOrUpdate
+// which is able to set the `storageConfiguration` and `corsConfiguration` properties
+func CreateOrUpdateDicomService(ctx context.Context, client *healthcareapis.DicomServicesClient, resourceGroupName string, workspaceName string, dicomServiceName string, dicomservice DicomService) (healthcareapis.DicomServicesCreateOrUpdateFuture, error) {
+	req, err := dicomServicePreparer(ctx, client, resourceGroupName, workspaceName, dicomServiceName, http.MethodPut, dicomservice)
+	if err != nil {
+		return healthcareapis.DicomServicesCreateOrUpdateFuture{}, autorest.NewErrorWithError(err, "healthcareapis.DicomServicesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	return client.CreateOrUpdateSender(req)
+}
+
+// GetDicomService is a version of healthcareapis.DicomServicesClient.Get which surfaces the
+// `storageConfiguration` and `corsConfiguration` properties
+func GetDicomService(ctx context.Context, client *healthcareapis.DicomServicesClient, resourceGroupName string, workspaceName string, dicomServiceName string) (result DicomService, err error) {
+	req, err := dicomServicePreparer(ctx, client, resourceGroupName, workspaceName, dicomServiceName, http.MethodGet, nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "healthcareapis.DicomServicesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "healthcareapis.DicomServicesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "healthcareapis.DicomServicesClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
+
+func fhirServicePreparer(ctx context.Context, client *healthcareapis.FhirServicesClient, resourceGroupName string, workspaceName string, fhirServiceName string, method string, body interface{}) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fhirServiceName":   autorest.Encode("path", fhirServiceName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+		"workspaceName":     autorest.Encode("path", workspaceName),
+	}
+
+	return preparerForMethod(method, body,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthcareApis/workspaces/{workspaceName}/fhirservices/{fhirServiceName}", pathParameters),
+	).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func dicomServicePreparer(ctx context.Context, client *healthcareapis.DicomServicesClient, resourceGroupName string, workspaceName string, dicomServiceName string, method string, body interface{}) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"dicomServiceName":  autorest.Encode("path", dicomServiceName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+		"workspaceName":     autorest.Encode("path", workspaceName),
+	}
+
+	return preparerForMethod(method, body,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthcareApis/workspaces/{workspaceName}/dicomservices/{dicomServiceName}", pathParameters),
+	).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func preparerForMethod(method string, body interface{}, decorators ...autorest.PrepareDecorator) autorest.Preparer {
+	queryParameters := map[string]interface{}{
+		"api-version": healthcareApisApiVersion,
+	}
+
+	base := []autorest.PrepareDecorator{
+		autorest.WithMethod(method),
+	}
+	if body != nil {
+		base = append(base, autorest.AsContentType("application/json; charset=utf-8"))
+	}
+	base = append(base, decorators...)
+	base = append(base, autorest.WithQueryParameters(queryParameters))
+	if body != nil {
+		base = append(base, autorest.WithJSON(body))
+	}
+
+	return autorest.CreatePreparer(base...)
+}
@@ -13,11 +13,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -65,6 +67,88 @@ func resourceHealthcareApisDicomService() *pluginsdk.Resource {
 
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
+			"storage": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"storage_account_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"file_system_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"cors": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed_origins": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MaxItems: 64,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"allowed_headers": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							MaxItems: 64,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"allowed_methods": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							MaxItems: 64,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"DELETE",
+									"GET",
+									"HEAD",
+									"OPTIONS",
+									"POST",
+									"PUT",
+								}, false),
+							},
+						},
+
+						"max_age_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 2000000000),
+						},
+
+						"credentials_allowed": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"authentication": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -150,19 +234,21 @@ func resourceHealthcareApisDicomServiceCreate(d *pluginsdk.ResourceData, meta in
 
 	t := d.Get("tags").(map[string]interface{})
 
-	parameters := healthcareapis.DicomService{
+	parameters := azuresdkhacks.DicomService{
 		Identity: identity,
-		DicomServiceProperties: &healthcareapis.DicomServiceProperties{
-			PublicNetworkAccess: healthcareapis.PublicNetworkAccessEnabled,
+		Properties: &azuresdkhacks.DicomServiceProperties{
+			PublicNetworkAccess:  healthcareapis.PublicNetworkAccessEnabled,
+			CorsConfiguration:    expandDicomCorsConfiguration(d.Get("cors").([]interface{})),
+			StorageConfiguration: expandDicomStorageConfiguration(d.Get("storage").([]interface{})),
 		},
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Tags:     tags.Expand(t),
 	}
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
-		parameters.DicomServiceProperties.PublicNetworkAccess = healthcareapis.PublicNetworkAccessDisabled
+		parameters.Properties.PublicNetworkAccess = healthcareapis.PublicNetworkAccessDisabled
 	}
 
-	future, err := client.CreateOrUpdate(ctx, dicomServiceId.ResourceGroup, dicomServiceId.WorkspaceName, dicomServiceId.Name, parameters)
+	future, err := azuresdkhacks.CreateOrUpdateDicomService(ctx, client, dicomServiceId.ResourceGroup, dicomServiceId.WorkspaceName, dicomServiceId.Name, parameters)
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", dicomServiceId, err)
 	}
@@ -186,7 +272,7 @@ func resourceHealthcareApisDicomServiceRead(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("parsing Dicom service error: %+v", err)
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+	resp, err := azuresdkhacks.GetDicomService(ctx, client, id.ResourceGroup, id.WorkspaceName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			d.SetId("")
@@ -203,10 +289,12 @@ func resourceHealthcareApisDicomServiceRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("location", location.Normalize(*resp.Location))
 	}
 
-	if props := resp.DicomServiceProperties; props != nil {
+	if props := resp.Properties; props != nil {
 		d.Set("authentication", flattenDicomAuthentication(props.AuthenticationConfiguration))
 		d.Set("private_endpoint", flattenDicomServicePrivateEndpoint(props.PrivateEndpointConnections))
 		d.Set("service_url", props.ServiceURL)
+		d.Set("storage", flattenDicomStorageConfiguration(props.StorageConfiguration))
+		d.Set("cors", flattenDicomCorsConfiguration(props.CorsConfiguration))
 
 		if props.PublicNetworkAccess != "" {
 			d.Set("public_network_access_enabled", props.PublicNetworkAccess == healthcareapis.PublicNetworkAccessEnabled)
@@ -239,16 +327,18 @@ func resourceHealthcareApisDicomServiceUpdate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
 
-	parameters := healthcareapis.DicomService{
+	parameters := azuresdkhacks.DicomService{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		DicomServiceProperties: &healthcareapis.DicomServiceProperties{
-			PublicNetworkAccess: healthcareapis.PublicNetworkAccessEnabled,
+		Properties: &azuresdkhacks.DicomServiceProperties{
+			PublicNetworkAccess:  healthcareapis.PublicNetworkAccessEnabled,
+			CorsConfiguration:    expandDicomCorsConfiguration(d.Get("cors").([]interface{})),
+			StorageConfiguration: expandDicomStorageConfiguration(d.Get("storage").([]interface{})),
 		},
 		Identity: expandedIdentity,
 	}
 
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
-		parameters.DicomServiceProperties.PublicNetworkAccess = healthcareapis.PublicNetworkAccessDisabled
+		parameters.Properties.PublicNetworkAccess = healthcareapis.PublicNetworkAccessDisabled
 	}
 
 	if d.HasChange("tags") {
@@ -257,7 +347,7 @@ func resourceHealthcareApisDicomServiceUpdate(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, parameters)
+	future, err := azuresdkhacks.CreateOrUpdateDicomService(ctx, client, id.ResourceGroup, id.WorkspaceName, id.Name, parameters)
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
@@ -383,6 +473,96 @@ func flattenDicomServicePrivateEndpoint(input *[]healthcareapis.PrivateEndpointC
 	return results
 }
 
+func expandDicomStorageConfiguration(input []interface{}) *azuresdkhacks.DicomStorageConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	block := input[0].(map[string]interface{})
+
+	return &azuresdkhacks.DicomStorageConfiguration{
+		AccountName:    utils.String(block["storage_account_name"].(string)),
+		FileSystemName: utils.String(block["file_system_name"].(string)),
+	}
+}
+
+func flattenDicomStorageConfiguration(input *azuresdkhacks.DicomStorageConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	storageAccountName := ""
+	if input.AccountName != nil {
+		storageAccountName = *input.AccountName
+	}
+
+	fileSystemName := ""
+	if input.FileSystemName != nil {
+		fileSystemName = *input.FileSystemName
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_account_name": storageAccountName,
+			"file_system_name":     fileSystemName,
+		},
+	}
+}
+
+func expandDicomCorsConfiguration(input []interface{}) *azuresdkhacks.DicomServiceCorsConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	block := input[0].(map[string]interface{})
+
+	cors := &azuresdkhacks.DicomServiceCorsConfiguration{
+		Origins:          utils.ExpandStringSlice(block["allowed_origins"].(*pluginsdk.Set).List()),
+		Headers:          utils.ExpandStringSlice(block["allowed_headers"].(*pluginsdk.Set).List()),
+		Methods:          utils.ExpandStringSlice(block["allowed_methods"].(*pluginsdk.Set).List()),
+		AllowCredentials: utils.Bool(block["credentials_allowed"].(bool)),
+	}
+
+	if v, ok := block["max_age_in_seconds"]; ok {
+		maxAgeInSeconds := int32(v.(int))
+		cors.MaxAge = &maxAgeInSeconds
+	}
+
+	return cors
+}
+
+func flattenDicomCorsConfiguration(input *azuresdkhacks.DicomServiceCorsConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	if input.Origins != nil && len(*input.Origins) == 0 &&
+		input.Headers != nil && len(*input.Headers) == 0 &&
+		input.Methods != nil && len(*input.Methods) == 0 {
+		return []interface{}{}
+	}
+
+	maxAge := 0
+	if input.MaxAge != nil {
+		maxAge = int(*input.MaxAge)
+	}
+
+	allowCredentials := false
+	if input.AllowCredentials != nil {
+		allowCredentials = *input.AllowCredentials
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allowed_origins":     utils.FlattenStringSlice(input.Origins),
+			"allowed_headers":     utils.FlattenStringSlice(input.Headers),
+			"allowed_methods":     utils.FlattenStringSlice(input.Methods),
+			"max_age_in_seconds":  maxAge,
+			"credentials_allowed": allowCredentials,
+		},
+	}
+}
+
 func expandDicomManagedIdentity(input []interface{}) (*healthcareapis.ServiceManagedIdentityIdentity, error) {
 	expanded, err := identity.ExpandSystemAndUserAssignedMap(input)
 	if err != nil {
@@ -83,6 +83,28 @@ func TestAccHealthCareDicomResource_updateUserAssignedIdentity(t *testing.T) {
 	})
 }
 
+func TestAccHealthCareDicomResource_storageAndCors(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_healthcare_dicom_service", "test")
+	r := HealthCareDicomResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageAndCors(data, 3600),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.storageAndCors(data, 1440),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccHealthCareDicomResource_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_healthcare_dicom_service", "test")
 	r := HealthCareDicomResource{}
@@ -190,6 +212,51 @@ resource "azurerm_healthcare_dicom_service" "test" {
 `, r.template(data), data.RandomInteger, data.RandomIntOfLength(10), data.Locations.Primary)
 }
 
+func (r HealthCareDicomResource) storageAndCors(data acceptance.TestData, maxAgeInSeconds int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acc%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctestfs%d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_healthcare_dicom_service" "test" {
+  name         = "dicom%d"
+  workspace_id = azurerm_healthcare_workspace.test.id
+  location     = "%s"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  storage {
+    storage_account_name = azurerm_storage_account.test.name
+    file_system_name     = azurerm_storage_data_lake_gen2_filesystem.test.name
+  }
+
+  cors {
+    allowed_origins     = ["https://acctest.com:123", "https://acctest1.com:3389"]
+    allowed_headers     = ["*"]
+    allowed_methods     = ["GET", "HEAD"]
+    max_age_in_seconds  = %d
+    credentials_allowed = false
+  }
+
+  depends_on = [azurerm_healthcare_workspace.test]
+}
+`, r.template(data), data.RandomIntOfLength(10), data.RandomInteger, data.RandomIntOfLength(10), data.Locations.Primary, maxAgeInSeconds)
+}
+
 func (r HealthCareDicomResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/validate"
@@ -215,6 +216,27 @@ func resourceHealthcareApisFhirService() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"import_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"storage_account_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"initial_import_mode_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"public_network_access_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -255,25 +277,26 @@ func resourceHealthcareApisFhirServiceCreate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
 
-	parameters := healthcareapis.FhirService{
+	parameters := azuresdkhacks.FhirService{
 		Identity: identity,
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
 		Kind:     healthcareapis.FhirServiceKind(d.Get("kind").(string)),
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
-		FhirServiceProperties: &healthcareapis.FhirServiceProperties{
+		Properties: &azuresdkhacks.FhirServiceProperties{
 			AuthenticationConfiguration: expandFhirAuthentication(d.Get("authentication").([]interface{})),
 			CorsConfiguration:           expandFhirCorsConfiguration(d.Get("cors").([]interface{})),
+			ImportConfiguration:         expandFhirImportConfiguration(d.Get("import_configuration").([]interface{})),
 		},
 	}
 
 	accessPolicyObjectIds, hasValues := d.GetOk("access_policy_object_ids")
 	if hasValues {
-		parameters.FhirServiceProperties.AccessPolicies = expandAccessPolicy(accessPolicyObjectIds.(*pluginsdk.Set).List())
+		parameters.Properties.AccessPolicies = expandAccessPolicy(accessPolicyObjectIds.(*pluginsdk.Set).List())
 	}
 
 	storageAcc, hasValues := d.GetOk("configuration_export_storage_account_name")
 	if hasValues {
-		parameters.FhirServiceProperties.ExportConfiguration = &healthcareapis.FhirServiceExportConfiguration{
+		parameters.Properties.ExportConfiguration = &healthcareapis.FhirServiceExportConfiguration{
 			StorageAccountName: utils.String(storageAcc.(string)),
 		}
 	}
@@ -289,9 +312,9 @@ func resourceHealthcareApisFhirServiceCreate(d *pluginsdk.ResourceData, meta int
 		loginServers := expandFhirAcrLoginServer(loginServersRaw.(*pluginsdk.Set).List())
 		acrConfig.LoginServers = loginServers
 	}
-	parameters.FhirServiceProperties.AcrConfiguration = &acrConfig
+	parameters.Properties.AcrConfiguration = &acrConfig
 
-	future, err := client.CreateOrUpdate(ctx, fhirServiceId.ResourceGroup, fhirServiceId.WorkspaceName, fhirServiceId.Name, parameters)
+	future, err := azuresdkhacks.CreateOrUpdateFhirService(ctx, client, fhirServiceId.ResourceGroup, fhirServiceId.WorkspaceName, fhirServiceId.Name, parameters)
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", fhirServiceId, err)
 	}
@@ -326,7 +349,7 @@ func resourceHealthcareApisFhirServiceRead(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+	resp, err := azuresdkhacks.GetFhirService(ctx, client, id.ResourceGroup, id.WorkspaceName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			d.SetId("")
@@ -349,7 +372,7 @@ func resourceHealthcareApisFhirServiceRead(d *pluginsdk.ResourceData, meta inter
 	}
 	d.Set("kind", resp.Kind)
 
-	if props := resp.FhirServiceProperties; props != nil {
+	if props := resp.Properties; props != nil {
 		d.Set("access_policy_object_ids", flattenFhirAccessPolicy(props.AccessPolicies))
 		d.Set("authentication", flattenFhirAuthentication(props.AuthenticationConfiguration))
 		d.Set("cors", flattenFhirCorsConfiguration(props.CorsConfiguration))
@@ -362,6 +385,7 @@ func resourceHealthcareApisFhirServiceRead(d *pluginsdk.ResourceData, meta inter
 		if props.ExportConfiguration != nil && props.ExportConfiguration.StorageAccountName != nil {
 			d.Set("configuration_export_storage_account_name", props.ExportConfiguration.StorageAccountName)
 		}
+		d.Set("import_configuration", flattenFhirImportConfiguration(props.ImportConfiguration))
 		if props.PublicNetworkAccess != "" {
 			d.Set("public_network_access_enabled", props.PublicNetworkAccess == healthcareapis.PublicNetworkAccessEnabled)
 		}
@@ -415,21 +439,22 @@ func resourceHealthcareApisFhirServiceUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
 
-	parameters := healthcareapis.FhirService{
+	parameters := azuresdkhacks.FhirService{
 		Identity: identity,
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
 		Kind:     healthcareapis.FhirServiceKind(d.Get("kind").(string)),
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
-		FhirServiceProperties: &healthcareapis.FhirServiceProperties{
+		Properties: &azuresdkhacks.FhirServiceProperties{
 			AuthenticationConfiguration: expandFhirAuthentication(d.Get("authentication").([]interface{})),
 			CorsConfiguration:           expandFhirCorsConfiguration(d.Get("cors").([]interface{})),
 			AccessPolicies:              expandAccessPolicy(d.Get("access_policy_object_ids").(*pluginsdk.Set).List()),
+			ImportConfiguration:         expandFhirImportConfiguration(d.Get("import_configuration").([]interface{})),
 		},
 	}
 
 	storageAcc, hasValues := d.GetOk("configuration_export_storage_account_name")
 	if hasValues {
-		parameters.FhirServiceProperties.ExportConfiguration = &healthcareapis.FhirServiceExportConfiguration{
+		parameters.Properties.ExportConfiguration = &healthcareapis.FhirServiceExportConfiguration{
 			StorageAccountName: utils.String(storageAcc.(string)),
 		}
 	}
@@ -445,9 +470,9 @@ func resourceHealthcareApisFhirServiceUpdate(d *pluginsdk.ResourceData, meta int
 		loginServers := expandFhirAcrLoginServer(loginServersRaw.(*pluginsdk.Set).List())
 		acrConfig.LoginServers = loginServers
 	}
-	parameters.FhirServiceProperties.AcrConfiguration = &acrConfig
+	parameters.Properties.AcrConfiguration = &acrConfig
 
-	future, err := client.CreateOrUpdate(ctx, fhirServiceId.ResourceGroup, fhirServiceId.WorkspaceName, fhirServiceId.Name, parameters)
+	future, err := azuresdkhacks.CreateOrUpdateFhirService(ctx, client, fhirServiceId.ResourceGroup, fhirServiceId.WorkspaceName, fhirServiceId.Name, parameters)
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", fhirServiceId, err)
 	}
@@ -603,6 +628,46 @@ func expandFhirCorsConfiguration(input []interface{}) *healthcareapis.FhirServic
 	return cors
 }
 
+func expandFhirImportConfiguration(input []interface{}) *azuresdkhacks.FhirServiceImportConfiguration {
+	if len(input) == 0 {
+		return &azuresdkhacks.FhirServiceImportConfiguration{
+			Enabled:           utils.Bool(false),
+			InitialImportMode: utils.Bool(false),
+		}
+	}
+
+	block := input[0].(map[string]interface{})
+
+	return &azuresdkhacks.FhirServiceImportConfiguration{
+		Enabled:              utils.Bool(true),
+		InitialImportMode:    utils.Bool(block["initial_import_mode_enabled"].(bool)),
+		IntegrationDataStore: utils.String(block["storage_account_name"].(string)),
+	}
+}
+
+func flattenFhirImportConfiguration(input *azuresdkhacks.FhirServiceImportConfiguration) []interface{} {
+	if input == nil || input.Enabled == nil || !*input.Enabled {
+		return []interface{}{}
+	}
+
+	storageAccountName := ""
+	if input.IntegrationDataStore != nil {
+		storageAccountName = *input.IntegrationDataStore
+	}
+
+	initialImportMode := false
+	if input.InitialImportMode != nil {
+		initialImportMode = *input.InitialImportMode
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_account_name":        storageAccountName,
+			"initial_import_mode_enabled": initialImportMode,
+		},
+	}
+}
+
 func expandFhirAcrLoginServer(input []interface{}) *[]string {
 	acrLoginServers := make([]string, 0)
 
@@ -0,0 +1,410 @@
+package hybridcompute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-03-10/machineextensions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-03-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ArcMachineExtensionModel struct {
+	Name                           string            `tfschema:"name"`
+	ArcMachineId                   string            `tfschema:"arc_machine_id"`
+	Location                       string            `tfschema:"location"`
+	Publisher                      string            `tfschema:"publisher"`
+	Type                           string            `tfschema:"type"`
+	TypeHandlerVersion             string            `tfschema:"type_handler_version"`
+	AutomaticUpgradeEnabled        bool              `tfschema:"automatic_upgrade_enabled"`
+	AutoUpgradeMinorVersionEnabled bool              `tfschema:"automatic_upgrade_minor_version_enabled"`
+	ForceUpdateTag                 string            `tfschema:"force_update_tag"`
+	Settings                       string            `tfschema:"settings"`
+	ProtectedSettings              string            `tfschema:"protected_settings"`
+	Tags                           map[string]string `tfschema:"tags"`
+}
+
+type ArcMachineExtensionResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachineExtensionResource{}
+
+func (r ArcMachineExtensionResource) ResourceType() string {
+	return "azurerm_arc_machine_extension"
+}
+
+func (r ArcMachineExtensionResource) ModelObject() interface{} {
+	return &ArcMachineExtensionModel{}
+}
+
+func (r ArcMachineExtensionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return machineextensions.ValidateExtensionID
+}
+
+func (r ArcMachineExtensionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"location": commonschema.Location(),
+
+		"publisher": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"type_handler_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"automatic_upgrade_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"automatic_upgrade_minor_version_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"force_update_tag": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"settings": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"protected_settings": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineExtensionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ArcMachineExtensionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ArcMachineExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+			machineId, err := machines.ParseMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := machineextensions.NewExtensionID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := machineextensions.MachineExtension{
+				Location: location.Normalize(model.Location),
+				Properties: &machineextensions.MachineExtensionProperties{
+					AutoUpgradeMinorVersion: &model.AutoUpgradeMinorVersionEnabled,
+					EnableAutomaticUpgrade:  &model.AutomaticUpgradeEnabled,
+					Publisher:               &model.Publisher,
+					Type:                    &model.Type,
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.TypeHandlerVersion != "" {
+				properties.Properties.TypeHandlerVersion = &model.TypeHandlerVersion
+			}
+
+			if model.ForceUpdateTag != "" {
+				properties.Properties.ForceUpdateTag = &model.ForceUpdateTag
+			}
+
+			if model.Settings != "" {
+				settings, err := expandArcMachineExtensionSettings(model.Settings)
+				if err != nil {
+					return fmt.Errorf("expanding `settings`: %+v", err)
+				}
+				properties.Properties.Settings = settings
+			}
+
+			if model.ProtectedSettings != "" {
+				protectedSettings, err := expandArcMachineExtensionSettings(model.ProtectedSettings)
+				if err != nil {
+					return fmt.Errorf("expanding `protected_settings`: %+v", err)
+				}
+				properties.Properties.ProtectedSettings = protectedSettings
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineExtensionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			id, err := machineextensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			updateModel := resp.Model
+			if updateModel.Properties == nil {
+				updateModel.Properties = &machineextensions.MachineExtensionProperties{}
+			}
+
+			// the instance view is read-only and may no longer be valid for the updated extension
+			updateModel.Properties.InstanceView = nil
+			updateModel.SystemData = nil
+
+			if metadata.ResourceData.HasChange("type_handler_version") {
+				updateModel.Properties.TypeHandlerVersion = &model.TypeHandlerVersion
+			}
+
+			if metadata.ResourceData.HasChange("automatic_upgrade_enabled") {
+				updateModel.Properties.EnableAutomaticUpgrade = &model.AutomaticUpgradeEnabled
+			}
+
+			if metadata.ResourceData.HasChange("automatic_upgrade_minor_version_enabled") {
+				updateModel.Properties.AutoUpgradeMinorVersion = &model.AutoUpgradeMinorVersionEnabled
+			}
+
+			if metadata.ResourceData.HasChange("force_update_tag") {
+				if model.ForceUpdateTag != "" {
+					updateModel.Properties.ForceUpdateTag = &model.ForceUpdateTag
+				} else {
+					updateModel.Properties.ForceUpdateTag = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("settings") {
+				if model.Settings != "" {
+					settings, err := expandArcMachineExtensionSettings(model.Settings)
+					if err != nil {
+						return fmt.Errorf("expanding `settings`: %+v", err)
+					}
+					updateModel.Properties.Settings = settings
+				} else {
+					updateModel.Properties.Settings = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("protected_settings") {
+				if model.ProtectedSettings != "" {
+					protectedSettings, err := expandArcMachineExtensionSettings(model.ProtectedSettings)
+					if err != nil {
+						return fmt.Errorf("expanding `protected_settings`: %+v", err)
+					}
+					updateModel.Properties.ProtectedSettings = protectedSettings
+				} else {
+					updateModel.Properties.ProtectedSettings = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				updateModel.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *updateModel); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineExtensionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			id, err := machineextensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			model := *resp.Model
+
+			var existingModel ArcMachineExtensionModel
+			if err := metadata.Decode(&existingModel); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ArcMachineExtensionModel{
+				Name:         id.ExtensionName,
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+				Location:     location.Normalize(model.Location),
+				// the protected settings are not returned by the API so are carried over from
+				// the existing state
+				ProtectedSettings: existingModel.ProtectedSettings,
+			}
+
+			if properties := model.Properties; properties != nil {
+				if properties.Publisher != nil {
+					state.Publisher = *properties.Publisher
+				}
+
+				if properties.Type != nil {
+					state.Type = *properties.Type
+				}
+
+				if properties.TypeHandlerVersion != nil {
+					state.TypeHandlerVersion = *properties.TypeHandlerVersion
+				}
+
+				if properties.EnableAutomaticUpgrade != nil {
+					state.AutomaticUpgradeEnabled = *properties.EnableAutomaticUpgrade
+				}
+
+				if properties.AutoUpgradeMinorVersion != nil {
+					state.AutoUpgradeMinorVersionEnabled = *properties.AutoUpgradeMinorVersion
+				}
+
+				if properties.ForceUpdateTag != nil {
+					state.ForceUpdateTag = *properties.ForceUpdateTag
+				}
+
+				if properties.Settings != nil {
+					settings, err := flattenArcMachineExtensionSettings(*properties.Settings)
+					if err != nil {
+						return fmt.Errorf("flattening `settings`: %+v", err)
+					}
+					state.Settings = settings
+				}
+			}
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineExtensionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			id, err := machineextensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandArcMachineExtensionSettings(input string) (*interface{}, error) {
+	var settings interface{}
+	if err := json.Unmarshal([]byte(input), &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+func flattenArcMachineExtensionSettings(input interface{}) (string, error) {
+	settings, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(settings), nil
+}
@@ -0,0 +1,163 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-03-10/machineextensions"
+)
+
+type ArcMachineExtensionResource struct{}
+
+func TestAccArcMachineExtension_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_extension", "test")
+	r := ArcMachineExtensionResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_settings"),
+	})
+}
+
+func TestAccArcMachineExtension_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_extension", "test")
+	r := ArcMachineExtensionResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data, clientSecret, randomUUID, password),
+			ExpectError: acceptance.RequiresImportError(data.ResourceType),
+		},
+	})
+}
+
+func TestAccArcMachineExtension_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_extension", "test")
+	r := ArcMachineExtensionResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_settings"),
+		{
+			Config: r.complete(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_settings"),
+	})
+}
+
+func (r ArcMachineExtensionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := machineextensions.ParseExtensionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.MachineExtensionsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ArcMachineExtensionResource) template(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_hybrid_compute_machine" "test" {
+  name                = azurerm_linux_virtual_machine.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  depends_on = [
+    azurerm_linux_virtual_machine.test
+  ]
+}
+`, HybridComputeMachineDataSource{}.template(data, secret, randomUUID, password))
+}
+
+func (r ArcMachineExtensionResource) basic(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_extension" "test" {
+  name           = "acctest-ext-%d"
+  arc_machine_id = data.azurerm_hybrid_compute_machine.test.id
+  location       = azurerm_resource_group.test.location
+  publisher      = "Microsoft.Azure.Monitor"
+  type           = "AzureMonitorLinuxAgent"
+}
+`, r.template(data, secret, randomUUID, password), data.RandomInteger)
+}
+
+func (r ArcMachineExtensionResource) requiresImport(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_extension" "import" {
+  name           = azurerm_arc_machine_extension.test.name
+  arc_machine_id = azurerm_arc_machine_extension.test.arc_machine_id
+  location       = azurerm_arc_machine_extension.test.location
+  publisher      = azurerm_arc_machine_extension.test.publisher
+  type           = azurerm_arc_machine_extension.test.type
+}
+`, r.basic(data, secret, randomUUID, password))
+}
+
+func (r ArcMachineExtensionResource) complete(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_extension" "test" {
+  name                                    = "acctest-ext-%d"
+  arc_machine_id                          = data.azurerm_hybrid_compute_machine.test.id
+  location                                = azurerm_resource_group.test.location
+  publisher                               = "Microsoft.Azure.Monitor"
+  type                                    = "AzureMonitorLinuxAgent"
+  automatic_upgrade_enabled               = false
+  automatic_upgrade_minor_version_enabled = false
+  force_update_tag                        = "first"
+
+  settings = jsonencode({
+    "proxy" : {
+      "mode" : "application"
+    }
+  })
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data, secret, randomUUID, password), data.RandomInteger)
+}
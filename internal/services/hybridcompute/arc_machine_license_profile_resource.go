@@ -0,0 +1,259 @@
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-03-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-06-20-preview/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-06-20-preview/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachineLicenseProfileModel struct {
+	ArcMachineId   string            `tfschema:"arc_machine_id"`
+	Location       string            `tfschema:"location"`
+	EsuLicenseId   string            `tfschema:"esu_license_id"`
+	Tags           map[string]string `tfschema:"tags"`
+	EsuEligibility string            `tfschema:"esu_eligibility"`
+	EsuKeyState    string            `tfschema:"esu_key_state"`
+	ServerType     string            `tfschema:"server_type"`
+}
+
+type ArcMachineLicenseProfileResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachineLicenseProfileResource{}
+
+func (r ArcMachineLicenseProfileResource) ResourceType() string {
+	return "azurerm_arc_machine_license_profile"
+}
+
+func (r ArcMachineLicenseProfileResource) ModelObject() interface{} {
+	return &ArcMachineLicenseProfileModel{}
+}
+
+func (r ArcMachineLicenseProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return licenseprofiles.ValidateLicenseProfileID
+}
+
+func (r ArcMachineLicenseProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"location": commonschema.Location(),
+
+		"esu_license_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: licenses.ValidateLicenseID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"esu_eligibility": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"esu_key_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"server_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ArcMachineLicenseProfileModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+			machineId, err := machines.ParseMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			// the service only supports a single license profile per machine, which is always named `default`
+			id := licenseprofiles.NewLicenseProfileID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, "default")
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := licenseprofiles.LicenseProfile{
+				Location: location.Normalize(model.Location),
+				Properties: &licenseprofiles.LicenseProfileProperties{
+					EsuProfile: &licenseprofiles.EsuProfileProperties{
+						AssignedLicense: &model.EsuLicenseId,
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+
+			id, err := licenseprofiles.ParseLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineLicenseProfileModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			updateModel := resp.Model
+			if updateModel.Properties == nil {
+				updateModel.Properties = &licenseprofiles.LicenseProfileProperties{}
+			}
+
+			updateModel.SystemData = nil
+
+			if metadata.ResourceData.HasChange("esu_license_id") {
+				updateModel.Properties.EsuProfile = &licenseprofiles.EsuProfileProperties{
+					AssignedLicense: &model.EsuLicenseId,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				updateModel.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *updateModel); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+
+			id, err := licenseprofiles.ParseLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			model := *resp.Model
+
+			state := ArcMachineLicenseProfileModel{
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+				Location:     location.Normalize(model.Location),
+			}
+
+			if properties := model.Properties; properties != nil {
+				if esuProfile := properties.EsuProfile; esuProfile != nil {
+					if esuProfile.AssignedLicense != nil {
+						state.EsuLicenseId = *esuProfile.AssignedLicense
+					}
+
+					if esuProfile.EsuEligibility != nil {
+						state.EsuEligibility = string(*esuProfile.EsuEligibility)
+					}
+
+					if esuProfile.EsuKeyState != nil {
+						state.EsuKeyState = string(*esuProfile.EsuKeyState)
+					}
+
+					if esuProfile.ServerType != nil {
+						state.ServerType = string(*esuProfile.ServerType)
+					}
+				}
+			}
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+
+			id, err := licenseprofiles.ParseLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
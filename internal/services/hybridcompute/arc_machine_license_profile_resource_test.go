@@ -0,0 +1,122 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-06-20-preview/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineLicenseProfileResource struct{}
+
+func TestAccArcMachineLicenseProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineLicenseProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data, clientSecret, randomUUID, password),
+			ExpectError: acceptance.RequiresImportError(data.ResourceType),
+		},
+	})
+}
+
+func (r ArcMachineLicenseProfileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := licenseprofiles.ParseLicenseProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.LicenseProfilesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ArcMachineLicenseProfileResource) template(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_hybrid_compute_machine" "test" {
+  name                = azurerm_linux_virtual_machine.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  depends_on = [
+    azurerm_linux_virtual_machine.test
+  ]
+}
+
+resource "azurerm_arc_machine_license" "test" {
+  name                = "acctest-l-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  license_type        = "ESU"
+
+  license_details {
+    state      = "Activated"
+    target     = "Windows Server 2012"
+    edition    = "Standard"
+    core_type  = "pCore"
+    processors = 16
+  }
+}
+`, HybridComputeMachineDataSource{}.template(data, secret, randomUUID, password), data.RandomInteger)
+}
+
+func (r ArcMachineLicenseProfileResource) basic(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "test" {
+  arc_machine_id = data.azurerm_hybrid_compute_machine.test.id
+  location       = azurerm_resource_group.test.location
+  esu_license_id = azurerm_arc_machine_license.test.id
+}
+`, r.template(data, secret, randomUUID, password))
+}
+
+func (r ArcMachineLicenseProfileResource) requiresImport(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "import" {
+  arc_machine_id = azurerm_arc_machine_license_profile.test.arc_machine_id
+  location       = azurerm_arc_machine_license_profile.test.location
+  esu_license_id = azurerm_arc_machine_license_profile.test.esu_license_id
+}
+`, r.basic(data, secret, randomUUID, password))
+}
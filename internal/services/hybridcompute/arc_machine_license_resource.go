@@ -0,0 +1,337 @@
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-06-20-preview/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineLicenseModel struct {
+	Name              string                `tfschema:"name"`
+	ResourceGroupName string                `tfschema:"resource_group_name"`
+	Location          string                `tfschema:"location"`
+	LicenseType       string                `tfschema:"license_type"`
+	LicenseDetails    []LicenseDetailsModel `tfschema:"license_details"`
+	Tags              map[string]string     `tfschema:"tags"`
+	ImmutableId       string                `tfschema:"immutable_id"`
+}
+
+type LicenseDetailsModel struct {
+	State      string `tfschema:"state"`
+	Target     string `tfschema:"target"`
+	Edition    string `tfschema:"edition"`
+	CoreType   string `tfschema:"core_type"`
+	Processors int64  `tfschema:"processors"`
+}
+
+type ArcMachineLicenseResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachineLicenseResource{}
+
+func (r ArcMachineLicenseResource) ResourceType() string {
+	return "azurerm_arc_machine_license"
+}
+
+func (r ArcMachineLicenseResource) ModelObject() interface{} {
+	return &ArcMachineLicenseModel{}
+}
+
+func (r ArcMachineLicenseResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return licenses.ValidateLicenseID
+}
+
+func (r ArcMachineLicenseResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"license_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseType(), false),
+		},
+
+		"license_details": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"state": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseState(), false),
+					},
+
+					"target": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseTarget(), false),
+					},
+
+					"edition": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseEdition(), false),
+					},
+
+					"core_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseCoreType(), false),
+					},
+
+					"processors": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineLicenseResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"immutable_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachineLicenseResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ArcMachineLicenseModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.HybridCompute.LicensesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := licenses.NewLicenseID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			licenseType := licenses.LicenseType(model.LicenseType)
+			properties := licenses.License{
+				Location: location.Normalize(model.Location),
+				Properties: &licenses.LicenseProperties{
+					LicenseDetails: expandLicenseDetailsModel(model.LicenseDetails),
+					LicenseType:    &licenseType,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineLicenseModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			updateModel := resp.Model
+			if updateModel.Properties == nil {
+				updateModel.Properties = &licenses.LicenseProperties{}
+			}
+
+			updateModel.SystemData = nil
+
+			if metadata.ResourceData.HasChange("license_details") {
+				updateModel.Properties.LicenseDetails = expandLicenseDetailsModel(model.LicenseDetails)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				updateModel.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *updateModel); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			model := *resp.Model
+
+			state := ArcMachineLicenseModel{
+				Name:              id.LicenseName,
+				ResourceGroupName: id.ResourceGroupName,
+				Location:          location.Normalize(model.Location),
+			}
+
+			if properties := model.Properties; properties != nil {
+				if properties.LicenseType != nil {
+					state.LicenseType = string(*properties.LicenseType)
+				}
+
+				state.LicenseDetails = flattenLicenseDetailsModel(properties.LicenseDetails)
+
+				if details := properties.LicenseDetails; details != nil && details.ImmutableId != nil {
+					state.ImmutableId = *details.ImmutableId
+				}
+			}
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineLicenseResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandLicenseDetailsModel(input []LicenseDetailsModel) *licenses.LicenseDetails {
+	if len(input) == 0 {
+		return nil
+	}
+
+	details := input[0]
+	state := licenses.LicenseState(details.State)
+	target := licenses.LicenseTarget(details.Target)
+	edition := licenses.LicenseEdition(details.Edition)
+	coreType := licenses.LicenseCoreType(details.CoreType)
+	return &licenses.LicenseDetails{
+		Edition:    &edition,
+		Processors: utils.Int64(details.Processors),
+		State:      &state,
+		Target:     &target,
+		Type:       &coreType,
+	}
+}
+
+func flattenLicenseDetailsModel(input *licenses.LicenseDetails) []LicenseDetailsModel {
+	if input == nil {
+		return []LicenseDetailsModel{}
+	}
+
+	details := LicenseDetailsModel{}
+	if input.State != nil {
+		details.State = string(*input.State)
+	}
+
+	if input.Target != nil {
+		details.Target = string(*input.Target)
+	}
+
+	if input.Edition != nil {
+		details.Edition = string(*input.Edition)
+	}
+
+	if input.Type != nil {
+		details.CoreType = string(*input.Type)
+	}
+
+	if input.Processors != nil {
+		details.Processors = *input.Processors
+	}
+
+	return []LicenseDetailsModel{details}
+}
@@ -0,0 +1,159 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-06-20-preview/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineLicenseResource struct{}
+
+func TestAccArcMachineLicense_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license", "test")
+	r := ArcMachineLicenseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineLicense_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license", "test")
+	r := ArcMachineLicenseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcMachineLicense_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license", "test")
+	r := ArcMachineLicenseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ArcMachineLicenseResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := licenses.ParseLicenseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.LicensesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ArcMachineLicenseResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ArcMachineLicenseResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license" "test" {
+  name                = "acctest-l-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  license_type        = "ESU"
+
+  license_details {
+    state      = "Deactivated"
+    target     = "Windows Server 2012"
+    edition    = "Standard"
+    core_type  = "pCore"
+    processors = 16
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ArcMachineLicenseResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license" "import" {
+  name                = azurerm_arc_machine_license.test.name
+  resource_group_name = azurerm_arc_machine_license.test.resource_group_name
+  location            = azurerm_arc_machine_license.test.location
+  license_type        = azurerm_arc_machine_license.test.license_type
+
+  license_details {
+    state      = "Deactivated"
+    target     = "Windows Server 2012"
+    edition    = "Standard"
+    core_type  = "pCore"
+    processors = 16
+  }
+}
+`, r.basic(data))
+}
+
+func (r ArcMachineLicenseResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license" "test" {
+  name                = "acctest-l-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  license_type        = "ESU"
+
+  license_details {
+    state      = "Activated"
+    target     = "Windows Server 2012"
+    edition    = "Standard"
+    core_type  = "pCore"
+    processors = 32
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -5,9 +5,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-03-10/machines"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-03-10/privateendpointconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-06-20-preview/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-06-20-preview/licenses"
 )
 
 type Client struct {
+	LicenseProfilesClient            *licenseprofiles.LicenseProfilesClient
+	LicensesClient                   *licenses.LicensesClient
 	MachineExtensionsClient          *machineextensions.MachineExtensionsClient
 	MachinesClient                   *machines.MachinesClient
 	PrivateEndpointConnectionsClient *privateendpointconnections.PrivateEndpointConnectionsClient
@@ -15,6 +19,12 @@ type Client struct {
 
 func NewClient(o *common.ClientOptions) *Client {
 
+	licenseProfilesClient := licenseprofiles.NewLicenseProfilesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&licenseProfilesClient.Client, o.ResourceManagerAuthorizer)
+
+	licensesClient := licenses.NewLicensesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&licensesClient.Client, o.ResourceManagerAuthorizer)
+
 	machineExtensionsClient := machineextensions.NewMachineExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&machineExtensionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -25,6 +35,8 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&privateEndpointConnectionsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		LicenseProfilesClient:            &licenseProfilesClient,
+		LicensesClient:                   &licensesClient,
 		MachineExtensionsClient:          &machineExtensionsClient,
 		MachinesClient:                   &machinesClient,
 		PrivateEndpointConnectionsClient: &privateEndpointConnectionsClient,
@@ -0,0 +1,18 @@
+package licenseprofiles
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LicenseProfilesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLicenseProfilesClientWithBaseURI(endpoint string) LicenseProfilesClient {
+	return LicenseProfilesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,26 @@
+package licenseprofiles
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type EsuEligibility string
+
+const (
+	EsuEligibilityEligible   EsuEligibility = "Eligible"
+	EsuEligibilityIneligible EsuEligibility = "Ineligible"
+	EsuEligibilityUnknown    EsuEligibility = "Unknown"
+)
+
+type EsuKeyState string
+
+const (
+	EsuKeyStateActive   EsuKeyState = "Active"
+	EsuKeyStateInactive EsuKeyState = "Inactive"
+)
+
+type EsuServerType string
+
+const (
+	EsuServerTypeDatacenter EsuServerType = "Datacenter"
+	EsuServerTypeStandard   EsuServerType = "Standard"
+)
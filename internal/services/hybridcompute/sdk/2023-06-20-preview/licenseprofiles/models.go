@@ -0,0 +1,36 @@
+package licenseprofiles
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LicenseProfile struct {
+	Id         *string                   `json:"id,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *LicenseProfileProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData    `json:"systemData,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type LicenseProfileProperties struct {
+	EsuProfile *EsuProfileProperties `json:"esuProfile,omitempty"`
+}
+
+type EsuProfileProperties struct {
+	AssignedLicense            *string         `json:"assignedLicense,omitempty"`
+	AssignedLicenseImmutableId *string         `json:"assignedLicenseImmutableId,omitempty"`
+	EsuEligibility             *EsuEligibility `json:"esuEligibility,omitempty"`
+	EsuKeyState                *EsuKeyState    `json:"esuKeyState,omitempty"`
+	EsuKeys                    *[]EsuKey       `json:"esuKeys,omitempty"`
+	ServerType                 *EsuServerType  `json:"serverType,omitempty"`
+}
+
+type EsuKey struct {
+	LicenseStatus *string `json:"licenseStatus,omitempty"`
+	Sku           *string `json:"sku,omitempty"`
+}
@@ -0,0 +1,18 @@
+package licenses
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LicensesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLicensesClientWithBaseURI(endpoint string) LicensesClient {
+	return LicensesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
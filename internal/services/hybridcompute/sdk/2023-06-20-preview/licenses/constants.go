@@ -0,0 +1,72 @@
+package licenses
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LicenseCoreType string
+
+const (
+	LicenseCoreTypePCore LicenseCoreType = "pCore"
+	LicenseCoreTypeVCore LicenseCoreType = "vCore"
+)
+
+func PossibleValuesForLicenseCoreType() []string {
+	return []string{
+		string(LicenseCoreTypePCore),
+		string(LicenseCoreTypeVCore),
+	}
+}
+
+type LicenseEdition string
+
+const (
+	LicenseEditionDatacenter LicenseEdition = "Datacenter"
+	LicenseEditionStandard   LicenseEdition = "Standard"
+)
+
+func PossibleValuesForLicenseEdition() []string {
+	return []string{
+		string(LicenseEditionDatacenter),
+		string(LicenseEditionStandard),
+	}
+}
+
+type LicenseState string
+
+const (
+	LicenseStateActivated   LicenseState = "Activated"
+	LicenseStateDeactivated LicenseState = "Deactivated"
+)
+
+func PossibleValuesForLicenseState() []string {
+	return []string{
+		string(LicenseStateActivated),
+		string(LicenseStateDeactivated),
+	}
+}
+
+type LicenseTarget string
+
+const (
+	LicenseTargetWindowsServerTwoZeroOneTwo     LicenseTarget = "Windows Server 2012"
+	LicenseTargetWindowsServerTwoZeroOneTwoRTwo LicenseTarget = "Windows Server 2012 R2"
+)
+
+func PossibleValuesForLicenseTarget() []string {
+	return []string{
+		string(LicenseTargetWindowsServerTwoZeroOneTwo),
+		string(LicenseTargetWindowsServerTwoZeroOneTwoRTwo),
+	}
+}
+
+type LicenseType string
+
+const (
+	LicenseTypeESU LicenseType = "ESU"
+)
+
+func PossibleValuesForLicenseType() []string {
+	return []string{
+		string(LicenseTypeESU),
+	}
+}
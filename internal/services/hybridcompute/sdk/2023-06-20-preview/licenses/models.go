@@ -0,0 +1,35 @@
+package licenses
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type License struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *LicenseProperties     `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type LicenseProperties struct {
+	LicenseDetails    *LicenseDetails `json:"licenseDetails,omitempty"`
+	LicenseType       *LicenseType    `json:"licenseType,omitempty"`
+	ProvisioningState *string         `json:"provisioningState,omitempty"`
+	TenantId          *string         `json:"tenantId,omitempty"`
+}
+
+type LicenseDetails struct {
+	AssignedLicenses *int64           `json:"assignedLicenses,omitempty"`
+	Edition          *LicenseEdition  `json:"edition,omitempty"`
+	ImmutableId      *string          `json:"immutableId,omitempty"`
+	Processors       *int64           `json:"processors,omitempty"`
+	State            *LicenseState    `json:"state,omitempty"`
+	Target           *LicenseTarget   `json:"target,omitempty"`
+	Type             *LicenseCoreType `json:"type,omitempty"`
+}
@@ -157,9 +157,31 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module", id.ID())
 	}
 
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// before creating check to see if a managed HSM with the same name exists in the soft delete state
+	softDeleted, err := client.GetDeleted(ctx, id.Name, location)
+	if err != nil {
+		// If Terraform lacks permission to read at the Subscription we'll get 403, not 404
+		if !utils.ResponseWasNotFound(softDeleted.Response) && !utils.ResponseWasForbidden(softDeleted.Response) {
+			return fmt.Errorf("checking for the presence of an existing Soft-Deleted Managed HSM %q (Location %q): %+v", id.Name, location, err)
+		}
+	}
+
+	// if so, does the user want us to recover it?
+	recoverSoftDeleted := false
+	if !utils.ResponseWasNotFound(softDeleted.Response) && !utils.ResponseWasForbidden(softDeleted.Response) {
+		if !meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedHSMs {
+			// this exists but the users opted out, so they must import this out-of-band
+			return fmt.Errorf(optedOutOfRecoveringSoftDeletedManagedHSMErrorFmt(id.Name, location))
+		}
+
+		recoverSoftDeleted = true
+	}
+
 	tenantId := uuid.FromStringOrNil(d.Get("tenant_id").(string))
 	hsm := keyvault.ManagedHsm{
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Location: utils.String(location),
 		Properties: &keyvault.ManagedHsmProperties{
 			TenantID:                  &tenantId,
 			InitialAdminObjectIds:     utils.ExpandStringSlice(d.Get("admin_object_ids").(*pluginsdk.Set).List()),
@@ -181,6 +203,10 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 		hsm.Properties.PublicNetworkAccess = keyvault.PublicNetworkAccessDisabled
 	}
 
+	if recoverSoftDeleted {
+		hsm.Properties.CreateMode = keyvault.CreateModeRecover
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, hsm)
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -328,3 +354,19 @@ func flattenMHSMNetworkAcls(acl *keyvault.MHSMNetworkRuleSet) []interface{} {
 	}
 	return []interface{}{res}
 }
+
+func optedOutOfRecoveringSoftDeletedManagedHSMErrorFmt(name, location string) string {
+	return fmt.Sprintf(`
+An existing soft-deleted Managed HSM exists with the Name %q in the location %q, however
+automatically recovering this Managed HSM has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted Managed HSM when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different name/location.
+`, name, location)
+}
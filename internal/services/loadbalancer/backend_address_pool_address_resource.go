@@ -9,11 +9,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/network"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
 
 var (
@@ -28,6 +28,7 @@ type BackendAddressPoolAddressModel struct {
 	BackendAddressPoolId    string                      `tfschema:"backend_address_pool_id"`
 	VirtualNetworkId        string                      `tfschema:"virtual_network_id"`
 	IPAddress               string                      `tfschema:"ip_address"`
+	AdminState              string                      `tfschema:"admin_state"`
 	FrontendIPConfiguration string                      `tfschema:"backend_address_ip_configuration_id"`
 	PortMapping             []inboundNATRulePortMapping `tfschema:"inbound_nat_rule_port_mapping"`
 }
@@ -93,6 +94,16 @@ func (r BackendAddressPoolAddressResource) Arguments() map[string]*pluginsdk.Sch
 			ValidateFunc: validation.IsIPAddress,
 		},
 
+		"admin_state": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.LoadBalancerBackendAddressAdminStateUp),
+				string(network.LoadBalancerBackendAddressAdminStateDown),
+				string(network.LoadBalancerBackendAddressAdminStateDrain),
+			}, false),
+		},
+
 		"backend_address_ip_configuration_id": {
 			Type:          pluginsdk.TypeString,
 			Optional:      true,
@@ -152,6 +163,9 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 				if model.FrontendIPConfiguration == "" {
 					return fmt.Errorf("Please set a Regional Backend Address Pool Addresses for the Global load balancer")
 				}
+				if model.AdminState != "" {
+					return fmt.Errorf("`admin_state` is not supported for Global tier Load Balancers")
+				}
 			}
 
 			id := parse.NewBackendAddressPoolAddressID(subscriptionId, poolId.ResourceGroup, poolId.LoadBalancerName, poolId.BackendAddressPoolName, model.Name)
@@ -189,13 +203,17 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 					},
 				})
 			} else {
-				addresses = append(addresses, network.LoadBalancerBackendAddress{
-					LoadBalancerBackendAddressPropertiesFormat: &network.LoadBalancerBackendAddressPropertiesFormat{
-						IPAddress: utils.String(model.IPAddress),
-						VirtualNetwork: &network.SubResource{
-							ID: utils.String(model.VirtualNetworkId),
-						},
+				properties := &network.LoadBalancerBackendAddressPropertiesFormat{
+					IPAddress: utils.String(model.IPAddress),
+					VirtualNetwork: &network.SubResource{
+						ID: utils.String(model.VirtualNetworkId),
 					},
+				}
+				if model.AdminState != "" {
+					properties.AdminState = network.LoadBalancerBackendAddressAdminState(model.AdminState)
+				}
+				addresses = append(addresses, network.LoadBalancerBackendAddress{
+					LoadBalancerBackendAddressPropertiesFormat: properties,
 					Name: utils.String(id.AddressName),
 				})
 			}
@@ -276,6 +294,10 @@ func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 					if props.VirtualNetwork != nil && props.VirtualNetwork.ID != nil {
 						model.VirtualNetworkId = *props.VirtualNetwork.ID
 					}
+
+					if props.AdminState != "" && props.AdminState != network.LoadBalancerBackendAddressAdminStateNone {
+						model.AdminState = string(props.AdminState)
+					}
 				}
 			}
 
@@ -434,13 +456,17 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 					},
 				}
 			} else {
-				addresses[index] = network.LoadBalancerBackendAddress{
-					LoadBalancerBackendAddressPropertiesFormat: &network.LoadBalancerBackendAddressPropertiesFormat{
-						IPAddress: utils.String(model.IPAddress),
-						VirtualNetwork: &network.SubResource{
-							ID: utils.String(model.VirtualNetworkId),
-						},
+				properties := &network.LoadBalancerBackendAddressPropertiesFormat{
+					IPAddress: utils.String(model.IPAddress),
+					VirtualNetwork: &network.SubResource{
+						ID: utils.String(model.VirtualNetworkId),
 					},
+				}
+				if model.AdminState != "" {
+					properties.AdminState = network.LoadBalancerBackendAddressAdminState(model.AdminState)
+				}
+				addresses[index] = network.LoadBalancerBackendAddress{
+					LoadBalancerBackendAddressPropertiesFormat: properties,
 					Name: utils.String(id.AddressName),
 				}
 			}
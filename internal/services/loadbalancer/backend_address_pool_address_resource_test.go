@@ -10,9 +10,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
 
 var _ types.TestResourceVerifyingRemoved = BackendAddressPoolAddressResourceTests{}
@@ -79,6 +79,36 @@ func TestAccBackendAddressPoolAddress_regionalLbUpdate(t *testing.T) {
 	})
 }
 
+func TestAccBackendAddressPoolAddress_regionalLbAdminState(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lb_backend_address_pool_address", "test")
+	r := BackendAddressPoolAddressResourceTests{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.adminState(data, "Up"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("admin_state").HasValue("Up"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.adminState(data, "Drain"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("admin_state").HasValue("Drain"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccBackendAddressPoolAddress_globalLbUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_lb_backend_address_pool_address", "test1")
 	r := BackendAddressPoolAddressResourceTests{}
@@ -233,6 +263,22 @@ resource "azurerm_lb_backend_address_pool_address" "test" {
 `, template)
 }
 
+func (t BackendAddressPoolAddressResourceTests) adminState(data acceptance.TestData, adminState string) string {
+	template := t.templateRegionalLB(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb_backend_address_pool_address" "test" {
+  name                    = "address"
+  backend_address_pool_id = azurerm_lb_backend_address_pool.test.id
+  virtual_network_id      = azurerm_virtual_network.test.id
+  ip_address              = "191.168.0.1"
+  admin_state             = %q
+  depends_on              = [azurerm_lb_backend_address_pool.test]
+}
+`, template, adminState)
+}
+
 func (t BackendAddressPoolAddressResourceTests) requiresImport(data acceptance.TestData) string {
 	template := t.basic(data)
 	return fmt.Sprintf(`
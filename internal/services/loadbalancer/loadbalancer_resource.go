@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/state"
@@ -26,7 +27,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
 
 func resourceArmLoadBalancer() *pluginsdk.Resource {
@@ -72,6 +72,14 @@ func resourceArmLoadBalancer() *pluginsdk.Resource {
 				}
 				return false
 			}),
+			// Basic SKU Load Balancers can be migrated to Standard in-place when opted into via the features block,
+			// every other SKU change requires the Load Balancer to be recreated
+			pluginsdk.ForceNewIfChange("sku", func(ctx context.Context, old, new, meta interface{}) bool {
+				if meta.(*clients.Client).Features.Network.MigrateBasicSkuInPlace {
+					return !(old.(string) == string(network.LoadBalancerSkuNameBasic) && new.(string) == string(network.LoadBalancerSkuNameStandard))
+				}
+				return true
+			}),
 		),
 	}
 }
@@ -398,7 +406,6 @@ func resourceArmLoadBalancerSchema() map[string]*pluginsdk.Schema {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
 			Default:  string(network.LoadBalancerSkuNameBasic),
-			ForceNew: true,
 			ValidateFunc: validation.StringInSlice([]string{
 				string(network.LoadBalancerSkuNameBasic),
 				string(network.LoadBalancerSkuNameStandard),
@@ -2,8 +2,8 @@ package client
 
 import (
 	"github.com/Azure/go-autorest/autorest"
-	loadtestservice_v2021_12_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2021-12-01-preview"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	loadtestservice_v2021_12_01_preview "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2021-12-01-preview"
 )
 
 func NewClient(o *common.ClientOptions) *loadtestservice_v2021_12_01_preview.Client {
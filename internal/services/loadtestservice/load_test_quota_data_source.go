@@ -0,0 +1,99 @@
+package loadtestservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2021-12-01-preview/quotas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = LoadTestQuotaDataSource{}
+
+type LoadTestQuotaDataSource struct{}
+
+type LoadTestQuotaDataSourceModel struct {
+	Name     string `tfschema:"name"`
+	Location string `tfschema:"location"`
+	Limit    int64  `tfschema:"limit"`
+	Usage    int64  `tfschema:"usage"`
+}
+
+func (d LoadTestQuotaDataSource) ResourceType() string {
+	return "azurerm_load_test_quota"
+}
+
+func (d LoadTestQuotaDataSource) ModelObject() interface{} {
+	return &LoadTestQuotaDataSourceModel{}
+}
+
+func (d LoadTestQuotaDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+	}
+}
+
+func (d LoadTestQuotaDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"limit": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"usage": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (d LoadTestQuotaDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LoadTestService.Quotas
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config LoadTestQuotaDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := quotas.NewQuotaID(subscriptionId, location.Normalize(config.Location), config.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := LoadTestQuotaDataSourceModel{
+				Name:     id.QuotaBucketName,
+				Location: location.Normalize(id.LocationName),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.Limit = pointer.From(model.Properties.Limit)
+				state.Usage = pointer.From(model.Properties.Usage)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
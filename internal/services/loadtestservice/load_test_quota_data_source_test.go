@@ -0,0 +1,39 @@
+package loadtestservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LoadTestQuotaDataSource struct{}
+
+func TestAccLoadTestQuotaDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_load_test_quota", "test")
+	d := LoadTestQuotaDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("limit").Exists(),
+				check.That(data.ResourceName).Key("usage").Exists(),
+			),
+		},
+	})
+}
+
+func (d LoadTestQuotaDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_load_test_quota" "test" {
+  name     = "maxConcurrentTestRuns"
+  location = %q
+}
+`, data.Locations.Primary)
+}
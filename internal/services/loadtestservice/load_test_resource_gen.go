@@ -10,13 +10,15 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2021-12-01-preview/loadtests"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2021-12-01-preview/loadtests"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 var _ sdk.Resource = LoadTestResource{}
@@ -29,13 +31,24 @@ func (r LoadTestResource) ModelObject() interface{} {
 }
 
 type LoadTestResourceSchema struct {
-	DataPlaneURI      string                         `tfschema:"data_plane_uri"`
-	Description       string                         `tfschema:"description"`
-	Identity          []identity.ModelSystemAssigned `tfschema:"identity"`
-	Location          string                         `tfschema:"location"`
-	Name              string                         `tfschema:"name"`
-	ResourceGroupName string                         `tfschema:"resource_group_name"`
-	Tags              map[string]interface{}         `tfschema:"tags"`
+	DataPlaneURI      string                                     `tfschema:"data_plane_uri"`
+	Description       string                                     `tfschema:"description"`
+	Encryption        []LoadTestResourceEncryptionSchema         `tfschema:"encryption"`
+	Identity          []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Location          string                                     `tfschema:"location"`
+	Name              string                                     `tfschema:"name"`
+	ResourceGroupName string                                     `tfschema:"resource_group_name"`
+	Tags              map[string]interface{}                     `tfschema:"tags"`
+}
+
+type LoadTestResourceEncryptionSchema struct {
+	Identity []LoadTestResourceEncryptionIdentitySchema `tfschema:"identity"`
+	KeyUrl   string                                     `tfschema:"key_url"`
+}
+
+type LoadTestResourceEncryptionIdentitySchema struct {
+	IdentityId string `tfschema:"identity_id"`
+	Type       string `tfschema:"type"`
 }
 
 func (r LoadTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -58,7 +71,48 @@ func (r LoadTestResource) Arguments() map[string]*pluginsdk.Schema {
 			Optional: true,
 			Type:     pluginsdk.TypeString,
 		},
-		"identity": commonschema.SystemAssignedIdentityOptional(),
+		"encryption": {
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"identity": {
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"identity_id": {
+									ForceNew:     true,
+									Required:     true,
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+								},
+								"type": {
+									ForceNew: true,
+									Required: true,
+									Type:     pluginsdk.TypeString,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(loadtests.TypeSystemAssigned),
+										string(loadtests.TypeUserAssigned),
+									}, false),
+								},
+							},
+						},
+						ForceNew: true,
+						MaxItems: 1,
+						Required: true,
+						Type:     pluginsdk.TypeList,
+					},
+					"key_url": {
+						ForceNew:     true,
+						Required:     true,
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+				},
+			},
+			ForceNew: true,
+			MaxItems: 1,
+			Optional: true,
+			Type:     pluginsdk.TypeList,
+		},
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 		"tags":     commonschema.Tags(),
 	}
 }
@@ -189,33 +243,131 @@ func (r LoadTestResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func (r LoadTestResource) mapLoadTestResourceEncryptionIdentitySchemaToEncryptionPropertiesIdentity(input LoadTestResourceEncryptionIdentitySchema, output *loadtests.EncryptionPropertiesIdentity) error {
+	output.ResourceId = &input.IdentityId
+
+	typeValue := loadtests.Type(input.Type)
+	output.Type = &typeValue
+
+	return nil
+}
+
+func (r LoadTestResource) mapEncryptionPropertiesIdentityToLoadTestResourceEncryptionIdentitySchema(input loadtests.EncryptionPropertiesIdentity, output *LoadTestResourceEncryptionIdentitySchema) error {
+	output.IdentityId = pointer.From(input.ResourceId)
+
+	if input.Type != nil {
+		output.Type = string(*input.Type)
+	}
+
+	return nil
+}
+
+func (r LoadTestResource) mapLoadTestResourceEncryptionSchemaToEncryptionProperties(input LoadTestResourceEncryptionSchema, output *loadtests.EncryptionProperties) error {
+	output.KeyUrl = &input.KeyUrl
+
+	if len(input.Identity) > 0 {
+		if output.Identity == nil {
+			output.Identity = &loadtests.EncryptionPropertiesIdentity{}
+		}
+		if err := r.mapLoadTestResourceEncryptionIdentitySchemaToEncryptionPropertiesIdentity(input.Identity[0], output.Identity); err != nil {
+			return fmt.Errorf("mapping Schema to SDK Field %q / Model %q: %+v", "EncryptionPropertiesIdentity", "Identity", err)
+		}
+	} else {
+		output.Identity = nil
+	}
+
+	return nil
+}
+
+func (r LoadTestResource) mapEncryptionPropertiesToLoadTestResourceEncryptionSchema(input loadtests.EncryptionProperties, output *LoadTestResourceEncryptionSchema) error {
+	output.KeyUrl = pointer.From(input.KeyUrl)
+
+	if input.Identity != nil {
+		identitySchema := LoadTestResourceEncryptionIdentitySchema{}
+		if err := r.mapEncryptionPropertiesIdentityToLoadTestResourceEncryptionIdentitySchema(*input.Identity, &identitySchema); err != nil {
+			return fmt.Errorf("mapping SDK Field %q / Model %q to Schema: %+v", "EncryptionPropertiesIdentity", "Identity", err)
+		}
+		output.Identity = []LoadTestResourceEncryptionIdentitySchema{identitySchema}
+	} else {
+		output.Identity = nil
+	}
+
+	return nil
+}
+
 func (r LoadTestResource) mapLoadTestResourceSchemaToLoadTestProperties(input LoadTestResourceSchema, output *loadtests.LoadTestProperties) error {
 
 	output.Description = &input.Description
+
+	if len(input.Encryption) > 0 {
+		if output.Encryption == nil {
+			output.Encryption = &loadtests.EncryptionProperties{}
+		}
+		if err := r.mapLoadTestResourceEncryptionSchemaToEncryptionProperties(input.Encryption[0], output.Encryption); err != nil {
+			return fmt.Errorf("mapping Schema to SDK Field %q / Model %q: %+v", "EncryptionProperties", "Encryption", err)
+		}
+	} else {
+		output.Encryption = nil
+	}
+
 	return nil
 }
 
 func (r LoadTestResource) mapLoadTestPropertiesToLoadTestResourceSchema(input loadtests.LoadTestProperties, output *LoadTestResourceSchema) error {
 	output.DataPlaneURI = pointer.From(input.DataPlaneURI)
 	output.Description = pointer.From(input.Description)
+
+	if input.Encryption != nil {
+		encryptionSchema := LoadTestResourceEncryptionSchema{}
+		if err := r.mapEncryptionPropertiesToLoadTestResourceEncryptionSchema(*input.Encryption, &encryptionSchema); err != nil {
+			return fmt.Errorf("mapping SDK Field %q / Model %q to Schema: %+v", "EncryptionProperties", "Encryption", err)
+		}
+		output.Encryption = []LoadTestResourceEncryptionSchema{encryptionSchema}
+	} else {
+		output.Encryption = nil
+	}
+
 	return nil
 }
 
 func (r LoadTestResource) mapLoadTestResourceSchemaToLoadTestResourcePatchRequestBodyProperties(input LoadTestResourceSchema, output *loadtests.LoadTestResourcePatchRequestBodyProperties) error {
 	output.Description = &input.Description
+
+	if len(input.Encryption) > 0 {
+		if output.Encryption == nil {
+			output.Encryption = &loadtests.EncryptionProperties{}
+		}
+		if err := r.mapLoadTestResourceEncryptionSchemaToEncryptionProperties(input.Encryption[0], output.Encryption); err != nil {
+			return fmt.Errorf("mapping Schema to SDK Field %q / Model %q: %+v", "EncryptionProperties", "Encryption", err)
+		}
+	} else {
+		output.Encryption = nil
+	}
+
 	return nil
 }
 
 func (r LoadTestResource) mapLoadTestResourcePatchRequestBodyPropertiesToLoadTestResourceSchema(input loadtests.LoadTestResourcePatchRequestBodyProperties, output *LoadTestResourceSchema) error {
 	output.Description = pointer.From(input.Description)
+
+	if input.Encryption != nil {
+		encryptionSchema := LoadTestResourceEncryptionSchema{}
+		if err := r.mapEncryptionPropertiesToLoadTestResourceEncryptionSchema(*input.Encryption, &encryptionSchema); err != nil {
+			return fmt.Errorf("mapping SDK Field %q / Model %q to Schema: %+v", "EncryptionProperties", "Encryption", err)
+		}
+		output.Encryption = []LoadTestResourceEncryptionSchema{encryptionSchema}
+	} else {
+		output.Encryption = nil
+	}
+
 	return nil
 }
 
 func (r LoadTestResource) mapLoadTestResourceSchemaToLoadTestResource(input LoadTestResourceSchema, output *loadtests.LoadTestResource) error {
 
-	identity, err := identity.ExpandSystemAssignedFromModel(input.Identity)
+	identity, err := identity.ExpandSystemAndUserAssignedMapFromModel(input.Identity)
 	if err != nil {
-		return fmt.Errorf("expanding SystemAssigned Identity: %+v", err)
+		return fmt.Errorf("expanding SystemAndUserAssigned Identity: %+v", err)
 	}
 	output.Identity = identity
 
@@ -234,7 +386,11 @@ func (r LoadTestResource) mapLoadTestResourceSchemaToLoadTestResource(input Load
 
 func (r LoadTestResource) mapLoadTestResourceToLoadTestResourceSchema(input loadtests.LoadTestResource, output *LoadTestResourceSchema) error {
 
-	output.Identity = identity.FlattenSystemAssignedToModel(input.Identity)
+	identityValue, err := identity.FlattenSystemAndUserAssignedMapToModel(input.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening SystemAndUserAssigned Identity: %+v", err)
+	}
+	output.Identity = *identityValue
 
 	output.Location = location.Normalize(input.Location)
 	output.Tags = tags.Flatten(input.Tags)
@@ -251,9 +407,9 @@ func (r LoadTestResource) mapLoadTestResourceToLoadTestResourceSchema(input load
 
 func (r LoadTestResource) mapLoadTestResourceSchemaToLoadTestResourcePatchRequestBody(input LoadTestResourceSchema, output *loadtests.LoadTestResourcePatchRequestBody) error {
 
-	identity, err := identity.ExpandSystemAssignedFromModel(input.Identity)
+	identity, err := identity.ExpandSystemAndUserAssignedMapFromModel(input.Identity)
 	if err != nil {
-		return fmt.Errorf("expanding SystemAssigned Identity: %+v", err)
+		return fmt.Errorf("expanding SystemAndUserAssigned Identity: %+v", err)
 	}
 	output.Identity = identity
 
@@ -271,7 +427,11 @@ func (r LoadTestResource) mapLoadTestResourceSchemaToLoadTestResourcePatchReques
 
 func (r LoadTestResource) mapLoadTestResourcePatchRequestBodyToLoadTestResourceSchema(input loadtests.LoadTestResourcePatchRequestBody, output *LoadTestResourceSchema) error {
 
-	output.Identity = identity.FlattenSystemAssignedToModel(input.Identity)
+	identityValue, err := identity.FlattenSystemAndUserAssignedMapToModel(input.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening SystemAndUserAssigned Identity: %+v", err)
+	}
+	output.Identity = *identityValue
 
 	output.Tags = tags.Flatten(input.Tags)
 
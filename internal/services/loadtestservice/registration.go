@@ -23,7 +23,11 @@ func (r Registration) Name() string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return r.autoRegistration.DataSources()
+	dataSources := []sdk.DataSource{
+		LoadTestQuotaDataSource{},
+	}
+	dataSources = append(dataSources, r.autoRegistration.DataSources()...)
+	return dataSources
 }
 
 func (r Registration) Resources() []sdk.Resource {
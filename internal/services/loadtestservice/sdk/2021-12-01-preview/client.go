@@ -2,11 +2,13 @@ package v2021_12_01_preview
 
 import (
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2021-12-01-preview/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2021-12-01-preview/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2021-12-01-preview/quotas"
 )
 
 type Client struct {
 	LoadTests *loadtests.LoadTestsClient
+	Quotas    *quotas.QuotasClient
 }
 
 func NewClientWithBaseURI(endpoint string, configureAuthFunc func(c *autorest.Client)) Client {
@@ -14,7 +16,11 @@ func NewClientWithBaseURI(endpoint string, configureAuthFunc func(c *autorest.Cl
 	loadTestsClient := loadtests.NewLoadTestsClientWithBaseURI(endpoint)
 	configureAuthFunc(&loadTestsClient.Client)
 
+	quotasClient := quotas.NewQuotasClientWithBaseURI(endpoint)
+	configureAuthFunc(&quotasClient.Client)
+
 	return Client{
 		LoadTests: &loadTestsClient,
+		Quotas:    &quotasClient,
 	}
 }
@@ -0,0 +1,9 @@
+package loadtests
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type EncryptionPropertiesIdentity struct {
+	ResourceId *string `json:"resourceId,omitempty"`
+	Type       *Type   `json:"type,omitempty"`
+}
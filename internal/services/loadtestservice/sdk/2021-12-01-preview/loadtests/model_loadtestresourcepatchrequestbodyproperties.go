@@ -4,5 +4,6 @@ package loadtests
 // Licensed under the MIT License. See NOTICE.txt in the project root for license information.
 
 type LoadTestResourcePatchRequestBodyProperties struct {
-	Description *string `json:"description,omitempty"`
+	Description *string               `json:"description,omitempty"`
+	Encryption  *EncryptionProperties `json:"encryption,omitempty"`
 }
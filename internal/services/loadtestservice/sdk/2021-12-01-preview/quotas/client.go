@@ -0,0 +1,18 @@
+package quotas
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type QuotasClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewQuotasClientWithBaseURI(endpoint string) QuotasClient {
+	return QuotasClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,11 @@
+package quotas
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type QuotaResource struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *QuotaResourceProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
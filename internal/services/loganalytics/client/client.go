@@ -14,6 +14,7 @@ import (
 	featureWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2022-10-01/tables"
 )
 
 type Client struct {
@@ -28,6 +29,7 @@ type Client struct {
 	StorageInsightsClient      *storageinsights.StorageInsightsClient
 	QueryPackQueriesClient     *querypackqueries.QueryPackQueriesClient
 	SharedKeyWorkspacesClient  *workspaces.WorkspacesClient
+	TablesClient               *tables.TablesClient
 	WorkspaceClient            *featureWorkspaces.WorkspacesClient // 2022-10-01 API version does not contain sharedkeys related API, so we keep two versions SDK of this API
 }
 
@@ -68,6 +70,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	QueryPackQueriesClient := querypackqueries.NewQueryPackQueriesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&QueryPackQueriesClient.Client, o.ResourceManagerAuthorizer)
 
+	TablesClient := tables.NewTablesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&TablesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ClusterClient:              &ClusterClient,
 		DataExportClient:           &DataExportClient,
@@ -80,6 +85,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		SolutionsClient:            &SolutionsClient,
 		StorageInsightsClient:      &StorageInsightsClient,
 		SharedKeyWorkspacesClient:  &WorkspacesClient,
+		TablesClient:               &TablesClient,
 		WorkspaceClient:            &featureWorkspaceClient,
 	}
 }
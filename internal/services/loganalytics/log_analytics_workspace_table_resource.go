@@ -0,0 +1,251 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LogAnalyticsWorkspaceTableModel struct {
+	Name                 string `tfschema:"name"`
+	WorkspaceId          string `tfschema:"workspace_id"`
+	Plan                 string `tfschema:"plan"`
+	RetentionInDays      int64  `tfschema:"retention_in_days"`
+	TotalRetentionInDays int64  `tfschema:"total_retention_in_days"`
+}
+
+type LogAnalyticsWorkspaceTableResource struct{}
+
+var _ sdk.ResourceWithUpdate = LogAnalyticsWorkspaceTableResource{}
+
+func (r LogAnalyticsWorkspaceTableResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_table"
+}
+
+func (r LogAnalyticsWorkspaceTableResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceTableModel{}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return tables.ValidateTableID
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"plan": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(tables.TablePlanEnumAnalytics),
+			ValidateFunc: validation.StringInSlice(
+				tables.PossibleValuesForTablePlanEnum(), false),
+		},
+
+		"retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(4, 730),
+		},
+
+		"total_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(4, 4383),
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LogAnalyticsWorkspaceTableModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			// tables cannot be created through this API - they either ship with the workspace or are
+			// created when data is first ingested, so this resource takes over management of an
+			// existing table rather than creating a new one
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found - the table must exist in the workspace before its settings can be managed", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			properties, err := r.expandProperties(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.UpdateThenPoll(ctx, id, tables.Table{Properties: properties}); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceTableModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties, err := r.expandProperties(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, tables.Table{Properties: properties}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceTableModel{
+				Name:        id.TableName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				Plan:        string(tables.TablePlanEnumAnalytics),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				if props.Plan != nil {
+					state.Plan = string(*props.Plan)
+				}
+				state.RetentionInDays = pointer.From(props.RetentionInDays)
+				state.TotalRetentionInDays = pointer.From(props.TotalRetentionInDays)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the table itself cannot be deleted, so deleting this resource resets the table back
+			// to the workspace defaults - the service interprets a retention of -1 as "inherit
+			// from the workspace"
+			plan := tables.TablePlanEnumAnalytics
+			properties := tables.TableProperties{
+				Plan:                 &plan,
+				RetentionInDays:      pointer.To(int64(-1)),
+				TotalRetentionInDays: pointer.To(int64(-1)),
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, tables.Table{Properties: &properties}); err != nil {
+				return fmt.Errorf("resetting %s to workspace defaults: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) expandProperties(model LogAnalyticsWorkspaceTableModel) (*tables.TableProperties, error) {
+	plan := tables.TablePlanEnum(model.Plan)
+	properties := tables.TableProperties{
+		Plan: &plan,
+	}
+
+	if model.RetentionInDays != 0 {
+		// the Basic and Auxiliary plans come with a fixed interactive retention period which
+		// cannot be changed per table
+		if plan != tables.TablePlanEnumAnalytics {
+			return nil, fmt.Errorf("`retention_in_days` cannot be set when `plan` is %q - interactive retention is fixed for this plan and only `total_retention_in_days` can be configured", model.Plan)
+		}
+		properties.RetentionInDays = pointer.To(model.RetentionInDays)
+	}
+
+	if model.TotalRetentionInDays != 0 {
+		if model.RetentionInDays != 0 && model.TotalRetentionInDays < model.RetentionInDays {
+			return nil, fmt.Errorf("`total_retention_in_days` (%d) cannot be less than `retention_in_days` (%d)", model.TotalRetentionInDays, model.RetentionInDays)
+		}
+		properties.TotalRetentionInDays = pointer.To(model.TotalRetentionInDays)
+	}
+
+	return &properties, nil
+}
@@ -24,6 +24,9 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		LogAnalyticsQueryPackResource{},
 		LogAnalyticsQueryPackQueryResource{},
+		LogAnalyticsWorkspaceTableResource{},
+		LogAnalyticsWorkspaceTableRestoreResource{},
+		LogAnalyticsWorkspaceTableSearchJobResource{},
 	}
 }
 
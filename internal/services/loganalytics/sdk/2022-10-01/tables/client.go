@@ -0,0 +1,18 @@
+package tables
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type TablesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTablesClientWithBaseURI(endpoint string) TablesClient {
+	return TablesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,71 @@
+package tables
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type TablePlanEnum string
+
+const (
+	TablePlanEnumAnalytics TablePlanEnum = "Analytics"
+	TablePlanEnumAuxiliary TablePlanEnum = "Auxiliary"
+	TablePlanEnumBasic     TablePlanEnum = "Basic"
+)
+
+func PossibleValuesForTablePlanEnum() []string {
+	return []string{
+		string(TablePlanEnumAnalytics),
+		string(TablePlanEnumAuxiliary),
+		string(TablePlanEnumBasic),
+	}
+}
+
+func parseTablePlanEnum(input string) (*TablePlanEnum, error) {
+	vals := map[string]TablePlanEnum{
+		"analytics": TablePlanEnumAnalytics,
+		"auxiliary": TablePlanEnumAuxiliary,
+		"basic":     TablePlanEnumBasic,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TablePlanEnum(input)
+	return &out, nil
+}
+
+type TableTypeEnum string
+
+const (
+	TableTypeEnumCustomLog     TableTypeEnum = "CustomLog"
+	TableTypeEnumMicrosoft     TableTypeEnum = "Microsoft"
+	TableTypeEnumRestoredLogs  TableTypeEnum = "RestoredLogs"
+	TableTypeEnumSearchResults TableTypeEnum = "SearchResults"
+)
+
+func PossibleValuesForTableTypeEnum() []string {
+	return []string{
+		string(TableTypeEnumCustomLog),
+		string(TableTypeEnumMicrosoft),
+		string(TableTypeEnumRestoredLogs),
+		string(TableTypeEnumSearchResults),
+	}
+}
+
+func parseTableTypeEnum(input string) (*TableTypeEnum, error) {
+	vals := map[string]TableTypeEnum{
+		"customlog":     TableTypeEnumCustomLog,
+		"microsoft":     TableTypeEnumMicrosoft,
+		"restoredlogs":  TableTypeEnumRestoredLogs,
+		"searchresults": TableTypeEnumSearchResults,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TableTypeEnum(input)
+	return &out, nil
+}
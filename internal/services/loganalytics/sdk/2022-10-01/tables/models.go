@@ -0,0 +1,42 @@
+package tables
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Table struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties *TableProperties `json:"properties,omitempty"`
+	Type       *string          `json:"type,omitempty"`
+}
+
+type TableProperties struct {
+	ArchiveRetentionInDays        *int64         `json:"archiveRetentionInDays,omitempty"`
+	LastPlanModifiedDate          *string        `json:"lastPlanModifiedDate,omitempty"`
+	Plan                          *TablePlanEnum `json:"plan,omitempty"`
+	ProvisioningState             *string        `json:"provisioningState,omitempty"`
+	RestoredLogs                  *RestoredLogs  `json:"restoredLogs,omitempty"`
+	RetentionInDays               *int64         `json:"retentionInDays,omitempty"`
+	RetentionInDaysAsDefault      *bool          `json:"retentionInDaysAsDefault,omitempty"`
+	SearchResults                 *SearchResults `json:"searchResults,omitempty"`
+	TableType                     *TableTypeEnum `json:"tableType,omitempty"`
+	TotalRetentionInDays          *int64         `json:"totalRetentionInDays,omitempty"`
+	TotalRetentionInDaysAsDefault *bool          `json:"totalRetentionInDaysAsDefault,omitempty"`
+}
+
+type RestoredLogs struct {
+	AzureAsyncOperationId *string `json:"azureAsyncOperationId,omitempty"`
+	EndRestoreTime        *string `json:"endRestoreTime,omitempty"`
+	SourceTable           *string `json:"sourceTable,omitempty"`
+	StartRestoreTime      *string `json:"startRestoreTime,omitempty"`
+}
+
+type SearchResults struct {
+	AzureAsyncOperationId *string `json:"azureAsyncOperationId,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	EndSearchTime         *string `json:"endSearchTime,omitempty"`
+	Limit                 *int64  `json:"limit,omitempty"`
+	Query                 *string `json:"query,omitempty"`
+	SourceTable           *string `json:"sourceTable,omitempty"`
+	StartSearchTime       *string `json:"startSearchTime,omitempty"`
+}
@@ -0,0 +1,253 @@
+package logic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceLogicAppStandardWorkflow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogicAppStandardWorkflowCreateOrUpdate,
+		Read:   resourceLogicAppStandardWorkflowRead,
+		Update: resourceLogicAppStandardWorkflowCreateOrUpdate,
+		Delete: resourceLogicAppStandardWorkflowDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LogicAppStandardWorkflowID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"logic_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogicAppStandardID,
+			},
+
+			// the contents of the `definition` section of the workflow's `workflow.json` file
+			"definition": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "Stateful",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Stateful",
+					"Stateless",
+				}, false),
+			},
+
+			"content_sha256": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLogicAppStandardWorkflowCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Logic App Standard workflow creation.")
+
+	logicAppId, err := parse.LogicAppStandardID(d.Get("logic_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLogicAppStandardWorkflowID(logicAppId.SubscriptionId, logicAppId.ResourceGroup, logicAppId.SiteName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.GetFunction(ctx, id.ResourceGroup, id.SiteName, id.WorkflowName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_logic_app_standard_workflow", id.ID())
+		}
+	}
+
+	workflowFile, err := expandLogicAppStandardWorkflowFile(d.Get("definition").(string), d.Get("kind").(string))
+	if err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(workflowFile)
+	if err != nil {
+		return fmt.Errorf("marshalling `workflow.json` content: %+v", err)
+	}
+
+	// each workflow is deployed as a `workflow.json` file in a directory named after the
+	// workflow within the Logic App's `wwwroot`, which the functions ARM API exposes as a
+	// function whose files can be pushed directly - avoiding the need for a zip deployment
+	envelope := web.FunctionEnvelope{
+		FunctionEnvelopeProperties: &web.FunctionEnvelopeProperties{
+			Config: workflowFile,
+			Files: map[string]*string{
+				"workflow.json": utils.String(string(content)),
+			},
+			IsDisabled: utils.Bool(false),
+		},
+	}
+
+	locks.ByID(logicAppId.ID())
+	defer locks.UnlockByID(logicAppId.ID())
+
+	future, err := client.CreateFunction(ctx, id.ResourceGroup, id.SiteName, id.WorkflowName, envelope)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLogicAppStandardWorkflowRead(d, meta)
+}
+
+func resourceLogicAppStandardWorkflowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardWorkflowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetFunction(ctx, id.ResourceGroup, id.SiteName, id.WorkflowName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.WorkflowName)
+	d.Set("logic_app_id", parse.NewLogicAppStandardID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID())
+
+	if props := resp.FunctionEnvelopeProperties; props != nil && props.Config != nil {
+		config, ok := props.Config.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("reading `workflow.json` content for %s: unexpected config format", *id)
+		}
+
+		kind := "Stateful"
+		if v, ok := config["kind"].(string); ok && v != "" {
+			kind = v
+		}
+		d.Set("kind", kind)
+
+		definition := ""
+		if v, ok := config["definition"]; ok {
+			definitionRaw, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("marshalling `definition` for %s: %+v", *id, err)
+			}
+			definition = string(definitionRaw)
+		}
+		d.Set("definition", definition)
+
+		// the content hash is re-computed from what's deployed, so out-of-band changes to
+		// the workflow file surface as a change to this attribute
+		contentHash, err := logicAppStandardWorkflowContentHash(config)
+		if err != nil {
+			return fmt.Errorf("computing content hash for %s: %+v", *id, err)
+		}
+		d.Set("content_sha256", contentHash)
+	}
+
+	return nil
+}
+
+func resourceLogicAppStandardWorkflowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardWorkflowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	logicAppId := parse.NewLogicAppStandardID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+	locks.ByID(logicAppId.ID())
+	defer locks.UnlockByID(logicAppId.ID())
+
+	log.Printf("[DEBUG] Deleting Logic App Standard Workflow %q (resource group %q)", id.WorkflowName, id.ResourceGroup)
+
+	resp, err := client.DeleteFunction(ctx, id.ResourceGroup, id.SiteName, id.WorkflowName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandLogicAppStandardWorkflowFile(definition string, kind string) (map[string]interface{}, error) {
+	var definitionMap interface{}
+	if err := json.Unmarshal([]byte(definition), &definitionMap); err != nil {
+		return nil, fmt.Errorf("unmarshalling `definition`: %+v", err)
+	}
+
+	return map[string]interface{}{
+		"definition": definitionMap,
+		"kind":       kind,
+	}, nil
+}
+
+func logicAppStandardWorkflowContentHash(workflowFile map[string]interface{}) (string, error) {
+	// json.Marshal sorts map keys, so the hash is stable regardless of where the content
+	// was read from
+	content, err := json.Marshal(workflowFile)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(content)
+	return hex.EncodeToString(digest[:]), nil
+}
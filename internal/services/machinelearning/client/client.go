@@ -5,12 +5,18 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2022-05-01/machinelearningcomputes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2022-05-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-04-01/batchendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-04-01/registrymanagement"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-08-01-preview/serverlessendpoint"
 )
 
 type Client struct {
-	ComputeClient    *machinelearningcomputes.MachineLearningComputesClient
-	WorkspacesClient *workspaces.WorkspacesClient
-	DatastoreClient  *datastore.DatastoreClient
+	ComputeClient            *machinelearningcomputes.MachineLearningComputesClient
+	WorkspacesClient         *workspaces.WorkspacesClient
+	DatastoreClient          *datastore.DatastoreClient
+	RegistryManagementClient *registrymanagement.RegistryManagementClient
+	BatchEndpointClient      *batchendpoint.BatchEndpointClient
+	ServerlessEndpointClient *serverlessendpoint.ServerlessEndpointClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -23,9 +29,21 @@ func NewClient(o *common.ClientOptions) *Client {
 	DatastoreClient := datastore.NewDatastoreClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DatastoreClient.Client, o.ResourceManagerAuthorizer)
 
+	RegistryManagementClient := registrymanagement.NewRegistryManagementClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RegistryManagementClient.Client, o.ResourceManagerAuthorizer)
+
+	BatchEndpointClient := batchendpoint.NewBatchEndpointClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&BatchEndpointClient.Client, o.ResourceManagerAuthorizer)
+
+	ServerlessEndpointClient := serverlessendpoint.NewServerlessEndpointClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ServerlessEndpointClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ComputeClient:    &ComputeClient,
-		WorkspacesClient: &WorkspacesClient,
-		DatastoreClient:  &DatastoreClient,
+		ComputeClient:            &ComputeClient,
+		WorkspacesClient:         &WorkspacesClient,
+		DatastoreClient:          &DatastoreClient,
+		RegistryManagementClient: &RegistryManagementClient,
+		BatchEndpointClient:      &BatchEndpointClient,
+		ServerlessEndpointClient: &ServerlessEndpointClient,
 	}
 }
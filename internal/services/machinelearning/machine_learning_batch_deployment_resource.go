@@ -0,0 +1,428 @@
+package machinelearning
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2022-05-01/machinelearningcomputes"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-04-01/batchendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceMachineLearningBatchDeployment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMachineLearningBatchDeploymentCreateOrUpdate,
+		Read:   resourceMachineLearningBatchDeploymentRead,
+		Update: resourceMachineLearningBatchDeploymentCreateOrUpdate,
+		Delete: resourceMachineLearningBatchDeploymentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := batchendpoint.ParseBatchDeploymentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9\-]{2,31}$`),
+					"The Machine Learning Batch Deployment name must be between 3 and 32 characters long, it can contain only letters, numbers and hyphens, and the first character must be a letter."),
+			},
+
+			"batch_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: batchendpoint.ValidateBatchEndpointID,
+			},
+
+			"location": commonschema.Location(),
+
+			"model_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"compute_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: machinelearningcomputes.ValidateComputeID,
+			},
+
+			"instance_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"instance_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"code_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"code_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"scoring_script": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"environment_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"environment_variables": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"error_threshold": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			"logging_level": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(batchendpoint.BatchLoggingLevelInfo),
+				ValidateFunc: validation.StringInSlice(
+					batchendpoint.PossibleValuesForBatchLoggingLevel(), false),
+			},
+
+			"max_concurrency_per_instance": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"mini_batch_size": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"output_action": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(batchendpoint.BatchOutputActionAppendRow),
+				ValidateFunc: validation.StringInSlice(
+					batchendpoint.PossibleValuesForBatchOutputAction(), false),
+			},
+
+			"output_file_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "predictions.csv",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"retry_settings": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"max_retries": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"timeout": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "PT30S",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceMachineLearningBatchDeploymentCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchEndpointClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	endpointId, err := batchendpoint.ParseBatchEndpointID(d.Get("batch_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := batchendpoint.NewBatchDeploymentID(endpointId.SubscriptionId, endpointId.ResourceGroupName, endpointId.WorkspaceName, endpointId.BatchEndpointName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.BatchDeploymentGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_machine_learning_batch_deployment", id.ID())
+		}
+	}
+
+	outputAction := batchendpoint.BatchOutputAction(d.Get("output_action").(string))
+	loggingLevel := batchendpoint.BatchLoggingLevel(d.Get("logging_level").(string))
+	deployment := batchendpoint.BatchDeploymentTrackedResource{
+		Location: location.Normalize(d.Get("location").(string)),
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Properties: batchendpoint.BatchDeployment{
+			Model: &batchendpoint.IdAssetReference{
+				AssetId:       d.Get("model_id").(string),
+				ReferenceType: batchendpoint.ReferenceTypeId,
+			},
+			ErrorThreshold:            pointer.To(int64(d.Get("error_threshold").(int))),
+			LoggingLevel:              &loggingLevel,
+			MaxConcurrencyPerInstance: pointer.To(int64(d.Get("max_concurrency_per_instance").(int))),
+			MiniBatchSize:             pointer.To(int64(d.Get("mini_batch_size").(int))),
+			OutputAction:              &outputAction,
+			OutputFileName:            pointer.To(d.Get("output_file_name").(string)),
+			Resources:                 expandMachineLearningBatchDeploymentResources(d),
+			RetrySettings:             expandMachineLearningBatchDeploymentRetrySettings(d.Get("retry_settings").([]interface{})),
+			CodeConfiguration:         expandMachineLearningBatchDeploymentCodeConfiguration(d.Get("code_configuration").([]interface{})),
+		},
+	}
+
+	if computeId := d.Get("compute_id").(string); computeId != "" {
+		deployment.Properties.Compute = pointer.To(computeId)
+	}
+
+	if environmentId := d.Get("environment_id").(string); environmentId != "" {
+		deployment.Properties.EnvironmentId = pointer.To(environmentId)
+	}
+
+	if environmentVariables := d.Get("environment_variables").(map[string]interface{}); len(environmentVariables) > 0 {
+		expandedEnvironmentVariables := make(map[string]string)
+		for k, v := range environmentVariables {
+			expandedEnvironmentVariables[k] = v.(string)
+		}
+		deployment.Properties.EnvironmentVariables = &expandedEnvironmentVariables
+	}
+
+	if description := d.Get("description").(string); description != "" {
+		deployment.Properties.Description = pointer.To(description)
+	}
+
+	if err := client.BatchDeploymentCreateOrUpdateThenPoll(ctx, id, deployment); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMachineLearningBatchDeploymentRead(d, meta)
+}
+
+func resourceMachineLearningBatchDeploymentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchEndpointClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := batchendpoint.ParseBatchDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.BatchDeploymentGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DeploymentName)
+	d.Set("batch_endpoint_id", batchendpoint.NewBatchEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.BatchEndpointName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		props := model.Properties
+		modelId := ""
+		if props.Model != nil {
+			modelId = props.Model.AssetId
+		}
+		d.Set("model_id", modelId)
+		d.Set("compute_id", pointer.From(props.Compute))
+		d.Set("environment_id", pointer.From(props.EnvironmentId))
+		d.Set("description", pointer.From(props.Description))
+		d.Set("error_threshold", int(pointer.From(props.ErrorThreshold)))
+		d.Set("max_concurrency_per_instance", int(pointer.From(props.MaxConcurrencyPerInstance)))
+		d.Set("mini_batch_size", int(pointer.From(props.MiniBatchSize)))
+		d.Set("output_file_name", pointer.From(props.OutputFileName))
+
+		if props.LoggingLevel != nil {
+			d.Set("logging_level", string(*props.LoggingLevel))
+		}
+		if props.OutputAction != nil {
+			d.Set("output_action", string(*props.OutputAction))
+		}
+
+		environmentVariables := make(map[string]interface{})
+		if props.EnvironmentVariables != nil {
+			for k, v := range *props.EnvironmentVariables {
+				environmentVariables[k] = v
+			}
+		}
+		d.Set("environment_variables", environmentVariables)
+
+		instanceCount := 1
+		instanceType := ""
+		if props.Resources != nil {
+			if props.Resources.InstanceCount != nil {
+				instanceCount = int(*props.Resources.InstanceCount)
+			}
+			instanceType = pointer.From(props.Resources.InstanceType)
+		}
+		d.Set("instance_count", instanceCount)
+		d.Set("instance_type", instanceType)
+
+		if err := d.Set("retry_settings", flattenMachineLearningBatchDeploymentRetrySettings(props.RetrySettings)); err != nil {
+			return fmt.Errorf("setting `retry_settings`: %+v", err)
+		}
+
+		if err := d.Set("code_configuration", flattenMachineLearningBatchDeploymentCodeConfiguration(props.CodeConfiguration)); err != nil {
+			return fmt.Errorf("setting `code_configuration`: %+v", err)
+		}
+
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMachineLearningBatchDeploymentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchEndpointClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := batchendpoint.ParseBatchDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.BatchDeploymentDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMachineLearningBatchDeploymentResources(d *pluginsdk.ResourceData) *batchendpoint.DeploymentResourceConfiguration {
+	out := batchendpoint.DeploymentResourceConfiguration{
+		InstanceCount: pointer.To(int64(d.Get("instance_count").(int))),
+	}
+
+	if instanceType := d.Get("instance_type").(string); instanceType != "" {
+		out.InstanceType = pointer.To(instanceType)
+	}
+
+	return &out
+}
+
+func expandMachineLearningBatchDeploymentRetrySettings(input []interface{}) *batchendpoint.BatchRetrySettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &batchendpoint.BatchRetrySettings{
+		MaxRetries: pointer.To(int64(raw["max_retries"].(int))),
+		Timeout:    pointer.To(raw["timeout"].(string)),
+	}
+}
+
+func flattenMachineLearningBatchDeploymentRetrySettings(input *batchendpoint.BatchRetrySettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_retries": int(pointer.From(input.MaxRetries)),
+			"timeout":     pointer.From(input.Timeout),
+		},
+	}
+}
+
+func expandMachineLearningBatchDeploymentCodeConfiguration(input []interface{}) *batchendpoint.CodeConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &batchendpoint.CodeConfiguration{
+		CodeId:        pointer.To(raw["code_id"].(string)),
+		ScoringScript: raw["scoring_script"].(string),
+	}
+}
+
+func flattenMachineLearningBatchDeploymentCodeConfiguration(input *batchendpoint.CodeConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"code_id":        pointer.From(input.CodeId),
+			"scoring_script": input.ScoringScript,
+		},
+	}
+}
@@ -0,0 +1,171 @@
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-04-01/batchendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MachineLearningBatchDeploymentResource struct{}
+
+func TestAccMachineLearningBatchDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_batch_deployment", "test")
+	r := MachineLearningBatchDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningBatchDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_batch_deployment", "test")
+	r := MachineLearningBatchDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningBatchDeployment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_batch_deployment", "test")
+	r := MachineLearningBatchDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_count").HasValue("2"),
+				check.That(data.ResourceName).Key("output_action").HasValue("SummaryOnly"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MachineLearningBatchDeploymentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := batchendpoint.ParseBatchDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.BatchEndpointClient.BatchDeploymentGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (MachineLearningBatchDeploymentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_compute_cluster" "test" {
+  name                          = "acctest-mlcc-%d"
+  location                      = azurerm_resource_group.test.location
+  machine_learning_workspace_id = azurerm_machine_learning_workspace.test.id
+  vm_priority                   = "Dedicated"
+  vm_size                       = "STANDARD_DS2_V2"
+
+  scale_settings {
+    min_node_count                       = 0
+    max_node_count                       = 2
+    scale_down_nodes_after_idle_duration = "PT30S"
+  }
+}
+
+resource "azurerm_machine_learning_batch_endpoint" "test" {
+  name                          = "acctest-mlbe-%d"
+  machine_learning_workspace_id = azurerm_machine_learning_workspace.test.id
+  location                      = azurerm_resource_group.test.location
+  auth_mode                     = "AADToken"
+}
+`, MachineLearningBatchEndpointResource{}.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r MachineLearningBatchDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_machine_learning_batch_deployment" "test" {
+  name              = "acctest-mlbd-%[2]d"
+  batch_endpoint_id = azurerm_machine_learning_batch_endpoint.test.id
+  location          = azurerm_resource_group.test.location
+  model_id          = "${azurerm_machine_learning_workspace.test.id}/models/acctestmodel/versions/1"
+  compute_id        = azurerm_machine_learning_compute_cluster.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MachineLearningBatchDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_batch_deployment" "import" {
+  name              = azurerm_machine_learning_batch_deployment.test.name
+  batch_endpoint_id = azurerm_machine_learning_batch_deployment.test.batch_endpoint_id
+  location          = azurerm_machine_learning_batch_deployment.test.location
+  model_id          = azurerm_machine_learning_batch_deployment.test.model_id
+  compute_id        = azurerm_machine_learning_batch_deployment.test.compute_id
+}
+`, r.basic(data))
+}
+
+func (r MachineLearningBatchDeploymentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_machine_learning_batch_deployment" "test" {
+  name              = "acctest-mlbd-%[2]d"
+  batch_endpoint_id = azurerm_machine_learning_batch_endpoint.test.id
+  location          = azurerm_resource_group.test.location
+  model_id          = "${azurerm_machine_learning_workspace.test.id}/models/acctestmodel/versions/1"
+  compute_id        = azurerm_machine_learning_compute_cluster.test.id
+
+  instance_count               = 2
+  max_concurrency_per_instance = 2
+  mini_batch_size              = 5
+  output_action                = "SummaryOnly"
+  output_file_name             = "results.csv"
+  error_threshold              = 10
+  logging_level                = "Debug"
+
+  environment_variables = {
+    EXAMPLE = "value"
+  }
+
+  retry_settings {
+    max_retries = 5
+    timeout     = "PT1M"
+  }
+
+  tags = {
+    Environment = "Production"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,223 @@
+package machinelearning
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2022-05-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-04-01/batchendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceMachineLearningBatchEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMachineLearningBatchEndpointCreateOrUpdate,
+		Read:   resourceMachineLearningBatchEndpointRead,
+		Update: resourceMachineLearningBatchEndpointCreateOrUpdate,
+		Delete: resourceMachineLearningBatchEndpointDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := batchendpoint.ParseBatchEndpointID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9\-]{2,31}$`),
+					"The Machine Learning Batch Endpoint name must be between 3 and 32 characters long, it can contain only letters, numbers and hyphens, and the first character must be a letter."),
+			},
+
+			"machine_learning_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: workspaces.ValidateWorkspaceID,
+			},
+
+			"location": commonschema.Location(),
+
+			"auth_mode": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(
+					batchendpoint.PossibleValuesForEndpointAuthMode(), false),
+			},
+
+			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"default_deployment_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"scoring_uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"swagger_uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceMachineLearningBatchEndpointCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchEndpointClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := workspaces.ParseWorkspaceID(d.Get("machine_learning_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := batchendpoint.NewBatchEndpointID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.BatchEndpointGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_machine_learning_batch_endpoint", id.ID())
+		}
+	}
+
+	expandedIdentity, err := expandIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	endpoint := batchendpoint.BatchEndpointTrackedResource{
+		Location: location.Normalize(d.Get("location").(string)),
+		Identity: expandedIdentity,
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Properties: batchendpoint.BatchEndpoint{
+			AuthMode: batchendpoint.EndpointAuthMode(d.Get("auth_mode").(string)),
+		},
+	}
+
+	if description := d.Get("description").(string); description != "" {
+		endpoint.Properties.Description = pointer.To(description)
+	}
+
+	if defaultDeploymentName := d.Get("default_deployment_name").(string); defaultDeploymentName != "" {
+		endpoint.Properties.Defaults = &batchendpoint.BatchEndpointDefaults{
+			DeploymentName: pointer.To(defaultDeploymentName),
+		}
+	}
+
+	if err := client.BatchEndpointCreateOrUpdateThenPoll(ctx, id, endpoint); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMachineLearningBatchEndpointRead(d, meta)
+}
+
+func resourceMachineLearningBatchEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchEndpointClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := batchendpoint.ParseBatchEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.BatchEndpointGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.BatchEndpointName)
+	d.Set("machine_learning_workspace_id", workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		flattenedIdentity, err := flattenIdentity(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		props := model.Properties
+		d.Set("auth_mode", string(props.AuthMode))
+		d.Set("description", pointer.From(props.Description))
+		d.Set("scoring_uri", pointer.From(props.ScoringUri))
+		d.Set("swagger_uri", pointer.From(props.SwaggerUri))
+
+		defaultDeploymentName := ""
+		if props.Defaults != nil {
+			defaultDeploymentName = pointer.From(props.Defaults.DeploymentName)
+		}
+		d.Set("default_deployment_name", defaultDeploymentName)
+
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMachineLearningBatchEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchEndpointClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := batchendpoint.ParseBatchEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.BatchEndpointDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
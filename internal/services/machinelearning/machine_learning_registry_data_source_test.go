@@ -0,0 +1,40 @@
+package machinelearning_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MachineLearningRegistryDataSource struct{}
+
+func TestAccMachineLearningRegistryDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_machine_learning_registry", "test")
+	d := MachineLearningRegistryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("identity.#").HasValue("1"),
+				check.That(data.ResourceName).Key("identity.0.type").HasValue("SystemAssigned"),
+				check.That(data.ResourceName).Key("managed_resource_group_id").Exists(),
+				check.That(data.ResourceName).Key("discovery_url").Exists(),
+			),
+		},
+	})
+}
+
+func (MachineLearningRegistryDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_machine_learning_registry" "test" {
+  name                = azurerm_machine_learning_registry.test.name
+  resource_group_name = azurerm_machine_learning_registry.test.resource_group_name
+}
+`, MachineLearningRegistryResource{}.basic(data))
+}
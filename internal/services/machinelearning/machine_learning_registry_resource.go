@@ -0,0 +1,338 @@
+package machinelearning
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-04-01/registrymanagement"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceMachineLearningRegistry() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMachineLearningRegistryCreateOrUpdate,
+		Read:   resourceMachineLearningRegistryRead,
+		Update: resourceMachineLearningRegistryCreateOrUpdate,
+		Delete: resourceMachineLearningRegistryDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := registrymanagement.ParseRegistryID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\-_]{1,31}$`),
+					"The Machine Learning Registry name must be between 2 and 32 characters long, it can contain only letters, numbers, hyphens and underscores, and the first character must be a letter or number."),
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"location": commonschema.Location(),
+
+			"identity": commonschema.SystemAssignedUserAssignedIdentityRequired(),
+
+			"additional_regions": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: location.EnhancedValidate,
+				},
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"encryption": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.VaultID,
+						},
+						"key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+						},
+					},
+				},
+			},
+
+			"managed_resource_group_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"discovery_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"mlflow_registry_uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceMachineLearningRegistryCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.RegistryManagementClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := registrymanagement.NewRegistryID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.RegistriesGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_machine_learning_registry", id.ID())
+		}
+	}
+
+	expandedIdentity, err := expandIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	publicNetworkAccess := "Disabled"
+	if d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = "Enabled"
+	}
+
+	loc := location.Normalize(d.Get("location").(string))
+	registry := registrymanagement.RegistryTrackedResource{
+		Location: loc,
+		Identity: expandedIdentity,
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Properties: &registrymanagement.Registry{
+			PublicNetworkAccess: &publicNetworkAccess,
+			Encryption:          expandMachineLearningRegistryEncryption(d.Get("encryption").([]interface{})),
+			RegionDetails:       expandMachineLearningRegistryRegionDetails(loc, d.Get("additional_regions").([]interface{})),
+		},
+	}
+
+	if err := client.RegistriesCreateOrUpdateThenPoll(ctx, id, registry); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMachineLearningRegistryRead(d, meta)
+}
+
+func resourceMachineLearningRegistryRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.RegistryManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := registrymanagement.ParseRegistryID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RegistriesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RegistryName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		flattenedIdentity, err := flattenIdentity(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			publicNetworkAccessEnabled := true
+			if props.PublicNetworkAccess != nil {
+				publicNetworkAccessEnabled = *props.PublicNetworkAccess == "Enabled"
+			}
+			d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+			d.Set("additional_regions", flattenMachineLearningRegistryAdditionalRegions(model.Location, props.RegionDetails))
+
+			if err := d.Set("encryption", flattenMachineLearningRegistryEncryption(props.Encryption)); err != nil {
+				return fmt.Errorf("setting `encryption`: %+v", err)
+			}
+
+			managedResourceGroupId := ""
+			if props.ManagedResourceGroup != nil {
+				managedResourceGroupId = pointer.From(props.ManagedResourceGroup.ResourceId)
+			}
+			d.Set("managed_resource_group_id", managedResourceGroupId)
+			d.Set("discovery_url", pointer.From(props.DiscoveryUrl))
+			d.Set("mlflow_registry_uri", pointer.From(props.MlFlowRegistryUri))
+		}
+
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMachineLearningRegistryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.RegistryManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := registrymanagement.ParseRegistryID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.RegistriesDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMachineLearningRegistryRegionDetails(primaryRegion string, input []interface{}) *[]registrymanagement.RegistryRegionArmDetails {
+	regions := []string{primaryRegion}
+	for _, v := range input {
+		regions = append(regions, location.Normalize(v.(string)))
+	}
+
+	details := make([]registrymanagement.RegistryRegionArmDetails, 0)
+	for _, region := range regions {
+		details = append(details, registrymanagement.RegistryRegionArmDetails{
+			Location: pointer.To(region),
+			AcrDetails: &[]registrymanagement.AcrDetails{
+				{
+					SystemCreatedAcrAccount: &registrymanagement.SystemCreatedAcrAccount{
+						AcrAccountSku: pointer.To("Premium"),
+					},
+				},
+			},
+			StorageAccountDetails: &[]registrymanagement.StorageAccountDetails{
+				{
+					SystemCreatedStorageAccount: &registrymanagement.SystemCreatedStorageAccount{
+						StorageAccountType: pointer.To("Standard_LRS"),
+					},
+				},
+			},
+		})
+	}
+
+	return &details
+}
+
+func flattenMachineLearningRegistryAdditionalRegions(primaryRegion string, input *[]registrymanagement.RegistryRegionArmDetails) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, detail := range *input {
+		region := location.NormalizeNilable(detail.Location)
+		if region == "" || region == location.Normalize(primaryRegion) {
+			continue
+		}
+		results = append(results, region)
+	}
+
+	return results
+}
+
+func expandMachineLearningRegistryEncryption(input []interface{}) *registrymanagement.EncryptionProperty {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	out := registrymanagement.EncryptionProperty{
+		KeyVaultProperties: registrymanagement.EncryptionKeyVaultProperties{
+			KeyVaultArmId: raw["key_vault_id"].(string),
+			KeyIdentifier: raw["key_id"].(string),
+		},
+		Status: registrymanagement.EncryptionStatusEnabled,
+	}
+
+	if identityId := raw["user_assigned_identity_id"].(string); identityId != "" {
+		out.Identity = &registrymanagement.IdentityForCmk{
+			UserAssignedIdentity: pointer.To(identityId),
+		}
+	}
+
+	return &out
+}
+
+func flattenMachineLearningRegistryEncryption(input *registrymanagement.EncryptionProperty) []interface{} {
+	if input == nil || input.Status != registrymanagement.EncryptionStatusEnabled {
+		return []interface{}{}
+	}
+
+	userAssignedIdentityId := ""
+	if input.Identity != nil {
+		userAssignedIdentityId = pointer.From(input.Identity.UserAssignedIdentity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_id":              input.KeyVaultProperties.KeyVaultArmId,
+			"key_id":                    input.KeyVaultProperties.KeyIdentifier,
+			"user_assigned_identity_id": userAssignedIdentityId,
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package batchendpoint
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BatchLoggingLevel string
+
+const (
+	BatchLoggingLevelDebug   BatchLoggingLevel = "Debug"
+	BatchLoggingLevelInfo    BatchLoggingLevel = "Info"
+	BatchLoggingLevelWarning BatchLoggingLevel = "Warning"
+)
+
+func PossibleValuesForBatchLoggingLevel() []string {
+	return []string{
+		string(BatchLoggingLevelDebug),
+		string(BatchLoggingLevelInfo),
+		string(BatchLoggingLevelWarning),
+	}
+}
+
+func parseBatchLoggingLevel(input string) (*BatchLoggingLevel, error) {
+	vals := map[string]BatchLoggingLevel{
+		"debug":   BatchLoggingLevelDebug,
+		"info":    BatchLoggingLevelInfo,
+		"warning": BatchLoggingLevelWarning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := BatchLoggingLevel(input)
+	return &out, nil
+}
+
+type BatchOutputAction string
+
+const (
+	BatchOutputActionAppendRow   BatchOutputAction = "AppendRow"
+	BatchOutputActionSummaryOnly BatchOutputAction = "SummaryOnly"
+)
+
+func PossibleValuesForBatchOutputAction() []string {
+	return []string{
+		string(BatchOutputActionAppendRow),
+		string(BatchOutputActionSummaryOnly),
+	}
+}
+
+func parseBatchOutputAction(input string) (*BatchOutputAction, error) {
+	vals := map[string]BatchOutputAction{
+		"appendrow":   BatchOutputActionAppendRow,
+		"summaryonly": BatchOutputActionSummaryOnly,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := BatchOutputAction(input)
+	return &out, nil
+}
+
+type EndpointAuthMode string
+
+const (
+	EndpointAuthModeAADToken EndpointAuthMode = "AADToken"
+	EndpointAuthModeAMLToken EndpointAuthMode = "AMLToken"
+	EndpointAuthModeKey      EndpointAuthMode = "Key"
+)
+
+func PossibleValuesForEndpointAuthMode() []string {
+	return []string{
+		string(EndpointAuthModeAADToken),
+		string(EndpointAuthModeAMLToken),
+		string(EndpointAuthModeKey),
+	}
+}
+
+func parseEndpointAuthMode(input string) (*EndpointAuthMode, error) {
+	vals := map[string]EndpointAuthMode{
+		"aadtoken": EndpointAuthModeAADToken,
+		"amltoken": EndpointAuthModeAMLToken,
+		"key":      EndpointAuthModeKey,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EndpointAuthMode(input)
+	return &out, nil
+}
+
+type ReferenceType string
+
+const (
+	ReferenceTypeDataPath   ReferenceType = "DataPath"
+	ReferenceTypeId         ReferenceType = "Id"
+	ReferenceTypeOutputPath ReferenceType = "OutputPath"
+)
+
+func PossibleValuesForReferenceType() []string {
+	return []string{
+		string(ReferenceTypeDataPath),
+		string(ReferenceTypeId),
+		string(ReferenceTypeOutputPath),
+	}
+}
+
+func parseReferenceType(input string) (*ReferenceType, error) {
+	vals := map[string]ReferenceType{
+		"datapath":   ReferenceTypeDataPath,
+		"id":         ReferenceTypeId,
+		"outputpath": ReferenceTypeOutputPath,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReferenceType(input)
+	return &out, nil
+}
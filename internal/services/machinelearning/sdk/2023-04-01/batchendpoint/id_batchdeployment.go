@@ -0,0 +1,153 @@
+package batchendpoint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+var _ resourceids.ResourceId = BatchDeploymentId{}
+
+// BatchDeploymentId is a struct representing the Resource ID for a Batch Deployment
+type BatchDeploymentId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkspaceName     string
+	BatchEndpointName string
+	DeploymentName    string
+}
+
+// NewBatchDeploymentID returns a new BatchDeploymentId struct
+func NewBatchDeploymentID(subscriptionId string, resourceGroupName string, workspaceName string, batchEndpointName string, deploymentName string) BatchDeploymentId {
+	return BatchDeploymentId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		WorkspaceName:     workspaceName,
+		BatchEndpointName: batchEndpointName,
+		DeploymentName:    deploymentName,
+	}
+}
+
+// ParseBatchDeploymentID parses 'input' into a BatchDeploymentId
+func ParseBatchDeploymentID(input string) (*BatchDeploymentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(BatchDeploymentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := BatchDeploymentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceName, ok = parsed.Parsed["workspaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceName' was not found in the resource id %q", input)
+	}
+
+	if id.BatchEndpointName, ok = parsed.Parsed["batchEndpointName"]; !ok {
+		return nil, fmt.Errorf("the segment 'batchEndpointName' was not found in the resource id %q", input)
+	}
+
+	if id.DeploymentName, ok = parsed.Parsed["deploymentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deploymentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseBatchDeploymentIDInsensitively parses 'input' case-insensitively into a BatchDeploymentId
+// note: this method should only be used for API response data and not user input
+func ParseBatchDeploymentIDInsensitively(input string) (*BatchDeploymentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(BatchDeploymentId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := BatchDeploymentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceName, ok = parsed.Parsed["workspaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceName' was not found in the resource id %q", input)
+	}
+
+	if id.BatchEndpointName, ok = parsed.Parsed["batchEndpointName"]; !ok {
+		return nil, fmt.Errorf("the segment 'batchEndpointName' was not found in the resource id %q", input)
+	}
+
+	if id.DeploymentName, ok = parsed.Parsed["deploymentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deploymentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateBatchDeploymentID checks that 'input' can be parsed as a Batch Deployment ID
+func ValidateBatchDeploymentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseBatchDeploymentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Batch Deployment ID
+func (id BatchDeploymentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MachineLearningServices/workspaces/%s/batchEndpoints/%s/deployments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.BatchEndpointName, id.DeploymentName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Batch Deployment ID
+func (id BatchDeploymentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMachineLearningServices", "Microsoft.MachineLearningServices", "Microsoft.MachineLearningServices"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspaceValue"),
+		resourceids.StaticSegment("staticBatchEndpoints", "batchEndpoints", "batchEndpoints"),
+		resourceids.UserSpecifiedSegment("batchEndpointName", "batchEndpointValue"),
+		resourceids.StaticSegment("staticDeployments", "deployments", "deployments"),
+		resourceids.UserSpecifiedSegment("deploymentName", "deploymentValue"),
+	}
+}
+
+// String returns a human-readable description of this Batch Deployment ID
+func (id BatchDeploymentId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Workspace Name: %q", id.WorkspaceName),
+		fmt.Sprintf("Batch Endpoint Name: %q", id.BatchEndpointName),
+		fmt.Sprintf("Deployment Name: %q", id.DeploymentName),
+	}
+	return fmt.Sprintf("Batch Deployment (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,79 @@
+package batchendpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BatchDeploymentCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// BatchDeploymentCreateOrUpdate ...
+func (c BatchEndpointClient) BatchDeploymentCreateOrUpdate(ctx context.Context, id BatchDeploymentId, input BatchDeploymentTrackedResource) (result BatchDeploymentCreateOrUpdateOperationResponse, err error) {
+	req, err := c.preparerForBatchDeploymentCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchDeploymentCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForBatchDeploymentCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchDeploymentCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// BatchDeploymentCreateOrUpdateThenPoll performs BatchDeploymentCreateOrUpdate then polls until it's completed
+func (c BatchEndpointClient) BatchDeploymentCreateOrUpdateThenPoll(ctx context.Context, id BatchDeploymentId, input BatchDeploymentTrackedResource) error {
+	result, err := c.BatchDeploymentCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing BatchDeploymentCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after BatchDeploymentCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForBatchDeploymentCreateOrUpdate prepares the BatchDeploymentCreateOrUpdate request.
+func (c BatchEndpointClient) preparerForBatchDeploymentCreateOrUpdate(ctx context.Context, id BatchDeploymentId, input BatchDeploymentTrackedResource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForBatchDeploymentCreateOrUpdate sends the BatchDeploymentCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c BatchEndpointClient) senderForBatchDeploymentCreateOrUpdate(ctx context.Context, req *http.Request) (future BatchDeploymentCreateOrUpdateOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
@@ -0,0 +1,78 @@
+package batchendpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BatchDeploymentDeleteOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// BatchDeploymentDelete ...
+func (c BatchEndpointClient) BatchDeploymentDelete(ctx context.Context, id BatchDeploymentId) (result BatchDeploymentDeleteOperationResponse, err error) {
+	req, err := c.preparerForBatchDeploymentDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchDeploymentDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForBatchDeploymentDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchDeploymentDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// BatchDeploymentDeleteThenPoll performs BatchDeploymentDelete then polls until it's completed
+func (c BatchEndpointClient) BatchDeploymentDeleteThenPoll(ctx context.Context, id BatchDeploymentId) error {
+	result, err := c.BatchDeploymentDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing BatchDeploymentDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after BatchDeploymentDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForBatchDeploymentDelete prepares the BatchDeploymentDelete request.
+func (c BatchEndpointClient) preparerForBatchDeploymentDelete(ctx context.Context, id BatchDeploymentId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForBatchDeploymentDelete sends the BatchDeploymentDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c BatchEndpointClient) senderForBatchDeploymentDelete(ctx context.Context, req *http.Request) (future BatchDeploymentDeleteOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
@@ -0,0 +1,68 @@
+package batchendpoint
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BatchDeploymentGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *BatchDeploymentTrackedResource
+}
+
+// BatchDeploymentGet ...
+func (c BatchEndpointClient) BatchDeploymentGet(ctx context.Context, id BatchDeploymentId) (result BatchDeploymentGetOperationResponse, err error) {
+	req, err := c.preparerForBatchDeploymentGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchDeploymentGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchDeploymentGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForBatchDeploymentGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchDeploymentGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForBatchDeploymentGet prepares the BatchDeploymentGet request.
+func (c BatchEndpointClient) preparerForBatchDeploymentGet(ctx context.Context, id BatchDeploymentId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForBatchDeploymentGet handles the response to the BatchDeploymentGet request. The method always
+// closes the http.Response Body.
+func (c BatchEndpointClient) responderForBatchDeploymentGet(resp *http.Response) (result BatchDeploymentGetOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
@@ -0,0 +1,79 @@
+package batchendpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BatchEndpointCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// BatchEndpointCreateOrUpdate ...
+func (c BatchEndpointClient) BatchEndpointCreateOrUpdate(ctx context.Context, id BatchEndpointId, input BatchEndpointTrackedResource) (result BatchEndpointCreateOrUpdateOperationResponse, err error) {
+	req, err := c.preparerForBatchEndpointCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchEndpointCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForBatchEndpointCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchEndpointCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// BatchEndpointCreateOrUpdateThenPoll performs BatchEndpointCreateOrUpdate then polls until it's completed
+func (c BatchEndpointClient) BatchEndpointCreateOrUpdateThenPoll(ctx context.Context, id BatchEndpointId, input BatchEndpointTrackedResource) error {
+	result, err := c.BatchEndpointCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing BatchEndpointCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after BatchEndpointCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForBatchEndpointCreateOrUpdate prepares the BatchEndpointCreateOrUpdate request.
+func (c BatchEndpointClient) preparerForBatchEndpointCreateOrUpdate(ctx context.Context, id BatchEndpointId, input BatchEndpointTrackedResource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForBatchEndpointCreateOrUpdate sends the BatchEndpointCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c BatchEndpointClient) senderForBatchEndpointCreateOrUpdate(ctx context.Context, req *http.Request) (future BatchEndpointCreateOrUpdateOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
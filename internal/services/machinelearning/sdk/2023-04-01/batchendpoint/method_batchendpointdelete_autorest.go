@@ -0,0 +1,78 @@
+package batchendpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BatchEndpointDeleteOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// BatchEndpointDelete ...
+func (c BatchEndpointClient) BatchEndpointDelete(ctx context.Context, id BatchEndpointId) (result BatchEndpointDeleteOperationResponse, err error) {
+	req, err := c.preparerForBatchEndpointDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchEndpointDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForBatchEndpointDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchEndpointDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// BatchEndpointDeleteThenPoll performs BatchEndpointDelete then polls until it's completed
+func (c BatchEndpointClient) BatchEndpointDeleteThenPoll(ctx context.Context, id BatchEndpointId) error {
+	result, err := c.BatchEndpointDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing BatchEndpointDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after BatchEndpointDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForBatchEndpointDelete prepares the BatchEndpointDelete request.
+func (c BatchEndpointClient) preparerForBatchEndpointDelete(ctx context.Context, id BatchEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForBatchEndpointDelete sends the BatchEndpointDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c BatchEndpointClient) senderForBatchEndpointDelete(ctx context.Context, req *http.Request) (future BatchEndpointDeleteOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
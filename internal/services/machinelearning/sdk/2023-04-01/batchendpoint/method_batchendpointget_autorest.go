@@ -0,0 +1,68 @@
+package batchendpoint
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BatchEndpointGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *BatchEndpointTrackedResource
+}
+
+// BatchEndpointGet ...
+func (c BatchEndpointClient) BatchEndpointGet(ctx context.Context, id BatchEndpointId) (result BatchEndpointGetOperationResponse, err error) {
+	req, err := c.preparerForBatchEndpointGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchEndpointGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchEndpointGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForBatchEndpointGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "batchendpoint.BatchEndpointClient", "BatchEndpointGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForBatchEndpointGet prepares the BatchEndpointGet request.
+func (c BatchEndpointClient) preparerForBatchEndpointGet(ctx context.Context, id BatchEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForBatchEndpointGet handles the response to the BatchEndpointGet request. The method always
+// closes the http.Response Body.
+func (c BatchEndpointClient) responderForBatchEndpointGet(resp *http.Response) (result BatchEndpointGetOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
@@ -0,0 +1,79 @@
+package batchendpoint
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BatchEndpointTrackedResource struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties BatchEndpoint                            `json:"properties"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
+
+type BatchEndpoint struct {
+	AuthMode          EndpointAuthMode       `json:"authMode"`
+	Defaults          *BatchEndpointDefaults `json:"defaults,omitempty"`
+	Description       *string                `json:"description,omitempty"`
+	Properties        *map[string]string     `json:"properties,omitempty"`
+	ProvisioningState *string                `json:"provisioningState,omitempty"`
+	ScoringUri        *string                `json:"scoringUri,omitempty"`
+	SwaggerUri        *string                `json:"swaggerUri,omitempty"`
+}
+
+type BatchEndpointDefaults struct {
+	DeploymentName *string `json:"deploymentName,omitempty"`
+}
+
+type BatchDeploymentTrackedResource struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties BatchDeployment    `json:"properties"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
+
+type BatchDeployment struct {
+	CodeConfiguration         *CodeConfiguration               `json:"codeConfiguration,omitempty"`
+	Compute                   *string                          `json:"compute,omitempty"`
+	Description               *string                          `json:"description,omitempty"`
+	EnvironmentId             *string                          `json:"environmentId,omitempty"`
+	EnvironmentVariables      *map[string]string               `json:"environmentVariables,omitempty"`
+	ErrorThreshold            *int64                           `json:"errorThreshold,omitempty"`
+	LoggingLevel              *BatchLoggingLevel               `json:"loggingLevel,omitempty"`
+	MaxConcurrencyPerInstance *int64                           `json:"maxConcurrencyPerInstance,omitempty"`
+	MiniBatchSize             *int64                           `json:"miniBatchSize,omitempty"`
+	Model                     *IdAssetReference                `json:"model,omitempty"`
+	OutputAction              *BatchOutputAction               `json:"outputAction,omitempty"`
+	OutputFileName            *string                          `json:"outputFileName,omitempty"`
+	ProvisioningState         *string                          `json:"provisioningState,omitempty"`
+	Resources                 *DeploymentResourceConfiguration `json:"resources,omitempty"`
+	RetrySettings             *BatchRetrySettings              `json:"retrySettings,omitempty"`
+}
+
+type CodeConfiguration struct {
+	CodeId        *string `json:"codeId,omitempty"`
+	ScoringScript string  `json:"scoringScript"`
+}
+
+type IdAssetReference struct {
+	AssetId       string        `json:"assetId"`
+	ReferenceType ReferenceType `json:"referenceType"`
+}
+
+type DeploymentResourceConfiguration struct {
+	InstanceCount *int64  `json:"instanceCount,omitempty"`
+	InstanceType  *string `json:"instanceType,omitempty"`
+}
+
+type BatchRetrySettings struct {
+	MaxRetries *int64  `json:"maxRetries,omitempty"`
+	Timeout    *string `json:"timeout,omitempty"`
+}
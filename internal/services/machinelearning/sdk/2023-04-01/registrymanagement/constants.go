@@ -0,0 +1,34 @@
+package registrymanagement
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type EncryptionStatus string
+
+const (
+	EncryptionStatusDisabled EncryptionStatus = "Disabled"
+	EncryptionStatusEnabled  EncryptionStatus = "Enabled"
+)
+
+func PossibleValuesForEncryptionStatus() []string {
+	return []string{
+		string(EncryptionStatusDisabled),
+		string(EncryptionStatusEnabled),
+	}
+}
+
+func parseEncryptionStatus(input string) (*EncryptionStatus, error) {
+	vals := map[string]EncryptionStatus{
+		"disabled": EncryptionStatusDisabled,
+		"enabled":  EncryptionStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EncryptionStatus(input)
+	return &out, nil
+}
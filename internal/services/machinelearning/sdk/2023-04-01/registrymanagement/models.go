@@ -0,0 +1,78 @@
+package registrymanagement
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RegistryTrackedResource struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *Registry                                `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData                   `json:"systemData,omitempty"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
+
+type Registry struct {
+	DiscoveryUrl                  *string                     `json:"discoveryUrl,omitempty"`
+	Encryption                    *EncryptionProperty         `json:"encryption,omitempty"`
+	IntellectualPropertyPublisher *string                     `json:"intellectualPropertyPublisher,omitempty"`
+	ManagedResourceGroup          *ArmResourceId              `json:"managedResourceGroup,omitempty"`
+	MlFlowRegistryUri             *string                     `json:"mlFlowRegistryUri,omitempty"`
+	PublicNetworkAccess           *string                     `json:"publicNetworkAccess,omitempty"`
+	RegionDetails                 *[]RegistryRegionArmDetails `json:"regionDetails,omitempty"`
+}
+
+type RegistryRegionArmDetails struct {
+	AcrDetails            *[]AcrDetails            `json:"acrDetails,omitempty"`
+	Location              *string                  `json:"location,omitempty"`
+	StorageAccountDetails *[]StorageAccountDetails `json:"storageAccountDetails,omitempty"`
+}
+
+type AcrDetails struct {
+	SystemCreatedAcrAccount *SystemCreatedAcrAccount `json:"systemCreatedAcrAccount,omitempty"`
+}
+
+type SystemCreatedAcrAccount struct {
+	AcrAccountName *string        `json:"acrAccountName,omitempty"`
+	AcrAccountSku  *string        `json:"acrAccountSku,omitempty"`
+	ArmResourceId  *ArmResourceId `json:"armResourceId,omitempty"`
+}
+
+type StorageAccountDetails struct {
+	SystemCreatedStorageAccount *SystemCreatedStorageAccount `json:"systemCreatedStorageAccount,omitempty"`
+}
+
+type SystemCreatedStorageAccount struct {
+	AllowBlobPublicAccess    *bool          `json:"allowBlobPublicAccess,omitempty"`
+	ArmResourceId            *ArmResourceId `json:"armResourceId,omitempty"`
+	StorageAccountHnsEnabled *bool          `json:"storageAccountHnsEnabled,omitempty"`
+	StorageAccountName       *string        `json:"storageAccountName,omitempty"`
+	StorageAccountType       *string        `json:"storageAccountType,omitempty"`
+}
+
+type ArmResourceId struct {
+	ResourceId *string `json:"resourceId,omitempty"`
+}
+
+type EncryptionProperty struct {
+	Identity           *IdentityForCmk              `json:"identity,omitempty"`
+	KeyVaultProperties EncryptionKeyVaultProperties `json:"keyVaultProperties"`
+	Status             EncryptionStatus             `json:"status"`
+}
+
+type EncryptionKeyVaultProperties struct {
+	IdentityClientId *string `json:"identityClientId,omitempty"`
+	KeyIdentifier    string  `json:"keyIdentifier"`
+	KeyVaultArmId    string  `json:"keyVaultArmId"`
+}
+
+type IdentityForCmk struct {
+	UserAssignedIdentity *string `json:"userAssignedIdentity,omitempty"`
+}
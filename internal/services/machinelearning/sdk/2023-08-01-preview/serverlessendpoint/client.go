@@ -0,0 +1,18 @@
+package serverlessendpoint
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ServerlessEndpointClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewServerlessEndpointClientWithBaseURI(endpoint string) ServerlessEndpointClient {
+	return ServerlessEndpointClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
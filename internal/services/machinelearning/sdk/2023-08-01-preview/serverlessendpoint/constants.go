@@ -0,0 +1,31 @@
+package serverlessendpoint
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ServerlessInferenceEndpointAuthMode string
+
+const (
+	ServerlessInferenceEndpointAuthModeKey ServerlessInferenceEndpointAuthMode = "Key"
+)
+
+func PossibleValuesForServerlessInferenceEndpointAuthMode() []string {
+	return []string{
+		string(ServerlessInferenceEndpointAuthModeKey),
+	}
+}
+
+func parseServerlessInferenceEndpointAuthMode(input string) (*ServerlessInferenceEndpointAuthMode, error) {
+	vals := map[string]ServerlessInferenceEndpointAuthMode{
+		"key": ServerlessInferenceEndpointAuthModeKey,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ServerlessInferenceEndpointAuthMode(input)
+	return &out, nil
+}
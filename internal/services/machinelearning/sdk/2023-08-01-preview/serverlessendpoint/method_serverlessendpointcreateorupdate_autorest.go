@@ -0,0 +1,79 @@
+package serverlessendpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ServerlessEndpointCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ServerlessEndpointCreateOrUpdate ...
+func (c ServerlessEndpointClient) ServerlessEndpointCreateOrUpdate(ctx context.Context, id ServerlessEndpointId, input ServerlessEndpointTrackedResource) (result ServerlessEndpointCreateOrUpdateOperationResponse, err error) {
+	req, err := c.preparerForServerlessEndpointCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ServerlessEndpointCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForServerlessEndpointCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ServerlessEndpointCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ServerlessEndpointCreateOrUpdateThenPoll performs ServerlessEndpointCreateOrUpdate then polls until it's completed
+func (c ServerlessEndpointClient) ServerlessEndpointCreateOrUpdateThenPoll(ctx context.Context, id ServerlessEndpointId, input ServerlessEndpointTrackedResource) error {
+	result, err := c.ServerlessEndpointCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing ServerlessEndpointCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ServerlessEndpointCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForServerlessEndpointCreateOrUpdate prepares the ServerlessEndpointCreateOrUpdate request.
+func (c ServerlessEndpointClient) preparerForServerlessEndpointCreateOrUpdate(ctx context.Context, id ServerlessEndpointId, input ServerlessEndpointTrackedResource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForServerlessEndpointCreateOrUpdate sends the ServerlessEndpointCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c ServerlessEndpointClient) senderForServerlessEndpointCreateOrUpdate(ctx context.Context, req *http.Request) (future ServerlessEndpointCreateOrUpdateOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
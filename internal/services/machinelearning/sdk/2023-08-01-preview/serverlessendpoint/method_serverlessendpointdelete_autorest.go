@@ -0,0 +1,78 @@
+package serverlessendpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ServerlessEndpointDeleteOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ServerlessEndpointDelete ...
+func (c ServerlessEndpointClient) ServerlessEndpointDelete(ctx context.Context, id ServerlessEndpointId) (result ServerlessEndpointDeleteOperationResponse, err error) {
+	req, err := c.preparerForServerlessEndpointDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ServerlessEndpointDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForServerlessEndpointDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ServerlessEndpointDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ServerlessEndpointDeleteThenPoll performs ServerlessEndpointDelete then polls until it's completed
+func (c ServerlessEndpointClient) ServerlessEndpointDeleteThenPoll(ctx context.Context, id ServerlessEndpointId) error {
+	result, err := c.ServerlessEndpointDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing ServerlessEndpointDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ServerlessEndpointDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForServerlessEndpointDelete prepares the ServerlessEndpointDelete request.
+func (c ServerlessEndpointClient) preparerForServerlessEndpointDelete(ctx context.Context, id ServerlessEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForServerlessEndpointDelete sends the ServerlessEndpointDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c ServerlessEndpointClient) senderForServerlessEndpointDelete(ctx context.Context, req *http.Request) (future ServerlessEndpointDeleteOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
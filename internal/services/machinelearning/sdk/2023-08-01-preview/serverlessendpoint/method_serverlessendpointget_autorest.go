@@ -0,0 +1,68 @@
+package serverlessendpoint
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ServerlessEndpointGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *ServerlessEndpointTrackedResource
+}
+
+// ServerlessEndpointGet ...
+func (c ServerlessEndpointClient) ServerlessEndpointGet(ctx context.Context, id ServerlessEndpointId) (result ServerlessEndpointGetOperationResponse, err error) {
+	req, err := c.preparerForServerlessEndpointGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ServerlessEndpointGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ServerlessEndpointGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForServerlessEndpointGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ServerlessEndpointGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForServerlessEndpointGet prepares the ServerlessEndpointGet request.
+func (c ServerlessEndpointClient) preparerForServerlessEndpointGet(ctx context.Context, id ServerlessEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForServerlessEndpointGet handles the response to the ServerlessEndpointGet request. The method always
+// closes the http.Response Body.
+func (c ServerlessEndpointClient) responderForServerlessEndpointGet(resp *http.Response) (result ServerlessEndpointGetOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
@@ -0,0 +1,36 @@
+package serverlessendpoint
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ServerlessEndpointTrackedResource struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties ServerlessEndpoint                       `json:"properties"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
+
+type ServerlessEndpoint struct {
+	AuthMode                  ServerlessInferenceEndpointAuthMode `json:"authMode"`
+	EndpointState             *string                             `json:"endpointState,omitempty"`
+	InferenceEndpoint         *ServerlessInferenceEndpoint        `json:"inferenceEndpoint,omitempty"`
+	MarketplaceSubscriptionId *string                             `json:"marketplaceSubscriptionId,omitempty"`
+	ModelSettings             *ModelSettings                      `json:"modelSettings,omitempty"`
+	ProvisioningState         *string                             `json:"provisioningState,omitempty"`
+}
+
+type ServerlessInferenceEndpoint struct {
+	Headers *map[string]string `json:"headers,omitempty"`
+	Uri     string             `json:"uri"`
+}
+
+type ModelSettings struct {
+	ModelId string `json:"modelId"`
+}
@@ -0,0 +1,315 @@
+package maintenance
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2022-07-01-preview/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/sdk/2022-07-01-preview/configurationassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmMaintenanceAssignmentDynamicScope() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmMaintenanceAssignmentDynamicScopeCreateUpdate,
+		Read:   resourceArmMaintenanceAssignmentDynamicScopeRead,
+		Update: resourceArmMaintenanceAssignmentDynamicScopeCreateUpdate,
+		Delete: resourceArmMaintenanceAssignmentDynamicScopeDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := configurationassignments.ParseSubscriptionConfigurationAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"maintenance_configuration_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: maintenanceconfigurations.ValidateMaintenanceConfigurationID,
+			},
+
+			"filter": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"locations": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"os_types": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Linux",
+									"Windows",
+								}, false),
+							},
+						},
+
+						"resource_groups": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"resource_types": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"tag_filter": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(configurationassignments.TagOperatorsAny),
+							ValidateFunc: validation.StringInSlice(configurationassignments.PossibleValuesForTagOperators(), false),
+						},
+
+						"tags": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"tag": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"values": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmMaintenanceAssignmentDynamicScopeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := configurationassignments.NewSubscriptionConfigurationAssignmentID(subscriptionId, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.ForSubscriptionsGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_maintenance_assignment_dynamic_scope", id.ID())
+		}
+	}
+
+	configurationId, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(d.Get("maintenance_configuration_id").(string))
+	if err != nil {
+		return err
+	}
+
+	configurationAssignment := configurationassignments.ConfigurationAssignment{
+		Name: utils.String(id.ConfigurationAssignmentName),
+		Properties: &configurationassignments.ConfigurationAssignmentProperties{
+			Filter:                     expandMaintenanceAssignmentDynamicScopeFilter(d.Get("filter").([]interface{})),
+			MaintenanceConfigurationId: utils.String(configurationId.ID()),
+		},
+	}
+
+	if _, err := client.ForSubscriptionsCreateOrUpdate(ctx, id, configurationAssignment); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceArmMaintenanceAssignmentDynamicScopeRead(d, meta)
+}
+
+func resourceArmMaintenanceAssignmentDynamicScopeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := configurationassignments.ParseSubscriptionConfigurationAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ForSubscriptionsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ConfigurationAssignmentName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			maintenanceConfigurationId := ""
+			if props.MaintenanceConfigurationId != nil {
+				parsedId, err := maintenanceconfigurations.ParseMaintenanceConfigurationIDInsensitively(*props.MaintenanceConfigurationId)
+				if err != nil {
+					return fmt.Errorf("parsing %q: %+v", *props.MaintenanceConfigurationId, err)
+				}
+				maintenanceConfigurationId = parsedId.ID()
+			}
+			d.Set("maintenance_configuration_id", maintenanceConfigurationId)
+
+			if err := d.Set("filter", flattenMaintenanceAssignmentDynamicScopeFilter(props.Filter)); err != nil {
+				return fmt.Errorf("setting `filter`: %+v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceArmMaintenanceAssignmentDynamicScopeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := configurationassignments.ParseSubscriptionConfigurationAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.ForSubscriptionsDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	return nil
+}
+
+func expandMaintenanceAssignmentDynamicScopeFilter(input []interface{}) *configurationassignments.ConfigurationAssignmentFilterProperties {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	filter := configurationassignments.ConfigurationAssignmentFilterProperties{
+		Locations:      utils.ExpandStringSlice(v["locations"].([]interface{})),
+		OsTypes:        utils.ExpandStringSlice(v["os_types"].([]interface{})),
+		ResourceGroups: utils.ExpandStringSlice(v["resource_groups"].([]interface{})),
+		ResourceTypes:  utils.ExpandStringSlice(v["resource_types"].([]interface{})),
+	}
+
+	filterOperator := configurationassignments.TagOperators(v["tag_filter"].(string))
+	tags := expandMaintenanceAssignmentDynamicScopeTags(v["tags"].([]interface{}))
+	if tags != nil {
+		filter.TagSettings = &configurationassignments.TagSettingsProperties{
+			FilterOperator: &filterOperator,
+			Tags:           tags,
+		}
+	}
+
+	return &filter
+}
+
+func flattenMaintenanceAssignmentDynamicScopeFilter(input *configurationassignments.ConfigurationAssignmentFilterProperties) []interface{} {
+	results := make([]interface{}, 0)
+
+	if v := input; v != nil {
+		output := make(map[string]interface{})
+
+		output["locations"] = utils.FlattenStringSlice(v.Locations)
+		output["os_types"] = utils.FlattenStringSlice(v.OsTypes)
+		output["resource_groups"] = utils.FlattenStringSlice(v.ResourceGroups)
+		output["resource_types"] = utils.FlattenStringSlice(v.ResourceTypes)
+
+		tagFilter := string(configurationassignments.TagOperatorsAny)
+		tags := make([]interface{}, 0)
+		if tagSettings := v.TagSettings; tagSettings != nil {
+			if tagSettings.FilterOperator != nil {
+				tagFilter = string(*tagSettings.FilterOperator)
+			}
+			tags = flattenMaintenanceAssignmentDynamicScopeTags(tagSettings.Tags)
+		}
+		output["tag_filter"] = tagFilter
+		output["tags"] = tags
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandMaintenanceAssignmentDynamicScopeTags(input []interface{}) *map[string][]string {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make(map[string][]string)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		values := make([]string, 0)
+		if expanded := utils.ExpandStringSlice(v["values"].([]interface{})); expanded != nil {
+			values = *expanded
+		}
+		output[v["tag"].(string)] = values
+	}
+	return &output
+}
+
+func flattenMaintenanceAssignmentDynamicScopeTags(input *map[string][]string) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input != nil {
+		for tag, values := range *input {
+			results = append(results, map[string]interface{}{
+				"tag":    tag,
+				"values": utils.FlattenStringSlice(&values),
+			})
+		}
+	}
+
+	return results
+}
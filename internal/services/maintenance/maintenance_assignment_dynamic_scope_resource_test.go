@@ -0,0 +1,194 @@
+package maintenance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/sdk/2022-07-01-preview/configurationassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MaintenanceAssignmentDynamicScopeResource struct{}
+
+func TestAccMaintenanceAssignmentDynamicScope_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maintenance_assignment_dynamic_scope", "test")
+	r := MaintenanceAssignmentDynamicScopeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMaintenanceAssignmentDynamicScope_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maintenance_assignment_dynamic_scope", "test")
+	r := MaintenanceAssignmentDynamicScopeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMaintenanceAssignmentDynamicScope_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maintenance_assignment_dynamic_scope", "test")
+	r := MaintenanceAssignmentDynamicScopeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMaintenanceAssignmentDynamicScope_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maintenance_assignment_dynamic_scope", "test")
+	r := MaintenanceAssignmentDynamicScopeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MaintenanceAssignmentDynamicScopeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := configurationassignments.ParseSubscriptionConfigurationAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Maintenance.ConfigurationAssignmentsClient.ForSubscriptionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_maintenance_assignment_dynamic_scope" "test" {
+  name                         = "acctest-MADS%d"
+  maintenance_configuration_id = azurerm_maintenance_configuration.test.id
+
+  filter {
+    resource_groups = [azurerm_resource_group.test.name]
+    os_types        = ["Linux"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_maintenance_assignment_dynamic_scope" "test" {
+  name                         = "acctest-MADS%d"
+  maintenance_configuration_id = azurerm_maintenance_configuration.test.id
+
+  filter {
+    locations       = ["%s"]
+    os_types        = ["Linux", "Windows"]
+    resource_groups = [azurerm_resource_group.test.name]
+    resource_types  = ["Microsoft.Compute/virtualMachines"]
+    tag_filter      = "All"
+
+    tags {
+      tag    = "environment"
+      values = ["testing"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_maintenance_assignment_dynamic_scope" "import" {
+  name                         = azurerm_maintenance_assignment_dynamic_scope.test.name
+  maintenance_configuration_id = azurerm_maintenance_assignment_dynamic_scope.test.maintenance_configuration_id
+
+  filter {
+    resource_groups = [azurerm_resource_group.test.name]
+    os_types        = ["Linux"]
+  }
+}
+`, r.basic(data))
+}
+
+func (MaintenanceAssignmentDynamicScopeResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-maint-%d"
+  location = "%s"
+}
+
+resource "azurerm_maintenance_configuration" "test" {
+  name                = "acctest-MC%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  scope               = "InGuestPatch"
+  visibility          = "Custom"
+
+  window {
+    start_date_time      = "5555-12-31 00:00"
+    expiration_date_time = "6666-12-31 00:00"
+    duration             = "02:00"
+    time_zone            = "Pacific Standard Time"
+    recur_every          = "2Days"
+  }
+
+  install_patches {
+    reboot = "IfRequired"
+    linux {
+      classifications_to_include = ["Critical", "Security"]
+    }
+    windows {
+      classifications_to_include = ["Critical", "Security"]
+    }
+  }
+
+  in_guest_user_patch_mode = "User"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,111 @@
+package configurationassignments
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = SubscriptionConfigurationAssignmentId{}
+
+// SubscriptionConfigurationAssignmentId is a struct representing the Resource ID for a Subscription Configuration Assignment
+type SubscriptionConfigurationAssignmentId struct {
+	SubscriptionId              string
+	ConfigurationAssignmentName string
+}
+
+// NewSubscriptionConfigurationAssignmentID returns a new SubscriptionConfigurationAssignmentId struct
+func NewSubscriptionConfigurationAssignmentID(subscriptionId string, configurationAssignmentName string) SubscriptionConfigurationAssignmentId {
+	return SubscriptionConfigurationAssignmentId{
+		SubscriptionId:              subscriptionId,
+		ConfigurationAssignmentName: configurationAssignmentName,
+	}
+}
+
+// ParseSubscriptionConfigurationAssignmentID parses 'input' into a SubscriptionConfigurationAssignmentId
+func ParseSubscriptionConfigurationAssignmentID(input string) (*SubscriptionConfigurationAssignmentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SubscriptionConfigurationAssignmentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SubscriptionConfigurationAssignmentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ConfigurationAssignmentName, ok = parsed.Parsed["configurationAssignmentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'configurationAssignmentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseSubscriptionConfigurationAssignmentIDInsensitively parses 'input' case-insensitively into a SubscriptionConfigurationAssignmentId
+// note: this method should only be used for API response data and not user input
+func ParseSubscriptionConfigurationAssignmentIDInsensitively(input string) (*SubscriptionConfigurationAssignmentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SubscriptionConfigurationAssignmentId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SubscriptionConfigurationAssignmentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ConfigurationAssignmentName, ok = parsed.Parsed["configurationAssignmentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'configurationAssignmentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateSubscriptionConfigurationAssignmentID checks that 'input' can be parsed as a Subscription Configuration Assignment ID
+func ValidateSubscriptionConfigurationAssignmentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSubscriptionConfigurationAssignmentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Subscription Configuration Assignment ID
+func (id SubscriptionConfigurationAssignmentId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Maintenance/configurationAssignments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ConfigurationAssignmentName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Subscription Configuration Assignment ID
+func (id SubscriptionConfigurationAssignmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMaintenance", "Microsoft.Maintenance", "Microsoft.Maintenance"),
+		resourceids.StaticSegment("staticConfigurationAssignments", "configurationAssignments", "configurationAssignments"),
+		resourceids.UserSpecifiedSegment("configurationAssignmentName", "configurationAssignmentValue"),
+	}
+}
+
+// String returns a human-readable description of this Subscription Configuration Assignment ID
+func (id SubscriptionConfigurationAssignmentId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Configuration Assignment Name: %q", id.ConfigurationAssignmentName),
+	}
+	return fmt.Sprintf("Subscription Configuration Assignment (%s)", strings.Join(components, "\n"))
+}
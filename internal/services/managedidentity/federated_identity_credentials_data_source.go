@@ -0,0 +1,101 @@
+package managedidentity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2022-01-31-preview/managedidentities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = FederatedIdentityCredentialsDataSource{}
+
+type FederatedIdentityCredentialsDataSource struct{}
+
+type FederatedIdentityCredentialsDataSourceModel struct {
+	ResourceName string                                `tfschema:"user_assigned_identity_id"`
+	Credentials  []FederatedIdentityCredentialsElement `tfschema:"credentials"`
+}
+
+func (FederatedIdentityCredentialsDataSource) ResourceType() string {
+	return "azurerm_federated_identity_credentials"
+}
+
+func (FederatedIdentityCredentialsDataSource) ModelObject() interface{} {
+	return &FederatedIdentityCredentialsDataSourceModel{}
+}
+
+func (FederatedIdentityCredentialsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
+	}
+}
+
+func (FederatedIdentityCredentialsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"credentials": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"issuer": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"subject": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"audience": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (FederatedIdentityCredentialsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedIdentity.ManagedIdentities
+
+			var state FederatedIdentityCredentialsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseUserAssignedIdentityID(state.ResourceName)
+			if err != nil {
+				return err
+			}
+
+			credentials, err := client.FederatedIdentityCredentialsListComplete(ctx, *id, managedidentities.DefaultFederatedIdentityCredentialsListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Federated Identity Credentials on %s: %+v", *id, err)
+			}
+
+			state.ResourceName = id.ID()
+			state.Credentials = flattenFederatedIdentityCredentialsElements(credentials.Items)
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package managedidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type FederatedIdentityCredentialsDataSource struct{}
+
+func TestAccFederatedIdentityCredentialsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_federated_identity_credentials", "test")
+	d := FederatedIdentityCredentialsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("credentials.#").HasValue("2"),
+				check.That(data.ResourceName).Key("credentials.0.name").Exists(),
+				check.That(data.ResourceName).Key("credentials.0.issuer").Exists(),
+				check.That(data.ResourceName).Key("credentials.0.subject").Exists(),
+			),
+		},
+	})
+}
+
+func (FederatedIdentityCredentialsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_federated_identity_credentials" "test" {
+  user_assigned_identity_id = azurerm_federated_identity_credentials.test.user_assigned_identity_id
+}
+`, FederatedIdentityCredentialsTestResource{}.basic(data))
+}
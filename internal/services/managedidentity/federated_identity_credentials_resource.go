@@ -0,0 +1,282 @@
+package managedidentity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2022-01-31-preview/managedidentities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = FederatedIdentityCredentialsResource{}
+
+// FederatedIdentityCredentialsResource manages the full set of Federated Identity Credentials
+// on a User Assigned Identity - the service only allows a single concurrent write per identity,
+// so managing many credentials as individual resources serializes on the identity lock and
+// becomes slow. This resource issues the writes sequentially itself, within a single lock.
+type FederatedIdentityCredentialsResource struct{}
+
+func (r FederatedIdentityCredentialsResource) ModelObject() interface{} {
+	return &FederatedIdentityCredentialsResourceSchema{}
+}
+
+type FederatedIdentityCredentialsResourceSchema struct {
+	ResourceName string                                `tfschema:"user_assigned_identity_id"`
+	Credential   []FederatedIdentityCredentialsElement `tfschema:"credential"`
+}
+
+type FederatedIdentityCredentialsElement struct {
+	Audience []string `tfschema:"audience"`
+	Issuer   string   `tfschema:"issuer"`
+	Name     string   `tfschema:"name"`
+	Subject  string   `tfschema:"subject"`
+}
+
+func (r FederatedIdentityCredentialsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateUserAssignedIdentityID
+}
+
+func (r FederatedIdentityCredentialsResource) ResourceType() string {
+	return "azurerm_federated_identity_credentials"
+}
+
+func (r FederatedIdentityCredentialsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			ForceNew:     true,
+			Required:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
+		"credential": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"issuer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"subject": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"audience": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FederatedIdentityCredentialsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedIdentity.ManagedIdentities
+
+			var config FederatedIdentityCredentialsResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parentId, err := commonids.ParseUserAssignedIdentityID(config.ResourceName)
+			if err != nil {
+				return fmt.Errorf("parsing parent resource ID: %+v", err)
+			}
+
+			locks.ByID(parentId.ID())
+			defer locks.UnlockByID(parentId.ID())
+
+			existing, err := client.FederatedIdentityCredentialsListComplete(ctx, *parentId, managedidentities.DefaultFederatedIdentityCredentialsListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing Federated Identity Credentials on %s: %+v", parentId, err)
+			}
+			if len(existing.Items) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), parentId)
+			}
+
+			for _, credential := range config.Credential {
+				credentialId := managedidentities.NewFederatedIdentityCredentialID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.UserAssignedIdentityName, credential.Name)
+				if _, err := client.FederatedIdentityCredentialsCreateOrUpdate(ctx, credentialId, expandFederatedIdentityCredentialsElement(credential)); err != nil {
+					return fmt.Errorf("creating %s: %+v", credentialId, err)
+				}
+			}
+
+			metadata.SetID(parentId)
+			return nil
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedIdentity.ManagedIdentities
+
+			id, err := commonids.ParseUserAssignedIdentityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			identity, err := client.UserAssignedIdentitiesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(identity.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			credentials, err := client.FederatedIdentityCredentialsListComplete(ctx, *id, managedidentities.DefaultFederatedIdentityCredentialsListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Federated Identity Credentials on %s: %+v", *id, err)
+			}
+
+			schema := FederatedIdentityCredentialsResourceSchema{
+				ResourceName: id.ID(),
+				Credential:   flattenFederatedIdentityCredentialsElements(credentials.Items),
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedIdentity.ManagedIdentities
+
+			var config FederatedIdentityCredentialsResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseUserAssignedIdentityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.FederatedIdentityCredentialsListComplete(ctx, *id, managedidentities.DefaultFederatedIdentityCredentialsListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Federated Identity Credentials on %s: %+v", *id, err)
+			}
+
+			desired := make(map[string]struct{})
+			for _, credential := range config.Credential {
+				desired[credential.Name] = struct{}{}
+				credentialId := managedidentities.NewFederatedIdentityCredentialID(id.SubscriptionId, id.ResourceGroupName, id.UserAssignedIdentityName, credential.Name)
+				if _, err := client.FederatedIdentityCredentialsCreateOrUpdate(ctx, credentialId, expandFederatedIdentityCredentialsElement(credential)); err != nil {
+					return fmt.Errorf("updating %s: %+v", credentialId, err)
+				}
+			}
+
+			for _, credential := range existing.Items {
+				if credential.Name == nil {
+					continue
+				}
+				if _, ok := desired[*credential.Name]; ok {
+					continue
+				}
+				credentialId := managedidentities.NewFederatedIdentityCredentialID(id.SubscriptionId, id.ResourceGroupName, id.UserAssignedIdentityName, *credential.Name)
+				if _, err := client.FederatedIdentityCredentialsDelete(ctx, credentialId); err != nil {
+					return fmt.Errorf("deleting %s: %+v", credentialId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedIdentity.ManagedIdentities
+
+			id, err := commonids.ParseUserAssignedIdentityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.FederatedIdentityCredentialsListComplete(ctx, *id, managedidentities.DefaultFederatedIdentityCredentialsListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Federated Identity Credentials on %s: %+v", *id, err)
+			}
+
+			for _, credential := range existing.Items {
+				if credential.Name == nil {
+					continue
+				}
+				credentialId := managedidentities.NewFederatedIdentityCredentialID(id.SubscriptionId, id.ResourceGroupName, id.UserAssignedIdentityName, *credential.Name)
+				if _, err := client.FederatedIdentityCredentialsDelete(ctx, credentialId); err != nil {
+					return fmt.Errorf("deleting %s: %+v", credentialId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandFederatedIdentityCredentialsElement(input FederatedIdentityCredentialsElement) managedidentities.FederatedIdentityCredential {
+	return managedidentities.FederatedIdentityCredential{
+		Properties: &managedidentities.FederatedIdentityCredentialProperties{
+			Audiences: input.Audience,
+			Issuer:    input.Issuer,
+			Subject:   input.Subject,
+		},
+	}
+}
+
+func flattenFederatedIdentityCredentialsElements(input []managedidentities.FederatedIdentityCredential) []FederatedIdentityCredentialsElement {
+	output := make([]FederatedIdentityCredentialsElement, 0)
+	for _, credential := range input {
+		element := FederatedIdentityCredentialsElement{
+			Audience: make([]string, 0),
+		}
+		if credential.Name != nil {
+			element.Name = *credential.Name
+		}
+		if props := credential.Properties; props != nil {
+			element.Audience = props.Audiences
+			element.Issuer = props.Issuer
+			element.Subject = props.Subject
+		}
+		output = append(output, element)
+	}
+	return output
+}
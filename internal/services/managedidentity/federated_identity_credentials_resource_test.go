@@ -0,0 +1,170 @@
+package managedidentity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2022-01-31-preview/managedidentities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FederatedIdentityCredentialsTestResource struct{}
+
+func TestAccFederatedIdentityCredentials_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_federated_identity_credentials", "test")
+	r := FederatedIdentityCredentialsTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFederatedIdentityCredentials_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_federated_identity_credentials", "test")
+	r := FederatedIdentityCredentialsTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFederatedIdentityCredentials_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_federated_identity_credentials", "test")
+	r := FederatedIdentityCredentialsTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r FederatedIdentityCredentialsTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseUserAssignedIdentityID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ManagedIdentity.ManagedIdentities.FederatedIdentityCredentialsListComplete(ctx, *id, managedidentities.DefaultFederatedIdentityCredentialsListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Federated Identity Credentials on %s: %+v", *id, err)
+	}
+
+	return utils.Bool(len(resp.Items) > 0), nil
+}
+
+func (r FederatedIdentityCredentialsTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+resource "azurerm_federated_identity_credentials" "test" {
+  user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+
+  credential {
+    audience = ["foo"]
+    issuer   = "https://foo"
+    name     = "acctest-first-${local.random_integer}"
+    subject  = "foo"
+  }
+
+  credential {
+    audience = ["bar"]
+    issuer   = "https://bar"
+    name     = "acctest-second-${local.random_integer}"
+    subject  = "bar"
+  }
+}
+`, r.template(data))
+}
+
+func (r FederatedIdentityCredentialsTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+resource "azurerm_federated_identity_credentials" "import" {
+  user_assigned_identity_id = azurerm_federated_identity_credentials.test.user_assigned_identity_id
+
+  credential {
+    audience = ["foo"]
+    issuer   = "https://foo"
+    name     = "acctest-first-${local.random_integer}"
+    subject  = "foo"
+  }
+}
+`, r.basic(data))
+}
+
+func (r FederatedIdentityCredentialsTestResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+resource "azurerm_federated_identity_credentials" "test" {
+  user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+
+  credential {
+    audience = ["foo"]
+    issuer   = "https://foo.updated"
+    name     = "acctest-first-${local.random_integer}"
+    subject  = "foo"
+  }
+
+  credential {
+    audience = ["baz"]
+    issuer   = "https://baz"
+    name     = "acctest-third-${local.random_integer}"
+    subject  = "baz"
+  }
+}
+`, r.template(data))
+}
+
+func (r FederatedIdentityCredentialsTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+locals {
+  random_integer   = %[1]d
+  primary_location = %[2]q
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-${local.random_integer}"
+  location = local.primary_location
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  location            = azurerm_resource_group.test.location
+  name                = "acctestuai-${local.random_integer}"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
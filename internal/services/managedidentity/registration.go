@@ -22,7 +22,9 @@ func (r Registration) Name() string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	dataSources := []sdk.DataSource{}
+	dataSources := []sdk.DataSource{
+		FederatedIdentityCredentialsDataSource{},
+	}
 	dataSources = append(dataSources, r.autoRegistration.DataSources()...)
 	return dataSources
 }
@@ -30,6 +32,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	resources := []sdk.Resource{
 		FederatedIdentityCredentialResource{},
+		FederatedIdentityCredentialsResource{},
 	}
 	resources = append(resources, r.autoRegistration.Resources()...)
 	return resources
@@ -6,19 +6,24 @@ import (
 )
 
 type Client struct {
-	GroupsClient       *managementgroups.Client
-	SubscriptionClient *managementgroups.SubscriptionsClient
+	GroupsClient            *managementgroups.Client
+	HierarchySettingsClient *managementgroups.HierarchySettingsClient
+	SubscriptionClient      *managementgroups.SubscriptionsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	GroupsClient := managementgroups.NewClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&GroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	HierarchySettingsClient := managementgroups.NewHierarchySettingsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&HierarchySettingsClient.Client, o.ResourceManagerAuthorizer)
+
 	SubscriptionClient := managementgroups.NewSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&SubscriptionClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		GroupsClient:       &GroupsClient,
-		SubscriptionClient: &SubscriptionClient,
+		GroupsClient:            &GroupsClient,
+		HierarchySettingsClient: &HierarchySettingsClient,
+		SubscriptionClient:      &SubscriptionClient,
 	}
 }
@@ -0,0 +1,158 @@
+package managementgroup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-05-01/managementgroups" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceManagementGroupHierarchySettings() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceManagementGroupHierarchySettingsCreateUpdate,
+		Read:   resourceManagementGroupHierarchySettingsRead,
+		Update: resourceManagementGroupHierarchySettingsCreateUpdate,
+		Delete: resourceManagementGroupHierarchySettingsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.HierarchySettingsID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagementGroupID,
+			},
+
+			"default_management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ManagementGroupID,
+			},
+
+			"require_authorization_for_group_creation": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceManagementGroupHierarchySettingsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.HierarchySettingsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managementGroupId, err := parse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewHierarchySettingsID(managementGroupId.Name)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ManagementGroupName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_management_group_hierarchy_settings", id.ID())
+		}
+	}
+
+	payload := managementgroups.CreateOrUpdateSettingsRequest{
+		CreateOrUpdateSettingsProperties: &managementgroups.CreateOrUpdateSettingsProperties{
+			RequireAuthorizationForGroupCreation: utils.Bool(d.Get("require_authorization_for_group_creation").(bool)),
+		},
+	}
+
+	if v := d.Get("default_management_group_id").(string); v != "" {
+		payload.CreateOrUpdateSettingsProperties.DefaultManagementGroup = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ManagementGroupName, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceManagementGroupHierarchySettingsRead(d, meta)
+}
+
+func resourceManagementGroupHierarchySettingsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.HierarchySettingsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HierarchySettingsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ManagementGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("management_group_id", parse.NewManagementGroupId(id.ManagementGroupName).ID())
+
+	if props := resp.HierarchySettingsProperties; props != nil {
+		defaultManagementGroupId := ""
+		if props.DefaultManagementGroup != nil {
+			defaultManagementGroupId = *props.DefaultManagementGroup
+		}
+		d.Set("default_management_group_id", defaultManagementGroupId)
+
+		requireAuthorization := false
+		if props.RequireAuthorizationForGroupCreation != nil {
+			requireAuthorization = *props.RequireAuthorizationForGroupCreation
+		}
+		d.Set("require_authorization_for_group_creation", requireAuthorization)
+	}
+
+	return nil
+}
+
+func resourceManagementGroupHierarchySettingsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.HierarchySettingsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HierarchySettingsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.ManagementGroupName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package managementgroup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagementGroupHierarchySettingsResource struct{}
+
+func TestAccManagementGroupHierarchySettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementGroupHierarchySettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccManagementGroupHierarchySettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("require_authorization_for_group_creation").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("require_authorization_for_group_creation").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ManagementGroupHierarchySettingsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.HierarchySettingsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ManagementGroups.HierarchySettingsClient.Get(ctx, id.ManagementGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (ManagementGroupHierarchySettingsResource) template() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "test" {}
+
+data "azurerm_management_group" "root" {
+  name = data.azurerm_client_config.test.tenant_id
+}
+
+resource "azurerm_management_group" "test" {
+  display_name               = "Testing-MG"
+  parent_management_group_id = data.azurerm_management_group.root.id
+}
+`
+}
+
+func (r ManagementGroupHierarchySettingsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_hierarchy_settings" "test" {
+  management_group_id = data.azurerm_management_group.root.id
+}
+`, r.template())
+}
+
+func (r ManagementGroupHierarchySettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_hierarchy_settings" "import" {
+  management_group_id = azurerm_management_group_hierarchy_settings.test.management_group_id
+}
+`, r.basic(data))
+}
+
+func (r ManagementGroupHierarchySettingsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_hierarchy_settings" "test" {
+  management_group_id                      = data.azurerm_management_group.root.id
+  default_management_group_id              = azurerm_management_group.test.id
+  require_authorization_for_group_creation = true
+}
+`, r.template())
+}
@@ -0,0 +1,42 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type HierarchySettingsId struct {
+	ManagementGroupName string
+}
+
+func NewHierarchySettingsID(managementGroupName string) HierarchySettingsId {
+	return HierarchySettingsId{
+		ManagementGroupName: managementGroupName,
+	}
+}
+
+func HierarchySettingsID(input string) (*HierarchySettingsId, error) {
+	// The settings object is a singleton named "default" under the Management Group,
+	// e.g. /providers/Microsoft.Management/managementGroups/group1/settings/default
+	suffix := "/settings/default"
+	if !strings.HasSuffix(input, suffix) {
+		return nil, fmt.Errorf("unable to parse Hierarchy Settings ID %q: expected id to end with %q", input, suffix)
+	}
+
+	managementGroupId, err := ManagementGroupID(strings.TrimSuffix(input, suffix))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Hierarchy Settings ID %q: %+v", input, err)
+	}
+
+	return &HierarchySettingsId{
+		ManagementGroupName: managementGroupId.Name,
+	}, nil
+}
+
+func (id HierarchySettingsId) ID() string {
+	return fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s/settings/default", id.ManagementGroupName)
+}
+
+func (id HierarchySettingsId) String() string {
+	return fmt.Sprintf("Hierarchy Settings for Management Group %q", id.ManagementGroupName)
+}
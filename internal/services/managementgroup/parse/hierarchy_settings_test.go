@@ -0,0 +1,52 @@
+package parse
+
+import "testing"
+
+func TestHierarchySettingsID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *HierarchySettingsId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing settings suffix
+			Input: "/providers/Microsoft.Management/managementGroups/group1",
+			Error: true,
+		},
+		{
+			// not a management group ID
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/settings/default",
+			Error: true,
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/group1/settings/default",
+			Expected: &HierarchySettingsId{
+				ManagementGroupName: "group1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := HierarchySettingsID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.ManagementGroupName != v.Expected.ManagementGroupName {
+			t.Fatalf("Expected %q but got %q for ManagementGroupName", v.Expected.ManagementGroupName, actual.ManagementGroupName)
+		}
+	}
+}
@@ -1,23 +1,32 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/datanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/service"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simgroup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/sims"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/site"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/slice"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
+	DataNetworkClient   *datanetwork.DataNetworkClient
 	MobileNetworkClient *mobilenetwork.MobileNetworkClient
 	ServiceClient       *service.ServiceClient
 	SIMGroupClient      *simgroup.SIMGroupClient
+	SIMPolicyClient     *simpolicy.SIMPolicyClient
+	SIMsClient          *sims.SIMsClient
 	SliceClient         *slice.SliceClient
 	SiteClient          *site.SiteClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	dataNetworkClient := datanetwork.NewDataNetworkClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dataNetworkClient.Client, o.ResourceManagerAuthorizer)
+
 	mobileNetworkClient := mobilenetwork.NewMobileNetworkClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&mobileNetworkClient.Client, o.ResourceManagerAuthorizer)
 
@@ -27,6 +36,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	simGroupClient := simgroup.NewSIMGroupClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&simGroupClient.Client, o.ResourceManagerAuthorizer)
 
+	simPolicyClient := simpolicy.NewSIMPolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&simPolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	simsClient := sims.NewSIMsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&simsClient.Client, o.ResourceManagerAuthorizer)
+
 	siteClient := site.NewSiteClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&siteClient.Client, o.ResourceManagerAuthorizer)
 
@@ -34,9 +49,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&sliceClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		DataNetworkClient:   &dataNetworkClient,
 		MobileNetworkClient: &mobileNetworkClient,
 		ServiceClient:       &serviceClient,
 		SIMGroupClient:      &simGroupClient,
+		SIMPolicyClient:     &simPolicyClient,
+		SIMsClient:          &simsClient,
 		SiteClient:          &siteClient,
 		SliceClient:         &sliceClient,
 	}
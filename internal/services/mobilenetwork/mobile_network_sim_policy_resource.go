@@ -0,0 +1,624 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/datanetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/service"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/slice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SimPolicyModel struct {
+	Name                               string                    `tfschema:"name"`
+	MobileNetworkId                    string                    `tfschema:"mobile_network_id"`
+	Location                           string                    `tfschema:"location"`
+	DefaultSliceId                     string                    `tfschema:"default_slice_id"`
+	RegistrationTimerInSeconds         int64                     `tfschema:"registration_timer_in_seconds"`
+	RatFrequencySelectionPriorityIndex int64                     `tfschema:"rat_frequency_selection_priority_index"`
+	UeAmbr                             []BitRateModel            `tfschema:"user_equipment_aggregate_maximum_bit_rate"`
+	SliceConfigurations                []SliceConfigurationModel `tfschema:"slice"`
+	Tags                               map[string]string         `tfschema:"tags"`
+}
+
+type SliceConfigurationModel struct {
+	SliceId              string                          `tfschema:"slice_id"`
+	DefaultDataNetworkId string                          `tfschema:"default_data_network_id"`
+	DataNetworks         []DataNetworkConfigurationModel `tfschema:"data_network"`
+}
+
+type DataNetworkConfigurationModel struct {
+	DataNetworkId                       string         `tfschema:"data_network_id"`
+	AdditionalAllowedSessionTypes       []string       `tfschema:"additional_allowed_session_types"`
+	AllocationAndRetentionPriorityLevel int64          `tfschema:"allocation_and_retention_priority_level"`
+	AllowedServicesIds                  []string       `tfschema:"allowed_services_ids"`
+	DefaultSessionType                  string         `tfschema:"default_session_type"`
+	QosIdentifier                       int64          `tfschema:"qos_indicator"`
+	MaxBufferedPackets                  int64          `tfschema:"max_buffered_packets"`
+	PreemptionCapability                string         `tfschema:"preemption_capability"`
+	PreemptionVulnerability             string         `tfschema:"preemption_vulnerability"`
+	SessionAmbr                         []BitRateModel `tfschema:"session_aggregate_maximum_bit_rate"`
+}
+
+type SimPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = SimPolicyResource{}
+
+func (r SimPolicyResource) ResourceType() string {
+	return "azurerm_mobile_network_sim_policy"
+}
+
+func (r SimPolicyResource) ModelObject() interface{} {
+	return &SimPolicyModel{}
+}
+
+func (r SimPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return simpolicy.ValidateSimPolicyID
+}
+
+func (r SimPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"mobile_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: mobilenetwork.ValidateMobileNetworkID,
+		},
+
+		"location": commonschema.Location(),
+
+		"default_slice_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: slice.ValidateSliceID,
+		},
+
+		"registration_timer_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      3240,
+			ValidateFunc: validation.IntAtLeast(30),
+		},
+
+		"rat_frequency_selection_priority_index": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 256),
+		},
+
+		"user_equipment_aggregate_maximum_bit_rate": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"downlink": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile(`^([1-9]\d*|0)(\.\d+)?\s(Kbps|Mbps|Gbps|Tbps)$`),
+							"The value must be a number followed by Kbps, Mbps, Gbps or Tbps.",
+						)},
+
+					"uplink": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile(`^([1-9]\d*|0)(\.\d+)?\s(Kbps|Mbps|Gbps|Tbps)$`),
+							"The value must be a number followed by Kbps, Mbps, Gbps or Tbps.",
+						)},
+				},
+			},
+		},
+
+		"slice": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"slice_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: slice.ValidateSliceID,
+					},
+
+					"default_data_network_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: datanetwork.ValidateDataNetworkID,
+					},
+
+					"data_network": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"data_network_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: datanetwork.ValidateDataNetworkID,
+								},
+
+								"allowed_services_ids": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: service.ValidateServiceID,
+									},
+								},
+
+								"session_aggregate_maximum_bit_rate": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"downlink": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringMatch(
+													regexp.MustCompile(`^([1-9]\d*|0)(\.\d+)?\s(Kbps|Mbps|Gbps|Tbps)$`),
+													"The value must be a number followed by Kbps, Mbps, Gbps or Tbps.",
+												)},
+
+											"uplink": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringMatch(
+													regexp.MustCompile(`^([1-9]\d*|0)(\.\d+)?\s(Kbps|Mbps|Gbps|Tbps)$`),
+													"The value must be a number followed by Kbps, Mbps, Gbps or Tbps.",
+												)},
+										},
+									},
+								},
+
+								"additional_allowed_session_types": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(simpolicy.PduSessionTypeIPvFour),
+											string(simpolicy.PduSessionTypeIPvSix),
+										}, false),
+									},
+								},
+
+								"allocation_and_retention_priority_level": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      9,
+									ValidateFunc: validation.IntBetween(1, 127),
+								},
+
+								"default_session_type": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Default:  string(simpolicy.PduSessionTypeIPvFour),
+									ValidateFunc: validation.StringInSlice([]string{
+										string(simpolicy.PduSessionTypeIPvFour),
+										string(simpolicy.PduSessionTypeIPvSix),
+									}, false),
+								},
+
+								"qos_indicator": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      9,
+									ValidateFunc: validation.IntBetween(1, 127),
+								},
+
+								"max_buffered_packets": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      10,
+									ValidateFunc: validation.IntAtLeast(0),
+								},
+
+								"preemption_capability": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Default:  string(simpolicy.PreemptionCapabilityNotPreempt),
+									ValidateFunc: validation.StringInSlice([]string{
+										string(simpolicy.PreemptionCapabilityNotPreempt),
+										string(simpolicy.PreemptionCapabilityMayPreempt),
+									}, false),
+								},
+
+								"preemption_vulnerability": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Default:  string(simpolicy.PreemptionVulnerabilityPreemptable),
+									ValidateFunc: validation.StringInSlice([]string{
+										string(simpolicy.PreemptionVulnerabilityNotPreemptable),
+										string(simpolicy.PreemptionVulnerabilityPreemptable),
+									}, false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r SimPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SimPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SimPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.MobileNetwork.SIMPolicyClient
+			mobileNetworkId, err := mobilenetwork.ParseMobileNetworkID(model.MobileNetworkId)
+			if err != nil {
+				return err
+			}
+
+			id := simpolicy.NewSimPolicyID(mobileNetworkId.SubscriptionId, mobileNetworkId.ResourceGroupName, mobileNetworkId.MobileNetworkName, model.Name)
+			existing, err := client.SimPoliciesGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := simpolicy.SimPolicy{
+				Location: location.Normalize(model.Location),
+				Properties: simpolicy.SimPolicyPropertiesFormat{
+					DefaultSlice: simpolicy.SliceResourceId{
+						Id: model.DefaultSliceId,
+					},
+					RegistrationTimer:   &model.RegistrationTimerInSeconds,
+					SliceConfigurations: expandSliceConfigurationModel(model.SliceConfigurations),
+					UeAmbr:              expandSimPolicyAmbr(model.UeAmbr),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.RatFrequencySelectionPriorityIndex != 0 {
+				properties.Properties.RfspIndex = &model.RatFrequencySelectionPriorityIndex
+			}
+
+			if err := client.SimPoliciesCreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SimPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMPolicyClient
+
+			id, err := simpolicy.ParseSimPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SimPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.SimPoliciesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: properties were nil", id)
+			}
+
+			updateModel := resp.Model
+
+			if metadata.ResourceData.HasChange("default_slice_id") {
+				updateModel.Properties.DefaultSlice = simpolicy.SliceResourceId{
+					Id: model.DefaultSliceId,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("registration_timer_in_seconds") {
+				updateModel.Properties.RegistrationTimer = &model.RegistrationTimerInSeconds
+			}
+
+			if metadata.ResourceData.HasChange("rat_frequency_selection_priority_index") {
+				if model.RatFrequencySelectionPriorityIndex != 0 {
+					updateModel.Properties.RfspIndex = &model.RatFrequencySelectionPriorityIndex
+				} else {
+					updateModel.Properties.RfspIndex = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("user_equipment_aggregate_maximum_bit_rate") {
+				updateModel.Properties.UeAmbr = expandSimPolicyAmbr(model.UeAmbr)
+			}
+
+			if metadata.ResourceData.HasChange("slice") {
+				updateModel.Properties.SliceConfigurations = expandSliceConfigurationModel(model.SliceConfigurations)
+			}
+
+			updateModel.SystemData = nil
+
+			if metadata.ResourceData.HasChange("tags") {
+				updateModel.Tags = &model.Tags
+			}
+
+			if err := client.SimPoliciesCreateOrUpdateThenPoll(ctx, *id, *updateModel); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SimPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMPolicyClient
+
+			id, err := simpolicy.ParseSimPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.SimPoliciesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			model := *resp.Model
+
+			state := SimPolicyModel{
+				Name:            id.SimPolicyName,
+				MobileNetworkId: mobilenetwork.NewMobileNetworkID(id.SubscriptionId, id.ResourceGroupName, id.MobileNetworkName).ID(),
+				Location:        location.Normalize(model.Location),
+			}
+
+			properties := model.Properties
+
+			state.DefaultSliceId = properties.DefaultSlice.Id
+
+			if properties.RegistrationTimer != nil {
+				state.RegistrationTimerInSeconds = *properties.RegistrationTimer
+			}
+
+			if properties.RfspIndex != nil {
+				state.RatFrequencySelectionPriorityIndex = *properties.RfspIndex
+			}
+
+			state.UeAmbr = flattenSimPolicyAmbr(properties.UeAmbr)
+			state.SliceConfigurations = flattenSliceConfigurationModel(properties.SliceConfigurations)
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SimPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMPolicyClient
+
+			id, err := simpolicy.ParseSimPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.SimPoliciesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err := resourceMobileNetworkChildWaitForDeletion(ctx, id.ID(), func() (*http.Response, error) {
+				resp, err := client.SimPoliciesGet(ctx, *id)
+				return resp.HttpResponse, err
+			}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSliceConfigurationModel(inputList []SliceConfigurationModel) []simpolicy.SliceConfiguration {
+	outputList := make([]simpolicy.SliceConfiguration, 0, len(inputList))
+
+	for _, input := range inputList {
+		outputList = append(outputList, simpolicy.SliceConfiguration{
+			DataNetworkConfigurations: expandDataNetworkConfigurationModel(input.DataNetworks),
+			DefaultDataNetwork: simpolicy.DataNetworkResourceId{
+				Id: input.DefaultDataNetworkId,
+			},
+			Slice: simpolicy.SliceResourceId{
+				Id: input.SliceId,
+			},
+		})
+	}
+
+	return outputList
+}
+
+func expandDataNetworkConfigurationModel(inputList []DataNetworkConfigurationModel) []simpolicy.DataNetworkConfiguration {
+	outputList := make([]simpolicy.DataNetworkConfiguration, 0, len(inputList))
+
+	for _, input := range inputList {
+		input := input
+
+		allowedServices := make([]simpolicy.ServiceResourceId, 0, len(input.AllowedServicesIds))
+		for _, serviceId := range input.AllowedServicesIds {
+			allowedServices = append(allowedServices, simpolicy.ServiceResourceId{
+				Id: serviceId,
+			})
+		}
+
+		defaultSessionType := simpolicy.PduSessionType(input.DefaultSessionType)
+		preemptionCapability := simpolicy.PreemptionCapability(input.PreemptionCapability)
+		preemptionVulnerability := simpolicy.PreemptionVulnerability(input.PreemptionVulnerability)
+
+		output := simpolicy.DataNetworkConfiguration{
+			AllocationAndRetentionPriorityLevel: &input.AllocationAndRetentionPriorityLevel,
+			AllowedServices:                     allowedServices,
+			DataNetwork: simpolicy.DataNetworkResourceId{
+				Id: input.DataNetworkId,
+			},
+			DefaultSessionType:             &defaultSessionType,
+			Fiveqi:                         &input.QosIdentifier,
+			MaximumNumberOfBufferedPackets: &input.MaxBufferedPackets,
+			PreemptionCapability:           &preemptionCapability,
+			PreemptionVulnerability:        &preemptionVulnerability,
+			SessionAmbr:                    expandSimPolicyAmbr(input.SessionAmbr),
+		}
+
+		if len(input.AdditionalAllowedSessionTypes) > 0 {
+			additionalAllowedSessionTypes := make([]simpolicy.PduSessionType, 0, len(input.AdditionalAllowedSessionTypes))
+			for _, sessionType := range input.AdditionalAllowedSessionTypes {
+				additionalAllowedSessionTypes = append(additionalAllowedSessionTypes, simpolicy.PduSessionType(sessionType))
+			}
+			output.AdditionalAllowedSessionTypes = &additionalAllowedSessionTypes
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func expandSimPolicyAmbr(inputList []BitRateModel) simpolicy.Ambr {
+	output := simpolicy.Ambr{}
+	if len(inputList) == 0 {
+		return output
+	}
+
+	input := inputList[0]
+	output.Downlink = input.Downlink
+	output.Uplink = input.Uplink
+
+	return output
+}
+
+func flattenSliceConfigurationModel(inputList []simpolicy.SliceConfiguration) []SliceConfigurationModel {
+	outputList := make([]SliceConfigurationModel, 0, len(inputList))
+
+	for _, input := range inputList {
+		outputList = append(outputList, SliceConfigurationModel{
+			SliceId:              input.Slice.Id,
+			DefaultDataNetworkId: input.DefaultDataNetwork.Id,
+			DataNetworks:         flattenDataNetworkConfigurationModel(input.DataNetworkConfigurations),
+		})
+	}
+
+	return outputList
+}
+
+func flattenDataNetworkConfigurationModel(inputList []simpolicy.DataNetworkConfiguration) []DataNetworkConfigurationModel {
+	outputList := make([]DataNetworkConfigurationModel, 0, len(inputList))
+
+	for _, input := range inputList {
+		output := DataNetworkConfigurationModel{
+			DataNetworkId: input.DataNetwork.Id,
+			SessionAmbr:   flattenSimPolicyAmbr(input.SessionAmbr),
+		}
+
+		allowedServicesIds := make([]string, 0, len(input.AllowedServices))
+		for _, allowedService := range input.AllowedServices {
+			allowedServicesIds = append(allowedServicesIds, allowedService.Id)
+		}
+		output.AllowedServicesIds = allowedServicesIds
+
+		if input.AdditionalAllowedSessionTypes != nil {
+			additionalAllowedSessionTypes := make([]string, 0, len(*input.AdditionalAllowedSessionTypes))
+			for _, sessionType := range *input.AdditionalAllowedSessionTypes {
+				additionalAllowedSessionTypes = append(additionalAllowedSessionTypes, string(sessionType))
+			}
+			output.AdditionalAllowedSessionTypes = additionalAllowedSessionTypes
+		}
+
+		if input.AllocationAndRetentionPriorityLevel != nil {
+			output.AllocationAndRetentionPriorityLevel = *input.AllocationAndRetentionPriorityLevel
+		}
+
+		if input.DefaultSessionType != nil {
+			output.DefaultSessionType = string(*input.DefaultSessionType)
+		}
+
+		if input.Fiveqi != nil {
+			output.QosIdentifier = *input.Fiveqi
+		}
+
+		if input.MaximumNumberOfBufferedPackets != nil {
+			output.MaxBufferedPackets = *input.MaximumNumberOfBufferedPackets
+		}
+
+		if input.PreemptionCapability != nil {
+			output.PreemptionCapability = string(*input.PreemptionCapability)
+		}
+
+		if input.PreemptionVulnerability != nil {
+			output.PreemptionVulnerability = string(*input.PreemptionVulnerability)
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func flattenSimPolicyAmbr(input simpolicy.Ambr) []BitRateModel {
+	return []BitRateModel{
+		{
+			Downlink: input.Downlink,
+			Uplink:   input.Uplink,
+		},
+	}
+}
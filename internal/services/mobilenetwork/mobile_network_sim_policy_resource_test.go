@@ -0,0 +1,262 @@
+package mobilenetwork_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MobileNetworkSimPolicyResource struct{}
+
+func TestAccMobileNetworkSimPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim_policy", "test")
+	// Limited regional availability for Mobile Network
+	data.Locations.Primary = "eastus"
+
+	r := MobileNetworkSimPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMobileNetworkSimPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim_policy", "test")
+	// Limited regional availability for Mobile Network
+	data.Locations.Primary = "eastus"
+
+	r := MobileNetworkSimPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMobileNetworkSimPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim_policy", "test")
+	// Limited regional availability for Mobile Network
+	data.Locations.Primary = "eastus"
+
+	r := MobileNetworkSimPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMobileNetworkSimPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim_policy", "test")
+	// Limited regional availability for Mobile Network
+	data.Locations.Primary = "eastus"
+
+	r := MobileNetworkSimPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MobileNetworkSimPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := simpolicy.ParseSimPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := clients.MobileNetwork.SIMPolicyClient
+	resp, err := client.SimPoliciesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MobileNetworkSimPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+			%s
+
+resource "azurerm_mobile_network_data_network" "test" {
+  name              = "acctest-mndn-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = "%[3]s"
+}
+
+resource "azurerm_mobile_network_slice" "test" {
+  name              = "acctest-mns-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = "%[3]s"
+  single_network_slice_selection_assistance_information {
+    slice_service_type = 1
+  }
+}
+
+resource "azurerm_mobile_network_service" "test" {
+  name               = "acctest-mnservice-%[2]d"
+  mobile_network_id  = azurerm_mobile_network.test.id
+  location           = "%[3]s"
+  service_precedence = 0
+
+  pcc_rule {
+    name                    = "default-rule"
+    precedence              = 1
+    traffic_control_enabled = true
+
+    service_data_flow_template {
+      direction      = "Uplink"
+      name           = "IP-to-server"
+      protocol       = ["ip"]
+      remote_ip_list = ["10.3.4.0/24"]
+    }
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MobileNetworkSimPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+			%s
+
+resource "azurerm_mobile_network_sim_policy" "test" {
+  name              = "acctest-mnsp-%d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = "%s"
+  default_slice_id  = azurerm_mobile_network_slice.test.id
+
+  user_equipment_aggregate_maximum_bit_rate {
+    downlink = "1 Gbps"
+    uplink   = "500 Mbps"
+  }
+
+  slice {
+    slice_id                = azurerm_mobile_network_slice.test.id
+    default_data_network_id = azurerm_mobile_network_data_network.test.id
+
+    data_network {
+      data_network_id      = azurerm_mobile_network_data_network.test.id
+      allowed_services_ids = [azurerm_mobile_network_service.test.id]
+
+      session_aggregate_maximum_bit_rate {
+        downlink = "1 Gbps"
+        uplink   = "500 Mbps"
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MobileNetworkSimPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+		%s
+
+resource "azurerm_mobile_network_sim_policy" "import" {
+  name              = azurerm_mobile_network_sim_policy.test.name
+  mobile_network_id = azurerm_mobile_network_sim_policy.test.mobile_network_id
+  location          = "%s"
+  default_slice_id  = azurerm_mobile_network_slice.test.id
+
+  user_equipment_aggregate_maximum_bit_rate {
+    downlink = "1 Gbps"
+    uplink   = "500 Mbps"
+  }
+
+  slice {
+    slice_id                = azurerm_mobile_network_slice.test.id
+    default_data_network_id = azurerm_mobile_network_data_network.test.id
+
+    data_network {
+      data_network_id      = azurerm_mobile_network_data_network.test.id
+      allowed_services_ids = [azurerm_mobile_network_service.test.id]
+
+      session_aggregate_maximum_bit_rate {
+        downlink = "1 Gbps"
+        uplink   = "500 Mbps"
+      }
+    }
+  }
+}
+`, r.basic(data), data.Locations.Primary)
+}
+
+func (r MobileNetworkSimPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+		%s
+
+resource "azurerm_mobile_network_sim_policy" "test" {
+  name                                   = "acctest-mnsp-%d"
+  mobile_network_id                      = azurerm_mobile_network.test.id
+  location                               = "%s"
+  default_slice_id                       = azurerm_mobile_network_slice.test.id
+  registration_timer_in_seconds          = 3240
+  rat_frequency_selection_priority_index = 2
+
+  user_equipment_aggregate_maximum_bit_rate {
+    downlink = "2 Gbps"
+    uplink   = "1 Gbps"
+  }
+
+  slice {
+    slice_id                = azurerm_mobile_network_slice.test.id
+    default_data_network_id = azurerm_mobile_network_data_network.test.id
+
+    data_network {
+      data_network_id                         = azurerm_mobile_network_data_network.test.id
+      allowed_services_ids                    = [azurerm_mobile_network_service.test.id]
+      additional_allowed_session_types        = ["IPv6"]
+      allocation_and_retention_priority_level = 2
+      default_session_type                    = "IPv4"
+      qos_indicator                           = 9
+      max_buffered_packets                    = 200
+      preemption_capability                   = "MayPreempt"
+      preemption_vulnerability                = "Preemptable"
+
+      session_aggregate_maximum_bit_rate {
+        downlink = "1 Gbps"
+        uplink   = "500 Mbps"
+      }
+    }
+  }
+
+  tags = {
+    key = "value"
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,531 @@
+package mobilenetwork
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/datanetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/sims"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/slice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SimsModel struct {
+	MobileNetworkSimGroupId string     `tfschema:"mobile_network_sim_group_id"`
+	Sims                    []SimModel `tfschema:"sim"`
+	EncryptedSimsJson       string     `tfschema:"encrypted_sims_json"`
+}
+
+type SimModel struct {
+	Name                                  string                 `tfschema:"name"`
+	InternationalMobileSubscriberIdentity string                 `tfschema:"international_mobile_subscriber_identity"`
+	IntegratedCircuitCardIdentifier       string                 `tfschema:"integrated_circuit_card_identifier"`
+	AuthenticationKey                     string                 `tfschema:"authentication_key"`
+	OperatorKeyCode                       string                 `tfschema:"operator_key_code"`
+	DeviceType                            string                 `tfschema:"device_type"`
+	SimPolicyId                           string                 `tfschema:"sim_policy_id"`
+	StaticIPConfigurations                []SimStaticIPConfModel `tfschema:"static_ip_configuration"`
+}
+
+type SimStaticIPConfModel struct {
+	AttachedDataNetworkId string `tfschema:"attached_data_network_id"`
+	SliceId               string `tfschema:"slice_id"`
+	StaticIPv4Address     string `tfschema:"static_ipv4_address"`
+}
+
+type SimsResource struct{}
+
+var _ sdk.ResourceWithUpdate = SimsResource{}
+
+func (r SimsResource) ResourceType() string {
+	return "azurerm_mobile_network_sims"
+}
+
+func (r SimsResource) ModelObject() interface{} {
+	return &SimsModel{}
+}
+
+func (r SimsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sims.ValidateSimGroupID
+}
+
+func (r SimsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"mobile_network_sim_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: sims.ValidateSimGroupID,
+		},
+
+		"sim": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ExactlyOneOf: []string{"sim", "encrypted_sims_json"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"international_mobile_subscriber_identity": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"integrated_circuit_card_identifier": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"authentication_key": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"operator_key_code": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"device_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sim_policy_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: simpolicy.ValidateSimPolicyID,
+					},
+
+					"static_ip_configuration": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"attached_data_network_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: datanetwork.ValidateDataNetworkID,
+								},
+
+								"slice_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: slice.ValidateSliceID,
+								},
+
+								"static_ipv4_address": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"encrypted_sims_json": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ExactlyOneOf:     []string{"sim", "encrypted_sims_json"},
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+	}
+}
+
+func (r SimsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SimsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SimsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.MobileNetwork.SIMsClient
+			id, err := sims.ParseSimGroupID(model.MobileNetworkSimGroupId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ListByGroupComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("checking for existing SIMs in %s: %+v", *id, err)
+			}
+
+			if len(existing.Items) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := uploadSims(ctx, client, *id, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SimsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMsClient
+
+			id, err := sims.ParseSimGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SimsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			oldRaw, newRaw := metadata.ResourceData.GetChange("sim")
+			removed := removedSimNames(simNamesFromConfig(oldRaw), simNamesFromConfig(newRaw))
+
+			if metadata.ResourceData.HasChange("encrypted_sims_json") {
+				oldJsonRaw, newJsonRaw := metadata.ResourceData.GetChange("encrypted_sims_json")
+				oldNames, err := simNamesFromEncryptedJson(oldJsonRaw.(string))
+				if err != nil {
+					return err
+				}
+				newNames, err := simNamesFromEncryptedJson(newJsonRaw.(string))
+				if err != nil {
+					return err
+				}
+				removed = append(removed, removedSimNames(oldNames, newNames)...)
+			}
+
+			if len(removed) > 0 {
+				if err := client.BulkDeleteThenPoll(ctx, *id, sims.SimDeleteList{Sims: removed}); err != nil {
+					return fmt.Errorf("deleting SIMs %v from %s: %+v", removed, *id, err)
+				}
+			}
+
+			if err := uploadSims(ctx, client, *id, model); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SimsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMsClient
+
+			id, err := sims.ParseSimGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state SimsModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.ListByGroup(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving SIMs in %s: %+v", *id, err)
+			}
+
+			items, err := client.ListByGroupComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving SIMs in %s: %+v", *id, err)
+			}
+
+			state.MobileNetworkSimGroupId = id.ID()
+
+			if len(state.EncryptedSimsJson) == 0 {
+				state.Sims = flattenSims(items.Items, state.Sims)
+				if len(state.Sims) == 0 {
+					return metadata.MarkAsGone(id)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SimsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMsClient
+
+			id, err := sims.ParseSimGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SimsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			names := make([]string, 0, len(model.Sims))
+			for _, sim := range model.Sims {
+				names = append(names, sim.Name)
+			}
+
+			if len(model.EncryptedSimsJson) > 0 {
+				encryptedNames, err := simNamesFromEncryptedJson(model.EncryptedSimsJson)
+				if err != nil {
+					return err
+				}
+				names = append(names, encryptedNames...)
+			}
+
+			if len(names) == 0 {
+				return nil
+			}
+
+			if err := client.BulkDeleteThenPoll(ctx, *id, sims.SimDeleteList{Sims: names}); err != nil {
+				return fmt.Errorf("deleting SIMs from %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func uploadSims(ctx context.Context, client *sims.SIMsClient, id sims.SimGroupId, model SimsModel) error {
+	if len(model.EncryptedSimsJson) > 0 {
+		var uploadList sims.EncryptedSimUploadList
+		if err := json.Unmarshal([]byte(model.EncryptedSimsJson), &uploadList); err != nil {
+			return fmt.Errorf("unmarshalling `encrypted_sims_json`: %+v", err)
+		}
+
+		if err := client.BulkUploadEncryptedThenPoll(ctx, id, uploadList); err != nil {
+			return fmt.Errorf("uploading encrypted SIMs to %s: %+v", id, err)
+		}
+
+		return nil
+	}
+
+	if err := client.BulkUploadThenPoll(ctx, id, sims.SimUploadList{Sims: expandSims(model.Sims)}); err != nil {
+		return fmt.Errorf("uploading SIMs to %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandSims(inputList []SimModel) []sims.SimNameAndProperties {
+	outputList := make([]sims.SimNameAndProperties, 0, len(inputList))
+
+	for _, input := range inputList {
+		input := input
+
+		properties := sims.SimPropertiesFormat{
+			InternationalMobileSubscriberIdentity: input.InternationalMobileSubscriberIdentity,
+		}
+
+		if input.IntegratedCircuitCardIdentifier != "" {
+			properties.IntegratedCircuitCardIdentifier = &input.IntegratedCircuitCardIdentifier
+		}
+
+		if input.AuthenticationKey != "" {
+			properties.AuthenticationKey = &input.AuthenticationKey
+		}
+
+		if input.OperatorKeyCode != "" {
+			properties.OperatorKeyCode = &input.OperatorKeyCode
+		}
+
+		if input.DeviceType != "" {
+			properties.DeviceType = &input.DeviceType
+		}
+
+		if input.SimPolicyId != "" {
+			properties.SimPolicy = &sims.SimPolicyResourceId{
+				Id: input.SimPolicyId,
+			}
+		}
+
+		if len(input.StaticIPConfigurations) > 0 {
+			staticIPConfigurations := make([]sims.SimStaticIPProperties, 0, len(input.StaticIPConfigurations))
+			for _, staticIPConfiguration := range input.StaticIPConfigurations {
+				staticIPConfiguration := staticIPConfiguration
+
+				output := sims.SimStaticIPProperties{
+					AttachedDataNetwork: &sims.AttachedDataNetworkResourceId{
+						Id: staticIPConfiguration.AttachedDataNetworkId,
+					},
+					Slice: &sims.SliceResourceId{
+						Id: staticIPConfiguration.SliceId,
+					},
+				}
+
+				if staticIPConfiguration.StaticIPv4Address != "" {
+					output.StaticIP = &sims.SimStaticIPPropertiesStaticIP{
+						IPv4Address: &staticIPConfiguration.StaticIPv4Address,
+					}
+				}
+
+				staticIPConfigurations = append(staticIPConfigurations, output)
+			}
+			properties.StaticIPConfiguration = &staticIPConfigurations
+		}
+
+		outputList = append(outputList, sims.SimNameAndProperties{
+			Name:       input.Name,
+			Properties: properties,
+		})
+	}
+
+	return outputList
+}
+
+// flattenSims builds the `sim` state from the SIMs which exist in the group, limited to
+// those this resource manages - the bulk APIs operate on a SIM Group which may also
+// contain SIMs uploaded out-of-band. The SIM credentials are write-only in the API so
+// these are carried over from the existing state.
+func flattenSims(inputList []sims.Sim, existingState []SimModel) []SimModel {
+	existingByName := make(map[string]SimModel, len(existingState))
+	for _, existing := range existingState {
+		existingByName[existing.Name] = existing
+	}
+
+	outputList := make([]SimModel, 0, len(inputList))
+
+	for _, input := range inputList {
+		if input.Name == nil {
+			continue
+		}
+
+		existing, ok := existingByName[*input.Name]
+		if !ok {
+			continue
+		}
+
+		output := SimModel{
+			Name:                                  *input.Name,
+			InternationalMobileSubscriberIdentity: input.Properties.InternationalMobileSubscriberIdentity,
+			AuthenticationKey:                     existing.AuthenticationKey,
+			OperatorKeyCode:                       existing.OperatorKeyCode,
+		}
+
+		if input.Properties.IntegratedCircuitCardIdentifier != nil {
+			output.IntegratedCircuitCardIdentifier = *input.Properties.IntegratedCircuitCardIdentifier
+		}
+
+		if input.Properties.DeviceType != nil {
+			output.DeviceType = *input.Properties.DeviceType
+		}
+
+		if input.Properties.SimPolicy != nil {
+			output.SimPolicyId = input.Properties.SimPolicy.Id
+		}
+
+		if input.Properties.StaticIPConfiguration != nil {
+			staticIPConfigurations := make([]SimStaticIPConfModel, 0, len(*input.Properties.StaticIPConfiguration))
+			for _, staticIPConfiguration := range *input.Properties.StaticIPConfiguration {
+				staticIPConf := SimStaticIPConfModel{}
+
+				if staticIPConfiguration.AttachedDataNetwork != nil {
+					staticIPConf.AttachedDataNetworkId = staticIPConfiguration.AttachedDataNetwork.Id
+				}
+
+				if staticIPConfiguration.Slice != nil {
+					staticIPConf.SliceId = staticIPConfiguration.Slice.Id
+				}
+
+				if staticIPConfiguration.StaticIP != nil && staticIPConfiguration.StaticIP.IPv4Address != nil {
+					staticIPConf.StaticIPv4Address = *staticIPConfiguration.StaticIP.IPv4Address
+				}
+
+				staticIPConfigurations = append(staticIPConfigurations, staticIPConf)
+			}
+			output.StaticIPConfigurations = staticIPConfigurations
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func simNamesFromConfig(input interface{}) []string {
+	outputList := make([]string, 0)
+
+	items, ok := input.([]interface{})
+	if !ok {
+		return outputList
+	}
+
+	for _, item := range items {
+		if v, ok := item.(map[string]interface{}); ok {
+			if name, ok := v["name"].(string); ok && name != "" {
+				outputList = append(outputList, name)
+			}
+		}
+	}
+
+	return outputList
+}
+
+func simNamesFromEncryptedJson(input string) ([]string, error) {
+	outputList := make([]string, 0)
+	if input == "" {
+		return outputList, nil
+	}
+
+	var uploadList sims.EncryptedSimUploadList
+	if err := json.Unmarshal([]byte(input), &uploadList); err != nil {
+		return nil, fmt.Errorf("unmarshalling `encrypted_sims_json`: %+v", err)
+	}
+
+	for _, sim := range uploadList.Sims {
+		outputList = append(outputList, sim.Name)
+	}
+
+	return outputList, nil
+}
+
+func removedSimNames(oldNames []string, newNames []string) []string {
+	outputList := make([]string, 0)
+
+	for _, oldName := range oldNames {
+		if !utils.SliceContainsValue(newNames, oldName) {
+			outputList = append(outputList, oldName)
+		}
+	}
+
+	return outputList
+}
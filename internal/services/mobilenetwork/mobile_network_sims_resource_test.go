@@ -0,0 +1,155 @@
+package mobilenetwork_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/sims"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MobileNetworkSimsResource struct{}
+
+func TestAccMobileNetworkSims_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sims", "test")
+	// Limited regional availability for Mobile Network
+	data.Locations.Primary = "eastus"
+
+	r := MobileNetworkSimsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sim.0.authentication_key", "sim.0.operator_key_code"),
+	})
+}
+
+func TestAccMobileNetworkSims_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sims", "test")
+	// Limited regional availability for Mobile Network
+	data.Locations.Primary = "eastus"
+
+	r := MobileNetworkSimsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMobileNetworkSims_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sims", "test")
+	// Limited regional availability for Mobile Network
+	data.Locations.Primary = "eastus"
+
+	r := MobileNetworkSimsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sim.0.authentication_key", "sim.0.operator_key_code"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sim.0.authentication_key", "sim.0.operator_key_code", "sim.1.authentication_key", "sim.1.operator_key_code"),
+	})
+}
+
+func (r MobileNetworkSimsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sims.ParseSimGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := clients.MobileNetwork.SIMsClient
+	resp, err := client.ListByGroup(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving SIMs in %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil && len(*resp.Model) > 0), nil
+}
+
+func (r MobileNetworkSimsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+			%s
+
+resource "azurerm_mobile_network_sims" "test" {
+  mobile_network_sim_group_id = azurerm_mobile_network_sim_group.test.id
+
+  sim {
+    name                                     = "acctest-sim1"
+    international_mobile_subscriber_identity = "000000000000001"
+    integrated_circuit_card_identifier       = "8900000000000000001"
+    authentication_key                       = "00000000000000000000000000000001"
+    operator_key_code                        = "00000000000000000000000000000001"
+  }
+}
+`, MobileNetworkSimGroupResource{}.basic(data))
+}
+
+func (r MobileNetworkSimsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+		%s
+
+resource "azurerm_mobile_network_sims" "import" {
+  mobile_network_sim_group_id = azurerm_mobile_network_sims.test.mobile_network_sim_group_id
+
+  sim {
+    name                                     = "acctest-sim1"
+    international_mobile_subscriber_identity = "000000000000001"
+    integrated_circuit_card_identifier       = "8900000000000000001"
+    authentication_key                       = "00000000000000000000000000000001"
+    operator_key_code                        = "00000000000000000000000000000001"
+  }
+}
+`, r.basic(data))
+}
+
+func (r MobileNetworkSimsResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+		%s
+
+resource "azurerm_mobile_network_sims" "test" {
+  mobile_network_sim_group_id = azurerm_mobile_network_sim_group.test.id
+
+  sim {
+    name                                     = "acctest-sim1"
+    international_mobile_subscriber_identity = "000000000000001"
+    integrated_circuit_card_identifier       = "8900000000000000001"
+    authentication_key                       = "00000000000000000000000000000001"
+    operator_key_code                        = "00000000000000000000000000000001"
+    device_type                              = "Sensor"
+  }
+
+  sim {
+    name                                     = "acctest-sim2"
+    international_mobile_subscriber_identity = "000000000000002"
+    integrated_circuit_card_identifier       = "8900000000000000002"
+    authentication_key                       = "00000000000000000000000000000002"
+    operator_key_code                        = "00000000000000000000000000000002"
+  }
+}
+`, MobileNetworkSimGroupResource{}.basic(data))
+}
@@ -52,8 +52,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns a list of Resources supported by this Service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		DataNetworkResource{},
 		MobileNetworkServiceResource{},
 		SimGroupResource{},
+		SimPolicyResource{},
+		SimsResource{},
 		SliceResource{},
 		MobileNetworkResource{},
 		SiteResource{},
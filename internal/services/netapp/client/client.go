@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2022-05-01/netappaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2022-05-01/snapshotpolicy"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2022-05-01/snapshots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2022-05-01/volumegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2022-05-01/volumes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2022-05-01/volumesreplication"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -14,6 +15,7 @@ type Client struct {
 	AccountClient           *netappaccounts.NetAppAccountsClient
 	PoolClient              *capacitypools.CapacityPoolsClient
 	VolumeClient            *volumes.VolumesClient
+	VolumeGroupClient       *volumegroups.VolumeGroupsClient
 	VolumeReplicationClient *volumesreplication.VolumesReplicationClient
 	SnapshotClient          *snapshots.SnapshotsClient
 	SnapshotPoliciesClient  *snapshotpolicy.SnapshotPolicyClient
@@ -29,6 +31,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	volumeClient := volumes.NewVolumesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&volumeClient.Client, o.ResourceManagerAuthorizer)
 
+	volumeGroupClient := volumegroups.NewVolumeGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&volumeGroupClient.Client, o.ResourceManagerAuthorizer)
+
 	volumeReplicationClient := volumesreplication.NewVolumesReplicationClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&volumeReplicationClient.Client, o.ResourceManagerAuthorizer)
 
@@ -42,6 +47,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		AccountClient:           &accountClient,
 		PoolClient:              &poolClient,
 		VolumeClient:            &volumeClient,
+		VolumeGroupClient:       &volumeGroupClient,
 		VolumeReplicationClient: &volumeReplicationClient,
 		SnapshotClient:          &snapshotClient,
 		SnapshotPoliciesClient:  &snapshotPoliciesClient,
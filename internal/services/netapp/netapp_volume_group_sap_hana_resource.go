@@ -0,0 +1,754 @@
+package netapp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2022-05-01/capacitypools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2022-05-01/volumegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2022-05-01/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	netAppValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// deploymentSpecIdSapHana is the deployment spec the service expects
+// for SAP HANA application volume groups.
+const deploymentSpecIdSapHana = "20542149-bfca-5618-1879-9863dc6767f1"
+
+func resourceNetAppVolumeGroupSapHana() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetAppVolumeGroupSapHanaCreate,
+		Read:   resourceNetAppVolumeGroupSapHanaRead,
+		Update: resourceNetAppVolumeGroupSapHanaUpdate,
+		Delete: resourceNetAppVolumeGroupSapHanaDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(120 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(120 * time.Minute),
+		},
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := volumegroups.ParseVolumeGroupID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: netAppValidate.VolumeName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"location": commonschema.Location(),
+
+			"account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: netAppValidate.AccountName,
+			},
+
+			"group_description": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_identifier": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 3),
+			},
+
+			"volume": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 5,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: netAppValidate.VolumeName,
+						},
+
+						"capacity_pool_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: capacitypools.ValidateCapacityPoolID,
+						},
+
+						"proximity_placement_group_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"volume_spec_name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"data",
+								"log",
+								"shared",
+								"data-backup",
+								"log-backup",
+							}, false),
+						},
+
+						"volume_path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: netAppValidate.VolumePath,
+						},
+
+						"service_level": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(volumegroups.ServiceLevelPremium),
+								string(volumegroups.ServiceLevelStandard),
+								string(volumegroups.ServiceLevelUltra),
+							}, false),
+						},
+
+						"subnet_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"protocols": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"NFSv3",
+									"NFSv4.1",
+								}, false),
+							},
+						},
+
+						"security_style": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(volumegroups.SecurityStyleUnix),
+								string(volumegroups.SecurityStyleNtfs),
+							}, false),
+						},
+
+						"storage_quota_in_gb": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(100, 102400),
+						},
+
+						"throughput_in_mibps": {
+							Type:         pluginsdk.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0.1),
+						},
+
+						"export_policy_rule": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 5,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"rule_index": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 5),
+									},
+
+									"allowed_clients": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"nfsv3_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Required: true,
+									},
+
+									"nfsv41_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Required: true,
+									},
+
+									"unix_read_only": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+
+									"unix_read_write": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+
+									"root_access_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"snapshot_directory_visible": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+
+						"data_protection_replication": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ForceNew: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"endpoint_type": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										Default:  "dst",
+										ValidateFunc: validation.StringInSlice([]string{
+											"dst",
+										}, false),
+									},
+
+									"remote_volume_location": commonschema.Location(),
+
+									"remote_volume_resource_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: azure.ValidateResourceID,
+									},
+
+									"replication_frequency": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"10minutes",
+											"daily",
+											"hourly",
+										}, false),
+									},
+								},
+							},
+						},
+
+						"data_protection_snapshot_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"snapshot_policy_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: azure.ValidateResourceID,
+									},
+								},
+							},
+						},
+
+						"mount_ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"tags": commonschema.Tags(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNetAppVolumeGroupSapHanaCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.VolumeGroupClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := volumegroups.NewVolumeGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string), d.Get("name").(string))
+
+	existing, err := client.VolumeGroupsGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_netapp_volume_group_sap_hana", id.ID())
+	}
+
+	volumeList, err := expandNetAppVolumeGroupSapHanaVolumes(d.Get("volume").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	applicationType := volumegroups.ApplicationTypeSAPNegativeHANA
+	parameters := volumegroups.VolumeGroupDetails{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Properties: &volumegroups.VolumeGroupProperties{
+			GroupMetaData: &volumegroups.VolumeGroupMetaData{
+				GroupDescription:      utils.String(d.Get("group_description").(string)),
+				ApplicationType:       &applicationType,
+				ApplicationIdentifier: utils.String(d.Get("application_identifier").(string)),
+				DeploymentSpecId:      utils.String(deploymentSpecIdSapHana),
+			},
+			Volumes: volumeList,
+		},
+	}
+
+	if err := client.VolumeGroupsCreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetAppVolumeGroupSapHanaRead(d, meta)
+}
+
+func resourceNetAppVolumeGroupSapHanaUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.VolumeClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if _, err := volumegroups.ParseVolumeGroupID(d.Id()); err != nil {
+		return err
+	}
+
+	// the group itself cannot be updated, changes are applied to the individual volumes
+	if d.HasChange("volume") {
+		for i, item := range d.Get("volume").([]interface{}) {
+			if !d.HasChange(fmt.Sprintf("volume.%d", i)) {
+				continue
+			}
+
+			volumeItem := item.(map[string]interface{})
+			volumeId, err := volumes.ParseVolumeID(volumeItem["id"].(string))
+			if err != nil {
+				return err
+			}
+
+			update := volumes.VolumePatch{
+				Properties: &volumes.VolumePatchProperties{
+					UsageThreshold:  utils.Int64(int64(volumeItem["storage_quota_in_gb"].(int)) * 1073741824),
+					ThroughputMibps: utils.Float(volumeItem["throughput_in_mibps"].(float64)),
+					ExportPolicy:    expandNetAppVolumeGroupVolumeExportPolicyRulePatch(volumeItem["export_policy_rule"].([]interface{})),
+				},
+				Tags: tags.Expand(volumeItem["tags"].(map[string]interface{})),
+			}
+
+			if dataProtectionSnapshotPolicy := expandNetAppVolumeDataProtectionSnapshotPolicyPatch(volumeItem["data_protection_snapshot_policy"].([]interface{})); dataProtectionSnapshotPolicy.Snapshot != nil {
+				update.Properties.DataProtection = dataProtectionSnapshotPolicy
+			}
+
+			if err := client.UpdateThenPoll(ctx, *volumeId, update); err != nil {
+				return fmt.Errorf("updating %s: %+v", volumeId, err)
+			}
+		}
+	}
+
+	return resourceNetAppVolumeGroupSapHanaRead(d, meta)
+}
+
+func resourceNetAppVolumeGroupSapHanaRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.VolumeGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := volumegroups.ParseVolumeGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.VolumeGroupsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.VolumeGroupName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("account_name", id.NetAppAccountName)
+
+	if model := resp.Model; model != nil {
+		if model.Location != nil {
+			d.Set("location", azure.NormalizeLocation(*model.Location))
+		}
+
+		if props := model.Properties; props != nil {
+			if metaData := props.GroupMetaData; metaData != nil {
+				d.Set("group_description", metaData.GroupDescription)
+				d.Set("application_identifier", metaData.ApplicationIdentifier)
+			}
+
+			volumeList, err := flattenNetAppVolumeGroupSapHanaVolumes(props.Volumes)
+			if err != nil {
+				return fmt.Errorf("flattening `volume`: %+v", err)
+			}
+			if err := d.Set("volume", volumeList); err != nil {
+				return fmt.Errorf("setting `volume`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceNetAppVolumeGroupSapHanaDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.VolumeGroupClient
+	volumeClient := meta.(*clients.Client).NetApp.VolumeClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := volumegroups.ParseVolumeGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.VolumeGroupsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// the volumes have to be removed before the group itself can be deleted
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Volumes != nil {
+		for _, volumeItem := range *model.Properties.Volumes {
+			if volumeItem.Id == nil {
+				continue
+			}
+
+			volumeId, err := volumes.ParseVolumeID(*volumeItem.Id)
+			if err != nil {
+				return err
+			}
+
+			if err := volumeClient.DeleteThenPoll(ctx, *volumeId, volumes.DeleteOperationOptions{
+				ForceDelete: utils.Bool(true),
+			}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", volumeId, err)
+			}
+		}
+	}
+
+	if err := client.VolumeGroupsDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandNetAppVolumeGroupSapHanaVolumes(input []interface{}) (*[]volumegroups.VolumeGroupVolumeProperties, error) {
+	results := make([]volumegroups.VolumeGroupVolumeProperties, 0)
+
+	for _, item := range input {
+		volumeItem := item.(map[string]interface{})
+
+		protocols := make([]string, 0)
+		for _, protocol := range volumeItem["protocols"].([]interface{}) {
+			protocols = append(protocols, protocol.(string))
+		}
+
+		securityStyle := volumegroups.SecurityStyle(volumeItem["security_style"].(string))
+		if strings.EqualFold(string(securityStyle), "ntfs") && len(protocols) == 1 && (strings.EqualFold(protocols[0], "nfsv3") || strings.EqualFold(protocols[0], "nfsv4.1")) {
+			return nil, fmt.Errorf("ntfs security style cannot be used in a NFSv3/NFSv4.1 enabled volume")
+		}
+
+		serviceLevel := volumegroups.ServiceLevel(volumeItem["service_level"].(string))
+
+		properties := volumegroups.VolumeProperties{
+			CreationToken:            volumeItem["volume_path"].(string),
+			CapacityPoolResourceId:   utils.String(volumeItem["capacity_pool_id"].(string)),
+			ServiceLevel:             &serviceLevel,
+			SubnetId:                 volumeItem["subnet_id"].(string),
+			ProtocolTypes:            &protocols,
+			SecurityStyle:            &securityStyle,
+			UsageThreshold:           int64(volumeItem["storage_quota_in_gb"].(int)) * 1073741824,
+			ThroughputMibps:          utils.Float(volumeItem["throughput_in_mibps"].(float64)),
+			VolumeSpecName:           utils.String(volumeItem["volume_spec_name"].(string)),
+			SnapshotDirectoryVisible: utils.Bool(volumeItem["snapshot_directory_visible"].(bool)),
+			ExportPolicy:             expandNetAppVolumeGroupVolumeExportPolicyRule(volumeItem["export_policy_rule"].([]interface{})),
+		}
+
+		if v := volumeItem["proximity_placement_group_id"].(string); v != "" {
+			properties.ProximityPlacementGroup = utils.String(v)
+		}
+
+		dataProtection := &volumegroups.VolumePropertiesDataProtection{}
+
+		if replicationRaw := volumeItem["data_protection_replication"].([]interface{}); len(replicationRaw) > 0 {
+			replication := replicationRaw[0].(map[string]interface{})
+
+			endpointType := volumegroups.EndpointType(replication["endpoint_type"].(string))
+			replicationSchedule := volumegroups.ReplicationSchedule(translateTFSchedule(replication["replication_frequency"].(string)))
+
+			dataProtection.Replication = &volumegroups.ReplicationObject{
+				EndpointType:           &endpointType,
+				RemoteVolumeRegion:     utils.String(replication["remote_volume_location"].(string)),
+				RemoteVolumeResourceId: replication["remote_volume_resource_id"].(string),
+				ReplicationSchedule:    &replicationSchedule,
+			}
+
+			// volumes with a replication configured have to be data protection volumes
+			properties.VolumeType = utils.String("DataProtection")
+		}
+
+		if snapshotPolicyRaw := volumeItem["data_protection_snapshot_policy"].([]interface{}); len(snapshotPolicyRaw) > 0 {
+			snapshotPolicy := snapshotPolicyRaw[0].(map[string]interface{})
+
+			dataProtection.Snapshot = &volumegroups.VolumeSnapshotProperties{
+				SnapshotPolicyId: utils.String(snapshotPolicy["snapshot_policy_id"].(string)),
+			}
+		}
+
+		if dataProtection.Replication != nil || dataProtection.Snapshot != nil {
+			properties.DataProtection = dataProtection
+		}
+
+		results = append(results, volumegroups.VolumeGroupVolumeProperties{
+			Name:       utils.String(volumeItem["name"].(string)),
+			Properties: properties,
+			Tags:       tags.Expand(volumeItem["tags"].(map[string]interface{})),
+		})
+	}
+
+	return &results, nil
+}
+
+func flattenNetAppVolumeGroupSapHanaVolumes(input *[]volumegroups.VolumeGroupVolumeProperties) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, volumeItem := range *input {
+		props := volumeItem.Properties
+
+		volumeName := ""
+		if volumeItem.Name != nil {
+			// the service returns the name in the `account/volume` format
+			volumeNameParts := strings.Split(*volumeItem.Name, "/")
+			volumeName = volumeNameParts[len(volumeNameParts)-1]
+		}
+
+		volumeId := ""
+		if volumeItem.Id != nil {
+			parsedVolumeId, err := volumes.ParseVolumeIDInsensitively(*volumeItem.Id)
+			if err != nil {
+				return nil, err
+			}
+			volumeId = parsedVolumeId.ID()
+		}
+
+		serviceLevel := ""
+		if props.ServiceLevel != nil {
+			serviceLevel = string(*props.ServiceLevel)
+		}
+
+		securityStyle := ""
+		if props.SecurityStyle != nil {
+			securityStyle = string(*props.SecurityStyle)
+		}
+
+		protocols := make([]interface{}, 0)
+		if props.ProtocolTypes != nil {
+			for _, protocol := range *props.ProtocolTypes {
+				protocols = append(protocols, protocol)
+			}
+		}
+
+		mountIpAddresses := make([]interface{}, 0)
+		if props.MountTargets != nil {
+			for _, mountTarget := range *props.MountTargets {
+				if mountTarget.IPAddress != nil {
+					mountIpAddresses = append(mountIpAddresses, *mountTarget.IPAddress)
+				}
+			}
+		}
+
+		result := map[string]interface{}{
+			"id":                              volumeId,
+			"name":                            volumeName,
+			"capacity_pool_id":                utils.NormalizeNilableString(props.CapacityPoolResourceId),
+			"proximity_placement_group_id":    utils.NormalizeNilableString(props.ProximityPlacementGroup),
+			"volume_spec_name":                utils.NormalizeNilableString(props.VolumeSpecName),
+			"volume_path":                     props.CreationToken,
+			"service_level":                   serviceLevel,
+			"subnet_id":                       props.SubnetId,
+			"protocols":                       protocols,
+			"security_style":                  securityStyle,
+			"storage_quota_in_gb":             props.UsageThreshold / 1073741824,
+			"snapshot_directory_visible":      props.SnapshotDirectoryVisible != nil && *props.SnapshotDirectoryVisible,
+			"export_policy_rule":              flattenNetAppVolumeGroupVolumeExportPolicyRule(props.ExportPolicy),
+			"data_protection_replication":     flattenNetAppVolumeGroupVolumeDataProtectionReplication(props.DataProtection),
+			"data_protection_snapshot_policy": flattenNetAppVolumeGroupVolumeDataProtectionSnapshotPolicy(props.DataProtection),
+			"mount_ip_addresses":              mountIpAddresses,
+			"tags":                            tags.Flatten(volumeItem.Tags),
+		}
+
+		if props.ThroughputMibps != nil {
+			result["throughput_in_mibps"] = *props.ThroughputMibps
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func expandNetAppVolumeGroupVolumeExportPolicyRule(input []interface{}) *volumegroups.VolumePropertiesExportPolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	rules := make([]volumegroups.ExportPolicyRule, 0)
+	for _, item := range input {
+		ruleItem := item.(map[string]interface{})
+
+		rules = append(rules, volumegroups.ExportPolicyRule{
+			RuleIndex:      utils.Int64(int64(ruleItem["rule_index"].(int))),
+			AllowedClients: utils.String(ruleItem["allowed_clients"].(string)),
+			Nfsv3:          utils.Bool(ruleItem["nfsv3_enabled"].(bool)),
+			Nfsv41:         utils.Bool(ruleItem["nfsv41_enabled"].(bool)),
+			UnixReadOnly:   utils.Bool(ruleItem["unix_read_only"].(bool)),
+			UnixReadWrite:  utils.Bool(ruleItem["unix_read_write"].(bool)),
+			HasRootAccess:  utils.Bool(ruleItem["root_access_enabled"].(bool)),
+		})
+	}
+
+	return &volumegroups.VolumePropertiesExportPolicy{
+		Rules: &rules,
+	}
+}
+
+func expandNetAppVolumeGroupVolumeExportPolicyRulePatch(input []interface{}) *volumes.VolumePatchPropertiesExportPolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	rules := make([]volumes.ExportPolicyRule, 0)
+	for _, item := range input {
+		ruleItem := item.(map[string]interface{})
+
+		rules = append(rules, volumes.ExportPolicyRule{
+			RuleIndex:      utils.Int64(int64(ruleItem["rule_index"].(int))),
+			AllowedClients: utils.String(ruleItem["allowed_clients"].(string)),
+			Nfsv3:          utils.Bool(ruleItem["nfsv3_enabled"].(bool)),
+			Nfsv41:         utils.Bool(ruleItem["nfsv41_enabled"].(bool)),
+			UnixReadOnly:   utils.Bool(ruleItem["unix_read_only"].(bool)),
+			UnixReadWrite:  utils.Bool(ruleItem["unix_read_write"].(bool)),
+			HasRootAccess:  utils.Bool(ruleItem["root_access_enabled"].(bool)),
+		})
+	}
+
+	return &volumes.VolumePatchPropertiesExportPolicy{
+		Rules: &rules,
+	}
+}
+
+func flattenNetAppVolumeGroupVolumeExportPolicyRule(input *volumegroups.VolumePropertiesExportPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Rules == nil {
+		return results
+	}
+
+	for _, rule := range *input.Rules {
+		ruleIndex := int64(0)
+		if rule.RuleIndex != nil {
+			ruleIndex = *rule.RuleIndex
+		}
+
+		results = append(results, map[string]interface{}{
+			"rule_index":          ruleIndex,
+			"allowed_clients":     utils.NormalizeNilableString(rule.AllowedClients),
+			"nfsv3_enabled":       rule.Nfsv3 != nil && *rule.Nfsv3,
+			"nfsv41_enabled":      rule.Nfsv41 != nil && *rule.Nfsv41,
+			"unix_read_only":      rule.UnixReadOnly != nil && *rule.UnixReadOnly,
+			"unix_read_write":     rule.UnixReadWrite != nil && *rule.UnixReadWrite,
+			"root_access_enabled": rule.HasRootAccess != nil && *rule.HasRootAccess,
+		})
+	}
+
+	return results
+}
+
+func flattenNetAppVolumeGroupVolumeDataProtectionReplication(input *volumegroups.VolumePropertiesDataProtection) []interface{} {
+	if input == nil || input.Replication == nil || input.Replication.EndpointType == nil || !strings.EqualFold(string(*input.Replication.EndpointType), "dst") {
+		return []interface{}{}
+	}
+
+	replicationFrequency := ""
+	if input.Replication.ReplicationSchedule != nil {
+		replicationFrequency = translateSDKSchedule(strings.ToLower(string(*input.Replication.ReplicationSchedule)))
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"endpoint_type":             strings.ToLower(string(*input.Replication.EndpointType)),
+			"remote_volume_location":    utils.NormalizeNilableString(input.Replication.RemoteVolumeRegion),
+			"remote_volume_resource_id": input.Replication.RemoteVolumeResourceId,
+			"replication_frequency":     replicationFrequency,
+		},
+	}
+}
+
+func flattenNetAppVolumeGroupVolumeDataProtectionSnapshotPolicy(input *volumegroups.VolumePropertiesDataProtection) []interface{} {
+	if input == nil || input.Snapshot == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"snapshot_policy_id": utils.NormalizeNilableString(input.Snapshot.SnapshotPolicyId),
+		},
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"net/http"
 
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/network"
 )
 
 // UpdateNetworkInterfaceAllowingRemovalOfNSG patches our way around a design flaw in the Azure
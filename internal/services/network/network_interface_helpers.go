@@ -1,6 +1,6 @@
 package network
 
-import "github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+import "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/network"
 
 func FindNetworkInterfaceIPConfiguration(input *[]network.InterfaceIPConfiguration, name string) *network.InterfaceIPConfiguration {
 	if input == nil {
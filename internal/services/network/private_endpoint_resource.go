@@ -14,7 +14,7 @@ import (
 	mariadbServers "github.com/hashicorp/go-azure-sdk/resource-manager/mariadb/2018-06-01/servers"
 	postgresqlServers "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2017-12-01/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/redis"
+	relayNamespaces "github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2022-02-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -24,13 +24,14 @@ import (
 	cosmosParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
 	mysqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
 
 func resourcePrivateEndpoint() *pluginsdk.Resource {
@@ -1153,6 +1154,11 @@ func normalizePrivateConnectionId(privateConnectionId string) string {
 			privateConnectionId = serverId.ID()
 		}
 	}
+	if strings.Contains(strings.ToLower(privateConnectionId), "microsoft.relay") {
+		if namespaceId, err := relayNamespaces.ParseNamespaceIDInsensitively(privateConnectionId); err == nil {
+			privateConnectionId = namespaceId.ID()
+		}
+	}
 	if strings.Contains(strings.ToLower(privateConnectionId), "microsoft.signalrservice") {
 		if serviceId, err := signalr.ParseSignalRIDInsensitively(privateConnectionId); err == nil {
 			privateConnectionId = serviceId.ID()
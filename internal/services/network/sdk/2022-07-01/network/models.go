@@ -8461,6 +8461,14 @@ type AzureFirewallApplicationRuleProtocol struct {
 	Port *int32 `json:"port,omitempty"`
 }
 
+// AzureFirewallAutoscaleConfiguration azure Firewall Autoscale Configuration parameters.
+type AzureFirewallAutoscaleConfiguration struct {
+	// MinCapacity - The minimum number of capacity units for this azure firewall. Use null to reset the value to the service default.
+	MinCapacity *int32 `json:"minCapacity,omitempty"`
+	// MaxCapacity - The maximum number of capacity units for this azure firewall. Use null to reset the value to the service default.
+	MaxCapacity *int32 `json:"maxCapacity,omitempty"`
+}
+
 // AzureFirewallFqdnTag azure Firewall FQDN Tag Resource.
 type AzureFirewallFqdnTag struct {
 	// AzureFirewallFqdnTagPropertiesFormat - Properties of the azure firewall FQDN tag.
@@ -9325,6 +9333,8 @@ type AzureFirewallPropertiesFormat struct {
 	Sku *AzureFirewallSku `json:"sku,omitempty"`
 	// AdditionalProperties - The additional properties used to further config this azure firewall.
 	AdditionalProperties map[string]*string `json:"additionalProperties"`
+	// AutoscaleConfiguration - Properties to provide a custom autoscale configuration to this azure firewall.
+	AutoscaleConfiguration *AzureFirewallAutoscaleConfiguration `json:"autoscaleConfiguration,omitempty"`
 }
 
 // MarshalJSON is the custom marshaler for AzureFirewallPropertiesFormat.
@@ -9363,6 +9373,9 @@ func (afpf AzureFirewallPropertiesFormat) MarshalJSON() ([]byte, error) {
 	if afpf.AdditionalProperties != nil {
 		objectMap["additionalProperties"] = afpf.AdditionalProperties
 	}
+	if afpf.AutoscaleConfiguration != nil {
+		objectMap["autoscaleConfiguration"] = afpf.AutoscaleConfiguration
+	}
 	return json.Marshal(objectMap)
 }
 
@@ -11,10 +11,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2017-04-01/notificationhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/sdk/2017-04-01/notificationhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -72,6 +72,34 @@ func resourceNotificationHub() *pluginsdk.Resource {
 				diff.ForceNew("gcm_credential")
 			}
 
+			oBrowser, nBrowser := diff.GetChange("browser_credential.#")
+			oBrowseri := oBrowser.(int)
+			nBrowseri := nBrowser.(int)
+			if nBrowseri < oBrowseri {
+				diff.ForceNew("browser_credential")
+			}
+
+			oFCMV1, nFCMV1 := diff.GetChange("fcm_v1_credential.#")
+			oFCMV1i := oFCMV1.(int)
+			nFCMV1i := nFCMV1.(int)
+			if nFCMV1i < oFCMV1i {
+				diff.ForceNew("fcm_v1_credential")
+			}
+
+			oMPNS, nMPNS := diff.GetChange("mpns_credential.#")
+			oMPNSi := oMPNS.(int)
+			nMPNSi := nMPNS.(int)
+			if nMPNSi < oMPNSi {
+				diff.ForceNew("mpns_credential")
+			}
+
+			oWNS, nWNS := diff.GetChange("wns_credential.#")
+			oWNSi := oWNS.(int)
+			nWNSi := nWNS.(int)
+			if nWNSi < oWNSi {
+				diff.ForceNew("wns_credential")
+			}
+
 			return nil
 		}),
 
@@ -131,6 +159,56 @@ func resourceNotificationHub() *pluginsdk.Resource {
 				},
 			},
 
+			"browser_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subject": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"vapid_private_key": {
+							Type:      pluginsdk.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"vapid_public_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"fcm_v1_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"client_email": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"private_key": {
+							Type:      pluginsdk.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"project_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"gcm_credential": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -146,6 +224,46 @@ func resourceNotificationHub() *pluginsdk.Resource {
 				},
 			},
 
+			"mpns_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"certificate_key": {
+							Type:      pluginsdk.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"thumbprint": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"wns_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"package_sid": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"secret_key": {
+							Type:      pluginsdk.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -174,8 +292,12 @@ func resourceNotificationHubCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	parameters := notificationhubs.NotificationHubCreateOrUpdateParameters{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Properties: notificationhubs.NotificationHubProperties{
-			ApnsCredential: expandNotificationHubsAPNSCredentials(d.Get("apns_credential").([]interface{})),
-			GcmCredential:  expandNotificationHubsGCMCredentials(d.Get("gcm_credential").([]interface{})),
+			ApnsCredential:    expandNotificationHubsAPNSCredentials(d.Get("apns_credential").([]interface{})),
+			BrowserCredential: expandNotificationHubsBrowserCredentials(d.Get("browser_credential").([]interface{})),
+			FcmV1Credential:   expandNotificationHubsFCMV1Credentials(d.Get("fcm_v1_credential").([]interface{})),
+			GcmCredential:     expandNotificationHubsGCMCredentials(d.Get("gcm_credential").([]interface{})),
+			MpnsCredential:    expandNotificationHubsMPNSCredentials(d.Get("mpns_credential").([]interface{})),
+			WnsCredential:     expandNotificationHubsWNSCredentials(d.Get("wns_credential").([]interface{})),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -257,10 +379,26 @@ func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) er
 			if setErr := d.Set("apns_credential", apns); setErr != nil {
 				return fmt.Errorf("setting `apns_credential`: %+v", setErr)
 			}
+			browser := flattenNotificationHubsBrowserCredentials(props.BrowserCredential)
+			if setErr := d.Set("browser_credential", browser); setErr != nil {
+				return fmt.Errorf("setting `browser_credential`: %+v", setErr)
+			}
+			fcmV1 := flattenNotificationHubsFCMV1Credentials(props.FcmV1Credential)
+			if setErr := d.Set("fcm_v1_credential", fcmV1); setErr != nil {
+				return fmt.Errorf("setting `fcm_v1_credential`: %+v", setErr)
+			}
 			gcm := flattenNotificationHubsGCMCredentials(props.GcmCredential)
 			if setErr := d.Set("gcm_credential", gcm); setErr != nil {
 				return fmt.Errorf("setting `gcm_credential`: %+v", setErr)
 			}
+			mpns := flattenNotificationHubsMPNSCredentials(props.MpnsCredential)
+			if setErr := d.Set("mpns_credential", mpns); setErr != nil {
+				return fmt.Errorf("setting `mpns_credential`: %+v", setErr)
+			}
+			wns := flattenNotificationHubsWNSCredentials(props.WnsCredential)
+			if setErr := d.Set("wns_credential", wns); setErr != nil {
+				return fmt.Errorf("setting `wns_credential`: %+v", setErr)
+			}
 		}
 	}
 
@@ -360,6 +498,84 @@ func flattenNotificationHubsAPNSCredentials(input *notificationhubs.ApnsCredenti
 	return []interface{}{output}
 }
 
+func expandNotificationHubsBrowserCredentials(inputs []interface{}) *notificationhubs.BrowserCredential {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	input := inputs[0].(map[string]interface{})
+	credentials := notificationhubs.BrowserCredential{
+		Properties: &notificationhubs.BrowserCredentialProperties{
+			Subject:         utils.String(input["subject"].(string)),
+			VapidPrivateKey: utils.String(input["vapid_private_key"].(string)),
+			VapidPublicKey:  utils.String(input["vapid_public_key"].(string)),
+		},
+	}
+	return &credentials
+}
+
+func flattenNotificationHubsBrowserCredentials(input *notificationhubs.BrowserCredential) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if props := input.Properties; props != nil {
+		if subject := props.Subject; subject != nil {
+			output["subject"] = *subject
+		}
+
+		if vapidPrivateKey := props.VapidPrivateKey; vapidPrivateKey != nil {
+			output["vapid_private_key"] = *vapidPrivateKey
+		}
+
+		if vapidPublicKey := props.VapidPublicKey; vapidPublicKey != nil {
+			output["vapid_public_key"] = *vapidPublicKey
+		}
+	}
+
+	return []interface{}{output}
+}
+
+func expandNotificationHubsFCMV1Credentials(inputs []interface{}) *notificationhubs.FcmV1Credential {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	input := inputs[0].(map[string]interface{})
+	credentials := notificationhubs.FcmV1Credential{
+		Properties: &notificationhubs.FcmV1CredentialProperties{
+			ClientEmail: utils.String(input["client_email"].(string)),
+			PrivateKey:  utils.String(input["private_key"].(string)),
+			ProjectId:   utils.String(input["project_id"].(string)),
+		},
+	}
+	return &credentials
+}
+
+func flattenNotificationHubsFCMV1Credentials(input *notificationhubs.FcmV1Credential) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if props := input.Properties; props != nil {
+		if clientEmail := props.ClientEmail; clientEmail != nil {
+			output["client_email"] = *clientEmail
+		}
+
+		if privateKey := props.PrivateKey; privateKey != nil {
+			output["private_key"] = *privateKey
+		}
+
+		if projectId := props.ProjectId; projectId != nil {
+			output["project_id"] = *projectId
+		}
+	}
+
+	return []interface{}{output}
+}
+
 func expandNotificationHubsGCMCredentials(inputs []interface{}) *notificationhubs.GcmCredential {
 	if len(inputs) == 0 {
 		return nil
@@ -389,3 +605,71 @@ func flattenNotificationHubsGCMCredentials(input *notificationhubs.GcmCredential
 
 	return []interface{}{output}
 }
+
+func expandNotificationHubsMPNSCredentials(inputs []interface{}) *notificationhubs.MpnsCredential {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	input := inputs[0].(map[string]interface{})
+	credentials := notificationhubs.MpnsCredential{
+		Properties: &notificationhubs.MpnsCredentialProperties{
+			CertificateKey: utils.String(input["certificate_key"].(string)),
+			Thumbprint:     utils.String(input["thumbprint"].(string)),
+		},
+	}
+	return &credentials
+}
+
+func flattenNotificationHubsMPNSCredentials(input *notificationhubs.MpnsCredential) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if props := input.Properties; props != nil {
+		if certificateKey := props.CertificateKey; certificateKey != nil {
+			output["certificate_key"] = *certificateKey
+		}
+
+		if thumbprint := props.Thumbprint; thumbprint != nil {
+			output["thumbprint"] = *thumbprint
+		}
+	}
+
+	return []interface{}{output}
+}
+
+func expandNotificationHubsWNSCredentials(inputs []interface{}) *notificationhubs.WnsCredential {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	input := inputs[0].(map[string]interface{})
+	credentials := notificationhubs.WnsCredential{
+		Properties: &notificationhubs.WnsCredentialProperties{
+			PackageSid: utils.String(input["package_sid"].(string)),
+			SecretKey:  utils.String(input["secret_key"].(string)),
+		},
+	}
+	return &credentials
+}
+
+func flattenNotificationHubsWNSCredentials(input *notificationhubs.WnsCredential) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if props := input.Properties; props != nil {
+		if packageSid := props.PackageSid; packageSid != nil {
+			output["package_sid"] = *packageSid
+		}
+
+		if secretKey := props.SecretKey; secretKey != nil {
+			output["secret_key"] = *secretKey
+		}
+	}
+
+	return []interface{}{output}
+}
@@ -0,0 +1,8 @@
+package notificationhubs
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BrowserCredential struct {
+	Properties *BrowserCredentialProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,10 @@
+package notificationhubs
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type BrowserCredentialProperties struct {
+	Subject         *string `json:"subject,omitempty"`
+	VapidPrivateKey *string `json:"vapidPrivateKey,omitempty"`
+	VapidPublicKey  *string `json:"vapidPublicKey,omitempty"`
+}
@@ -0,0 +1,8 @@
+package notificationhubs
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type FcmV1Credential struct {
+	Properties *FcmV1CredentialProperties `json:"properties,omitempty"`
+}
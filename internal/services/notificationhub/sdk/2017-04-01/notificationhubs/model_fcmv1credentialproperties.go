@@ -0,0 +1,10 @@
+package notificationhubs
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type FcmV1CredentialProperties struct {
+	ClientEmail *string `json:"clientEmail,omitempty"`
+	PrivateKey  *string `json:"privateKey,omitempty"`
+	ProjectId   *string `json:"projectId,omitempty"`
+}
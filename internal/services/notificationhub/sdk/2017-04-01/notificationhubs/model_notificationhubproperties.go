@@ -8,6 +8,8 @@ type NotificationHubProperties struct {
 	ApnsCredential     *ApnsCredential                            `json:"apnsCredential,omitempty"`
 	AuthorizationRules *[]SharedAccessAuthorizationRuleProperties `json:"authorizationRules,omitempty"`
 	BaiduCredential    *BaiduCredential                           `json:"baiduCredential,omitempty"`
+	BrowserCredential  *BrowserCredential                         `json:"browserCredential,omitempty"`
+	FcmV1Credential    *FcmV1Credential                           `json:"fcmV1Credential,omitempty"`
 	GcmCredential      *GcmCredential                             `json:"gcmCredential,omitempty"`
 	MpnsCredential     *MpnsCredential                            `json:"mpnsCredential,omitempty"`
 	Name               *string                                    `json:"name,omitempty"`
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/autonomousdatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/cloudexadatainfrastructures"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/cloudvmclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/dbsystemshapes"
+)
+
+type Client struct {
+	AutonomousDatabasesClient         *autonomousdatabases.AutonomousDatabasesClient
+	CloudExadataInfrastructuresClient *cloudexadatainfrastructures.CloudExadataInfrastructuresClient
+	CloudVMClustersClient             *cloudvmclusters.CloudVMClustersClient
+	DbSystemShapesClient              *dbsystemshapes.DbSystemShapesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	autonomousDatabasesClient := autonomousdatabases.NewAutonomousDatabasesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&autonomousDatabasesClient.Client, o.ResourceManagerAuthorizer)
+
+	cloudExadataInfrastructuresClient := cloudexadatainfrastructures.NewCloudExadataInfrastructuresClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&cloudExadataInfrastructuresClient.Client, o.ResourceManagerAuthorizer)
+
+	cloudVMClustersClient := cloudvmclusters.NewCloudVMClustersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&cloudVMClustersClient.Client, o.ResourceManagerAuthorizer)
+
+	dbSystemShapesClient := dbsystemshapes.NewDbSystemShapesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dbSystemShapesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AutonomousDatabasesClient:         &autonomousDatabasesClient,
+		CloudExadataInfrastructuresClient: &cloudExadataInfrastructuresClient,
+		CloudVMClustersClient:             &cloudVMClustersClient,
+		DbSystemShapesClient:              &dbSystemShapesClient,
+	}
+}
@@ -0,0 +1,399 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/autonomousdatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type OracleAutonomousDatabaseModel struct {
+	Name                         string            `tfschema:"name"`
+	ResourceGroupName            string            `tfschema:"resource_group_name"`
+	Location                     string            `tfschema:"location"`
+	AdminPassword                string            `tfschema:"admin_password"`
+	AutoScalingEnabled           bool              `tfschema:"auto_scaling_enabled"`
+	AutoScalingForStorageEnabled bool              `tfschema:"auto_scaling_for_storage_enabled"`
+	BackupRetentionPeriodInDays  int64             `tfschema:"backup_retention_period_in_days"`
+	CharacterSet                 string            `tfschema:"character_set"`
+	ComputeCount                 float64           `tfschema:"compute_count"`
+	ComputeModel                 string            `tfschema:"compute_model"`
+	DataStorageSizeInTbs         int64             `tfschema:"data_storage_size_in_tbs"`
+	DbVersion                    string            `tfschema:"db_version"`
+	DbWorkload                   string            `tfschema:"db_workload"`
+	DisplayName                  string            `tfschema:"display_name"`
+	LicenseModel                 string            `tfschema:"license_model"`
+	MtlsConnectionRequired       bool              `tfschema:"mtls_connection_required"`
+	NationalCharacterSet         string            `tfschema:"national_character_set"`
+	SubnetId                     string            `tfschema:"subnet_id"`
+	VirtualNetworkId             string            `tfschema:"virtual_network_id"`
+	Tags                         map[string]string `tfschema:"tags"`
+	OciUrl                       string            `tfschema:"oci_url"`
+	Ocid                         string            `tfschema:"ocid"`
+}
+
+type OracleAutonomousDatabaseResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*OracleAutonomousDatabaseResource)(nil)
+
+func (r OracleAutonomousDatabaseResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,29}$`),
+				"the name must be between 1 and 30 characters, begin with a letter and may only contain letters and numbers",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"admin_password": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringLenBetween(12, 30),
+		},
+
+		"character_set": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"compute_count": {
+			Type:         pluginsdk.TypeFloat,
+			Required:     true,
+			ValidateFunc: validation.FloatAtLeast(2),
+		},
+
+		"compute_model": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(autonomousdatabases.PossibleValuesForComputeModel(), false),
+		},
+
+		"data_storage_size_in_tbs": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 384),
+		},
+
+		"db_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"db_workload": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(autonomousdatabases.PossibleValuesForWorkloadType(), false),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"license_model": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(autonomousdatabases.PossibleValuesForLicenseModel(), false),
+		},
+
+		"national_character_set": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.VirtualNetworkID,
+		},
+
+		"auto_scaling_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"auto_scaling_for_storage_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"backup_retention_period_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      60,
+			ValidateFunc: validation.IntBetween(1, 60),
+		},
+
+		"mtls_connection_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r OracleAutonomousDatabaseResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"oci_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ocid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r OracleAutonomousDatabaseResource) ModelObject() interface{} {
+	return &OracleAutonomousDatabaseModel{}
+}
+
+func (r OracleAutonomousDatabaseResource) ResourceType() string {
+	return "azurerm_oracle_autonomous_database"
+}
+
+func (r OracleAutonomousDatabaseResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return autonomousdatabases.ValidateAutonomousDatabaseID
+}
+
+func (r OracleAutonomousDatabaseResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.AutonomousDatabasesClient
+			subscriptionId := meta.Client.Account.SubscriptionId
+
+			var model OracleAutonomousDatabaseModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			id := autonomousdatabases.NewAutonomousDatabaseID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := autonomousdatabases.AutonomousDatabase{
+				Location: location.Normalize(model.Location),
+				Properties: &autonomousdatabases.AutonomousDatabaseProperties{
+					AdminPassword:                  pointer.To(model.AdminPassword),
+					BackupRetentionPeriodInDays:    pointer.To(model.BackupRetentionPeriodInDays),
+					CharacterSet:                   pointer.To(model.CharacterSet),
+					ComputeCount:                   pointer.To(model.ComputeCount),
+					ComputeModel:                   pointer.To(autonomousdatabases.ComputeModel(model.ComputeModel)),
+					DataBaseType:                   "Regular",
+					DataStorageSizeInTbs:           pointer.To(model.DataStorageSizeInTbs),
+					DbVersion:                      pointer.To(model.DbVersion),
+					DbWorkload:                     pointer.To(autonomousdatabases.WorkloadType(model.DbWorkload)),
+					DisplayName:                    pointer.To(model.DisplayName),
+					IsAutoScalingEnabled:           pointer.To(model.AutoScalingEnabled),
+					IsAutoScalingForStorageEnabled: pointer.To(model.AutoScalingForStorageEnabled),
+					IsMtlsConnectionRequired:       pointer.To(model.MtlsConnectionRequired),
+					LicenseModel:                   pointer.To(autonomousdatabases.LicenseModel(model.LicenseModel)),
+					NcharacterSet:                  pointer.To(model.NationalCharacterSet),
+					SubnetId:                       pointer.To(model.SubnetId),
+					VnetId:                         pointer.To(model.VirtualNetworkId),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r OracleAutonomousDatabaseResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.AutonomousDatabasesClient
+
+			id, err := autonomousdatabases.ParseAutonomousDatabaseID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := OracleAutonomousDatabaseModel{
+				Name:              id.AutonomousDatabaseName,
+				ResourceGroupName: id.ResourceGroupName,
+				// the API doesn't return the admin password
+				AdminPassword: meta.ResourceData.Get("admin_password").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					output.AutoScalingEnabled = pointer.From(props.IsAutoScalingEnabled)
+					output.AutoScalingForStorageEnabled = pointer.From(props.IsAutoScalingForStorageEnabled)
+					output.BackupRetentionPeriodInDays = pointer.From(props.BackupRetentionPeriodInDays)
+					output.CharacterSet = pointer.From(props.CharacterSet)
+					output.ComputeCount = pointer.From(props.ComputeCount)
+					output.DataStorageSizeInTbs = pointer.From(props.DataStorageSizeInTbs)
+					output.DbVersion = pointer.From(props.DbVersion)
+					output.DisplayName = pointer.From(props.DisplayName)
+					output.MtlsConnectionRequired = pointer.From(props.IsMtlsConnectionRequired)
+					output.NationalCharacterSet = pointer.From(props.NcharacterSet)
+					output.OciUrl = pointer.From(props.OciUrl)
+					output.Ocid = pointer.From(props.Ocid)
+					output.SubnetId = pointer.From(props.SubnetId)
+					output.VirtualNetworkId = pointer.From(props.VnetId)
+
+					if props.ComputeModel != nil {
+						output.ComputeModel = string(*props.ComputeModel)
+					}
+					if props.DbWorkload != nil {
+						output.DbWorkload = string(*props.DbWorkload)
+					}
+					if props.LicenseModel != nil {
+						output.LicenseModel = string(*props.LicenseModel)
+					}
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r OracleAutonomousDatabaseResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.AutonomousDatabasesClient
+
+			id, err := autonomousdatabases.ParseAutonomousDatabaseID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model OracleAutonomousDatabaseModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := autonomousdatabases.AutonomousDatabaseUpdate{}
+
+			if meta.ResourceData.HasChanges("auto_scaling_enabled", "auto_scaling_for_storage_enabled", "backup_retention_period_in_days", "compute_count", "data_storage_size_in_tbs", "license_model") {
+				props := &autonomousdatabases.AutonomousDatabaseUpdateProperties{}
+
+				if meta.ResourceData.HasChange("auto_scaling_enabled") {
+					props.IsAutoScalingEnabled = pointer.To(model.AutoScalingEnabled)
+				}
+				if meta.ResourceData.HasChange("auto_scaling_for_storage_enabled") {
+					props.IsAutoScalingForStorageEnabled = pointer.To(model.AutoScalingForStorageEnabled)
+				}
+				if meta.ResourceData.HasChange("backup_retention_period_in_days") {
+					props.BackupRetentionPeriodInDays = pointer.To(model.BackupRetentionPeriodInDays)
+				}
+				if meta.ResourceData.HasChange("compute_count") {
+					props.ComputeCount = pointer.To(model.ComputeCount)
+				}
+				if meta.ResourceData.HasChange("data_storage_size_in_tbs") {
+					props.DataStorageSizeInTbs = pointer.To(model.DataStorageSizeInTbs)
+				}
+				if meta.ResourceData.HasChange("license_model") {
+					props.LicenseModel = pointer.To(autonomousdatabases.LicenseModel(model.LicenseModel))
+				}
+
+				payload.Properties = props
+			}
+
+			if meta.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r OracleAutonomousDatabaseResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.AutonomousDatabasesClient
+
+			id, err := autonomousdatabases.ParseAutonomousDatabaseID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,203 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/autonomousdatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type OracleAutonomousDatabaseResource struct{}
+
+func TestAccOracleAutonomousDatabase_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_oracle_autonomous_database", "test")
+	r := OracleAutonomousDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func TestAccOracleAutonomousDatabase_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_oracle_autonomous_database", "test")
+	r := OracleAutonomousDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccOracleAutonomousDatabase_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_oracle_autonomous_database", "test")
+	r := OracleAutonomousDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func (OracleAutonomousDatabaseResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := autonomousdatabases.ParseAutonomousDatabaseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Oracle.AutonomousDatabasesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r OracleAutonomousDatabaseResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_autonomous_database" "test" {
+  name                     = "OFakeAdbs%[2]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  display_name             = "OFakeAdbs%[2]d"
+  admin_password           = "TestPass#2024#"
+  character_set            = "AL32UTF8"
+  national_character_set   = "AL16UTF16"
+  compute_count            = 2
+  compute_model            = "ECPU"
+  data_storage_size_in_tbs = 1
+  db_version               = "19c"
+  db_workload              = "OLTP"
+  license_model            = "LicenseIncluded"
+  subnet_id                = azurerm_subnet.test.id
+  virtual_network_id       = azurerm_virtual_network.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r OracleAutonomousDatabaseResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_autonomous_database" "test" {
+  name                     = "OFakeAdbs%[2]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  display_name             = "OFakeAdbs%[2]d"
+  admin_password           = "TestPass#2024#"
+  character_set            = "AL32UTF8"
+  national_character_set   = "AL16UTF16"
+  compute_count            = 3
+  compute_model            = "ECPU"
+  data_storage_size_in_tbs = 2
+  db_version               = "19c"
+  db_workload              = "OLTP"
+  license_model            = "LicenseIncluded"
+  subnet_id                = azurerm_subnet.test.id
+  virtual_network_id       = azurerm_virtual_network.test.id
+  auto_scaling_enabled     = true
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r OracleAutonomousDatabaseResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_autonomous_database" "import" {
+  name                     = azurerm_oracle_autonomous_database.test.name
+  resource_group_name      = azurerm_oracle_autonomous_database.test.resource_group_name
+  location                 = azurerm_oracle_autonomous_database.test.location
+  display_name             = azurerm_oracle_autonomous_database.test.display_name
+  admin_password           = azurerm_oracle_autonomous_database.test.admin_password
+  character_set            = azurerm_oracle_autonomous_database.test.character_set
+  national_character_set   = azurerm_oracle_autonomous_database.test.national_character_set
+  compute_count            = azurerm_oracle_autonomous_database.test.compute_count
+  compute_model            = azurerm_oracle_autonomous_database.test.compute_model
+  data_storage_size_in_tbs = azurerm_oracle_autonomous_database.test.data_storage_size_in_tbs
+  db_version               = azurerm_oracle_autonomous_database.test.db_version
+  db_workload              = azurerm_oracle_autonomous_database.test.db_workload
+  license_model            = azurerm_oracle_autonomous_database.test.license_model
+  subnet_id                = azurerm_oracle_autonomous_database.test.subnet_id
+  virtual_network_id       = azurerm_oracle_autonomous_database.test.virtual_network_id
+}
+`, r.basic(data))
+}
+
+func (OracleAutonomousDatabaseResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-oracle-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+
+  delegation {
+    name = "delegation"
+
+    service_delegation {
+      name = "Oracle.Database/networkAttachments"
+
+      actions = [
+        "Microsoft.Network/networkinterfaces/*",
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+      ]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
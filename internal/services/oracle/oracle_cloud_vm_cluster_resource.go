@@ -0,0 +1,452 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/cloudexadatainfrastructures"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/cloudvmclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type OracleCloudVmClusterModel struct {
+	Name                         string            `tfschema:"name"`
+	ResourceGroupName            string            `tfschema:"resource_group_name"`
+	Location                     string            `tfschema:"location"`
+	BackupSubnetCidr             string            `tfschema:"backup_subnet_cidr"`
+	CloudExadataInfrastructureId string            `tfschema:"cloud_exadata_infrastructure_id"`
+	ClusterName                  string            `tfschema:"cluster_name"`
+	CpuCoreCount                 int64             `tfschema:"cpu_core_count"`
+	DataStoragePercentage        int64             `tfschema:"data_storage_percentage"`
+	DataStorageSizeInTbs         float64           `tfschema:"data_storage_size_in_tbs"`
+	DbNodeStorageSizeInGbs       int64             `tfschema:"db_node_storage_size_in_gbs"`
+	DisplayName                  string            `tfschema:"display_name"`
+	GiVersion                    string            `tfschema:"gi_version"`
+	Hostname                     string            `tfschema:"hostname"`
+	LicenseModel                 string            `tfschema:"license_model"`
+	LocalBackupEnabled           bool              `tfschema:"local_backup_enabled"`
+	MemorySizeInGbs              int64             `tfschema:"memory_size_in_gbs"`
+	SparseDiskgroupEnabled       bool              `tfschema:"sparse_diskgroup_enabled"`
+	SshPublicKeys                []string          `tfschema:"ssh_public_keys"`
+	SubnetId                     string            `tfschema:"subnet_id"`
+	TimeZone                     string            `tfschema:"time_zone"`
+	VirtualNetworkId             string            `tfschema:"virtual_network_id"`
+	Tags                         map[string]string `tfschema:"tags"`
+	Domain                       string            `tfschema:"domain"`
+	ListenerPort                 int64             `tfschema:"listener_port"`
+	NodeCount                    int64             `tfschema:"node_count"`
+	OciUrl                       string            `tfschema:"oci_url"`
+	Ocid                         string            `tfschema:"ocid"`
+}
+
+type OracleCloudVmClusterResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*OracleCloudVmClusterResource)(nil)
+
+func (r OracleCloudVmClusterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]{0,29}$`),
+				"the name must be between 1 and 30 characters, begin with a letter and may only contain letters, numbers, underscores and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"cloud_exadata_infrastructure_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cloudexadatainfrastructures.ValidateCloudExadataInfrastructureID,
+		},
+
+		"cpu_core_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(2),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"gi_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"hostname": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ssh_public_keys": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.VirtualNetworkID,
+		},
+
+		"backup_subnet_cidr": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsCIDR,
+		},
+
+		"cluster_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"data_storage_percentage": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+
+		"data_storage_size_in_tbs": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.FloatAtLeast(2),
+		},
+
+		"db_node_storage_size_in_gbs": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"license_model": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(cloudvmclusters.LicenseModelLicenseIncluded),
+			ValidateFunc: validation.StringInSlice(cloudvmclusters.PossibleValuesForLicenseModel(), false),
+		},
+
+		"local_backup_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"memory_size_in_gbs": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"sparse_diskgroup_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"time_zone": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r OracleCloudVmClusterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"domain": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"listener_port": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"node_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"oci_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ocid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r OracleCloudVmClusterResource) ModelObject() interface{} {
+	return &OracleCloudVmClusterModel{}
+}
+
+func (r OracleCloudVmClusterResource) ResourceType() string {
+	return "azurerm_oracle_cloud_vm_cluster"
+}
+
+func (r OracleCloudVmClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return cloudvmclusters.ValidateCloudVMClusterID
+}
+
+func (r OracleCloudVmClusterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.CloudVMClustersClient
+			subscriptionId := meta.Client.Account.SubscriptionId
+
+			var model OracleCloudVmClusterModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			id := cloudvmclusters.NewCloudVMClusterID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := &cloudvmclusters.CloudVMClusterProperties{
+				BackupSubnetCidr:             model.BackupSubnetCidr,
+				CloudExadataInfrastructureId: model.CloudExadataInfrastructureId,
+				CpuCoreCount:                 model.CpuCoreCount,
+				DisplayName:                  model.DisplayName,
+				GiVersion:                    model.GiVersion,
+				Hostname:                     model.Hostname,
+				IsLocalBackupEnabled:         pointer.To(model.LocalBackupEnabled),
+				IsSparseDiskgroupEnabled:     pointer.To(model.SparseDiskgroupEnabled),
+				LicenseModel:                 pointer.To(cloudvmclusters.LicenseModel(model.LicenseModel)),
+				SshPublicKeys:                model.SshPublicKeys,
+				SubnetId:                     model.SubnetId,
+				VnetId:                       model.VirtualNetworkId,
+			}
+
+			if model.ClusterName != "" {
+				props.ClusterName = pointer.To(model.ClusterName)
+			}
+			if model.DataStoragePercentage > 0 {
+				props.DataStoragePercentage = pointer.To(model.DataStoragePercentage)
+			}
+			if model.DataStorageSizeInTbs > 0 {
+				props.DataStorageSizeInTbs = pointer.To(model.DataStorageSizeInTbs)
+			}
+			if model.DbNodeStorageSizeInGbs > 0 {
+				props.DbNodeStorageSizeInGbs = pointer.To(model.DbNodeStorageSizeInGbs)
+			}
+			if model.MemorySizeInGbs > 0 {
+				props.MemorySizeInGbs = pointer.To(model.MemorySizeInGbs)
+			}
+			if model.TimeZone != "" {
+				props.TimeZone = pointer.To(model.TimeZone)
+			}
+
+			payload := cloudvmclusters.CloudVMCluster{
+				Location:   location.Normalize(model.Location),
+				Properties: props,
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r OracleCloudVmClusterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.CloudVMClustersClient
+
+			id, err := cloudvmclusters.ParseCloudVMClusterID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := OracleCloudVmClusterModel{
+				Name:              id.CloudVMClusterName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					output.BackupSubnetCidr = props.BackupSubnetCidr
+					output.CloudExadataInfrastructureId = props.CloudExadataInfrastructureId
+					output.ClusterName = pointer.From(props.ClusterName)
+					output.CpuCoreCount = props.CpuCoreCount
+					output.DataStoragePercentage = pointer.From(props.DataStoragePercentage)
+					output.DataStorageSizeInTbs = pointer.From(props.DataStorageSizeInTbs)
+					output.DbNodeStorageSizeInGbs = pointer.From(props.DbNodeStorageSizeInGbs)
+					output.DisplayName = props.DisplayName
+					output.Domain = pointer.From(props.Domain)
+					output.GiVersion = props.GiVersion
+					output.Hostname = props.Hostname
+					output.ListenerPort = pointer.From(props.ListenerPort)
+					output.LocalBackupEnabled = pointer.From(props.IsLocalBackupEnabled)
+					output.MemorySizeInGbs = pointer.From(props.MemorySizeInGbs)
+					output.NodeCount = pointer.From(props.NodeCount)
+					output.OciUrl = pointer.From(props.OciUrl)
+					output.Ocid = pointer.From(props.Ocid)
+					output.SparseDiskgroupEnabled = pointer.From(props.IsSparseDiskgroupEnabled)
+					output.SshPublicKeys = props.SshPublicKeys
+					output.SubnetId = props.SubnetId
+					output.TimeZone = pointer.From(props.TimeZone)
+					output.VirtualNetworkId = props.VnetId
+
+					if props.LicenseModel != nil {
+						output.LicenseModel = string(*props.LicenseModel)
+					}
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r OracleCloudVmClusterResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.CloudVMClustersClient
+
+			id, err := cloudvmclusters.ParseCloudVMClusterID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model OracleCloudVmClusterModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := cloudvmclusters.CloudVMClusterUpdate{}
+
+			if meta.ResourceData.HasChanges("cpu_core_count", "data_storage_size_in_tbs", "license_model", "ssh_public_keys") {
+				props := &cloudvmclusters.CloudVMClusterUpdateProperties{}
+
+				if meta.ResourceData.HasChange("cpu_core_count") {
+					props.CpuCoreCount = pointer.To(model.CpuCoreCount)
+				}
+				if meta.ResourceData.HasChange("data_storage_size_in_tbs") {
+					props.DataStorageSizeInTbs = pointer.To(model.DataStorageSizeInTbs)
+				}
+				if meta.ResourceData.HasChange("license_model") {
+					props.LicenseModel = pointer.To(cloudvmclusters.LicenseModel(model.LicenseModel))
+				}
+				if meta.ResourceData.HasChange("ssh_public_keys") {
+					props.SshPublicKeys = pointer.To(model.SshPublicKeys)
+				}
+
+				payload.Properties = props
+			}
+
+			if meta.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r OracleCloudVmClusterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.CloudVMClustersClient
+
+			id, err := cloudvmclusters.ParseCloudVMClusterID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
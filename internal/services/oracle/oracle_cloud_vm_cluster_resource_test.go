@@ -0,0 +1,159 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/cloudvmclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type OracleCloudVmClusterResource struct{}
+
+func TestAccOracleCloudVmCluster_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_oracle_cloud_vm_cluster", "test")
+	r := OracleCloudVmClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccOracleCloudVmCluster_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_oracle_cloud_vm_cluster", "test")
+	r := OracleCloudVmClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (OracleCloudVmClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := cloudvmclusters.ParseCloudVMClusterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Oracle.CloudVMClustersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r OracleCloudVmClusterResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_cloud_vm_cluster" "test" {
+  name                            = "OFakeVm%[2]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  cloud_exadata_infrastructure_id = azurerm_oracle_exadata_infrastructure.test.id
+  display_name                    = "OFakeVm%[2]d"
+  cpu_core_count                  = 4
+  gi_version                      = "19.0.0.0"
+  hostname                        = "hostname"
+  ssh_public_keys                 = [var.ssh_public_key]
+  subnet_id                       = azurerm_subnet.test.id
+  virtual_network_id              = azurerm_virtual_network.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r OracleCloudVmClusterResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_cloud_vm_cluster" "import" {
+  name                            = azurerm_oracle_cloud_vm_cluster.test.name
+  resource_group_name             = azurerm_oracle_cloud_vm_cluster.test.resource_group_name
+  location                        = azurerm_oracle_cloud_vm_cluster.test.location
+  cloud_exadata_infrastructure_id = azurerm_oracle_cloud_vm_cluster.test.cloud_exadata_infrastructure_id
+  display_name                    = azurerm_oracle_cloud_vm_cluster.test.display_name
+  cpu_core_count                  = azurerm_oracle_cloud_vm_cluster.test.cpu_core_count
+  gi_version                      = azurerm_oracle_cloud_vm_cluster.test.gi_version
+  hostname                        = azurerm_oracle_cloud_vm_cluster.test.hostname
+  ssh_public_keys                 = azurerm_oracle_cloud_vm_cluster.test.ssh_public_keys
+  subnet_id                       = azurerm_oracle_cloud_vm_cluster.test.subnet_id
+  virtual_network_id              = azurerm_oracle_cloud_vm_cluster.test.virtual_network_id
+}
+`, r.basic(data))
+}
+
+func (OracleCloudVmClusterResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+variable "ssh_public_key" {
+  default = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCqaZoyiz1qbdOjGL3gNXz2dLXXF893njJiJdvNVpHXXNvcbkB1pEfAMCgCzg1AnCMqwNAQ6HekRrrif06caeoR+G3Sh7kVlDgj5UVQyxc0CTcEjlFkFLRGsMG9oIh6+TTUlV9lNmZOZ9Ja5cQgRbbWCFgBmTSSc9zdFMZnTqZyxymsdeZNzAa3rM1Aqnx3y2gTaBX9Kgt6GiYRmtBjc4EO7mJDN73naU8YTQqLO8Nrgz4SWdVU2kOq1tRGvHSdHGUBmDXVjAmRB994am4+mpCbYkreOHMqEQxbU4NXDWpIqTILZHv99WWS5v7qHSpTqZZ17o2tVMTgp5frmmUCzQ7V"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-oracle-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+
+  delegation {
+    name = "delegation"
+
+    service_delegation {
+      name = "Oracle.Database/networkAttachments"
+
+      actions = [
+        "Microsoft.Network/networkinterfaces/*",
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+      ]
+    }
+  }
+}
+
+resource "azurerm_oracle_exadata_infrastructure" "test" {
+  name                = "OFake%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "OFake%[1]d"
+  shape               = "Exadata.X9M"
+  compute_count       = 2
+  storage_count       = 3
+  zones               = ["3"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,185 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/dbsystemshapes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type OracleDbSystemShapesDataSourceModel struct {
+	Location       string               `tfschema:"location"`
+	DbSystemShapes []DbSystemShapeModel `tfschema:"db_system_shapes"`
+}
+
+type DbSystemShapeModel struct {
+	Name                        string `tfschema:"name"`
+	AvailableCoreCount          int64  `tfschema:"available_core_count"`
+	AvailableCoreCountPerNode   int64  `tfschema:"available_core_count_per_node"`
+	AvailableDataStorageInTbs   int64  `tfschema:"available_data_storage_in_tbs"`
+	AvailableDbNodeStorageInGbs int64  `tfschema:"available_db_node_storage_in_gbs"`
+	AvailableMemoryInGbs        int64  `tfschema:"available_memory_in_gbs"`
+	CoreCountIncrement          int64  `tfschema:"core_count_increment"`
+	MaximumNodeCount            int64  `tfschema:"maximum_node_count"`
+	MinimumCoreCount            int64  `tfschema:"minimum_core_count"`
+	MinimumDataStorageInTbs     int64  `tfschema:"minimum_data_storage_in_tbs"`
+	MinimumMemoryPerNodeInGbs   int64  `tfschema:"minimum_memory_per_node_in_gbs"`
+	MinimumNodeCount            int64  `tfschema:"minimum_node_count"`
+	ShapeFamily                 string `tfschema:"shape_family"`
+}
+
+type OracleDbSystemShapesDataSource struct{}
+
+var _ sdk.DataSource = OracleDbSystemShapesDataSource{}
+
+func (d OracleDbSystemShapesDataSource) ModelObject() interface{} {
+	return &OracleDbSystemShapesDataSourceModel{}
+}
+
+func (d OracleDbSystemShapesDataSource) ResourceType() string {
+	return "azurerm_oracle_db_system_shapes"
+}
+
+func (d OracleDbSystemShapesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+	}
+}
+
+func (d OracleDbSystemShapesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"db_system_shapes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"available_core_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"available_core_count_per_node": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"available_data_storage_in_tbs": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"available_db_node_storage_in_gbs": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"available_memory_in_gbs": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"core_count_increment": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"maximum_node_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"minimum_core_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"minimum_data_storage_in_tbs": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"minimum_memory_per_node_in_gbs": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"minimum_node_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"shape_family": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d OracleDbSystemShapesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.DbSystemShapesClient
+			subscriptionId := meta.Client.Account.SubscriptionId
+
+			var state OracleDbSystemShapesDataSourceModel
+			if err := meta.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := dbsystemshapes.NewLocationID(subscriptionId, location.Normalize(state.Location))
+
+			resp, err := client.ListByLocation(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving DB System Shapes for %s: %+v", id, err)
+			}
+
+			state.DbSystemShapes = make([]DbSystemShapeModel, 0)
+			if model := resp.Model; model != nil {
+				for _, item := range model.Value {
+					shape := DbSystemShapeModel{
+						Name: pointer.From(item.Name),
+					}
+
+					if props := item.Properties; props != nil {
+						shape.AvailableCoreCount = props.AvailableCoreCount
+						shape.AvailableCoreCountPerNode = pointer.From(props.AvailableCoreCountPerNode)
+						shape.AvailableDataStorageInTbs = pointer.From(props.AvailableDataStorageInTbs)
+						shape.AvailableDbNodeStorageInGbs = pointer.From(props.AvailableDbNodeStorageInGbs)
+						shape.AvailableMemoryInGbs = pointer.From(props.AvailableMemoryInGbs)
+						shape.CoreCountIncrement = pointer.From(props.CoreCountIncrement)
+						shape.MaximumNodeCount = pointer.From(props.MaximumNodeCount)
+						shape.MinimumCoreCount = pointer.From(props.MinimumCoreCount)
+						shape.MinimumDataStorageInTbs = pointer.From(props.MinimumDataStorageInTbs)
+						shape.MinimumMemoryPerNodeInGbs = pointer.From(props.MinimumMemoryPerNodeInGbs)
+						shape.MinimumNodeCount = pointer.From(props.MinimumNodeCount)
+						shape.ShapeFamily = pointer.From(props.ShapeFamily)
+					}
+
+					state.DbSystemShapes = append(state.DbSystemShapes, shape)
+				}
+			}
+
+			meta.SetID(id)
+			return meta.Encode(&state)
+		},
+	}
+}
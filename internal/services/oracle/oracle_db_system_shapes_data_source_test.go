@@ -0,0 +1,37 @@
+package oracle_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type OracleDbSystemShapesDataSource struct{}
+
+func TestAccOracleDbSystemShapesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_oracle_db_system_shapes", "test")
+	r := OracleDbSystemShapesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("db_system_shapes.#").Exists(),
+			),
+		},
+	})
+}
+
+func (OracleDbSystemShapesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_oracle_db_system_shapes" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,258 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/cloudexadatainfrastructures"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type OracleExadataInfrastructureModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	ComputeCount      int64             `tfschema:"compute_count"`
+	DisplayName       string            `tfschema:"display_name"`
+	Shape             string            `tfschema:"shape"`
+	StorageCount      int64             `tfschema:"storage_count"`
+	Zones             []string          `tfschema:"zones"`
+	Tags              map[string]string `tfschema:"tags"`
+	OciUrl            string            `tfschema:"oci_url"`
+	Ocid              string            `tfschema:"ocid"`
+}
+
+type OracleExadataInfrastructureResource struct{}
+
+var _ sdk.ResourceWithUpdate = (*OracleExadataInfrastructureResource)(nil)
+
+func (r OracleExadataInfrastructureResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]{0,29}$`),
+				"the name must be between 1 and 30 characters, begin with a letter and may only contain letters, numbers, underscores and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"compute_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(2),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"shape": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(3),
+		},
+
+		"zones": commonschema.ZonesMultipleRequiredForceNew(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r OracleExadataInfrastructureResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"oci_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ocid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r OracleExadataInfrastructureResource) ModelObject() interface{} {
+	return &OracleExadataInfrastructureModel{}
+}
+
+func (r OracleExadataInfrastructureResource) ResourceType() string {
+	return "azurerm_oracle_exadata_infrastructure"
+}
+
+func (r OracleExadataInfrastructureResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return cloudexadatainfrastructures.ValidateCloudExadataInfrastructureID
+}
+
+func (r OracleExadataInfrastructureResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.CloudExadataInfrastructuresClient
+			subscriptionId := meta.Client.Account.SubscriptionId
+
+			var model OracleExadataInfrastructureModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			id := cloudexadatainfrastructures.NewCloudExadataInfrastructureID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if !response.WasNotFound(existing.HttpResponse) {
+				if err != nil {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := cloudexadatainfrastructures.CloudExadataInfrastructure{
+				Location: location.Normalize(model.Location),
+				Properties: &cloudexadatainfrastructures.CloudExadataInfrastructureProperties{
+					ComputeCount: pointer.To(model.ComputeCount),
+					DisplayName:  model.DisplayName,
+					Shape:        model.Shape,
+					StorageCount: pointer.To(model.StorageCount),
+				},
+				Tags:  &model.Tags,
+				Zones: model.Zones,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			meta.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r OracleExadataInfrastructureResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.CloudExadataInfrastructuresClient
+
+			id, err := cloudexadatainfrastructures.ParseCloudExadataInfrastructureID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			output := OracleExadataInfrastructureModel{
+				Name:              id.CloudExadataInfrastructureName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+				output.Zones = model.Zones
+
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					output.ComputeCount = pointer.From(props.ComputeCount)
+					output.DisplayName = props.DisplayName
+					output.OciUrl = pointer.From(props.OciUrl)
+					output.Ocid = pointer.From(props.Ocid)
+					output.Shape = props.Shape
+					output.StorageCount = pointer.From(props.StorageCount)
+				}
+			}
+
+			return meta.Encode(&output)
+		},
+	}
+}
+
+func (r OracleExadataInfrastructureResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.CloudExadataInfrastructuresClient
+
+			id, err := cloudexadatainfrastructures.ParseCloudExadataInfrastructureID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model OracleExadataInfrastructureModel
+			if err := meta.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := cloudexadatainfrastructures.CloudExadataInfrastructureUpdate{}
+
+			if meta.ResourceData.HasChanges("compute_count", "storage_count") {
+				payload.Properties = &cloudexadatainfrastructures.CloudExadataInfrastructureUpdateProperties{
+					ComputeCount: pointer.To(model.ComputeCount),
+					StorageCount: pointer.To(model.StorageCount),
+				}
+			}
+
+			if meta.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r OracleExadataInfrastructureResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.Oracle.CloudExadataInfrastructuresClient
+
+			id, err := cloudexadatainfrastructures.ParseCloudExadataInfrastructureID(meta.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
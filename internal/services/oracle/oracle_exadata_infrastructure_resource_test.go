@@ -0,0 +1,154 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/sdk/2023-09-01/cloudexadatainfrastructures"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type OracleExadataInfrastructureResource struct{}
+
+func TestAccOracleExadataInfrastructure_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_oracle_exadata_infrastructure", "test")
+	r := OracleExadataInfrastructureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccOracleExadataInfrastructure_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_oracle_exadata_infrastructure", "test")
+	r := OracleExadataInfrastructureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccOracleExadataInfrastructure_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_oracle_exadata_infrastructure", "test")
+	r := OracleExadataInfrastructureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (OracleExadataInfrastructureResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := cloudexadatainfrastructures.ParseCloudExadataInfrastructureID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Oracle.CloudExadataInfrastructuresClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r OracleExadataInfrastructureResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_exadata_infrastructure" "test" {
+  name                = "OFake%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "OFake%[2]d"
+  shape               = "Exadata.X9M"
+  compute_count       = 2
+  storage_count       = 3
+  zones               = ["3"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r OracleExadataInfrastructureResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_exadata_infrastructure" "test" {
+  name                = "OFake%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "OFake%[2]d"
+  shape               = "Exadata.X9M"
+  compute_count       = 2
+  storage_count       = 3
+  zones               = ["3"]
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r OracleExadataInfrastructureResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_exadata_infrastructure" "import" {
+  name                = azurerm_oracle_exadata_infrastructure.test.name
+  resource_group_name = azurerm_oracle_exadata_infrastructure.test.resource_group_name
+  location            = azurerm_oracle_exadata_infrastructure.test.location
+  display_name        = azurerm_oracle_exadata_infrastructure.test.display_name
+  shape               = azurerm_oracle_exadata_infrastructure.test.shape
+  compute_count       = azurerm_oracle_exadata_infrastructure.test.compute_count
+  storage_count       = azurerm_oracle_exadata_infrastructure.test.storage_count
+  zones               = azurerm_oracle_exadata_infrastructure.test.zones
+}
+`, r.basic(data))
+}
+
+func (OracleExadataInfrastructureResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-oracle-%[1]d"
+  location = "%[2]s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
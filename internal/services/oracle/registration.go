@@ -0,0 +1,39 @@
+package oracle
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type Registration struct{}
+
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/oracle"
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Oracle"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Oracle",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		OracleDbSystemShapesDataSource{},
+	}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		OracleAutonomousDatabaseResource{},
+		OracleCloudVmClusterResource{},
+		OracleExadataInfrastructureResource{},
+	}
+}
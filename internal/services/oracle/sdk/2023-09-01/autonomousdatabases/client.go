@@ -0,0 +1,18 @@
+package autonomousdatabases
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AutonomousDatabasesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAutonomousDatabasesClientWithBaseURI(endpoint string) AutonomousDatabasesClient {
+	return AutonomousDatabasesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,173 @@
+package autonomousdatabases
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AutonomousDatabaseLifecycleState string
+
+const (
+	AutonomousDatabaseLifecycleStateAvailable    AutonomousDatabaseLifecycleState = "Available"
+	AutonomousDatabaseLifecycleStateProvisioning AutonomousDatabaseLifecycleState = "Provisioning"
+	AutonomousDatabaseLifecycleStateStopped      AutonomousDatabaseLifecycleState = "Stopped"
+	AutonomousDatabaseLifecycleStateTerminated   AutonomousDatabaseLifecycleState = "Terminated"
+	AutonomousDatabaseLifecycleStateTerminating  AutonomousDatabaseLifecycleState = "Terminating"
+	AutonomousDatabaseLifecycleStateUnavailable  AutonomousDatabaseLifecycleState = "Unavailable"
+	AutonomousDatabaseLifecycleStateUpdating     AutonomousDatabaseLifecycleState = "Updating"
+)
+
+func PossibleValuesForAutonomousDatabaseLifecycleState() []string {
+	return []string{
+		string(AutonomousDatabaseLifecycleStateAvailable),
+		string(AutonomousDatabaseLifecycleStateProvisioning),
+		string(AutonomousDatabaseLifecycleStateStopped),
+		string(AutonomousDatabaseLifecycleStateTerminated),
+		string(AutonomousDatabaseLifecycleStateTerminating),
+		string(AutonomousDatabaseLifecycleStateUnavailable),
+		string(AutonomousDatabaseLifecycleStateUpdating),
+	}
+}
+
+func parseAutonomousDatabaseLifecycleState(input string) (*AutonomousDatabaseLifecycleState, error) {
+	vals := map[string]AutonomousDatabaseLifecycleState{
+		"available":    AutonomousDatabaseLifecycleStateAvailable,
+		"provisioning": AutonomousDatabaseLifecycleStateProvisioning,
+		"stopped":      AutonomousDatabaseLifecycleStateStopped,
+		"terminated":   AutonomousDatabaseLifecycleStateTerminated,
+		"terminating":  AutonomousDatabaseLifecycleStateTerminating,
+		"unavailable":  AutonomousDatabaseLifecycleStateUnavailable,
+		"updating":     AutonomousDatabaseLifecycleStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AutonomousDatabaseLifecycleState(input)
+	return &out, nil
+}
+
+type AzureResourceProvisioningState string
+
+const (
+	AzureResourceProvisioningStateCanceled     AzureResourceProvisioningState = "Canceled"
+	AzureResourceProvisioningStateFailed       AzureResourceProvisioningState = "Failed"
+	AzureResourceProvisioningStateProvisioning AzureResourceProvisioningState = "Provisioning"
+	AzureResourceProvisioningStateSucceeded    AzureResourceProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForAzureResourceProvisioningState() []string {
+	return []string{
+		string(AzureResourceProvisioningStateCanceled),
+		string(AzureResourceProvisioningStateFailed),
+		string(AzureResourceProvisioningStateProvisioning),
+		string(AzureResourceProvisioningStateSucceeded),
+	}
+}
+
+func parseAzureResourceProvisioningState(input string) (*AzureResourceProvisioningState, error) {
+	vals := map[string]AzureResourceProvisioningState{
+		"canceled":     AzureResourceProvisioningStateCanceled,
+		"failed":       AzureResourceProvisioningStateFailed,
+		"provisioning": AzureResourceProvisioningStateProvisioning,
+		"succeeded":    AzureResourceProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AzureResourceProvisioningState(input)
+	return &out, nil
+}
+
+type ComputeModel string
+
+const (
+	ComputeModelECPU ComputeModel = "ECPU"
+	ComputeModelOCPU ComputeModel = "OCPU"
+)
+
+func PossibleValuesForComputeModel() []string {
+	return []string{
+		string(ComputeModelECPU),
+		string(ComputeModelOCPU),
+	}
+}
+
+func parseComputeModel(input string) (*ComputeModel, error) {
+	vals := map[string]ComputeModel{
+		"ecpu": ComputeModelECPU,
+		"ocpu": ComputeModelOCPU,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ComputeModel(input)
+	return &out, nil
+}
+
+type LicenseModel string
+
+const (
+	LicenseModelBringYourOwnLicense LicenseModel = "BringYourOwnLicense"
+	LicenseModelLicenseIncluded     LicenseModel = "LicenseIncluded"
+)
+
+func PossibleValuesForLicenseModel() []string {
+	return []string{
+		string(LicenseModelBringYourOwnLicense),
+		string(LicenseModelLicenseIncluded),
+	}
+}
+
+func parseLicenseModel(input string) (*LicenseModel, error) {
+	vals := map[string]LicenseModel{
+		"bringyourownlicense": LicenseModelBringYourOwnLicense,
+		"licenseincluded":     LicenseModelLicenseIncluded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LicenseModel(input)
+	return &out, nil
+}
+
+type WorkloadType string
+
+const (
+	WorkloadTypeAJD  WorkloadType = "AJD"
+	WorkloadTypeAPEX WorkloadType = "APEX"
+	WorkloadTypeDW   WorkloadType = "DW"
+	WorkloadTypeOLTP WorkloadType = "OLTP"
+)
+
+func PossibleValuesForWorkloadType() []string {
+	return []string{
+		string(WorkloadTypeAJD),
+		string(WorkloadTypeAPEX),
+		string(WorkloadTypeDW),
+		string(WorkloadTypeOLTP),
+	}
+}
+
+func parseWorkloadType(input string) (*WorkloadType, error) {
+	vals := map[string]WorkloadType{
+		"ajd":  WorkloadTypeAJD,
+		"apex": WorkloadTypeAPEX,
+		"dw":   WorkloadTypeDW,
+		"oltp": WorkloadTypeOLTP,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WorkloadType(input)
+	return &out, nil
+}
@@ -0,0 +1,124 @@
+package autonomousdatabases
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = AutonomousDatabaseId{}
+
+// AutonomousDatabaseId is a struct representing the Resource ID for a Autonomous Database
+type AutonomousDatabaseId struct {
+	SubscriptionId         string
+	ResourceGroupName      string
+	AutonomousDatabaseName string
+}
+
+// NewAutonomousDatabaseID returns a new AutonomousDatabaseId struct
+func NewAutonomousDatabaseID(subscriptionId string, resourceGroupName string, autonomousDatabaseName string) AutonomousDatabaseId {
+	return AutonomousDatabaseId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroupName:      resourceGroupName,
+		AutonomousDatabaseName: autonomousDatabaseName,
+	}
+}
+
+// ParseAutonomousDatabaseID parses 'input' into a AutonomousDatabaseId
+func ParseAutonomousDatabaseID(input string) (*AutonomousDatabaseId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AutonomousDatabaseId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AutonomousDatabaseId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.AutonomousDatabaseName, ok = parsed.Parsed["autonomousDatabaseName"]; !ok {
+		return nil, fmt.Errorf("the segment 'autonomousDatabaseName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseAutonomousDatabaseIDInsensitively parses 'input' case-insensitively into a AutonomousDatabaseId
+// note: this method should only be used for API response data and not user input
+func ParseAutonomousDatabaseIDInsensitively(input string) (*AutonomousDatabaseId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AutonomousDatabaseId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AutonomousDatabaseId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.AutonomousDatabaseName, ok = parsed.Parsed["autonomousDatabaseName"]; !ok {
+		return nil, fmt.Errorf("the segment 'autonomousDatabaseName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateAutonomousDatabaseID checks that 'input' can be parsed as a Autonomous Database ID
+func ValidateAutonomousDatabaseID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAutonomousDatabaseID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Autonomous Database ID
+func (id AutonomousDatabaseId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Oracle.Database/autonomousDatabases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.AutonomousDatabaseName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Autonomous Database ID
+func (id AutonomousDatabaseId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticOracleDatabase", "Oracle.Database", "Oracle.Database"),
+		resourceids.StaticSegment("staticAutonomousDatabases", "autonomousDatabases", "autonomousDatabases"),
+		resourceids.UserSpecifiedSegment("autonomousDatabaseName", "autonomousDatabaseNameValue"),
+	}
+}
+
+// String returns a human-readable description of this Autonomous Database ID
+func (id AutonomousDatabaseId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Autonomous Database Name: %q", id.AutonomousDatabaseName),
+	}
+	return fmt.Sprintf("Autonomous Database (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,30 @@
+package autonomousdatabases
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AutonomousDatabaseProperties struct {
+	AdminPassword                  *string                           `json:"adminPassword,omitempty"`
+	BackupRetentionPeriodInDays    *int64                            `json:"backupRetentionPeriodInDays,omitempty"`
+	CharacterSet                   *string                           `json:"characterSet,omitempty"`
+	ComputeCount                   *float64                          `json:"computeCount,omitempty"`
+	ComputeModel                   *ComputeModel                     `json:"computeModel,omitempty"`
+	DataBaseType                   string                            `json:"dataBaseType"`
+	DataStorageSizeInTbs           *int64                            `json:"dataStorageSizeInTbs,omitempty"`
+	DbVersion                      *string                           `json:"dbVersion,omitempty"`
+	DbWorkload                     *WorkloadType                     `json:"dbWorkload,omitempty"`
+	DisplayName                    *string                           `json:"displayName,omitempty"`
+	IsAutoScalingEnabled           *bool                             `json:"isAutoScalingEnabled,omitempty"`
+	IsAutoScalingForStorageEnabled *bool                             `json:"isAutoScalingForStorageEnabled,omitempty"`
+	IsMtlsConnectionRequired       *bool                             `json:"isMtlsConnectionRequired,omitempty"`
+	LicenseModel                   *LicenseModel                     `json:"licenseModel,omitempty"`
+	LifecycleDetails               *string                           `json:"lifecycleDetails,omitempty"`
+	LifecycleState                 *AutonomousDatabaseLifecycleState `json:"lifecycleState,omitempty"`
+	NcharacterSet                  *string                           `json:"ncharacterSet,omitempty"`
+	OciUrl                         *string                           `json:"ociUrl,omitempty"`
+	Ocid                           *string                           `json:"ocid,omitempty"`
+	ProvisioningState              *AzureResourceProvisioningState   `json:"provisioningState,omitempty"`
+	SubnetId                       *string                           `json:"subnetId,omitempty"`
+	TimeCreated                    *string                           `json:"timeCreated,omitempty"`
+	VnetId                         *string                           `json:"vnetId,omitempty"`
+}
@@ -0,0 +1,15 @@
+package autonomousdatabases
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AutonomousDatabaseUpdateProperties struct {
+	BackupRetentionPeriodInDays    *int64        `json:"backupRetentionPeriodInDays,omitempty"`
+	ComputeCount                   *float64      `json:"computeCount,omitempty"`
+	DataStorageSizeInTbs           *int64        `json:"dataStorageSizeInTbs,omitempty"`
+	DisplayName                    *string       `json:"displayName,omitempty"`
+	IsAutoScalingEnabled           *bool         `json:"isAutoScalingEnabled,omitempty"`
+	IsAutoScalingForStorageEnabled *bool         `json:"isAutoScalingForStorageEnabled,omitempty"`
+	IsMtlsConnectionRequired       *bool         `json:"isMtlsConnectionRequired,omitempty"`
+	LicenseModel                   *LicenseModel `json:"licenseModel,omitempty"`
+}
@@ -0,0 +1,18 @@
+package cloudexadatainfrastructures
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CloudExadataInfrastructuresClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCloudExadataInfrastructuresClientWithBaseURI(endpoint string) CloudExadataInfrastructuresClient {
+	return CloudExadataInfrastructuresClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
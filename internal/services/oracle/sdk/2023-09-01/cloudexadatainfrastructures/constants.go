@@ -0,0 +1,83 @@
+package cloudexadatainfrastructures
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AzureResourceProvisioningState string
+
+const (
+	AzureResourceProvisioningStateCanceled     AzureResourceProvisioningState = "Canceled"
+	AzureResourceProvisioningStateFailed       AzureResourceProvisioningState = "Failed"
+	AzureResourceProvisioningStateProvisioning AzureResourceProvisioningState = "Provisioning"
+	AzureResourceProvisioningStateSucceeded    AzureResourceProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForAzureResourceProvisioningState() []string {
+	return []string{
+		string(AzureResourceProvisioningStateCanceled),
+		string(AzureResourceProvisioningStateFailed),
+		string(AzureResourceProvisioningStateProvisioning),
+		string(AzureResourceProvisioningStateSucceeded),
+	}
+}
+
+func parseAzureResourceProvisioningState(input string) (*AzureResourceProvisioningState, error) {
+	vals := map[string]AzureResourceProvisioningState{
+		"canceled":     AzureResourceProvisioningStateCanceled,
+		"failed":       AzureResourceProvisioningStateFailed,
+		"provisioning": AzureResourceProvisioningStateProvisioning,
+		"succeeded":    AzureResourceProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AzureResourceProvisioningState(input)
+	return &out, nil
+}
+
+type CloudExadataInfrastructureLifecycleState string
+
+const (
+	CloudExadataInfrastructureLifecycleStateAvailable             CloudExadataInfrastructureLifecycleState = "Available"
+	CloudExadataInfrastructureLifecycleStateFailed                CloudExadataInfrastructureLifecycleState = "Failed"
+	CloudExadataInfrastructureLifecycleStateMaintenanceInProgress CloudExadataInfrastructureLifecycleState = "MaintenanceInProgress"
+	CloudExadataInfrastructureLifecycleStateProvisioning          CloudExadataInfrastructureLifecycleState = "Provisioning"
+	CloudExadataInfrastructureLifecycleStateTerminated            CloudExadataInfrastructureLifecycleState = "Terminated"
+	CloudExadataInfrastructureLifecycleStateTerminating           CloudExadataInfrastructureLifecycleState = "Terminating"
+	CloudExadataInfrastructureLifecycleStateUpdating              CloudExadataInfrastructureLifecycleState = "Updating"
+)
+
+func PossibleValuesForCloudExadataInfrastructureLifecycleState() []string {
+	return []string{
+		string(CloudExadataInfrastructureLifecycleStateAvailable),
+		string(CloudExadataInfrastructureLifecycleStateFailed),
+		string(CloudExadataInfrastructureLifecycleStateMaintenanceInProgress),
+		string(CloudExadataInfrastructureLifecycleStateProvisioning),
+		string(CloudExadataInfrastructureLifecycleStateTerminated),
+		string(CloudExadataInfrastructureLifecycleStateTerminating),
+		string(CloudExadataInfrastructureLifecycleStateUpdating),
+	}
+}
+
+func parseCloudExadataInfrastructureLifecycleState(input string) (*CloudExadataInfrastructureLifecycleState, error) {
+	vals := map[string]CloudExadataInfrastructureLifecycleState{
+		"available":             CloudExadataInfrastructureLifecycleStateAvailable,
+		"failed":                CloudExadataInfrastructureLifecycleStateFailed,
+		"maintenanceinprogress": CloudExadataInfrastructureLifecycleStateMaintenanceInProgress,
+		"provisioning":          CloudExadataInfrastructureLifecycleStateProvisioning,
+		"terminated":            CloudExadataInfrastructureLifecycleStateTerminated,
+		"terminating":           CloudExadataInfrastructureLifecycleStateTerminating,
+		"updating":              CloudExadataInfrastructureLifecycleStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CloudExadataInfrastructureLifecycleState(input)
+	return &out, nil
+}
@@ -0,0 +1,124 @@
+package cloudexadatainfrastructures
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = CloudExadataInfrastructureId{}
+
+// CloudExadataInfrastructureId is a struct representing the Resource ID for a Cloud Exadata Infrastructure
+type CloudExadataInfrastructureId struct {
+	SubscriptionId                 string
+	ResourceGroupName              string
+	CloudExadataInfrastructureName string
+}
+
+// NewCloudExadataInfrastructureID returns a new CloudExadataInfrastructureId struct
+func NewCloudExadataInfrastructureID(subscriptionId string, resourceGroupName string, cloudExadataInfrastructureName string) CloudExadataInfrastructureId {
+	return CloudExadataInfrastructureId{
+		SubscriptionId:                 subscriptionId,
+		ResourceGroupName:              resourceGroupName,
+		CloudExadataInfrastructureName: cloudExadataInfrastructureName,
+	}
+}
+
+// ParseCloudExadataInfrastructureID parses 'input' into a CloudExadataInfrastructureId
+func ParseCloudExadataInfrastructureID(input string) (*CloudExadataInfrastructureId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CloudExadataInfrastructureId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CloudExadataInfrastructureId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CloudExadataInfrastructureName, ok = parsed.Parsed["cloudExadataInfrastructureName"]; !ok {
+		return nil, fmt.Errorf("the segment 'cloudExadataInfrastructureName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseCloudExadataInfrastructureIDInsensitively parses 'input' case-insensitively into a CloudExadataInfrastructureId
+// note: this method should only be used for API response data and not user input
+func ParseCloudExadataInfrastructureIDInsensitively(input string) (*CloudExadataInfrastructureId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CloudExadataInfrastructureId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CloudExadataInfrastructureId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CloudExadataInfrastructureName, ok = parsed.Parsed["cloudExadataInfrastructureName"]; !ok {
+		return nil, fmt.Errorf("the segment 'cloudExadataInfrastructureName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateCloudExadataInfrastructureID checks that 'input' can be parsed as a Cloud Exadata Infrastructure ID
+func ValidateCloudExadataInfrastructureID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseCloudExadataInfrastructureID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Cloud Exadata Infrastructure ID
+func (id CloudExadataInfrastructureId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Oracle.Database/cloudExadataInfrastructures/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.CloudExadataInfrastructureName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Cloud Exadata Infrastructure ID
+func (id CloudExadataInfrastructureId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticOracleDatabase", "Oracle.Database", "Oracle.Database"),
+		resourceids.StaticSegment("staticCloudExadataInfrastructures", "cloudExadataInfrastructures", "cloudExadataInfrastructures"),
+		resourceids.UserSpecifiedSegment("cloudExadataInfrastructureName", "cloudExadataInfrastructureNameValue"),
+	}
+}
+
+// String returns a human-readable description of this Cloud Exadata Infrastructure ID
+func (id CloudExadataInfrastructureId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Cloud Exadata Infrastructure Name: %q", id.CloudExadataInfrastructureName),
+	}
+	return fmt.Sprintf("Cloud Exadata Infrastructure (%s)", strings.Join(components, "\n"))
+}
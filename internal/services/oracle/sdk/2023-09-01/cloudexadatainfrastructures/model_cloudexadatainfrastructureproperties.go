@@ -0,0 +1,22 @@
+package cloudexadatainfrastructures
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CloudExadataInfrastructureProperties struct {
+	AvailableStorageSizeInGbs *int64                                    `json:"availableStorageSizeInGbs,omitempty"`
+	ComputeCount              *int64                                    `json:"computeCount,omitempty"`
+	CpuCount                  *int64                                    `json:"cpuCount,omitempty"`
+	DisplayName               string                                    `json:"displayName"`
+	LifecycleDetails          *string                                   `json:"lifecycleDetails,omitempty"`
+	LifecycleState            *CloudExadataInfrastructureLifecycleState `json:"lifecycleState,omitempty"`
+	MaxCpuCount               *int64                                    `json:"maxCpuCount,omitempty"`
+	MemorySizeInGbs           *int64                                    `json:"memorySizeInGbs,omitempty"`
+	OciUrl                    *string                                   `json:"ociUrl,omitempty"`
+	Ocid                      *string                                   `json:"ocid,omitempty"`
+	ProvisioningState         *AzureResourceProvisioningState           `json:"provisioningState,omitempty"`
+	Shape                     string                                    `json:"shape"`
+	StorageCount              *int64                                    `json:"storageCount,omitempty"`
+	TimeCreated               *string                                   `json:"timeCreated,omitempty"`
+	TotalStorageSizeInGbs     *int64                                    `json:"totalStorageSizeInGbs,omitempty"`
+}
@@ -0,0 +1,18 @@
+package cloudvmclusters
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CloudVMClustersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCloudVMClustersClientWithBaseURI(endpoint string) CloudVMClustersClient {
+	return CloudVMClustersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
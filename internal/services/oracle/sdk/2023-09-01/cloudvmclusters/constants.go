@@ -0,0 +1,111 @@
+package cloudvmclusters
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AzureResourceProvisioningState string
+
+const (
+	AzureResourceProvisioningStateCanceled     AzureResourceProvisioningState = "Canceled"
+	AzureResourceProvisioningStateFailed       AzureResourceProvisioningState = "Failed"
+	AzureResourceProvisioningStateProvisioning AzureResourceProvisioningState = "Provisioning"
+	AzureResourceProvisioningStateSucceeded    AzureResourceProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForAzureResourceProvisioningState() []string {
+	return []string{
+		string(AzureResourceProvisioningStateCanceled),
+		string(AzureResourceProvisioningStateFailed),
+		string(AzureResourceProvisioningStateProvisioning),
+		string(AzureResourceProvisioningStateSucceeded),
+	}
+}
+
+func parseAzureResourceProvisioningState(input string) (*AzureResourceProvisioningState, error) {
+	vals := map[string]AzureResourceProvisioningState{
+		"canceled":     AzureResourceProvisioningStateCanceled,
+		"failed":       AzureResourceProvisioningStateFailed,
+		"provisioning": AzureResourceProvisioningStateProvisioning,
+		"succeeded":    AzureResourceProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AzureResourceProvisioningState(input)
+	return &out, nil
+}
+
+type CloudVMClusterLifecycleState string
+
+const (
+	CloudVMClusterLifecycleStateAvailable             CloudVMClusterLifecycleState = "Available"
+	CloudVMClusterLifecycleStateFailed                CloudVMClusterLifecycleState = "Failed"
+	CloudVMClusterLifecycleStateMaintenanceInProgress CloudVMClusterLifecycleState = "MaintenanceInProgress"
+	CloudVMClusterLifecycleStateProvisioning          CloudVMClusterLifecycleState = "Provisioning"
+	CloudVMClusterLifecycleStateTerminated            CloudVMClusterLifecycleState = "Terminated"
+	CloudVMClusterLifecycleStateTerminating           CloudVMClusterLifecycleState = "Terminating"
+	CloudVMClusterLifecycleStateUpdating              CloudVMClusterLifecycleState = "Updating"
+)
+
+func PossibleValuesForCloudVMClusterLifecycleState() []string {
+	return []string{
+		string(CloudVMClusterLifecycleStateAvailable),
+		string(CloudVMClusterLifecycleStateFailed),
+		string(CloudVMClusterLifecycleStateMaintenanceInProgress),
+		string(CloudVMClusterLifecycleStateProvisioning),
+		string(CloudVMClusterLifecycleStateTerminated),
+		string(CloudVMClusterLifecycleStateTerminating),
+		string(CloudVMClusterLifecycleStateUpdating),
+	}
+}
+
+func parseCloudVMClusterLifecycleState(input string) (*CloudVMClusterLifecycleState, error) {
+	vals := map[string]CloudVMClusterLifecycleState{
+		"available":             CloudVMClusterLifecycleStateAvailable,
+		"failed":                CloudVMClusterLifecycleStateFailed,
+		"maintenanceinprogress": CloudVMClusterLifecycleStateMaintenanceInProgress,
+		"provisioning":          CloudVMClusterLifecycleStateProvisioning,
+		"terminated":            CloudVMClusterLifecycleStateTerminated,
+		"terminating":           CloudVMClusterLifecycleStateTerminating,
+		"updating":              CloudVMClusterLifecycleStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CloudVMClusterLifecycleState(input)
+	return &out, nil
+}
+
+type LicenseModel string
+
+const (
+	LicenseModelBringYourOwnLicense LicenseModel = "BringYourOwnLicense"
+	LicenseModelLicenseIncluded     LicenseModel = "LicenseIncluded"
+)
+
+func PossibleValuesForLicenseModel() []string {
+	return []string{
+		string(LicenseModelBringYourOwnLicense),
+		string(LicenseModelLicenseIncluded),
+	}
+}
+
+func parseLicenseModel(input string) (*LicenseModel, error) {
+	vals := map[string]LicenseModel{
+		"bringyourownlicense": LicenseModelBringYourOwnLicense,
+		"licenseincluded":     LicenseModelLicenseIncluded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LicenseModel(input)
+	return &out, nil
+}
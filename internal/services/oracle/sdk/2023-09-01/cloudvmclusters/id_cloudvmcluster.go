@@ -0,0 +1,124 @@
+package cloudvmclusters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = CloudVMClusterId{}
+
+// CloudVMClusterId is a struct representing the Resource ID for a Cloud VM Cluster
+type CloudVMClusterId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	CloudVMClusterName string
+}
+
+// NewCloudVMClusterID returns a new CloudVMClusterId struct
+func NewCloudVMClusterID(subscriptionId string, resourceGroupName string, cloudVMClusterName string) CloudVMClusterId {
+	return CloudVMClusterId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		CloudVMClusterName: cloudVMClusterName,
+	}
+}
+
+// ParseCloudVMClusterID parses 'input' into a CloudVMClusterId
+func ParseCloudVMClusterID(input string) (*CloudVMClusterId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CloudVMClusterId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CloudVMClusterId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CloudVMClusterName, ok = parsed.Parsed["cloudVmClusterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'cloudVmClusterName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseCloudVMClusterIDInsensitively parses 'input' case-insensitively into a CloudVMClusterId
+// note: this method should only be used for API response data and not user input
+func ParseCloudVMClusterIDInsensitively(input string) (*CloudVMClusterId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CloudVMClusterId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CloudVMClusterId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CloudVMClusterName, ok = parsed.Parsed["cloudVmClusterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'cloudVmClusterName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateCloudVMClusterID checks that 'input' can be parsed as a Cloud VM Cluster ID
+func ValidateCloudVMClusterID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseCloudVMClusterID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Cloud VM Cluster ID
+func (id CloudVMClusterId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Oracle.Database/cloudVmClusters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.CloudVMClusterName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Cloud VM Cluster ID
+func (id CloudVMClusterId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticOracleDatabase", "Oracle.Database", "Oracle.Database"),
+		resourceids.StaticSegment("staticCloudVmClusters", "cloudVmClusters", "cloudVmClusters"),
+		resourceids.UserSpecifiedSegment("cloudVmClusterName", "cloudVmClusterNameValue"),
+	}
+}
+
+// String returns a human-readable description of this Cloud VM Cluster ID
+func (id CloudVMClusterId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Cloud VM Cluster Name: %q", id.CloudVMClusterName),
+	}
+	return fmt.Sprintf("Cloud VM Cluster (%s)", strings.Join(components, "\n"))
+}
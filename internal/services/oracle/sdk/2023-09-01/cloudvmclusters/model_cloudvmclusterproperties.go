@@ -0,0 +1,34 @@
+package cloudvmclusters
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CloudVMClusterProperties struct {
+	BackupSubnetCidr             string                          `json:"backupSubnetCidr,omitempty"`
+	CloudExadataInfrastructureId string                          `json:"cloudExadataInfrastructureId"`
+	ClusterName                  *string                         `json:"clusterName,omitempty"`
+	CpuCoreCount                 int64                           `json:"cpuCoreCount"`
+	DataStoragePercentage        *int64                          `json:"dataStoragePercentage,omitempty"`
+	DataStorageSizeInTbs         *float64                        `json:"dataStorageSizeInTbs,omitempty"`
+	DbNodeStorageSizeInGbs       *int64                          `json:"dbNodeStorageSizeInGbs,omitempty"`
+	DisplayName                  string                          `json:"displayName"`
+	Domain                       *string                         `json:"domain,omitempty"`
+	GiVersion                    string                          `json:"giVersion"`
+	Hostname                     string                          `json:"hostname"`
+	IsLocalBackupEnabled         *bool                           `json:"isLocalBackupEnabled,omitempty"`
+	IsSparseDiskgroupEnabled     *bool                           `json:"isSparseDiskgroupEnabled,omitempty"`
+	LicenseModel                 *LicenseModel                   `json:"licenseModel,omitempty"`
+	LifecycleDetails             *string                         `json:"lifecycleDetails,omitempty"`
+	LifecycleState               *CloudVMClusterLifecycleState   `json:"lifecycleState,omitempty"`
+	ListenerPort                 *int64                          `json:"listenerPort,omitempty"`
+	MemorySizeInGbs              *int64                          `json:"memorySizeInGbs,omitempty"`
+	NodeCount                    *int64                          `json:"nodeCount,omitempty"`
+	OciUrl                       *string                         `json:"ociUrl,omitempty"`
+	Ocid                         *string                         `json:"ocid,omitempty"`
+	ProvisioningState            *AzureResourceProvisioningState `json:"provisioningState,omitempty"`
+	SshPublicKeys                []string                        `json:"sshPublicKeys"`
+	SubnetId                     string                          `json:"subnetId"`
+	TimeCreated                  *string                         `json:"timeCreated,omitempty"`
+	TimeZone                     *string                         `json:"timeZone,omitempty"`
+	VnetId                       string                          `json:"vnetId"`
+}
@@ -0,0 +1,12 @@
+package cloudvmclusters
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CloudVMClusterUpdateProperties struct {
+	CpuCoreCount         *int64        `json:"cpuCoreCount,omitempty"`
+	DataStorageSizeInTbs *float64      `json:"dataStorageSizeInTbs,omitempty"`
+	DisplayName          *string       `json:"displayName,omitempty"`
+	LicenseModel         *LicenseModel `json:"licenseModel,omitempty"`
+	SshPublicKeys        *[]string     `json:"sshPublicKeys,omitempty"`
+}
@@ -0,0 +1,18 @@
+package dbsystemshapes
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DbSystemShapesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDbSystemShapesClientWithBaseURI(endpoint string) DbSystemShapesClient {
+	return DbSystemShapesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package dbsystemshapes
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DbSystemShapeListResult struct {
+	NextLink *string         `json:"nextLink,omitempty"`
+	Value    []DbSystemShape `json:"value"`
+}
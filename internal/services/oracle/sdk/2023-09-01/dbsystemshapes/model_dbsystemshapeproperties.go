@@ -0,0 +1,21 @@
+package dbsystemshapes
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DbSystemShapeProperties struct {
+	AvailableCoreCount               int64   `json:"availableCoreCount"`
+	AvailableCoreCountPerNode        *int64  `json:"availableCoreCountPerNode,omitempty"`
+	AvailableDataStorageInTbs        *int64  `json:"availableDataStorageInTbs,omitempty"`
+	AvailableDbNodeStorageInGbs      *int64  `json:"availableDbNodeStorageInGbs,omitempty"`
+	AvailableMemoryInGbs             *int64  `json:"availableMemoryInGbs,omitempty"`
+	CoreCountIncrement               *int64  `json:"coreCountIncrement,omitempty"`
+	MaximumNodeCount                 *int64  `json:"maximumNodeCount,omitempty"`
+	MinimumCoreCount                 *int64  `json:"minimumCoreCount,omitempty"`
+	MinimumDataStorageInTbs          *int64  `json:"minimumDataStorageInTbs,omitempty"`
+	MinimumDbNodeStoragePerNodeInGbs *int64  `json:"minimumDbNodeStoragePerNodeInGbs,omitempty"`
+	MinimumMemoryPerNodeInGbs        *int64  `json:"minimumMemoryPerNodeInGbs,omitempty"`
+	MinimumNodeCount                 *int64  `json:"minimumNodeCount,omitempty"`
+	RuntimeMinimumCoreCount          *int64  `json:"runtimeMinimumCoreCount,omitempty"`
+	ShapeFamily                      *string `json:"shapeFamily,omitempty"`
+}
@@ -6,7 +6,6 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -14,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2021-06-01-preview/policy" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -51,6 +51,10 @@ func (br assignmentBaseResource) createFunc(resourceName, scopeFieldName string)
 				assignment.AssignmentProperties.Description = utils.String(v)
 			}
 
+			if v := metadata.ResourceData.Get("definition_version").(string); v != "" {
+				assignment.AssignmentProperties.DefinitionVersion = utils.String(v)
+			}
+
 			if v := metadata.ResourceData.Get("location").(string); v != "" {
 				assignment.Location = utils.String(azure.NormalizeLocation(v))
 			}
@@ -169,6 +173,7 @@ func (br assignmentBaseResource) readFunc(scopeFieldName string) sdk.ResourceFun
 				metadata.ResourceData.Set("enforce", props.EnforcementMode == policy.EnforcementModeDefault)
 				metadata.ResourceData.Set("not_scopes", props.NotScopes)
 				metadata.ResourceData.Set("policy_definition_id", props.PolicyDefinitionID)
+				metadata.ResourceData.Set("definition_version", props.DefinitionVersion)
 
 				metadata.ResourceData.Set("non_compliance_message", br.flattenNonComplianceMessages(props.NonComplianceMessages))
 
@@ -232,6 +237,9 @@ func (br assignmentBaseResource) updateFunc() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("policy_definition_id") {
 				update.AssignmentProperties.PolicyDefinitionID = utils.String(metadata.ResourceData.Get("policy_definition_id").(string))
 			}
+			if metadata.ResourceData.HasChange("definition_version") {
+				update.AssignmentProperties.DefinitionVersion = utils.String(metadata.ResourceData.Get("definition_version").(string))
+			}
 
 			if metadata.ResourceData.HasChange("identity") {
 				if update.Location == nil {
@@ -307,6 +315,13 @@ func (br assignmentBaseResource) arguments(fields map[string]*pluginsdk.Schema)
 			),
 		},
 
+		"definition_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.PolicyDefinitionReferenceVersion,
+		},
+
 		"description": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
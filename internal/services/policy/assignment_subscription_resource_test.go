@@ -78,6 +78,22 @@ func TestAccSubscriptionPolicyAssignment_basicWithBuiltInPolicyNonComplianceMess
 	})
 }
 
+func TestAccSubscriptionPolicyAssignment_definitionVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_assignment", "test")
+	r := SubscriptionAssignmentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withBuiltInPolicyDefinitionVersion(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("definition_version").HasValue("1.*.*"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSubscriptionPolicyAssignment_basicWithBuiltInPolicySet(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_assignment", "test")
 	r := SubscriptionAssignmentTestResource{}
@@ -319,6 +335,33 @@ resource "azurerm_subscription_policy_assignment" "test" {
 `, template, data.RandomInteger, r.locations(data))
 }
 
+func (r SubscriptionAssignmentTestResource) withBuiltInPolicyDefinitionVersion(data acceptance.TestData) string {
+	template := r.template()
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+data "azurerm_policy_definition" "test" {
+  display_name = "Allowed locations"
+}
+
+resource "azurerm_subscription_policy_assignment" "test" {
+  name                 = "acctestpa-sub-%[2]d"
+  subscription_id      = data.azurerm_subscription.test.id
+  policy_definition_id = data.azurerm_policy_definition.test.id
+  definition_version   = "1.*.*"
+  parameters = jsonencode({
+    "listOfAllowedLocations" = {
+      "value" = %s
+    }
+  })
+}
+`, template, data.RandomInteger, r.locations(data))
+}
+
 func (r SubscriptionAssignmentTestResource) withBuiltInPolicyNonComplianceMessage(data acceptance.TestData) string {
 	template := r.template()
 	return fmt.Sprintf(`
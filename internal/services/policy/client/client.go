@@ -1,11 +1,11 @@
 package client
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/guestconfiguration/mgmt/2020-06-25/guestconfiguration"          // nolint: staticcheck
-	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy"               // nolint: staticcheck
-	policyPreview "github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/Azure/azure-sdk-for-go/services/guestconfiguration/mgmt/2020-06-25/guestconfiguration" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2021-10-01/remediations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2021-06-01-preview/policy"               // nolint: staticcheck
+	policyPreview "github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2021-06-01-preview/policy" // nolint: staticcheck
 )
 
 type Client struct {
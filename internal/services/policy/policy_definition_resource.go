@@ -7,12 +7,13 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	mgmtGrpParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -82,6 +83,10 @@ func resourceArmPolicyDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta int
 		Description: utils.String(description),
 	}
 
+	if version := d.Get("version").(string); version != "" {
+		properties.Version = utils.String(version)
+	}
+
 	if policyRuleString := d.Get("policy_rule").(string); policyRuleString != "" {
 		policyRule, err := pluginsdk.ExpandJsonFromString(policyRuleString)
 		if err != nil {
@@ -202,6 +207,7 @@ func resourceArmPolicyDefinitionRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("mode", props.Mode)
 		d.Set("display_name", props.DisplayName)
 		d.Set("description", props.Description)
+		d.Set("version", props.Version)
 
 		if policyRuleStr := flattenJSON(props.PolicyRule); policyRuleStr != "" {
 			d.Set("policy_rule", policyRuleStr)
@@ -349,6 +355,13 @@ func resourceArmPolicyDefinitionSchema() map[string]*pluginsdk.Schema {
 			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 		},
 
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.PolicyDefinitionVersion,
+		},
+
 		"role_definition_ids": {
 			Type:     pluginsdk.TypeList,
 			Computed: true,
@@ -10,13 +10,13 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	mgmtGrpParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2021-06-01-preview/policy" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -100,6 +100,13 @@ func resourcePolicySetDefinitionSchema() map[string]*pluginsdk.Schema {
 			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 		},
 
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.PolicyDefinitionVersion,
+		},
+
 		// lintignore: S013
 		"policy_definition_reference": {
 			Type:     pluginsdk.TypeList,
@@ -240,6 +247,10 @@ func resourceArmPolicySetDefinitionCreate(d *pluginsdk.ResourceData, meta interf
 		Description: utils.String(d.Get("description").(string)),
 	}
 
+	if version := d.Get("version").(string); version != "" {
+		properties.Version = utils.String(version)
+	}
+
 	if metaDataString := d.Get("metadata").(string); metaDataString != "" {
 		metaData, err := pluginsdk.ExpandJsonFromString(metaDataString)
 		if err != nil {
@@ -356,6 +367,10 @@ func resourceArmPolicySetDefinitionUpdate(d *pluginsdk.ResourceData, meta interf
 		existing.SetDefinitionProperties.Description = utils.String(d.Get("description").(string))
 	}
 
+	if d.HasChange("version") {
+		existing.SetDefinitionProperties.Version = utils.String(d.Get("version").(string))
+	}
+
 	if d.HasChange("metadata") {
 		metaDataString := d.Get("metadata").(string)
 		if metaDataString != "" {
@@ -459,6 +474,7 @@ func resourceArmPolicySetDefinitionRead(d *pluginsdk.ResourceData, meta interfac
 		d.Set("policy_type", string(props.PolicyType))
 		d.Set("display_name", props.DisplayName)
 		d.Set("description", props.Description)
+		d.Set("version", props.Version)
 
 		if metadata := props.Metadata; metadata != nil {
 			metadataVal := metadata.(map[string]interface{})
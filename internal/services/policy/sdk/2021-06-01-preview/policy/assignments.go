@@ -8,11 +8,12 @@ package policy
 
 import (
 	"context"
+	"net/http"
+
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
 	"github.com/Azure/go-autorest/tracing"
-	"net/http"
 )
 
 // AssignmentsClient is the client for the Assignments methods of the Policy service.
@@ -528,7 +529,7 @@ func (client AssignmentsClient) GetByIDResponder(resp *http.Response) (result As
 
 // List this operation retrieves the list of all policy assignments associated with the given subscription that match
 // the optional given $filter. Valid values for $filter are: 'atScope()', 'atExactScope()' or 'policyDefinitionId eq
-// '{value}''. If $filter is not provided, the unfiltered list includes all policy assignments associated with the
+// '{value}”. If $filter is not provided, the unfiltered list includes all policy assignments associated with the
 // subscription, including those that apply directly or from management groups that contain the given subscription, as
 // well as any applied to objects contained within the subscription. If $filter=atScope() is provided, the returned
 // list includes all policy assignments that apply to the subscription, which is everything in the unfiltered list
@@ -538,7 +539,7 @@ func (client AssignmentsClient) GetByIDResponder(resp *http.Response) (result As
 // {value}.
 // Parameters:
 // filter - the filter to apply on the operation. Valid values for $filter are: 'atScope()', 'atExactScope()'
-// or 'policyDefinitionId eq '{value}''. If $filter is not provided, no filtering is performed. If
+// or 'policyDefinitionId eq '{value}”. If $filter is not provided, no filtering is performed. If
 // $filter=atScope() is provided, the returned list only includes all policy assignments that apply to the
 // scope, which is everything in the unfiltered list except those applied to sub scopes contained within the
 // given scope. If $filter=atExactScope() is provided, the returned list only includes all policy assignments
@@ -674,7 +675,7 @@ func (client AssignmentsClient) ListComplete(ctx context.Context, filter string,
 
 // ListForManagementGroup this operation retrieves the list of all policy assignments applicable to the management
 // group that match the given $filter. Valid values for $filter are: 'atScope()', 'atExactScope()' or
-// 'policyDefinitionId eq '{value}''. If $filter=atScope() is provided, the returned list includes all policy
+// 'policyDefinitionId eq '{value}”. If $filter=atScope() is provided, the returned list includes all policy
 // assignments that are assigned to the management group or the management group's ancestors. If $filter=atExactScope()
 // is provided, the returned list only includes all policy assignments that at the management group. If
 // $filter=policyDefinitionId eq '{value}' is provided, the returned list includes all policy assignments of the policy
@@ -682,7 +683,7 @@ func (client AssignmentsClient) ListComplete(ctx context.Context, filter string,
 // Parameters:
 // managementGroupID - the ID of the management group.
 // filter - the filter to apply on the operation. Valid values for $filter are: 'atScope()', 'atExactScope()'
-// or 'policyDefinitionId eq '{value}''. If $filter is not provided, no filtering is performed. If
+// or 'policyDefinitionId eq '{value}”. If $filter is not provided, no filtering is performed. If
 // $filter=atScope() is provided, the returned list only includes all policy assignments that apply to the
 // scope, which is everything in the unfiltered list except those applied to sub scopes contained within the
 // given scope. If $filter=atExactScope() is provided, the returned list only includes all policy assignments
@@ -818,7 +819,7 @@ func (client AssignmentsClient) ListForManagementGroupComplete(ctx context.Conte
 
 // ListForResource this operation retrieves the list of all policy assignments associated with the specified resource
 // in the given resource group and subscription that match the optional given $filter. Valid values for $filter are:
-// 'atScope()', 'atExactScope()' or 'policyDefinitionId eq '{value}''. If $filter is not provided, the unfiltered list
+// 'atScope()', 'atExactScope()' or 'policyDefinitionId eq '{value}”. If $filter is not provided, the unfiltered list
 // includes all policy assignments associated with the resource, including those that apply directly or from all
 // containing scopes, as well as any applied to resources contained within the resource. If $filter=atScope() is
 // provided, the returned list includes all policy assignments that apply to the resource, which is everything in the
@@ -827,13 +828,13 @@ func (client AssignmentsClient) ListForManagementGroupComplete(ctx context.Conte
 // $filter=policyDefinitionId eq '{value}' is provided, the returned list includes all policy assignments of the policy
 // definition whose id is {value} that apply to the resource. Three parameters plus the resource name are used to
 // identify a specific resource. If the resource is not part of a parent resource (the more common case), the parent
-// resource path should not be provided (or provided as ''). For example a web app could be specified as
-// ({resourceProviderNamespace} == 'Microsoft.Web', {parentResourcePath} == '', {resourceType} == 'sites',
+// resource path should not be provided (or provided as ”). For example a web app could be specified as
+// ({resourceProviderNamespace} == 'Microsoft.Web', {parentResourcePath} == ”, {resourceType} == 'sites',
 // {resourceName} == 'MyWebApp'). If the resource is part of a parent resource, then all parameters should be provided.
 // For example a virtual machine DNS name could be specified as ({resourceProviderNamespace} == 'Microsoft.Compute',
 // {parentResourcePath} == 'virtualMachines/MyVirtualMachine', {resourceType} == 'domainNames', {resourceName} ==
 // 'MyComputerName'). A convenient alternative to providing the namespace and type name separately is to provide both
-// in the {resourceType} parameter, format: ({resourceProviderNamespace} == '', {parentResourcePath} == '',
+// in the {resourceType} parameter, format: ({resourceProviderNamespace} == ”, {parentResourcePath} == ”,
 // {resourceType} == 'Microsoft.Web/sites', {resourceName} == 'MyWebApp').
 // Parameters:
 // resourceGroupName - the name of the resource group containing the resource.
@@ -844,7 +845,7 @@ func (client AssignmentsClient) ListForManagementGroupComplete(ctx context.Conte
 // Microsoft.Web/sites).
 // resourceName - the name of the resource.
 // filter - the filter to apply on the operation. Valid values for $filter are: 'atScope()', 'atExactScope()'
-// or 'policyDefinitionId eq '{value}''. If $filter is not provided, no filtering is performed. If
+// or 'policyDefinitionId eq '{value}”. If $filter is not provided, no filtering is performed. If
 // $filter=atScope() is provided, the returned list only includes all policy assignments that apply to the
 // scope, which is everything in the unfiltered list except those applied to sub scopes contained within the
 // given scope. If $filter=atExactScope() is provided, the returned list only includes all policy assignments
@@ -989,7 +990,7 @@ func (client AssignmentsClient) ListForResourceComplete(ctx context.Context, res
 
 // ListForResourceGroup this operation retrieves the list of all policy assignments associated with the given resource
 // group in the given subscription that match the optional given $filter. Valid values for $filter are: 'atScope()',
-// 'atExactScope()' or 'policyDefinitionId eq '{value}''. If $filter is not provided, the unfiltered list includes all
+// 'atExactScope()' or 'policyDefinitionId eq '{value}”. If $filter is not provided, the unfiltered list includes all
 // policy assignments associated with the resource group, including those that apply directly or apply from containing
 // scopes, as well as any applied to resources contained within the resource group. If $filter=atScope() is provided,
 // the returned list includes all policy assignments that apply to the resource group, which is everything in the
@@ -1000,7 +1001,7 @@ func (client AssignmentsClient) ListForResourceComplete(ctx context.Context, res
 // Parameters:
 // resourceGroupName - the name of the resource group that contains policy assignments.
 // filter - the filter to apply on the operation. Valid values for $filter are: 'atScope()', 'atExactScope()'
-// or 'policyDefinitionId eq '{value}''. If $filter is not provided, no filtering is performed. If
+// or 'policyDefinitionId eq '{value}”. If $filter is not provided, no filtering is performed. If
 // $filter=atScope() is provided, the returned list only includes all policy assignments that apply to the
 // scope, which is everything in the unfiltered list except those applied to sub scopes contained within the
 // given scope. If $filter=atExactScope() is provided, the returned list only includes all policy assignments
@@ -8,10 +8,11 @@ package policy
 
 import (
 	"context"
+	"net/http"
+
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/tracing"
-	"net/http"
 )
 
 // DataPolicyManifestsClient is the client for the DataPolicyManifests methods of the Policy service.
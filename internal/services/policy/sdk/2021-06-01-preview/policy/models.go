@@ -9,15 +9,16 @@ package policy
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/Azure/go-autorest/tracing"
-	"net/http"
 )
 
 // The package's fully qualified name.
-const fqdn = "github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy"
+const fqdn = "github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2021-06-01-preview/policy"
 
 // Alias the alias type.
 type Alias struct {
@@ -398,6 +399,8 @@ type AssignmentProperties struct {
 	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
 	// NonComplianceMessages - The messages that describe why a resource is non-compliant with the policy.
 	NonComplianceMessages *[]NonComplianceMessage `json:"nonComplianceMessages,omitempty"`
+	// DefinitionVersion - The version of the policy definition to use.
+	DefinitionVersion *string `json:"definitionVersion,omitempty"`
 }
 
 // MarshalJSON is the custom marshaler for AssignmentProperties.
@@ -427,6 +430,9 @@ func (ap AssignmentProperties) MarshalJSON() ([]byte, error) {
 	if ap.NonComplianceMessages != nil {
 		objectMap["nonComplianceMessages"] = ap.NonComplianceMessages
 	}
+	if ap.DefinitionVersion != nil {
+		objectMap["definitionVersion"] = ap.DefinitionVersion
+	}
 	return json.Marshal(objectMap)
 }
 
@@ -1099,6 +1105,8 @@ type DefinitionProperties struct {
 	Metadata interface{} `json:"metadata,omitempty"`
 	// Parameters - The parameter definitions for parameters used in the policy rule. The keys are the parameter names.
 	Parameters map[string]*ParameterDefinitionsValue `json:"parameters"`
+	// Version - The policy definition version in #.#.# format.
+	Version *string `json:"version,omitempty"`
 }
 
 // MarshalJSON is the custom marshaler for DefinitionProperties.
@@ -1125,6 +1133,9 @@ func (dp DefinitionProperties) MarshalJSON() ([]byte, error) {
 	if dp.Parameters != nil {
 		objectMap["parameters"] = dp.Parameters
 	}
+	if dp.Version != nil {
+		objectMap["version"] = dp.Version
+	}
 	return json.Marshal(objectMap)
 }
 
@@ -1894,6 +1905,8 @@ type SetDefinitionProperties struct {
 	PolicyDefinitions *[]DefinitionReference `json:"policyDefinitions,omitempty"`
 	// PolicyDefinitionGroups - The metadata describing groups of policy definition references within the policy set definition.
 	PolicyDefinitionGroups *[]DefinitionGroup `json:"policyDefinitionGroups,omitempty"`
+	// Version - The policy set definition version in #.#.# format.
+	Version *string `json:"version,omitempty"`
 }
 
 // MarshalJSON is the custom marshaler for SetDefinitionProperties.
@@ -1920,6 +1933,9 @@ func (sdp SetDefinitionProperties) MarshalJSON() ([]byte, error) {
 	if sdp.PolicyDefinitionGroups != nil {
 		objectMap["policyDefinitionGroups"] = sdp.PolicyDefinitionGroups
 	}
+	if sdp.Version != nil {
+		objectMap["version"] = sdp.Version
+	}
 	return json.Marshal(objectMap)
 }
 
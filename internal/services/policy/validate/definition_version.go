@@ -0,0 +1,39 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// PolicyDefinitionVersion validates a fully qualified version of a policy
+// definition or policy set definition, e.g. `1.2.1`.
+func PolicyDefinitionVersion(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^\d+\.\d+\.\d+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `#.#.#`, e.g. `1.2.1`, got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+// PolicyDefinitionReferenceVersion validates the version of a policy
+// definition or policy set definition referenced by a policy assignment,
+// where the minor and patch segments may be wildcards, e.g. `1.*.*`.
+func PolicyDefinitionReferenceVersion(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^\d+\.(\d+|\*)\.(\d+|\*)$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `#.#.#`, where the minor and patch segments may be `*`, e.g. `1.2.1` or `1.*.*`, got %q", k, v))
+	}
+
+	return warnings, errors
+}
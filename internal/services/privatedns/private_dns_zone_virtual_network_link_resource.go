@@ -8,10 +8,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/virtualnetworklinks"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2018-09-01/virtualnetworklinks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -71,6 +71,13 @@ func resourcePrivateDnsZoneVirtualNetworkLink() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"resolution_policy": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(virtualnetworklinks.PossibleValuesForResolutionPolicy(), false),
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -107,6 +114,11 @@ func resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *pluginsdk.ResourceD
 		},
 	}
 
+	if v, ok := d.GetOk("resolution_policy"); ok {
+		resolutionPolicy := virtualnetworklinks.ResolutionPolicy(v.(string))
+		parameters.Properties.ResolutionPolicy = &resolutionPolicy
+	}
+
 	options := virtualnetworklinks.CreateOrUpdateOperationOptions{
 		IfMatch:     utils.String(""),
 		IfNoneMatch: utils.String(""),
@@ -147,6 +159,12 @@ func resourcePrivateDnsZoneVirtualNetworkLinkRead(d *pluginsdk.ResourceData, met
 		if props := model.Properties; props != nil {
 			d.Set("registration_enabled", props.RegistrationEnabled)
 
+			resolutionPolicy := ""
+			if props.ResolutionPolicy != nil {
+				resolutionPolicy = string(*props.ResolutionPolicy)
+			}
+			d.Set("resolution_policy", resolutionPolicy)
+
 			if network := props.VirtualNetwork; network != nil {
 				d.Set("virtual_network_id", network.Id)
 			}
@@ -0,0 +1,311 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2018-09-01/virtualnetworklinks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePrivateDnsZoneVirtualNetworkLinks() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsZoneVirtualNetworkLinksCreate,
+		Read:   resourcePrivateDnsZoneVirtualNetworkLinksRead,
+		Update: resourcePrivateDnsZoneVirtualNetworkLinksUpdate,
+		Delete: resourcePrivateDnsZoneVirtualNetworkLinksDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := virtualnetworklinks.ParsePrivateDnsZoneID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(3 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(3 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(3 * time.Hour),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"private_dns_zone_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"link": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"virtual_network_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"registration_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"resolution_policy": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(virtualnetworklinks.PossibleValuesForResolutionPolicy(), false),
+						},
+					},
+				},
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourcePrivateDnsZoneVirtualNetworkLinksCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId := virtualnetworklinks.NewPrivateDnsZoneID(subscriptionId, d.Get("resource_group_name").(string), d.Get("private_dns_zone_name").(string))
+
+	existing, err := client.ListComplete(ctx, zoneId, virtualnetworklinks.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing existing virtual network links within %s: %+v", zoneId, err)
+	}
+	if len(existing.Items) > 0 {
+		return tf.ImportAsExistsError("azurerm_private_dns_zone_virtual_network_links", zoneId.ID())
+	}
+
+	if err := reconcilePrivateDnsZoneVirtualNetworkLinks(ctx, client, zoneId, d, existing.Items); err != nil {
+		return err
+	}
+
+	d.SetId(zoneId.ID())
+	return resourcePrivateDnsZoneVirtualNetworkLinksRead(d, meta)
+}
+
+func resourcePrivateDnsZoneVirtualNetworkLinksUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := virtualnetworklinks.ParsePrivateDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.ListComplete(ctx, *zoneId, virtualnetworklinks.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing existing virtual network links within %s: %+v", *zoneId, err)
+	}
+
+	if err := reconcilePrivateDnsZoneVirtualNetworkLinks(ctx, client, *zoneId, d, existing.Items); err != nil {
+		return err
+	}
+
+	return resourcePrivateDnsZoneVirtualNetworkLinksRead(d, meta)
+}
+
+func resourcePrivateDnsZoneVirtualNetworkLinksRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := virtualnetworklinks.ParsePrivateDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListComplete(ctx, *zoneId, virtualnetworklinks.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing virtual network links within %s: %+v", *zoneId, err)
+	}
+
+	if len(resp.Items) == 0 {
+		log.Printf("[INFO] no virtual network links exist within %s - removing from state", *zoneId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("private_dns_zone_name", zoneId.PrivateDnsZoneName)
+	d.Set("resource_group_name", zoneId.ResourceGroupName)
+
+	links := make([]interface{}, 0)
+	for _, item := range resp.Items {
+		link := map[string]interface{}{}
+		if item.Name != nil {
+			link["name"] = *item.Name
+		}
+		if props := item.Properties; props != nil {
+			if props.RegistrationEnabled != nil {
+				link["registration_enabled"] = *props.RegistrationEnabled
+			}
+			resolutionPolicy := ""
+			if props.ResolutionPolicy != nil {
+				resolutionPolicy = string(*props.ResolutionPolicy)
+			}
+			link["resolution_policy"] = resolutionPolicy
+			if network := props.VirtualNetwork; network != nil && network.Id != nil {
+				link["virtual_network_id"] = *network.Id
+			}
+		}
+		links = append(links, link)
+	}
+	if err := d.Set("link", links); err != nil {
+		return fmt.Errorf("setting `link`: %+v", err)
+	}
+
+	// the tags are identical across every link managed by this resource, so the first link is authoritative
+	return tags.FlattenAndSet(d, resp.Items[0].Tags)
+}
+
+func resourcePrivateDnsZoneVirtualNetworkLinksDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := virtualnetworklinks.ParsePrivateDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.ListComplete(ctx, *zoneId, virtualnetworklinks.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing existing virtual network links within %s: %+v", *zoneId, err)
+	}
+
+	for _, item := range existing.Items {
+		if item.Name == nil {
+			continue
+		}
+
+		linkId := virtualnetworklinks.NewVirtualNetworkLinkID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateDnsZoneName, *item.Name)
+		if err := deletePrivateDnsZoneVirtualNetworkLink(ctx, client, linkId, d.Timeout(pluginsdk.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func reconcilePrivateDnsZoneVirtualNetworkLinks(ctx context.Context, client *virtualnetworklinks.VirtualNetworkLinksClient, zoneId virtualnetworklinks.PrivateDnsZoneId, d *pluginsdk.ResourceData, existing []virtualnetworklinks.VirtualNetworkLink) error {
+	t := tags.Expand(d.Get("tags").(map[string]interface{}))
+
+	desired := make(map[string]map[string]interface{})
+	for _, raw := range d.Get("link").(*pluginsdk.Set).List() {
+		link := raw.(map[string]interface{})
+		desired[link["name"].(string)] = link
+	}
+
+	// remove any links which exist within the zone but aren't defined in the configuration
+	for _, item := range existing {
+		if item.Name == nil {
+			continue
+		}
+		if _, ok := desired[*item.Name]; ok {
+			continue
+		}
+
+		linkId := virtualnetworklinks.NewVirtualNetworkLinkID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateDnsZoneName, *item.Name)
+		if err := deletePrivateDnsZoneVirtualNetworkLink(ctx, client, linkId, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	for name, link := range desired {
+		linkId := virtualnetworklinks.NewVirtualNetworkLinkID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateDnsZoneName, name)
+
+		parameters := virtualnetworklinks.VirtualNetworkLink{
+			Location: utils.String("global"),
+			Tags:     t,
+			Properties: &virtualnetworklinks.VirtualNetworkLinkProperties{
+				VirtualNetwork: &virtualnetworklinks.SubResource{
+					Id: utils.String(link["virtual_network_id"].(string)),
+				},
+				RegistrationEnabled: utils.Bool(link["registration_enabled"].(bool)),
+			},
+		}
+
+		if v := link["resolution_policy"].(string); v != "" {
+			resolutionPolicy := virtualnetworklinks.ResolutionPolicy(v)
+			parameters.Properties.ResolutionPolicy = &resolutionPolicy
+		}
+
+		options := virtualnetworklinks.CreateOrUpdateOperationOptions{
+			IfMatch:     utils.String(""),
+			IfNoneMatch: utils.String(""),
+		}
+
+		if err := client.CreateOrUpdateThenPoll(ctx, linkId, parameters, options); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", linkId, err)
+		}
+	}
+
+	return nil
+}
+
+func deletePrivateDnsZoneVirtualNetworkLink(ctx context.Context, client *virtualnetworklinks.VirtualNetworkLinksClient, id virtualnetworklinks.VirtualNetworkLinkId, timeout time.Duration) error {
+	options := virtualnetworklinks.DeleteOperationOptions{IfMatch: utils.String("")}
+
+	if err := client.DeleteThenPoll(ctx, id, options); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	// whilst the Delete above returns a Future, the Azure API's broken such that even though it's marked as "gone"
+	// it's still kicking around - so we have to poll until this is actually gone
+	log.Printf("[DEBUG] Waiting for %s to be deleted", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Available"},
+		Target:  []string{"NotFound"},
+		Refresh: func() (interface{}, string, error) {
+			log.Printf("[DEBUG] Checking to see if %s is still available", id)
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					log.Printf("[DEBUG] %s was not found", id)
+					return "NotFound", "NotFound", nil
+				}
+
+				return "", "error", err
+			}
+
+			log.Printf("[DEBUG] %s still exists", id)
+			return "Available", "Available", nil
+		},
+		Delay:                     30 * time.Second,
+		PollInterval:              10 * time.Second,
+		ContinuousTargetOccurence: 10,
+		Timeout:                   timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2018-09-01/virtualnetworklinks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsZoneVirtualNetworkLinksResource struct{}
+
+func TestAccPrivateDnsZoneVirtualNetworkLinks_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_virtual_network_links", "test")
+	r := PrivateDnsZoneVirtualNetworkLinksResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsZoneVirtualNetworkLinks_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_virtual_network_links", "test")
+	r := PrivateDnsZoneVirtualNetworkLinksResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PrivateDnsZoneVirtualNetworkLinksResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := virtualnetworklinks.ParsePrivateDnsZoneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDns.VirtualNetworkLinksClient.ListComplete(ctx, *id, virtualnetworklinks.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing virtual network links within %s: %+v", *id, err)
+	}
+
+	return utils.Bool(len(resp.Items) > 0), nil
+}
+
+func (PrivateDnsZoneVirtualNetworkLinksResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "first" {
+  name                = "vnet1-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_virtual_network" "second" {
+  name                = "vnet2-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.1.0.0/16"]
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r PrivateDnsZoneVirtualNetworkLinksResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_virtual_network_links" "test" {
+  private_dns_zone_name = azurerm_private_dns_zone.test.name
+  resource_group_name   = azurerm_resource_group.test.name
+
+  link {
+    name               = "acctestlink1-%[2]d"
+    virtual_network_id = azurerm_virtual_network.first.id
+  }
+
+  link {
+    name                 = "acctestlink2-%[2]d"
+    virtual_network_id   = azurerm_virtual_network.second.id
+    registration_enabled = true
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PrivateDnsZoneVirtualNetworkLinksResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_virtual_network_links" "test" {
+  private_dns_zone_name = azurerm_private_dns_zone.test.name
+  resource_group_name   = azurerm_resource_group.test.name
+
+  link {
+    name               = "acctestlink1-%[2]d"
+    virtual_network_id = azurerm_virtual_network.first.id
+    resolution_policy  = "NxDomainRedirect"
+  }
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
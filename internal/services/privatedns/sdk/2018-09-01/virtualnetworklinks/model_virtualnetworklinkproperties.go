@@ -6,6 +6,7 @@ package virtualnetworklinks
 type VirtualNetworkLinkProperties struct {
 	ProvisioningState       *ProvisioningState       `json:"provisioningState,omitempty"`
 	RegistrationEnabled     *bool                    `json:"registrationEnabled,omitempty"`
+	ResolutionPolicy        *ResolutionPolicy        `json:"resolutionPolicy,omitempty"`
 	VirtualNetwork          *SubResource             `json:"virtualNetwork,omitempty"`
 	VirtualNetworkLinkState *VirtualNetworkLinkState `json:"virtualNetworkLinkState,omitempty"`
 }
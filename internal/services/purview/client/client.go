@@ -1,19 +1,25 @@
 package client
 
 import (
-	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-07-01/account"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-07-01/account"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/kafkaconfiguration"
 )
 
 type Client struct {
-	AccountsClient *account.AccountClient
+	AccountsClient            *account.AccountClient
+	KafkaConfigurationsClient *kafkaconfiguration.KafkaConfigurationClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	accountsClient := account.NewAccountClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
 
+	kafkaConfigurationsClient := kafkaconfiguration.NewKafkaConfigurationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&kafkaConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AccountsClient: &accountsClient,
+		AccountsClient:            &accountsClient,
+		KafkaConfigurationsClient: &kafkaConfigurationsClient,
 	}
 }
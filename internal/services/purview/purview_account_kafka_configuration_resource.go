@@ -0,0 +1,228 @@
+package purview
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-07-01/account"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/kafkaconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePurviewAccountKafkaConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePurviewAccountKafkaConfigurationCreateUpdate,
+		Read:   resourcePurviewAccountKafkaConfigurationRead,
+		Update: resourcePurviewAccountKafkaConfigurationCreateUpdate,
+		Delete: resourcePurviewAccountKafkaConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := kafkaconfiguration.ParseKafkaConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9][-a-zA-Z0-9]{1,61}[a-zA-Z0-9]$`),
+					"The Kafka Configuration name must be between 3 and 63 characters long, it can contain only letters, numbers and hyphens, and the first and last characters must be a letter or number."),
+			},
+
+			"purview_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: account.ValidateAccountID,
+			},
+
+			"event_hub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: eventhubs.ValidateEventhubID,
+			},
+
+			"event_hub_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(kafkaconfiguration.EventHubTypeHook),
+					string(kafkaconfiguration.EventHubTypeNotification),
+				}, false),
+			},
+
+			"event_hub_partition_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"consumer_group": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"event_streaming_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"user_assigned_identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			},
+		},
+	}
+}
+
+func resourcePurviewAccountKafkaConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.KafkaConfigurationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := account.ParseAccountID(d.Get("purview_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := kafkaconfiguration.NewKafkaConfigurationID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_purview_account_kafka_configuration", id.ID())
+		}
+	}
+
+	eventHubType := kafkaconfiguration.EventHubType(d.Get("event_hub_type").(string))
+	eventStreamingState := kafkaconfiguration.EventStreamingStateDisabled
+	if d.Get("event_streaming_enabled").(bool) {
+		eventStreamingState = kafkaconfiguration.EventStreamingStateEnabled
+	}
+	eventStreamingType := kafkaconfiguration.EventStreamingTypeAzure
+
+	props := kafkaconfiguration.KafkaConfigurationProperties{
+		EventHubResourceId:  pointer.To(d.Get("event_hub_id").(string)),
+		EventHubType:        &eventHubType,
+		EventStreamingState: &eventStreamingState,
+		EventStreamingType:  &eventStreamingType,
+	}
+
+	if v, ok := d.GetOk("event_hub_partition_id"); ok {
+		props.EventHubPartitionId = pointer.To(v.(string))
+	}
+
+	if v, ok := d.GetOk("consumer_group"); ok {
+		props.ConsumerGroup = pointer.To(v.(string))
+	}
+
+	credentialsType := kafkaconfiguration.CredentialsTypeSystemAssigned
+	props.Credentials = &kafkaconfiguration.Credentials{
+		Type: &credentialsType,
+	}
+	if v, ok := d.GetOk("user_assigned_identity_id"); ok {
+		credentialsType = kafkaconfiguration.CredentialsTypeUserAssigned
+		props.Credentials = &kafkaconfiguration.Credentials{
+			IdentityId: pointer.To(v.(string)),
+			Type:       &credentialsType,
+		}
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, kafkaconfiguration.KafkaConfiguration{Properties: &props}); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePurviewAccountKafkaConfigurationRead(d, meta)
+}
+
+func resourcePurviewAccountKafkaConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.KafkaConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.KafkaConfigurationName)
+	d.Set("purview_account_id", account.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("event_hub_id", pointer.From(props.EventHubResourceId))
+			d.Set("event_hub_type", string(pointer.From(props.EventHubType)))
+			d.Set("event_hub_partition_id", pointer.From(props.EventHubPartitionId))
+			d.Set("consumer_group", pointer.From(props.ConsumerGroup))
+
+			eventStreamingEnabled := false
+			if props.EventStreamingState != nil {
+				eventStreamingEnabled = *props.EventStreamingState == kafkaconfiguration.EventStreamingStateEnabled
+			}
+			d.Set("event_streaming_enabled", eventStreamingEnabled)
+
+			userAssignedIdentityId := ""
+			if credentials := props.Credentials; credentials != nil && credentials.IdentityId != nil {
+				userAssignedIdentityId = *credentials.IdentityId
+			}
+			d.Set("user_assigned_identity_id", userAssignedIdentityId)
+		}
+	}
+
+	return nil
+}
+
+func resourcePurviewAccountKafkaConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.KafkaConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
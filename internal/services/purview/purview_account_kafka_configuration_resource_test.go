@@ -0,0 +1,169 @@
+package purview_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/kafkaconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PurviewAccountKafkaConfigurationResource struct{}
+
+func TestAccPurviewAccountKafkaConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_account_kafka_configuration", "test")
+	r := PurviewAccountKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPurviewAccountKafkaConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_account_kafka_configuration", "test")
+	r := PurviewAccountKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPurviewAccountKafkaConfiguration_hook(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_account_kafka_configuration", "test")
+	r := PurviewAccountKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hook(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PurviewAccountKafkaConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Purview.KafkaConfigurationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r PurviewAccountKafkaConfigurationResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_account_kafka_configuration" "test" {
+  name               = "acctestkafka%d"
+  purview_account_id = azurerm_purview_account.test.id
+  event_hub_id       = azurerm_eventhub.test.id
+  event_hub_type     = "Notification"
+}
+`, template, data.RandomInteger)
+}
+
+func (r PurviewAccountKafkaConfigurationResource) requiresImport(data acceptance.TestData) string {
+	template := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_account_kafka_configuration" "import" {
+  name               = azurerm_purview_account_kafka_configuration.test.name
+  purview_account_id = azurerm_purview_account_kafka_configuration.test.purview_account_id
+  event_hub_id       = azurerm_purview_account_kafka_configuration.test.event_hub_id
+  event_hub_type     = azurerm_purview_account_kafka_configuration.test.event_hub_type
+}
+`, template)
+}
+
+func (r PurviewAccountKafkaConfigurationResource) hook(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_consumer_group" "test" {
+  name                = "acctesteventhubcg-%d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  eventhub_name       = azurerm_eventhub.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_purview_account_kafka_configuration" "test" {
+  name                   = "acctestkafka%d"
+  purview_account_id     = azurerm_purview_account.test.id
+  event_hub_id           = azurerm_eventhub.test.id
+  event_hub_type         = "Hook"
+  event_hub_partition_id = "1"
+  consumer_group         = azurerm_eventhub_consumer_group.test.name
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
+
+func (r PurviewAccountKafkaConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-purview-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_purview_account" "test" {
+  name                      = "acctestsw%d"
+  resource_group_name       = azurerm_resource_group.test.name
+  location                  = azurerm_resource_group.test.location
+  managed_event_hub_enabled = false
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -11,10 +11,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-07-01/account"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-07-01/account"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -83,6 +83,12 @@ func resourcePurviewAccountCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	}
 	purviewAccount.Properties.PublicNetworkAccess = &publicNetworkAccessEnabled
 
+	managedEventHubState := account.ManagedEventHubStateDisabled
+	if d.Get("managed_event_hub_enabled").(bool) {
+		managedEventHubState = account.ManagedEventHubStateEnabled
+	}
+	purviewAccount.Properties.ManagedEventHubState = &managedEventHubState
+
 	if v, ok := d.GetOk("managed_resource_group_name"); ok {
 		purviewAccount.Properties.ManagedResourceGroupName = utils.String(v.(string))
 	}
@@ -140,6 +146,12 @@ func resourcePurviewAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 			}
 			d.Set("public_network_enabled", publicNetworkAccessEnabled)
 
+			managedEventHubEnabled := true
+			if props.ManagedEventHubState != nil {
+				managedEventHubEnabled = *props.ManagedEventHubState != account.ManagedEventHubStateDisabled
+			}
+			d.Set("managed_event_hub_enabled", managedEventHubEnabled)
+
 			managedResourceGroupName := ""
 			if props.ManagedResourceGroupName != nil {
 				managedResourceGroupName = *props.ManagedResourceGroupName
@@ -236,6 +248,12 @@ func resourcePurviewSchema() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"managed_event_hub_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
 		"managed_resource_group_name": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
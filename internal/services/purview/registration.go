@@ -30,6 +30,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_purview_account": resourcePurviewAccount(),
+		"azurerm_purview_account":                     resourcePurviewAccount(),
+		"azurerm_purview_account_kafka_configuration": resourcePurviewAccountKafkaConfiguration(),
 	}
 }
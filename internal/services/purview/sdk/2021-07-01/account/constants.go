@@ -5,6 +5,37 @@ import "strings"
 // Copyright (c) Microsoft Corporation. All rights reserved.
 // Licensed under the MIT License. See NOTICE.txt in the project root for license information.
 
+type ManagedEventHubState string
+
+const (
+	ManagedEventHubStateDisabled     ManagedEventHubState = "Disabled"
+	ManagedEventHubStateEnabled      ManagedEventHubState = "Enabled"
+	ManagedEventHubStateNotSpecified ManagedEventHubState = "NotSpecified"
+)
+
+func PossibleValuesForManagedEventHubState() []string {
+	return []string{
+		string(ManagedEventHubStateDisabled),
+		string(ManagedEventHubStateEnabled),
+		string(ManagedEventHubStateNotSpecified),
+	}
+}
+
+func parseManagedEventHubState(input string) (*ManagedEventHubState, error) {
+	vals := map[string]ManagedEventHubState{
+		"disabled":     ManagedEventHubStateDisabled,
+		"enabled":      ManagedEventHubStateEnabled,
+		"notspecified": ManagedEventHubStateNotSpecified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedEventHubState(input)
+	return &out, nil
+}
+
 type Name string
 
 const (
@@ -16,6 +16,7 @@ type AccountProperties struct {
 	CreatedByObjectId          *string                      `json:"createdByObjectId,omitempty"`
 	Endpoints                  *AccountEndpoints            `json:"endpoints,omitempty"`
 	FriendlyName               *string                      `json:"friendlyName,omitempty"`
+	ManagedEventHubState       *ManagedEventHubState        `json:"managedEventHubState,omitempty"`
 	ManagedResourceGroupName   *string                      `json:"managedResourceGroupName,omitempty"`
 	ManagedResources           *ManagedResources            `json:"managedResources,omitempty"`
 	PrivateEndpointConnections *[]PrivateEndpointConnection `json:"privateEndpointConnections,omitempty"`
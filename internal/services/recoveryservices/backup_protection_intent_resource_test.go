@@ -0,0 +1,207 @@
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type BackupProtectionIntentResource struct{}
+
+func TestAccBackupProtectionIntent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protection_intent", "test")
+	r := BackupProtectionIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBackupProtectionIntent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protection_intent", "test")
+	r := BackupProtectionIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t BackupProtectionIntentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackupProtectionIntentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ProtectionIntentClient.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.BackupProtectionIntentName)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id.String(), err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r BackupProtectionIntentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-bpi-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-rsv-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+  soft_delete_enabled = false
+}
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-bpvmw-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+
+  workload_type = "SQLDataBase"
+
+  settings {
+    time_zone           = "UTC"
+    compression_enabled = false
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "15:00"
+    }
+
+    retention_daily {
+      count = 8
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 15
+    }
+
+    simple_retention {
+      count = 8
+    }
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = "acctestvm%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  size                = "Standard_D2s_v3"
+  admin_username      = "adminuser"
+  admin_password      = "P@ssw0rd1234!"
+
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftSQLServer"
+    offer     = "sql2019-ws2019"
+    sku       = "sqldev"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  virtual_machine_id = azurerm_windows_virtual_machine.test.id
+  sql_license_type   = "PAYG"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r BackupProtectionIntentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protection_intent" "test" {
+  name                = "acctest-bpi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  workload_item_type  = "SQLInstance"
+  source_resource_id  = azurerm_windows_virtual_machine.test.id
+  backup_policy_id    = azurerm_backup_policy_vm_workload.test.id
+
+  depends_on = [azurerm_mssql_virtual_machine.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r BackupProtectionIntentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protection_intent" "import" {
+  name                = azurerm_backup_protection_intent.test.name
+  resource_group_name = azurerm_backup_protection_intent.test.resource_group_name
+  recovery_vault_name = azurerm_backup_protection_intent.test.recovery_vault_name
+  workload_item_type  = azurerm_backup_protection_intent.test.workload_item_type
+  source_resource_id  = azurerm_backup_protection_intent.test.source_resource_id
+  backup_policy_id    = azurerm_backup_protection_intent.test.backup_policy_id
+}
+`, r.basic(data))
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2022-10-01/vaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2021-12-01/backupresourcestorageconfigsnoncrr"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2021-12-01/backupresourcevaultconfigs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2021-12-01/resourceguardproxy"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationfabrics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationnetworkmappings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationpolicies"
@@ -22,6 +23,7 @@ type Client struct {
 	ProtectableItemsClient                    *backup.ProtectableItemsClient
 	ProtectedItemsClient                      *backup.ProtectedItemsClient
 	ProtectedItemsGroupClient                 *backup.ProtectedItemsGroupClient
+	ProtectionIntentClient                    *backup.ProtectionIntentClient
 	ProtectionPoliciesClient                  *backup.ProtectionPoliciesClient
 	ProtectionContainerOperationResultsClient *backup.ProtectionContainerOperationResultsClient
 	BackupProtectionContainersClient          *backup.ProtectionContainersClient
@@ -33,6 +35,7 @@ type Client struct {
 	VaultReplicationProvider                  *replicationrecoveryservicesproviders.ReplicationRecoveryServicesProvidersClient
 	VaultsSettingsClient                      *replicationvaultsetting.ReplicationVaultSettingClient
 	StorageConfigsClient                      *backupresourcestorageconfigsnoncrr.BackupResourceStorageConfigsNonCRRClient
+	ResourceGuardProxyClient                  *resourceguardproxy.ResourceGuardProxyClient
 	FabricClient                              *replicationfabrics.ReplicationFabricsClient
 	ProtectionContainerClient                 *replicationprotectioncontainers.ReplicationProtectionContainersClient
 	ReplicationPoliciesClient                 *replicationpolicies.ReplicationPoliciesClient
@@ -52,6 +55,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	storageConfigsClient := backupresourcestorageconfigsnoncrr.NewBackupResourceStorageConfigsNonCRRClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&storageConfigsClient.Client, o.ResourceManagerAuthorizer)
 
+	resourceGuardProxyClient := resourceguardproxy.NewResourceGuardProxyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resourceGuardProxyClient.Client, o.ResourceManagerAuthorizer)
+
 	vaultsClient := vaults.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -67,6 +73,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	protectedItemsGroupClient := backup.NewProtectedItemsGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&protectedItemsGroupClient.Client, o.ResourceManagerAuthorizer)
 
+	protectionIntentClient := backup.NewProtectionIntentClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&protectionIntentClient.Client, o.ResourceManagerAuthorizer)
+
 	protectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&protectionPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -107,6 +116,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ProtectableItemsClient:                    &protectableItemsClient,
 		ProtectedItemsClient:                      &protectedItemsClient,
 		ProtectedItemsGroupClient:                 &protectedItemsGroupClient,
+		ProtectionIntentClient:                    &protectionIntentClient,
 		ProtectionPoliciesClient:                  &protectionPoliciesClient,
 		ProtectionContainerOperationResultsClient: &backupProtectionContainerOperationResultsClient,
 		BackupProtectionContainersClient:          &backupProtectionContainersClient,
@@ -117,6 +127,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		VaultCertificatesClient:                   &vaultCertificatesClient,
 		VaultsSettingsClient:                      &vaultSettingsClient,
 		StorageConfigsClient:                      &storageConfigsClient,
+		ResourceGuardProxyClient:                  &resourceGuardProxyClient,
 		FabricClient:                              &fabricClient,
 		ProtectionContainerClient:                 &protectionContainerClient,
 		ReplicationPoliciesClient:                 &replicationPoliciesClient,
@@ -0,0 +1,172 @@
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2022-04-01/resourceguards"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2022-10-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2021-12-01/resourceguardproxy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VaultGuardProxyResource struct{}
+
+var _ sdk.Resource = VaultGuardProxyResource{}
+
+type VaultGuardProxyModel struct {
+	VaultId         string `tfschema:"vault_id"`
+	ResourceGuardId string `tfschema:"resource_guard_id"`
+}
+
+func (VaultGuardProxyResource) ResourceType() string {
+	return "azurerm_recovery_services_vault_resource_guard_association"
+}
+
+func (VaultGuardProxyResource) ModelObject() interface{} {
+	return &VaultGuardProxyModel{}
+}
+
+func (VaultGuardProxyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return resourceguardproxy.ValidateBackupResourceGuardProxyID
+}
+
+func (VaultGuardProxyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"vault_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: vaults.ValidateVaultID,
+		},
+
+		"resource_guard_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: resourceguards.ValidateResourceGuardID,
+		},
+	}
+}
+
+func (VaultGuardProxyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VaultGuardProxyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ResourceGuardProxyClient
+
+			var plan VaultGuardProxyModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			vaultId, err := vaults.ParseVaultID(plan.VaultId)
+			if err != nil {
+				return err
+			}
+
+			// the name of the proxy is restricted by the service.
+			id := resourceguardproxy.NewBackupResourceGuardProxyID(vaultId.SubscriptionId, vaultId.ResourceGroupName, vaultId.VaultName, "VaultProxy")
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			proxy := resourceguardproxy.ResourceGuardProxyBaseResource{
+				Properties: &resourceguardproxy.ResourceGuardProxyBase{
+					ResourceGuardResourceId: utils.String(plan.ResourceGuardId),
+				},
+			}
+
+			if _, err = client.Put(ctx, id, proxy); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VaultGuardProxyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ResourceGuardProxyClient
+
+			id, err := resourceguardproxy.ParseBackupResourceGuardProxyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := VaultGuardProxyModel{
+				VaultId: vaults.NewVaultID(id.SubscriptionId, id.ResourceGroupName, id.VaultName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ResourceGuardResourceId != nil {
+				state.ResourceGuardId = *model.Properties.ResourceGuardResourceId
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VaultGuardProxyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ResourceGuardProxyClient
+
+			var state VaultGuardProxyModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := resourceguardproxy.ParseBackupResourceGuardProxyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// deleting the proxy is a guarded operation, it needs to be unlocked before it can be removed.
+			unlockRequest := resourceguardproxy.UnlockDeleteRequest{
+				ResourceGuardOperationRequests: &[]string{
+					fmt.Sprintf("%s/deleteResourceGuardProxyRequests/default", state.ResourceGuardId),
+				},
+				ResourceToBeDeleted: utils.String(id.ID()),
+			}
+
+			if _, err = client.UnlockDelete(ctx, *id, unlockRequest); err != nil {
+				return fmt.Errorf("unlocking delete for %s: %+v", id, err)
+			}
+
+			if _, err = client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
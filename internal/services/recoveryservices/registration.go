@@ -29,6 +29,7 @@ func (r Registration) Resources() []sdk.Resource {
 		ReplicationPolicyHyperVResource{},
 		HyperVSiteResource{},
 		HyperVReplicationPolicyAssociationResource{},
+		VaultGuardProxyResource{},
 	}
 }
 
@@ -64,6 +65,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_backup_policy_file_share":                   resourceBackupProtectionPolicyFileShare(),
 		"azurerm_backup_protected_file_share":                resourceBackupProtectedFileShare(),
 		"azurerm_backup_protected_vm":                        resourceRecoveryServicesBackupProtectedVM(),
+		"azurerm_backup_protection_intent":                   resourceBackupProtectionIntent(),
 		"azurerm_backup_policy_vm":                           resourceBackupProtectionPolicyVM(),
 		"azurerm_recovery_services_vault":                    resourceRecoveryServicesVault(),
 		"azurerm_site_recovery_fabric":                       resourceSiteRecoveryFabric(),
@@ -2,8 +2,8 @@ package client
 
 import (
 	"github.com/Azure/go-autorest/autorest"
-	redis_2021_06_01 "github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	redis_2021_06_01 "github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01"
 )
 
 func NewClient(o *common.ClientOptions) *redis_2021_06_01.Client {
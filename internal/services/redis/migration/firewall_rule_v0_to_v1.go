@@ -4,7 +4,7 @@ import (
 	"context"
 	"log"
 
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/firewallrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -15,8 +15,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/patchschedules"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/redis"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -26,6 +24,8 @@ import (
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/patchschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -173,6 +173,16 @@ func resourceRedisCache() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"aad_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+
+						"flush_on_scale_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+
 						"rdb_backup_enabled": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -341,6 +351,13 @@ func resourceRedisCache() *pluginsdk.Resource {
 				},
 			},
 
+			"update_channel": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(redis.UpdateChannelStable),
+				ValidateFunc: validation.StringInSlice(redis.PossibleValuesForUpdateChannel(), false),
+			},
+
 			"tags": commonschema.Tags(),
 		},
 
@@ -402,6 +419,7 @@ func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error
 			MinimumTlsVersion:   pointer.To(redis.TlsVersion(d.Get("minimum_tls_version").(string))),
 			RedisConfiguration:  redisConfiguration,
 			PublicNetworkAccess: pointer.To(publicNetworkAccess),
+			UpdateChannel:       pointer.To(redis.UpdateChannel(d.Get("update_channel").(string))),
 		},
 		Identity: redisIdentity,
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
@@ -562,6 +580,10 @@ func resourceRedisCacheUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		parameters.Properties.RedisConfiguration = redisConfiguration
 	}
 
+	if d.HasChange("update_channel") {
+		parameters.Properties.UpdateChannel = pointer.To(redis.UpdateChannel(d.Get("update_channel").(string)))
+	}
+
 	if _, err := client.Update(ctx, *id, parameters); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
@@ -708,6 +730,12 @@ func resourceRedisCacheRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("redis_version", props.RedisVersion)
 		d.Set("tenant_settings", flattenTenantSettings(props.TenantSettings))
 
+		updateChannel := string(redis.UpdateChannelStable)
+		if props.UpdateChannel != nil {
+			updateChannel = string(*props.UpdateChannel)
+		}
+		d.Set("update_channel", updateChannel)
+
 		redisConfiguration, err := flattenRedisConfiguration(props.RedisConfiguration)
 		if err != nil {
 			return fmt.Errorf("flattening `redis_configuration`: %+v", err)
@@ -846,6 +874,15 @@ func expandRedisConfiguration(d *pluginsdk.ResourceData) (*redis.RedisCommonProp
 		output.AofBackupEnabled = utils.String(strconv.FormatBool(v))
 	}
 
+	// Entra ID (AAD) Authentication
+	if v := raw["aad_enabled"].(bool); v {
+		output.AadEnabled = utils.String(strconv.FormatBool(v))
+	}
+
+	if v := raw["flush_on_scale_enabled"].(bool); v {
+		output.FlushOnScale = utils.String(strconv.FormatBool(v))
+	}
+
 	if v := raw["aof_storage_connection_string_0"].(string); v != "" {
 		output.AofStorageConnectionString0 = utils.String(v)
 	}
@@ -996,6 +1033,22 @@ func flattenRedisConfiguration(input *redis.RedisCommonPropertiesRedisConfigurat
 		outputs["aof_storage_connection_string_1"] = *input.AofStorageConnectionString1
 	}
 
+	if v := input.AadEnabled; v != nil {
+		b, err := strconv.ParseBool(*v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `aad-enabled` %q: %+v", *v, err)
+		}
+		outputs["aad_enabled"] = b
+	}
+
+	if v := input.FlushOnScale; v != nil {
+		b, err := strconv.ParseBool(*v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `flush-on-scale` %q: %+v", *v, err)
+		}
+		outputs["flush_on_scale_enabled"] = b
+	}
+
 	// `authnotrequired` is not set for instances launched outside a VNET
 	outputs["enable_authentication"] = true
 	if v := input.Authnotrequired; v != nil {
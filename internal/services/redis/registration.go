@@ -35,8 +35,10 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_redis_cache":         resourceRedisCache(),
-		"azurerm_redis_firewall_rule": resourceRedisFirewallRule(),
-		"azurerm_redis_linked_server": resourceRedisLinkedServer(),
+		"azurerm_redis_cache":                          resourceRedisCache(),
+		"azurerm_redis_cache_access_policy":            resourceRedisCacheAccessPolicy(),
+		"azurerm_redis_cache_access_policy_assignment": resourceRedisCacheAccessPolicyAssignment(),
+		"azurerm_redis_firewall_rule":                  resourceRedisFirewallRule(),
+		"azurerm_redis_linked_server":                  resourceRedisLinkedServer(),
 	}
 }
@@ -0,0 +1,74 @@
+package accesspolicies
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AccessPolicyProvisioningState string
+
+const (
+	AccessPolicyProvisioningStateCanceled  AccessPolicyProvisioningState = "Canceled"
+	AccessPolicyProvisioningStateDeleted   AccessPolicyProvisioningState = "Deleted"
+	AccessPolicyProvisioningStateDeleting  AccessPolicyProvisioningState = "Deleting"
+	AccessPolicyProvisioningStateFailed    AccessPolicyProvisioningState = "Failed"
+	AccessPolicyProvisioningStateSucceeded AccessPolicyProvisioningState = "Succeeded"
+	AccessPolicyProvisioningStateUpdating  AccessPolicyProvisioningState = "Updating"
+)
+
+func PossibleValuesForAccessPolicyProvisioningState() []string {
+	return []string{
+		string(AccessPolicyProvisioningStateCanceled),
+		string(AccessPolicyProvisioningStateDeleted),
+		string(AccessPolicyProvisioningStateDeleting),
+		string(AccessPolicyProvisioningStateFailed),
+		string(AccessPolicyProvisioningStateSucceeded),
+		string(AccessPolicyProvisioningStateUpdating),
+	}
+}
+
+func parseAccessPolicyProvisioningState(input string) (*AccessPolicyProvisioningState, error) {
+	vals := map[string]AccessPolicyProvisioningState{
+		"canceled":  AccessPolicyProvisioningStateCanceled,
+		"deleted":   AccessPolicyProvisioningStateDeleted,
+		"deleting":  AccessPolicyProvisioningStateDeleting,
+		"failed":    AccessPolicyProvisioningStateFailed,
+		"succeeded": AccessPolicyProvisioningStateSucceeded,
+		"updating":  AccessPolicyProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AccessPolicyProvisioningState(input)
+	return &out, nil
+}
+
+type AccessPolicyType string
+
+const (
+	AccessPolicyTypeBuiltIn AccessPolicyType = "BuiltIn"
+	AccessPolicyTypeCustom  AccessPolicyType = "Custom"
+)
+
+func PossibleValuesForAccessPolicyType() []string {
+	return []string{
+		string(AccessPolicyTypeBuiltIn),
+		string(AccessPolicyTypeCustom),
+	}
+}
+
+func parseAccessPolicyType(input string) (*AccessPolicyType, error) {
+	vals := map[string]AccessPolicyType{
+		"builtin": AccessPolicyTypeBuiltIn,
+		"custom":  AccessPolicyTypeCustom,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AccessPolicyType(input)
+	return &out, nil
+}
@@ -0,0 +1,18 @@
+package accesspolicyassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AccessPolicyAssignmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAccessPolicyAssignmentsClientWithBaseURI(endpoint string) AccessPolicyAssignmentsClient {
+	return AccessPolicyAssignmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
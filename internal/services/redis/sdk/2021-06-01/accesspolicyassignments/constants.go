@@ -0,0 +1,46 @@
+package accesspolicyassignments
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AccessPolicyAssignmentProvisioningState string
+
+const (
+	AccessPolicyAssignmentProvisioningStateCanceled  AccessPolicyAssignmentProvisioningState = "Canceled"
+	AccessPolicyAssignmentProvisioningStateDeleted   AccessPolicyAssignmentProvisioningState = "Deleted"
+	AccessPolicyAssignmentProvisioningStateDeleting  AccessPolicyAssignmentProvisioningState = "Deleting"
+	AccessPolicyAssignmentProvisioningStateFailed    AccessPolicyAssignmentProvisioningState = "Failed"
+	AccessPolicyAssignmentProvisioningStateSucceeded AccessPolicyAssignmentProvisioningState = "Succeeded"
+	AccessPolicyAssignmentProvisioningStateUpdating  AccessPolicyAssignmentProvisioningState = "Updating"
+)
+
+func PossibleValuesForAccessPolicyAssignmentProvisioningState() []string {
+	return []string{
+		string(AccessPolicyAssignmentProvisioningStateCanceled),
+		string(AccessPolicyAssignmentProvisioningStateDeleted),
+		string(AccessPolicyAssignmentProvisioningStateDeleting),
+		string(AccessPolicyAssignmentProvisioningStateFailed),
+		string(AccessPolicyAssignmentProvisioningStateSucceeded),
+		string(AccessPolicyAssignmentProvisioningStateUpdating),
+	}
+}
+
+func parseAccessPolicyAssignmentProvisioningState(input string) (*AccessPolicyAssignmentProvisioningState, error) {
+	vals := map[string]AccessPolicyAssignmentProvisioningState{
+		"canceled":  AccessPolicyAssignmentProvisioningStateCanceled,
+		"deleted":   AccessPolicyAssignmentProvisioningStateDeleted,
+		"deleting":  AccessPolicyAssignmentProvisioningStateDeleting,
+		"failed":    AccessPolicyAssignmentProvisioningStateFailed,
+		"succeeded": AccessPolicyAssignmentProvisioningStateSucceeded,
+		"updating":  AccessPolicyAssignmentProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AccessPolicyAssignmentProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,11 @@
+package accesspolicyassignments
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RedisCacheAccessPolicyAssignmentProperties struct {
+	AccessPolicyName  string                                   `json:"accessPolicyName"`
+	ObjectId          string                                   `json:"objectId"`
+	ObjectIdAlias     string                                   `json:"objectIdAlias"`
+	ProvisioningState *AccessPolicyAssignmentProvisioningState `json:"provisioningState,omitempty"`
+}
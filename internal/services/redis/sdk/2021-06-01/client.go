@@ -2,14 +2,18 @@ package v2021_06_01
 
 import (
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/firewallrules"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/patchschedules"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/privateendpointconnections"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/privatelinkresources"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/redis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/accesspolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/accesspolicyassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/patchschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/privatelinkresources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/redis"
 )
 
 type Client struct {
+	AccessPolicies             *accesspolicies.AccessPoliciesClient
+	AccessPolicyAssignments    *accesspolicyassignments.AccessPolicyAssignmentsClient
 	FirewallRules              *firewallrules.FirewallRulesClient
 	PatchSchedules             *patchschedules.PatchSchedulesClient
 	PrivateEndpointConnections *privateendpointconnections.PrivateEndpointConnectionsClient
@@ -19,6 +23,12 @@ type Client struct {
 
 func NewClientWithBaseURI(endpoint string, configureAuthFunc func(c *autorest.Client)) Client {
 
+	accessPoliciesClient := accesspolicies.NewAccessPoliciesClientWithBaseURI(endpoint)
+	configureAuthFunc(&accessPoliciesClient.Client)
+
+	accessPolicyAssignmentsClient := accesspolicyassignments.NewAccessPolicyAssignmentsClientWithBaseURI(endpoint)
+	configureAuthFunc(&accessPolicyAssignmentsClient.Client)
+
 	firewallRulesClient := firewallrules.NewFirewallRulesClientWithBaseURI(endpoint)
 	configureAuthFunc(&firewallRulesClient.Client)
 
@@ -35,6 +45,8 @@ func NewClientWithBaseURI(endpoint string, configureAuthFunc func(c *autorest.Cl
 	configureAuthFunc(&redisClient.Client)
 
 	return Client{
+		AccessPolicies:             &accessPoliciesClient,
+		AccessPolicyAssignments:    &accessPolicyAssignmentsClient,
 		FirewallRules:              &firewallRulesClient,
 		PatchSchedules:             &patchSchedulesClient,
 		PrivateEndpointConnections: &privateEndpointConnectionsClient,
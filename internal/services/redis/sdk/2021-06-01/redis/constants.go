@@ -381,3 +381,31 @@ func parseTlsVersion(input string) (*TlsVersion, error) {
 	out := TlsVersion(input)
 	return &out, nil
 }
+
+type UpdateChannel string
+
+const (
+	UpdateChannelPreview UpdateChannel = "Preview"
+	UpdateChannelStable  UpdateChannel = "Stable"
+)
+
+func PossibleValuesForUpdateChannel() []string {
+	return []string{
+		string(UpdateChannelPreview),
+		string(UpdateChannelStable),
+	}
+}
+
+func parseUpdateChannel(input string) (*UpdateChannel, error) {
+	vals := map[string]UpdateChannel{
+		"preview": UpdateChannelPreview,
+		"stable":  UpdateChannelStable,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := UpdateChannel(input)
+	return &out, nil
+}
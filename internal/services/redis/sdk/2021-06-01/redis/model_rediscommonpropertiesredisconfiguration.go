@@ -4,6 +4,7 @@ package redis
 // Licensed under the MIT License. See NOTICE.txt in the project root for license information.
 
 type RedisCommonPropertiesRedisConfiguration struct {
+	AadEnabled                         *string `json:"aad-enabled,omitempty"`
 	AofBackupEnabled                   *string `json:"aof-backup-enabled,omitempty"`
 	AofStorageConnectionString0        *string `json:"aof-storage-connection-string-0,omitempty"`
 	AofStorageConnectionString1        *string `json:"aof-storage-connection-string-1,omitempty"`
@@ -13,6 +14,7 @@ type RedisCommonPropertiesRedisConfiguration struct {
 	MaxmemoryDelta                     *string `json:"maxmemory-delta,omitempty"`
 	MaxmemoryPolicy                    *string `json:"maxmemory-policy,omitempty"`
 	MaxmemoryReserved                  *string `json:"maxmemory-reserved,omitempty"`
+	FlushOnScale                       *string `json:"flush-on-scale,omitempty"`
 	NotifyKeyspaceEvents               *string `json:"notify-keyspace-events,omitempty"`
 	PreferredDataArchiveAuthMethod     *string `json:"preferred-data-archive-auth-method,omitempty"`
 	PreferredDataPersistenceAuthMethod *string `json:"preferred-data-persistence-auth-method,omitempty"`
@@ -16,4 +16,5 @@ type RedisCreateProperties struct {
 	StaticIP            *string                                  `json:"staticIP,omitempty"`
 	SubnetId            *string                                  `json:"subnetId,omitempty"`
 	TenantSettings      *map[string]string                       `json:"tenantSettings,omitempty"`
+	UpdateChannel       *UpdateChannel                           `json:"updateChannel,omitempty"`
 }
@@ -24,4 +24,5 @@ type RedisProperties struct {
 	StaticIP                   *string                                  `json:"staticIP,omitempty"`
 	SubnetId                   *string                                  `json:"subnetId,omitempty"`
 	TenantSettings             *map[string]string                       `json:"tenantSettings,omitempty"`
+	UpdateChannel              *UpdateChannel                           `json:"updateChannel,omitempty"`
 }
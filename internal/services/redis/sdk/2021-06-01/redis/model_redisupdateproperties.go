@@ -14,4 +14,5 @@ type RedisUpdateProperties struct {
 	ShardCount          *int64                                   `json:"shardCount,omitempty"`
 	Sku                 *Sku                                     `json:"sku,omitempty"`
 	TenantSettings      *map[string]string                       `json:"tenantSettings,omitempty"`
+	UpdateChannel       *UpdateChannel                           `json:"updateChannel,omitempty"`
 }
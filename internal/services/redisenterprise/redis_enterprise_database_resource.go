@@ -444,7 +444,7 @@ func resourceRedisEnterpriseDatabaseUpdate(d *pluginsdk.ResourceData, meta inter
 
 	future, err := client.Create(ctx, id, parameters)
 	if err != nil {
-		return fmt.Errorf("updatig %s: %+v", id, err)
+		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
 	if err := future.Poller.PollUntilDone(); err != nil {
@@ -4,11 +4,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	networkrulesets "github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2021-11-01/namespaces"
 )
 
 type Client struct {
 	HybridConnectionsClient *hybridconnections.HybridConnectionsClient
 	NamespacesClient        *namespaces.NamespacesClient
+	NetworkRuleSetsClient   *networkrulesets.NamespacesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,8 +20,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	namespacesClient := namespaces.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&namespacesClient.Client, o.ResourceManagerAuthorizer)
 
+	networkRuleSetsClient := networkrulesets.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&networkRuleSetsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		HybridConnectionsClient: &hybridConnectionsClient,
 		NamespacesClient:        &namespacesClient,
+		NetworkRuleSetsClient:   &networkRuleSetsClient,
 	}
 }
@@ -37,5 +37,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_relay_hybrid_connection_authorization_rule": resourceRelayHybridConnectionAuthorizationRule(),
 		"azurerm_relay_namespace":                            resourceRelayNamespace(),
 		"azurerm_relay_namespace_authorization_rule":         resourceRelayNamespaceAuthorizationRule(),
+		"azurerm_relay_namespace_network_rule_set":           resourceRelayNamespaceNetworkRuleSet(),
 	}
 }
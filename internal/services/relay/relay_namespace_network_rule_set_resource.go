@@ -0,0 +1,255 @@
+package relay
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2021-11-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceRelayNamespaceNetworkRuleSet() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRelayNamespaceNetworkRuleSetCreateUpdate,
+		Read:   resourceRelayNamespaceNetworkRuleSetRead,
+		Update: resourceRelayNamespaceNetworkRuleSetCreateUpdate,
+		Delete: resourceRelayNamespaceNetworkRuleSetDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := namespaces.ParseNamespaceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			//lintignore: S013
+			"namespace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: namespaces.ValidateNamespaceID,
+			},
+
+			"default_action": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(namespaces.DefaultActionAllow),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(namespaces.DefaultActionAllow),
+					string(namespaces.DefaultActionDeny),
+				}, false),
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"ip_rules": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceRelayNamespaceNetworkRuleSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Relay.NetworkRuleSetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Get("namespace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.GetNetworkRuleSet(ctx, *id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+		}
+
+		// This resource is unique to the corresponding relay namespace.
+		// It will be created automatically along with the namespace, therefore we check whether this resource is identical to a "deleted" one
+		if model := existing.Model; model != nil {
+			if !relayNamespaceNetworkRuleSetNullified(*model) {
+				return tf.ImportAsExistsError("azurerm_relay_namespace_network_rule_set", id.ID())
+			}
+		}
+	}
+
+	defaultAction := namespaces.DefaultAction(d.Get("default_action").(string))
+	ipRule := expandRelayNamespaceIPRules(d.Get("ip_rules").(*pluginsdk.Set).List())
+
+	// API doesn't accept "Deny" to be set for "default_action" if no "ip_rules" is defined and returns no error message to the user
+	if defaultAction == namespaces.DefaultActionDeny && ipRule == nil {
+		return fmt.Errorf("the default action of %s can only be set to `Allow` if no `ip_rules` is set", id)
+	}
+
+	publicNetworkAccess := namespaces.PublicNetworkAccessEnabled
+	if !d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = namespaces.PublicNetworkAccessDisabled
+	}
+
+	parameters := namespaces.NetworkRuleSet{
+		Properties: &namespaces.NetworkRuleSetProperties{
+			DefaultAction:       &defaultAction,
+			IPRules:             ipRule,
+			PublicNetworkAccess: &publicNetworkAccess,
+		},
+	}
+
+	if _, err := client.CreateOrUpdateNetworkRuleSet(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceRelayNamespaceNetworkRuleSetRead(d, meta)
+}
+
+func resourceRelayNamespaceNetworkRuleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Relay.NetworkRuleSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetNetworkRuleSet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("%s was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("namespace_id", id.ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			defaultAction := ""
+			if v := props.DefaultAction; v != nil {
+				defaultAction = string(*v)
+			}
+			d.Set("default_action", defaultAction)
+
+			publicNetworkAccess := string(namespaces.PublicNetworkAccessEnabled)
+			if v := props.PublicNetworkAccess; v != nil {
+				publicNetworkAccess = string(*v)
+			}
+			d.Set("public_network_access_enabled", strings.EqualFold(publicNetworkAccess, string(namespaces.PublicNetworkAccessEnabled)))
+
+			if err := d.Set("ip_rules", flattenRelayNamespaceIPRules(props.IPRules)); err != nil {
+				return fmt.Errorf("setting `ip_rules`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceRelayNamespaceNetworkRuleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Relay.NetworkRuleSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// A network rule set is unique to a namespace and cannot be deleted.
+	// Therefore we here are just disabling it by setting the default_action to allow and removing all its rules
+	defaultAction := namespaces.DefaultActionAllow
+	publicNetworkAccess := namespaces.PublicNetworkAccessEnabled
+	parameters := namespaces.NetworkRuleSet{
+		Properties: &namespaces.NetworkRuleSetProperties{
+			DefaultAction:       &defaultAction,
+			PublicNetworkAccess: &publicNetworkAccess,
+		},
+	}
+
+	if _, err := client.CreateOrUpdateNetworkRuleSet(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandRelayNamespaceIPRules(input []interface{}) *[]namespaces.NWRuleSetIPRules {
+	if len(input) == 0 {
+		return nil
+	}
+
+	action := namespaces.NetworkRuleIPActionAllow
+	result := make([]namespaces.NWRuleSetIPRules, 0)
+	for _, v := range input {
+		result = append(result, namespaces.NWRuleSetIPRules{
+			IPMask: utils.String(v.(string)),
+			Action: &action,
+		})
+	}
+
+	return &result
+}
+
+func flattenRelayNamespaceIPRules(input *[]namespaces.NWRuleSetIPRules) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil || len(*input) == 0 {
+		return result
+	}
+
+	for _, v := range *input {
+		if v.IPMask != nil {
+			result = append(result, *v.IPMask)
+		}
+	}
+
+	return result
+}
+
+func relayNamespaceNetworkRuleSetNullified(resp namespaces.NetworkRuleSet) bool {
+	if resp.Id == nil || *resp.Id == "" {
+		return true
+	}
+
+	if props := resp.Properties; props != nil {
+		if props.DefaultAction != nil && *props.DefaultAction != namespaces.DefaultActionAllow {
+			return false
+		}
+
+		if props.IPRules != nil && len(*props.IPRules) > 0 {
+			return false
+		}
+
+		if props.PublicNetworkAccess != nil && *props.PublicNetworkAccess != namespaces.PublicNetworkAccessEnabled {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,165 @@
+package relay_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2021-11-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RelayNamespaceNetworkRuleSetResource struct{}
+
+func TestAccRelayNamespaceNetworkRuleSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_namespace_network_rule_set", "test")
+	r := RelayNamespaceNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRelayNamespaceNetworkRuleSet_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_namespace_network_rule_set", "test")
+	r := RelayNamespaceNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_action").HasValue("Deny"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRelayNamespaceNetworkRuleSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_namespace_network_rule_set", "test")
+	r := RelayNamespaceNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRelayNamespaceNetworkRuleSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_namespace_network_rule_set", "test")
+	r := RelayNamespaceNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t RelayNamespaceNetworkRuleSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := namespaces.ParseNamespaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Relay.NetworkRuleSetsClient.GetNetworkRuleSet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r RelayNamespaceNetworkRuleSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_namespace_network_rule_set" "test" {
+  namespace_id = azurerm_relay_namespace.test.id
+
+  ip_rules = ["1.1.1.1"]
+}
+`, r.template(data))
+}
+
+func (r RelayNamespaceNetworkRuleSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_namespace_network_rule_set" "test" {
+  namespace_id = azurerm_relay_namespace.test.id
+
+  default_action                = "Deny"
+  public_network_access_enabled = true
+
+  ip_rules = ["1.1.1.1", "2.2.2.2/24"]
+}
+`, r.template(data))
+}
+
+func (RelayNamespaceNetworkRuleSetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-relay-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r RelayNamespaceNetworkRuleSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_namespace_network_rule_set" "import" {
+  namespace_id = azurerm_relay_namespace_network_rule_set.test.namespace_id
+
+  ip_rules = ["1.1.1.1"]
+}
+`, r.basic(data))
+}
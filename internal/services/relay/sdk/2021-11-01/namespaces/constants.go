@@ -0,0 +1,87 @@
+package namespaces
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DefaultAction string
+
+const (
+	DefaultActionAllow DefaultAction = "Allow"
+	DefaultActionDeny  DefaultAction = "Deny"
+)
+
+func PossibleValuesForDefaultAction() []string {
+	return []string{
+		string(DefaultActionAllow),
+		string(DefaultActionDeny),
+	}
+}
+
+func parseDefaultAction(input string) (*DefaultAction, error) {
+	vals := map[string]DefaultAction{
+		"allow": DefaultActionAllow,
+		"deny":  DefaultActionDeny,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DefaultAction(input)
+	return &out, nil
+}
+
+type NetworkRuleIPAction string
+
+const (
+	NetworkRuleIPActionAllow NetworkRuleIPAction = "Allow"
+)
+
+func PossibleValuesForNetworkRuleIPAction() []string {
+	return []string{
+		string(NetworkRuleIPActionAllow),
+	}
+}
+
+func parseNetworkRuleIPAction(input string) (*NetworkRuleIPAction, error) {
+	vals := map[string]NetworkRuleIPAction{
+		"allow": NetworkRuleIPActionAllow,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NetworkRuleIPAction(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
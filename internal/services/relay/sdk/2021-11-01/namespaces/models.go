@@ -0,0 +1,22 @@
+package namespaces
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type NetworkRuleSet struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *NetworkRuleSetProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type NetworkRuleSetProperties struct {
+	DefaultAction       *DefaultAction       `json:"defaultAction,omitempty"`
+	IPRules             *[]NWRuleSetIPRules  `json:"ipRules,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+}
+
+type NWRuleSetIPRules struct {
+	Action *NetworkRuleIPAction `json:"action,omitempty"`
+	IPMask *string              `json:"ipMask,omitempty"`
+}
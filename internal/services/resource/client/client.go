@@ -8,11 +8,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2022-08-01-preview/deploymentstacks"
 )
 
 type Client struct {
 	DeploymentsClient           *resources.DeploymentsClient
 	DeploymentScriptsClient     *deploymentscripts.DeploymentScriptsClient
+	DeploymentStacksClient      *deploymentstacks.DeploymentStacksClient
 	FeaturesClient              *features.Client
 	GroupsClient                *resources.GroupsClient
 	LocksClient                 *managementlocks.ManagementLocksClient
@@ -32,6 +34,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	deploymentScriptsClient := deploymentscripts.NewDeploymentScriptsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&deploymentScriptsClient.Client, o.ResourceManagerAuthorizer)
 
+	deploymentStacksClient := deploymentstacks.NewDeploymentStacksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&deploymentStacksClient.Client, o.ResourceManagerAuthorizer)
+
 	featuresClient := features.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&featuresClient.Client, o.ResourceManagerAuthorizer)
 
@@ -62,6 +67,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		GroupsClient:                &groupsClient,
 		DeploymentsClient:           &deploymentsClient,
 		DeploymentScriptsClient:     &deploymentScriptsClient,
+		DeploymentStacksClient:      &deploymentStacksClient,
 		FeaturesClient:              &featuresClient,
 		LocksClient:                 &locksClient,
 		ProvidersClient:             &providersClient,
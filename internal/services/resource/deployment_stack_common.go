@@ -0,0 +1,224 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2022-08-01-preview/deploymentstacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func deploymentStackDenySettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"mode": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(deploymentstacks.PossibleValuesForDenySettingsMode(), false),
+				},
+
+				"apply_to_child_scopes": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"excluded_actions": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 200,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"excluded_principals": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 5,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func deploymentStackActionOnUnmanageSchema(supportsManagementGroups bool) *pluginsdk.Schema {
+	fields := map[string]*pluginsdk.Schema{
+		"resources": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(deploymentstacks.PossibleValuesForDeploymentStacksDeleteDetachEnum(), false),
+		},
+
+		"resource_groups": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(deploymentstacks.DeploymentStacksDeleteDetachEnumDetach),
+			ValidateFunc: validation.StringInSlice(deploymentstacks.PossibleValuesForDeploymentStacksDeleteDetachEnum(), false),
+		},
+	}
+
+	if supportsManagementGroups {
+		fields["management_groups"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(deploymentstacks.DeploymentStacksDeleteDetachEnumDetach),
+			ValidateFunc: validation.StringInSlice(deploymentstacks.PossibleValuesForDeploymentStacksDeleteDetachEnum(), false),
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandDeploymentStackDenySettings(input []interface{}) deploymentstacks.DenySettings {
+	raw := input[0].(map[string]interface{})
+
+	return deploymentstacks.DenySettings{
+		Mode:               deploymentstacks.DenySettingsMode(raw["mode"].(string)),
+		ApplyToChildScopes: utils.Bool(raw["apply_to_child_scopes"].(bool)),
+		ExcludedActions:    utils.ExpandStringSlice(raw["excluded_actions"].([]interface{})),
+		ExcludedPrincipals: utils.ExpandStringSlice(raw["excluded_principals"].([]interface{})),
+	}
+}
+
+func flattenDeploymentStackDenySettings(input deploymentstacks.DenySettings) []interface{} {
+	applyToChildScopes := false
+	if input.ApplyToChildScopes != nil {
+		applyToChildScopes = *input.ApplyToChildScopes
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                  string(input.Mode),
+			"apply_to_child_scopes": applyToChildScopes,
+			"excluded_actions":      utils.FlattenStringSlice(input.ExcludedActions),
+			"excluded_principals":   utils.FlattenStringSlice(input.ExcludedPrincipals),
+		},
+	}
+}
+
+func expandDeploymentStackActionOnUnmanage(input []interface{}, supportsManagementGroups bool) deploymentstacks.DeploymentStackPropertiesActionOnUnmanage {
+	raw := input[0].(map[string]interface{})
+
+	resourceGroups := deploymentstacks.DeploymentStacksDeleteDetachEnum(raw["resource_groups"].(string))
+	output := deploymentstacks.DeploymentStackPropertiesActionOnUnmanage{
+		Resources:      deploymentstacks.DeploymentStacksDeleteDetachEnum(raw["resources"].(string)),
+		ResourceGroups: &resourceGroups,
+	}
+
+	if supportsManagementGroups {
+		managementGroups := deploymentstacks.DeploymentStacksDeleteDetachEnum(raw["management_groups"].(string))
+		output.ManagementGroups = &managementGroups
+	}
+
+	return output
+}
+
+func flattenDeploymentStackActionOnUnmanage(input deploymentstacks.DeploymentStackPropertiesActionOnUnmanage, supportsManagementGroups bool) []interface{} {
+	resourceGroups := deploymentstacks.DeploymentStacksDeleteDetachEnumDetach
+	if input.ResourceGroups != nil {
+		resourceGroups = *input.ResourceGroups
+	}
+
+	output := map[string]interface{}{
+		"resources":       string(input.Resources),
+		"resource_groups": string(resourceGroups),
+	}
+
+	if supportsManagementGroups {
+		managementGroups := deploymentstacks.DeploymentStacksDeleteDetachEnumDetach
+		if input.ManagementGroups != nil {
+			managementGroups = *input.ManagementGroups
+		}
+		output["management_groups"] = string(managementGroups)
+	}
+
+	return []interface{}{output}
+}
+
+func expandDeploymentStackProperties(d *pluginsdk.ResourceData, supportsManagementGroups bool) (*deploymentstacks.DeploymentStackProperties, error) {
+	props := deploymentstacks.DeploymentStackProperties{
+		ActionOnUnmanage: expandDeploymentStackActionOnUnmanage(d.Get("action_on_unmanage").([]interface{}), supportsManagementGroups),
+		DenySettings:     expandDeploymentStackDenySettings(d.Get("deny_settings").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		props.Description = utils.String(v.(string))
+	}
+
+	if templateRaw, ok := d.GetOk("template_content"); ok {
+		template, err := expandTemplateDeploymentBody(templateRaw.(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		var t interface{} = *template
+		props.Template = &t
+	}
+
+	if templateSpecVersionID, ok := d.GetOk("template_spec_version_id"); ok {
+		props.TemplateLink = &deploymentstacks.DeploymentStacksTemplateLink{
+			Id: utils.String(templateSpecVersionID.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("parameters_content"); ok && v != "" {
+		parameters, err := expandTemplateDeploymentBody(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		var p interface{} = *parameters
+		props.Parameters = &p
+	}
+
+	return &props, nil
+}
+
+// deploymentStackUnmanageActions returns the unmanage actions configured on the deployment stack,
+// which are passed as query parameters when the stack is deleted
+func deploymentStackUnmanageActions(d *pluginsdk.ResourceData) (resources deploymentstacks.DeploymentStacksDeleteDetachEnum, resourceGroups deploymentstacks.DeploymentStacksDeleteDetachEnum, managementGroups deploymentstacks.DeploymentStacksDeleteDetachEnum) {
+	resources = deploymentstacks.DeploymentStacksDeleteDetachEnumDetach
+	resourceGroups = deploymentstacks.DeploymentStacksDeleteDetachEnumDetach
+	managementGroups = deploymentstacks.DeploymentStacksDeleteDetachEnumDetach
+
+	raw := d.Get("action_on_unmanage").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return
+	}
+
+	config := raw[0].(map[string]interface{})
+	resources = deploymentstacks.DeploymentStacksDeleteDetachEnum(config["resources"].(string))
+	if v, ok := config["resource_groups"]; ok {
+		resourceGroups = deploymentstacks.DeploymentStacksDeleteDetachEnum(v.(string))
+	}
+	if v, ok := config["management_groups"]; ok {
+		managementGroups = deploymentstacks.DeploymentStacksDeleteDetachEnum(v.(string))
+	}
+
+	return
+}
+
+func flattenDeploymentStackOutputs(input *interface{}) (*string, error) {
+	if input == nil {
+		output := "{}"
+		return &output, nil
+	}
+
+	return flattenTemplateDeploymentBody(*input)
+}
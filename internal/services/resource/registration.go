@@ -29,13 +29,16 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_resources":                            dataSourceResources(),
-		"azurerm_resource_group":                       dataSourceResourceGroup(),
-		"azurerm_template_spec_version":                dataSourceTemplateSpecVersion(),
-		"azurerm_management_group_template_deployment": dataSourceManagementGroupTemplateDeployment(),
-		"azurerm_resource_group_template_deployment":   dataSourceResourceGroupTemplateDeployment(),
-		"azurerm_subscription_template_deployment":     dataSourceSubscriptionTemplateDeployment(),
-		"azurerm_tenant_template_deployment":           dataSourceTenantTemplateDeployment(),
+		"azurerm_resources":                                dataSourceResources(),
+		"azurerm_resource_group":                           dataSourceResourceGroup(),
+		"azurerm_template_spec_version":                    dataSourceTemplateSpecVersion(),
+		"azurerm_template_spec_versions":                   dataSourceTemplateSpecVersions(),
+		"azurerm_management_group_template_deployment":     dataSourceManagementGroupTemplateDeployment(),
+		"azurerm_resource_group_template_deployment":       dataSourceResourceGroupTemplateDeployment(),
+		"azurerm_subscription_template_deployment":         dataSourceSubscriptionTemplateDeployment(),
+		"azurerm_subscription_template_deployment_what_if": dataSourceSubscriptionTemplateDeploymentWhatIf(),
+		"azurerm_tenant_template_deployment":               dataSourceTenantTemplateDeployment(),
+		"azurerm_tenant_template_deployment_what_if":       dataSourceTenantTemplateDeploymentWhatIf(),
 	}
 }
 
@@ -43,9 +46,12 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_management_lock":                      resourceManagementLock(),
+		"azurerm_management_group_deployment_stack":    managementGroupDeploymentStackResource(),
 		"azurerm_management_group_template_deployment": managementGroupTemplateDeploymentResource(),
 		"azurerm_resource_group":                       resourceResourceGroup(),
+		"azurerm_resource_group_deployment_stack":      resourceGroupDeploymentStackResource(),
 		"azurerm_resource_group_template_deployment":   resourceGroupTemplateDeploymentResource(),
+		"azurerm_subscription_deployment_stack":        subscriptionDeploymentStackResource(),
 		"azurerm_subscription_template_deployment":     subscriptionTemplateDeploymentResource(),
 		"azurerm_template_deployment":                  resourceTemplateDeployment(),
 		"azurerm_tenant_template_deployment":           tenantTemplateDeploymentResource(),
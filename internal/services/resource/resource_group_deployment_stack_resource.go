@@ -0,0 +1,259 @@
+package resource
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2022-08-01-preview/deploymentstacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceGroupDeploymentStackResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceGroupDeploymentStackResourceCreate,
+		Read:   resourceGroupDeploymentStackResourceRead,
+		Update: resourceGroupDeploymentStackResourceUpdate,
+		Delete: resourceGroupDeploymentStackResourceDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := deploymentstacks.ParseDeploymentStackID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(180 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
+		},
+
+		// (lintignore needed as we need to make sure the JSON is usable in `output_content`)
+
+		// lintignore:S033
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TemplateDeploymentName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"action_on_unmanage": deploymentStackActionOnUnmanageSchema(false),
+
+			"deny_settings": deploymentStackDenySettingsSchema(),
+
+			"template_content": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"template_content",
+					"template_spec_version_id",
+				},
+				StateFunc: utils.NormalizeJson,
+			},
+
+			"template_spec_version_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"template_content",
+					"template_spec_version_id",
+				},
+				ValidateFunc: validate.TemplateSpecVersionID,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+
+			"parameters_content": {
+				Type:      pluginsdk.TypeString,
+				Optional:  true,
+				StateFunc: utils.NormalizeJson,
+			},
+
+			"tags": commonschema.Tags(),
+
+			// Computed
+			"deployment_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"output_content": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
+				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
+			},
+		},
+	}
+}
+
+func resourceGroupDeploymentStackResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentStacksClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := deploymentstacks.NewDeploymentStackID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.GetAtResourceGroup(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_resource_group_deployment_stack", id.ID())
+	}
+
+	props, err := expandDeploymentStackProperties(d, false)
+	if err != nil {
+		return err
+	}
+
+	stack := deploymentstacks.DeploymentStack{
+		Properties: props,
+		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Provisioning %s..", id)
+	if err := client.CreateOrUpdateAtResourceGroupThenPoll(ctx, id, stack); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceGroupDeploymentStackResourceRead(d, meta)
+}
+
+func resourceGroupDeploymentStackResourceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentStacksClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := deploymentstacks.ParseDeploymentStackID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the API doesn't have a Patch operation, so the stack is re-put from the config
+	props, err := expandDeploymentStackProperties(d, false)
+	if err != nil {
+		return err
+	}
+
+	stack := deploymentstacks.DeploymentStack{
+		Properties: props,
+		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating %s..", id)
+	if err := client.CreateOrUpdateAtResourceGroupThenPoll(ctx, *id, stack); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return resourceGroupDeploymentStackResourceRead(d, meta)
+}
+
+func resourceGroupDeploymentStackResourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentStacksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := deploymentstacks.ParseDeploymentStackID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAtResourceGroup(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.DeploymentStackName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			if err := d.Set("action_on_unmanage", flattenDeploymentStackActionOnUnmanage(props.ActionOnUnmanage, false)); err != nil {
+				return fmt.Errorf("setting `action_on_unmanage`: %+v", err)
+			}
+
+			if err := d.Set("deny_settings", flattenDeploymentStackDenySettings(props.DenySettings)); err != nil {
+				return fmt.Errorf("setting `deny_settings`: %+v", err)
+			}
+
+			description := ""
+			if props.Description != nil {
+				description = *props.Description
+			}
+			d.Set("description", description)
+
+			deploymentId := ""
+			if props.DeploymentId != nil {
+				deploymentId = *props.DeploymentId
+			}
+			d.Set("deployment_id", deploymentId)
+
+			templateSpecVersionId := ""
+			if props.TemplateLink != nil && props.TemplateLink.Id != nil {
+				templateSpecVersionId = *props.TemplateLink.Id
+			}
+			d.Set("template_spec_version_id", templateSpecVersionId)
+
+			flattenedOutputs, err := flattenDeploymentStackOutputs(props.Outputs)
+			if err != nil {
+				return fmt.Errorf("flattening `output_content`: %+v", err)
+			}
+			d.Set("output_content", flattenedOutputs)
+		}
+
+		return tags.FlattenAndSet(d, model.Tags)
+	}
+
+	return nil
+}
+
+func resourceGroupDeploymentStackResourceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentStacksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := deploymentstacks.ParseDeploymentStackID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourcesAction, resourceGroupsAction, _ := deploymentStackUnmanageActions(d)
+	options := deploymentstacks.DeleteAtResourceGroupOperationOptions{
+		UnmanageActionResources:      &resourcesAction,
+		UnmanageActionResourceGroups: &resourceGroupsAction,
+	}
+
+	log.Printf("[DEBUG] Deleting %s..", id)
+	if err := client.DeleteAtResourceGroupThenPoll(ctx, *id, options); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
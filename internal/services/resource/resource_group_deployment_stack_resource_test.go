@@ -0,0 +1,192 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2022-08-01-preview/deploymentstacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ResourceGroupDeploymentStackResource struct{}
+
+func TestAccResourceGroupDeploymentStack_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deny_settings.0.mode").HasValue("denyDelete"),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+	})
+}
+
+func (t ResourceGroupDeploymentStackResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := deploymentstacks.ParseDeploymentStackID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.DeploymentStacksClient.GetAtResourceGroup(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ResourceGroupDeploymentStackResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGroupDeploymentStackResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                = "acctestdeploystack%d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  action_on_unmanage {
+    resources = "detach"
+  }
+
+  deny_settings {
+    mode = "none"
+  }
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "exampleOutput": {
+      "type": "String",
+      "value": "example"
+    }
+  }
+}
+TEMPLATE
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ResourceGroupDeploymentStackResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_deployment_stack" "import" {
+  name                = azurerm_resource_group_deployment_stack.test.name
+  resource_group_name = azurerm_resource_group_deployment_stack.test.resource_group_name
+
+  action_on_unmanage {
+    resources = "detach"
+  }
+
+  deny_settings {
+    mode = "none"
+  }
+
+  template_content = azurerm_resource_group_deployment_stack.test.template_content
+}
+`, r.basic(data))
+}
+
+func (r ResourceGroupDeploymentStackResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                = "acctestdeploystack%d"
+  resource_group_name = azurerm_resource_group.test.name
+  description         = "acceptance test stack"
+
+  action_on_unmanage {
+    resources = "delete"
+  }
+
+  deny_settings {
+    mode = "denyDelete"
+  }
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "exampleOutput": {
+      "type": "String",
+      "value": "example-updated"
+    }
+  }
+}
+TEMPLATE
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
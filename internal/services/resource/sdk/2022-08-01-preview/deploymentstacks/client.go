@@ -0,0 +1,18 @@
+package deploymentstacks
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DeploymentStacksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDeploymentStacksClientWithBaseURI(endpoint string) DeploymentStacksClient {
+	return DeploymentStacksClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,124 @@
+package deploymentstacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = DeploymentStackId{}
+
+// DeploymentStackId is a struct representing the Resource ID for a Deployment Stack
+type DeploymentStackId struct {
+	SubscriptionId      string
+	ResourceGroupName   string
+	DeploymentStackName string
+}
+
+// NewDeploymentStackID returns a new DeploymentStackId struct
+func NewDeploymentStackID(subscriptionId string, resourceGroupName string, deploymentStackName string) DeploymentStackId {
+	return DeploymentStackId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroupName:   resourceGroupName,
+		DeploymentStackName: deploymentStackName,
+	}
+}
+
+// ParseDeploymentStackID parses 'input' into a DeploymentStackId
+func ParseDeploymentStackID(input string) (*DeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DeploymentStackId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DeploymentStackId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DeploymentStackName, ok = parsed.Parsed["deploymentStackName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deploymentStackName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseDeploymentStackIDInsensitively parses 'input' case-insensitively into a DeploymentStackId
+// note: this method should only be used for API response data and not user input
+func ParseDeploymentStackIDInsensitively(input string) (*DeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DeploymentStackId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DeploymentStackId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DeploymentStackName, ok = parsed.Parsed["deploymentStackName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deploymentStackName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateDeploymentStackID checks that 'input' can be parsed as a Deployment Stack ID
+func ValidateDeploymentStackID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseDeploymentStackID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Deployment Stack ID
+func (id DeploymentStackId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Resources/deploymentStacks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.DeploymentStackName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Deployment Stack ID
+func (id DeploymentStackId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources", "Microsoft.Resources"),
+		resourceids.StaticSegment("staticDeploymentStacks", "deploymentStacks", "deploymentStacks"),
+		resourceids.UserSpecifiedSegment("deploymentStackName", "deploymentStackValue"),
+	}
+}
+
+// String returns a human-readable description of this Deployment Stack ID
+func (id DeploymentStackId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Deployment Stack Name: %q", id.DeploymentStackName),
+	}
+	return fmt.Sprintf("Deployment Stack (%s)", strings.Join(components, "\n"))
+}
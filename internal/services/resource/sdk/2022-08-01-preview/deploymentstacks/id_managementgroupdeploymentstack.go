@@ -0,0 +1,113 @@
+package deploymentstacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ManagementGroupDeploymentStackId{}
+
+// ManagementGroupDeploymentStackId is a struct representing the Resource ID for a Management Group Deployment Stack
+type ManagementGroupDeploymentStackId struct {
+	ManagementGroupName string
+	DeploymentStackName string
+}
+
+// NewManagementGroupDeploymentStackID returns a new ManagementGroupDeploymentStackId struct
+func NewManagementGroupDeploymentStackID(managementGroupName string, deploymentStackName string) ManagementGroupDeploymentStackId {
+	return ManagementGroupDeploymentStackId{
+		ManagementGroupName: managementGroupName,
+		DeploymentStackName: deploymentStackName,
+	}
+}
+
+// ParseManagementGroupDeploymentStackID parses 'input' into a ManagementGroupDeploymentStackId
+func ParseManagementGroupDeploymentStackID(input string) (*ManagementGroupDeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ManagementGroupDeploymentStackId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ManagementGroupDeploymentStackId{}
+
+	if id.ManagementGroupName, ok = parsed.Parsed["managementGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'managementGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DeploymentStackName, ok = parsed.Parsed["deploymentStackName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deploymentStackName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseManagementGroupDeploymentStackIDInsensitively parses 'input' case-insensitively into a ManagementGroupDeploymentStackId
+// note: this method should only be used for API response data and not user input
+func ParseManagementGroupDeploymentStackIDInsensitively(input string) (*ManagementGroupDeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ManagementGroupDeploymentStackId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ManagementGroupDeploymentStackId{}
+
+	if id.ManagementGroupName, ok = parsed.Parsed["managementGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'managementGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DeploymentStackName, ok = parsed.Parsed["deploymentStackName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deploymentStackName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateManagementGroupDeploymentStackID checks that 'input' can be parsed as a Management Group Deployment Stack ID
+func ValidateManagementGroupDeploymentStackID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseManagementGroupDeploymentStackID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Management Group Deployment Stack ID
+func (id ManagementGroupDeploymentStackId) ID() string {
+	fmtString := "/providers/Microsoft.Management/managementGroups/%s/providers/Microsoft.Resources/deploymentStacks/%s"
+	return fmt.Sprintf(fmtString, id.ManagementGroupName, id.DeploymentStackName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Management Group Deployment Stack ID
+func (id ManagementGroupDeploymentStackId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftManagement", "Microsoft.Management", "Microsoft.Management"),
+		resourceids.StaticSegment("staticManagementGroups", "managementGroups", "managementGroups"),
+		resourceids.UserSpecifiedSegment("managementGroupName", "managementGroupValue"),
+		resourceids.StaticSegment("staticProviders2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources", "Microsoft.Resources"),
+		resourceids.StaticSegment("staticDeploymentStacks", "deploymentStacks", "deploymentStacks"),
+		resourceids.UserSpecifiedSegment("deploymentStackName", "deploymentStackValue"),
+	}
+}
+
+// String returns a human-readable description of this Management Group Deployment Stack ID
+func (id ManagementGroupDeploymentStackId) String() string {
+	components := []string{
+		fmt.Sprintf("Management Group Name: %q", id.ManagementGroupName),
+		fmt.Sprintf("Deployment Stack Name: %q", id.DeploymentStackName),
+	}
+	return fmt.Sprintf("Management Group Deployment Stack (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,111 @@
+package deploymentstacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = SubscriptionDeploymentStackId{}
+
+// SubscriptionDeploymentStackId is a struct representing the Resource ID for a Subscription Deployment Stack
+type SubscriptionDeploymentStackId struct {
+	SubscriptionId      string
+	DeploymentStackName string
+}
+
+// NewSubscriptionDeploymentStackID returns a new SubscriptionDeploymentStackId struct
+func NewSubscriptionDeploymentStackID(subscriptionId string, deploymentStackName string) SubscriptionDeploymentStackId {
+	return SubscriptionDeploymentStackId{
+		SubscriptionId:      subscriptionId,
+		DeploymentStackName: deploymentStackName,
+	}
+}
+
+// ParseSubscriptionDeploymentStackID parses 'input' into a SubscriptionDeploymentStackId
+func ParseSubscriptionDeploymentStackID(input string) (*SubscriptionDeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SubscriptionDeploymentStackId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SubscriptionDeploymentStackId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.DeploymentStackName, ok = parsed.Parsed["deploymentStackName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deploymentStackName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseSubscriptionDeploymentStackIDInsensitively parses 'input' case-insensitively into a SubscriptionDeploymentStackId
+// note: this method should only be used for API response data and not user input
+func ParseSubscriptionDeploymentStackIDInsensitively(input string) (*SubscriptionDeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SubscriptionDeploymentStackId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SubscriptionDeploymentStackId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.DeploymentStackName, ok = parsed.Parsed["deploymentStackName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deploymentStackName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateSubscriptionDeploymentStackID checks that 'input' can be parsed as a Subscription Deployment Stack ID
+func ValidateSubscriptionDeploymentStackID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSubscriptionDeploymentStackID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Subscription Deployment Stack ID
+func (id SubscriptionDeploymentStackId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Resources/deploymentStacks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.DeploymentStackName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Subscription Deployment Stack ID
+func (id SubscriptionDeploymentStackId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources", "Microsoft.Resources"),
+		resourceids.StaticSegment("staticDeploymentStacks", "deploymentStacks", "deploymentStacks"),
+		resourceids.UserSpecifiedSegment("deploymentStackName", "deploymentStackValue"),
+	}
+}
+
+// String returns a human-readable description of this Subscription Deployment Stack ID
+func (id SubscriptionDeploymentStackId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Deployment Stack Name: %q", id.DeploymentStackName),
+	}
+	return fmt.Sprintf("Subscription Deployment Stack (%s)", strings.Join(components, "\n"))
+}
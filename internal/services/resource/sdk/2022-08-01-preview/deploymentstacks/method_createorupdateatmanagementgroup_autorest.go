@@ -0,0 +1,79 @@
+package deploymentstacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CreateOrUpdateAtManagementGroupOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdateAtManagementGroup ...
+func (c DeploymentStacksClient) CreateOrUpdateAtManagementGroup(ctx context.Context, id ManagementGroupDeploymentStackId, input DeploymentStack) (result CreateOrUpdateAtManagementGroupOperationResponse, err error) {
+	req, err := c.preparerForCreateOrUpdateAtManagementGroup(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "CreateOrUpdateAtManagementGroup", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateOrUpdateAtManagementGroup(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "CreateOrUpdateAtManagementGroup", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateAtManagementGroupThenPoll performs CreateOrUpdateAtManagementGroup then polls until it's completed
+func (c DeploymentStacksClient) CreateOrUpdateAtManagementGroupThenPoll(ctx context.Context, id ManagementGroupDeploymentStackId, input DeploymentStack) error {
+	result, err := c.CreateOrUpdateAtManagementGroup(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdateAtManagementGroup: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdateAtManagementGroup: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateOrUpdateAtManagementGroup prepares the CreateOrUpdateAtManagementGroup request.
+func (c DeploymentStacksClient) preparerForCreateOrUpdateAtManagementGroup(ctx context.Context, id ManagementGroupDeploymentStackId, input DeploymentStack) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateOrUpdateAtManagementGroup sends the CreateOrUpdateAtManagementGroup request. The method will close the
+// http.Response Body if it receives an error.
+func (c DeploymentStacksClient) senderForCreateOrUpdateAtManagementGroup(ctx context.Context, req *http.Request) (future CreateOrUpdateAtManagementGroupOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
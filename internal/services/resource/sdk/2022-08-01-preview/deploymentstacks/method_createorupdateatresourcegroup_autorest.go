@@ -0,0 +1,79 @@
+package deploymentstacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CreateOrUpdateAtResourceGroupOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdateAtResourceGroup ...
+func (c DeploymentStacksClient) CreateOrUpdateAtResourceGroup(ctx context.Context, id DeploymentStackId, input DeploymentStack) (result CreateOrUpdateAtResourceGroupOperationResponse, err error) {
+	req, err := c.preparerForCreateOrUpdateAtResourceGroup(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "CreateOrUpdateAtResourceGroup", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateOrUpdateAtResourceGroup(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "CreateOrUpdateAtResourceGroup", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateAtResourceGroupThenPoll performs CreateOrUpdateAtResourceGroup then polls until it's completed
+func (c DeploymentStacksClient) CreateOrUpdateAtResourceGroupThenPoll(ctx context.Context, id DeploymentStackId, input DeploymentStack) error {
+	result, err := c.CreateOrUpdateAtResourceGroup(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdateAtResourceGroup: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdateAtResourceGroup: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateOrUpdateAtResourceGroup prepares the CreateOrUpdateAtResourceGroup request.
+func (c DeploymentStacksClient) preparerForCreateOrUpdateAtResourceGroup(ctx context.Context, id DeploymentStackId, input DeploymentStack) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateOrUpdateAtResourceGroup sends the CreateOrUpdateAtResourceGroup request. The method will close the
+// http.Response Body if it receives an error.
+func (c DeploymentStacksClient) senderForCreateOrUpdateAtResourceGroup(ctx context.Context, req *http.Request) (future CreateOrUpdateAtResourceGroupOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
@@ -0,0 +1,79 @@
+package deploymentstacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CreateOrUpdateAtSubscriptionOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdateAtSubscription ...
+func (c DeploymentStacksClient) CreateOrUpdateAtSubscription(ctx context.Context, id SubscriptionDeploymentStackId, input DeploymentStack) (result CreateOrUpdateAtSubscriptionOperationResponse, err error) {
+	req, err := c.preparerForCreateOrUpdateAtSubscription(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "CreateOrUpdateAtSubscription", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateOrUpdateAtSubscription(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "CreateOrUpdateAtSubscription", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateAtSubscriptionThenPoll performs CreateOrUpdateAtSubscription then polls until it's completed
+func (c DeploymentStacksClient) CreateOrUpdateAtSubscriptionThenPoll(ctx context.Context, id SubscriptionDeploymentStackId, input DeploymentStack) error {
+	result, err := c.CreateOrUpdateAtSubscription(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdateAtSubscription: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdateAtSubscription: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateOrUpdateAtSubscription prepares the CreateOrUpdateAtSubscription request.
+func (c DeploymentStacksClient) preparerForCreateOrUpdateAtSubscription(ctx context.Context, id SubscriptionDeploymentStackId, input DeploymentStack) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateOrUpdateAtSubscription sends the CreateOrUpdateAtSubscription request. The method will close the
+// http.Response Body if it receives an error.
+func (c DeploymentStacksClient) senderForCreateOrUpdateAtSubscription(ctx context.Context, req *http.Request) (future CreateOrUpdateAtSubscriptionOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
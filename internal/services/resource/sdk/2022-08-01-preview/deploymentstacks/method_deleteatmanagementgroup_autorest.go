@@ -0,0 +1,117 @@
+package deploymentstacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DeleteAtManagementGroupOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type DeleteAtManagementGroupOperationOptions struct {
+	UnmanageActionManagementGroups *DeploymentStacksDeleteDetachEnum
+	UnmanageActionResourceGroups   *DeploymentStacksDeleteDetachEnum
+	UnmanageActionResources        *DeploymentStacksDeleteDetachEnum
+}
+
+func DefaultDeleteAtManagementGroupOperationOptions() DeleteAtManagementGroupOperationOptions {
+	return DeleteAtManagementGroupOperationOptions{}
+}
+
+func (o DeleteAtManagementGroupOperationOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	return out
+}
+
+func (o DeleteAtManagementGroupOperationOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.UnmanageActionManagementGroups != nil {
+		out["unmanageAction.ManagementGroups"] = *o.UnmanageActionManagementGroups
+	}
+
+	if o.UnmanageActionResourceGroups != nil {
+		out["unmanageAction.ResourceGroups"] = *o.UnmanageActionResourceGroups
+	}
+
+	if o.UnmanageActionResources != nil {
+		out["unmanageAction.Resources"] = *o.UnmanageActionResources
+	}
+
+	return out
+}
+
+// DeleteAtManagementGroup ...
+func (c DeploymentStacksClient) DeleteAtManagementGroup(ctx context.Context, id ManagementGroupDeploymentStackId, options DeleteAtManagementGroupOperationOptions) (result DeleteAtManagementGroupOperationResponse, err error) {
+	req, err := c.preparerForDeleteAtManagementGroup(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "DeleteAtManagementGroup", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDeleteAtManagementGroup(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "DeleteAtManagementGroup", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DeleteAtManagementGroupThenPoll performs DeleteAtManagementGroup then polls until it's completed
+func (c DeploymentStacksClient) DeleteAtManagementGroupThenPoll(ctx context.Context, id ManagementGroupDeploymentStackId, options DeleteAtManagementGroupOperationOptions) error {
+	result, err := c.DeleteAtManagementGroup(ctx, id, options)
+	if err != nil {
+		return fmt.Errorf("performing DeleteAtManagementGroup: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DeleteAtManagementGroup: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDeleteAtManagementGroup prepares the DeleteAtManagementGroup request.
+func (c DeploymentStacksClient) preparerForDeleteAtManagementGroup(ctx context.Context, id ManagementGroupDeploymentStackId, options DeleteAtManagementGroupOperationOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDeleteAtManagementGroup sends the DeleteAtManagementGroup request. The method will close the
+// http.Response Body if it receives an error.
+func (c DeploymentStacksClient) senderForDeleteAtManagementGroup(ctx context.Context, req *http.Request) (future DeleteAtManagementGroupOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
@@ -0,0 +1,112 @@
+package deploymentstacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DeleteAtResourceGroupOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type DeleteAtResourceGroupOperationOptions struct {
+	UnmanageActionResourceGroups *DeploymentStacksDeleteDetachEnum
+	UnmanageActionResources      *DeploymentStacksDeleteDetachEnum
+}
+
+func DefaultDeleteAtResourceGroupOperationOptions() DeleteAtResourceGroupOperationOptions {
+	return DeleteAtResourceGroupOperationOptions{}
+}
+
+func (o DeleteAtResourceGroupOperationOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	return out
+}
+
+func (o DeleteAtResourceGroupOperationOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.UnmanageActionResourceGroups != nil {
+		out["unmanageAction.ResourceGroups"] = *o.UnmanageActionResourceGroups
+	}
+
+	if o.UnmanageActionResources != nil {
+		out["unmanageAction.Resources"] = *o.UnmanageActionResources
+	}
+
+	return out
+}
+
+// DeleteAtResourceGroup ...
+func (c DeploymentStacksClient) DeleteAtResourceGroup(ctx context.Context, id DeploymentStackId, options DeleteAtResourceGroupOperationOptions) (result DeleteAtResourceGroupOperationResponse, err error) {
+	req, err := c.preparerForDeleteAtResourceGroup(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "DeleteAtResourceGroup", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDeleteAtResourceGroup(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "DeleteAtResourceGroup", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DeleteAtResourceGroupThenPoll performs DeleteAtResourceGroup then polls until it's completed
+func (c DeploymentStacksClient) DeleteAtResourceGroupThenPoll(ctx context.Context, id DeploymentStackId, options DeleteAtResourceGroupOperationOptions) error {
+	result, err := c.DeleteAtResourceGroup(ctx, id, options)
+	if err != nil {
+		return fmt.Errorf("performing DeleteAtResourceGroup: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DeleteAtResourceGroup: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDeleteAtResourceGroup prepares the DeleteAtResourceGroup request.
+func (c DeploymentStacksClient) preparerForDeleteAtResourceGroup(ctx context.Context, id DeploymentStackId, options DeleteAtResourceGroupOperationOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDeleteAtResourceGroup sends the DeleteAtResourceGroup request. The method will close the
+// http.Response Body if it receives an error.
+func (c DeploymentStacksClient) senderForDeleteAtResourceGroup(ctx context.Context, req *http.Request) (future DeleteAtResourceGroupOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
@@ -0,0 +1,112 @@
+package deploymentstacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DeleteAtSubscriptionOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type DeleteAtSubscriptionOperationOptions struct {
+	UnmanageActionResourceGroups *DeploymentStacksDeleteDetachEnum
+	UnmanageActionResources      *DeploymentStacksDeleteDetachEnum
+}
+
+func DefaultDeleteAtSubscriptionOperationOptions() DeleteAtSubscriptionOperationOptions {
+	return DeleteAtSubscriptionOperationOptions{}
+}
+
+func (o DeleteAtSubscriptionOperationOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	return out
+}
+
+func (o DeleteAtSubscriptionOperationOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.UnmanageActionResourceGroups != nil {
+		out["unmanageAction.ResourceGroups"] = *o.UnmanageActionResourceGroups
+	}
+
+	if o.UnmanageActionResources != nil {
+		out["unmanageAction.Resources"] = *o.UnmanageActionResources
+	}
+
+	return out
+}
+
+// DeleteAtSubscription ...
+func (c DeploymentStacksClient) DeleteAtSubscription(ctx context.Context, id SubscriptionDeploymentStackId, options DeleteAtSubscriptionOperationOptions) (result DeleteAtSubscriptionOperationResponse, err error) {
+	req, err := c.preparerForDeleteAtSubscription(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "DeleteAtSubscription", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDeleteAtSubscription(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "DeleteAtSubscription", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DeleteAtSubscriptionThenPoll performs DeleteAtSubscription then polls until it's completed
+func (c DeploymentStacksClient) DeleteAtSubscriptionThenPoll(ctx context.Context, id SubscriptionDeploymentStackId, options DeleteAtSubscriptionOperationOptions) error {
+	result, err := c.DeleteAtSubscription(ctx, id, options)
+	if err != nil {
+		return fmt.Errorf("performing DeleteAtSubscription: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DeleteAtSubscription: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDeleteAtSubscription prepares the DeleteAtSubscription request.
+func (c DeploymentStacksClient) preparerForDeleteAtSubscription(ctx context.Context, id SubscriptionDeploymentStackId, options DeleteAtSubscriptionOperationOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDeleteAtSubscription sends the DeleteAtSubscription request. The method will close the
+// http.Response Body if it receives an error.
+func (c DeploymentStacksClient) senderForDeleteAtSubscription(ctx context.Context, req *http.Request) (future DeleteAtSubscriptionOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
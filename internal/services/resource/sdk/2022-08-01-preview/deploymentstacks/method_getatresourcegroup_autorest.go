@@ -0,0 +1,68 @@
+package deploymentstacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type GetAtResourceGroupOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *DeploymentStack
+}
+
+// GetAtResourceGroup ...
+func (c DeploymentStacksClient) GetAtResourceGroup(ctx context.Context, id DeploymentStackId) (result GetAtResourceGroupOperationResponse, err error) {
+	req, err := c.preparerForGetAtResourceGroup(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "GetAtResourceGroup", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "GetAtResourceGroup", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetAtResourceGroup(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "GetAtResourceGroup", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetAtResourceGroup prepares the GetAtResourceGroup request.
+func (c DeploymentStacksClient) preparerForGetAtResourceGroup(ctx context.Context, id DeploymentStackId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetAtResourceGroup handles the response to the GetAtResourceGroup request. The method always
+// closes the http.Response Body.
+func (c DeploymentStacksClient) responderForGetAtResourceGroup(resp *http.Response) (result GetAtResourceGroupOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
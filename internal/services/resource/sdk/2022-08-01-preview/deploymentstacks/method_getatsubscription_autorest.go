@@ -0,0 +1,68 @@
+package deploymentstacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type GetAtSubscriptionOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *DeploymentStack
+}
+
+// GetAtSubscription ...
+func (c DeploymentStacksClient) GetAtSubscription(ctx context.Context, id SubscriptionDeploymentStackId) (result GetAtSubscriptionOperationResponse, err error) {
+	req, err := c.preparerForGetAtSubscription(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "GetAtSubscription", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "GetAtSubscription", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetAtSubscription(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "deploymentstacks.DeploymentStacksClient", "GetAtSubscription", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetAtSubscription prepares the GetAtSubscription request.
+func (c DeploymentStacksClient) preparerForGetAtSubscription(ctx context.Context, id SubscriptionDeploymentStackId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetAtSubscription handles the response to the GetAtSubscription request. The method always
+// closes the http.Response Body.
+func (c DeploymentStacksClient) responderForGetAtSubscription(resp *http.Response) (result GetAtSubscriptionOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
@@ -0,0 +1,10 @@
+package deploymentstacks
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DeploymentStackPropertiesActionOnUnmanage struct {
+	ManagementGroups *DeploymentStacksDeleteDetachEnum `json:"managementGroups,omitempty"`
+	ResourceGroups   *DeploymentStacksDeleteDetachEnum `json:"resourceGroups,omitempty"`
+	Resources        DeploymentStacksDeleteDetachEnum  `json:"resources"`
+}
@@ -0,0 +1,8 @@
+package deploymentstacks
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ResourceReference struct {
+	Id *string `json:"id,omitempty"`
+}
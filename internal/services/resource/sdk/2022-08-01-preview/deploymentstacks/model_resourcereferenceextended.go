@@ -0,0 +1,9 @@
+package deploymentstacks
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ResourceReferenceExtended struct {
+	Error *ErrorResponse `json:"error,omitempty"`
+	Id    *string        `json:"id,omitempty"`
+}
@@ -0,0 +1,58 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SubscriptionTemplateDeploymentWhatIfDataSource struct{}
+
+func TestAccDataSourceSubscriptionTemplateDeploymentWhatIf_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subscription_template_deployment_what_if", "test")
+	r := SubscriptionTemplateDeploymentWhatIfDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+				check.That(data.ResourceName).Key("change.#").HasValue("1"),
+				check.That(data.ResourceName).Key("change.0.change_type").HasValue("Create"),
+			),
+		},
+	})
+}
+
+func (SubscriptionTemplateDeploymentWhatIfDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription_template_deployment_what_if" "test" {
+  name     = "acctestsubwhatif-%d"
+  location = %q
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Resources/resourceGroups",
+      "apiVersion": "2018-05-01",
+      "location": %q,
+      "name": "acctestrg-whatif-%d",
+      "properties": {}
+    }
+  ]
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary, data.Locations.Primary, data.RandomInteger)
+}
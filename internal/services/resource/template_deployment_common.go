@@ -86,6 +86,39 @@ func flattenTemplateDeploymentBody(input interface{}) (*string, error) {
 	return &output, nil
 }
 
+func flattenTemplateDeploymentWhatIfChanges(input *[]resources.WhatIfChange) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, change := range *input {
+		resourceId := ""
+		if change.ResourceID != nil {
+			resourceId = *change.ResourceID
+		}
+
+		before, err := flattenTemplateDeploymentBody(change.Before)
+		if err != nil {
+			return nil, fmt.Errorf("flattening `before` for %q: %+v", resourceId, err)
+		}
+
+		after, err := flattenTemplateDeploymentBody(change.After)
+		if err != nil {
+			return nil, fmt.Errorf("flattening `after` for %q: %+v", resourceId, err)
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id": resourceId,
+			"change_type": string(change.ChangeType),
+			"before":      *before,
+			"after":       *after,
+		})
+	}
+
+	return output, nil
+}
+
 func filterOutTemplateDeploymentParameters(input interface{}) interface{} {
 	if input == nil {
 		return nil
@@ -0,0 +1,108 @@
+package resource
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceTemplateSpecVersions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceTemplateSpecVersionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.TemplateSpecName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"versions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTemplateSpecVersionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.TemplateSpecsVersionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewTemplateSpecID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing versions for %s: %+v", id, err)
+	}
+
+	versions := make([]interface{}, 0)
+	for iterator.NotDone() {
+		version := iterator.Value()
+
+		versionId := ""
+		if version.ID != nil {
+			versionId = *version.ID
+		}
+
+		name := ""
+		if version.Name != nil {
+			name = *version.Name
+		}
+
+		description := ""
+		if props := version.VersionProperties; props != nil && props.Description != nil {
+			description = *props.Description
+		}
+
+		versions = append(versions, map[string]interface{}{
+			"id":          versionId,
+			"name":        name,
+			"description": description,
+		})
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing versions for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("setting `versions`: %+v", err)
+	}
+
+	return nil
+}
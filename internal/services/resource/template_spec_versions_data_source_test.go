@@ -0,0 +1,39 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type TemplateSpecVersionsDataSource struct{}
+
+func TestAccDataSourceTemplateSpecVersions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_template_spec_versions", "test")
+	r := TemplateSpecVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("versions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("versions.0.name").HasValue("v1.0.0"),
+				check.That(data.ResourceName).Key("versions.0.id").Exists(),
+			),
+		},
+	})
+}
+
+func (TemplateSpecVersionsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_template_spec_versions" "test" {
+  name                = "acctest-standing-data-empty"
+  resource_group_name = "standing-data-for-acctest"
+}
+`
+}
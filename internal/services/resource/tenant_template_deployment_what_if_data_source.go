@@ -0,0 +1,192 @@
+package resource
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceTenantTemplateDeploymentWhatIf() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceTenantTemplateDeploymentWhatIfRead,
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		// (lintignore needed as the `before` and `after` payloads are JSON for use with `jsondecode`)
+
+		// lintignore:S033
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.TemplateDeploymentName,
+			},
+
+			"location": commonschema.Location(),
+
+			"template_content": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"template_content",
+					"template_spec_version_id",
+				},
+				StateFunc: utils.NormalizeJson,
+			},
+
+			"template_spec_version_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"template_content",
+					"template_spec_version_id",
+				},
+				ValidateFunc: validate.TemplateSpecVersionID,
+			},
+
+			"parameters_content": {
+				Type:      pluginsdk.TypeString,
+				Optional:  true,
+				StateFunc: utils.NormalizeJson,
+			},
+
+			"result_format": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(resources.WhatIfResultFormatFullResourcePayloads),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(resources.WhatIfResultFormatFullResourcePayloads),
+					string(resources.WhatIfResultFormatResourceIDOnly),
+				}, false),
+			},
+
+			// Computed
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"change": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"change_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						// NOTE: the `before` and `after` payloads vary per resource type - exposing these as JSON
+						// allows the users to interact with/map objects as required via `jsondecode`
+						"before": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"after": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTenantTemplateDeploymentWhatIfRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewTenantTemplateDeploymentID(d.Get("name").(string))
+
+	whatIf := resources.ScopedDeploymentWhatIf{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &resources.DeploymentWhatIfProperties{
+			Mode: resources.DeploymentModeIncremental,
+			WhatIfSettings: &resources.DeploymentWhatIfSettings{
+				ResultFormat: resources.WhatIfResultFormat(d.Get("result_format").(string)),
+			},
+		},
+	}
+
+	if templateRaw, ok := d.GetOk("template_content"); ok {
+		template, err := expandTemplateDeploymentBody(templateRaw.(string))
+		if err != nil {
+			return fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		whatIf.Properties.Template = template
+	}
+
+	if templateSpecVersionID, ok := d.GetOk("template_spec_version_id"); ok {
+		whatIf.Properties.TemplateLink = &resources.TemplateLink{
+			ID: utils.String(templateSpecVersionID.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("parameters_content"); ok && v != "" {
+		parameters, err := expandTemplateDeploymentBody(v.(string))
+		if err != nil {
+			return fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		whatIf.Properties.Parameters = parameters
+	}
+
+	log.Printf("[DEBUG] Running What-If for Tenant Template Deployment %q..", id.DeploymentName)
+	future, err := client.WhatIfAtTenantScope(ctx, id.DeploymentName, whatIf)
+	if err != nil {
+		return fmt.Errorf("running What-If for Tenant Template Deployment %q: %+v", id.DeploymentName, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for What-If of Tenant Template Deployment %q..", id.DeploymentName)
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for What-If of Tenant Template Deployment %q: %+v", id.DeploymentName, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving What-If result for Tenant Template Deployment %q: %+v", id.DeploymentName, err)
+	}
+
+	if result.Error != nil {
+		return fmt.Errorf("What-If for Tenant Template Deployment %q failed: %+v", id.DeploymentName, *result.Error)
+	}
+
+	d.SetId(id.ID())
+
+	status := ""
+	if result.Status != nil {
+		status = *result.Status
+	}
+	d.Set("status", status)
+
+	if props := result.WhatIfOperationProperties; props != nil {
+		changes, err := flattenTemplateDeploymentWhatIfChanges(props.Changes)
+		if err != nil {
+			return fmt.Errorf("flattening `change`: %+v", err)
+		}
+		if err := d.Set("change", changes); err != nil {
+			return fmt.Errorf("setting `change`: %+v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type TenantTemplateDeploymentWhatIfDataSource struct{}
+
+func TestAccDataSourceTenantTemplateDeploymentWhatIf_empty(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_tenant_template_deployment_what_if", "test")
+	if data.Client().IsServicePrincipal {
+		t.Skip("Skipping due to permissions unavailable on tenant scope")
+	}
+	r := TenantTemplateDeploymentWhatIfDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.empty(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+			),
+		},
+	})
+}
+
+func (TenantTemplateDeploymentWhatIfDataSource) empty(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_tenant_template_deployment_what_if" "test" {
+  name     = "acctesttenantwhatif-%d"
+  location = %q
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/tenantDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/resourcemover/mgmt/2021-01-01/resourcemover"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	MoveCollectionClient *resourcemover.MoveCollectionsClient
+	MoveResourceClient   *resourcemover.MoveResourcesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	moveCollectionClient := resourcemover.NewMoveCollectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&moveCollectionClient.Client, o.ResourceManagerAuthorizer)
+
+	moveResourceClient := resourcemover.NewMoveResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&moveResourceClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		MoveCollectionClient: &moveCollectionClient,
+		MoveResourceClient:   &moveResourceClient,
+	}
+}
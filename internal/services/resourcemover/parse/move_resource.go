@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type MoveResourceId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	MoveCollectionName string
+	Name               string
+}
+
+func NewMoveResourceID(subscriptionId, resourceGroup, moveCollectionName, name string) MoveResourceId {
+	return MoveResourceId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		MoveCollectionName: moveCollectionName,
+		Name:               name,
+	}
+}
+
+func (id MoveResourceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Move Collection Name %q", id.MoveCollectionName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Move Resource", segmentsStr)
+}
+
+func (id MoveResourceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Migrate/moveCollections/%s/moveResources/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MoveCollectionName, id.Name)
+}
+
+// MoveResourceID parses a MoveResource ID into an MoveResourceId struct
+func MoveResourceID(input string) (*MoveResourceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MoveResourceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MoveCollectionName, err = id.PopSegment("moveCollections"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("moveResources"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,40 @@
+package resourcemover
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type Registration struct{}
+
+var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/resource-mover"
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Resource Mover"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Resource Mover",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_resource_mover_move_collection": resourceResourceMoverMoveCollection(),
+		"azurerm_resource_mover_move_resource":   resourceResourceMoverMoveResource(),
+		"azurerm_resource_mover_move_operation":  resourceResourceMoverMoveOperation(),
+	}
+}
@@ -0,0 +1,164 @@
+package resourcemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resourcemover/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ResourceMoverMoveCollectionResource struct{}
+
+func TestAccResourceMoverMoveCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_mover_move_collection", "test")
+	r := ResourceMoverMoveCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceMoverMoveCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_mover_move_collection", "test")
+	r := ResourceMoverMoveCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccResourceMoverMoveCollection_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_mover_move_collection", "test")
+	r := ResourceMoverMoveCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceMoverMoveCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_mover_move_collection", "test")
+	r := ResourceMoverMoveCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ResourceMoverMoveCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.MoveCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ResourceMover.MoveCollectionClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ResourceMoverMoveCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-rmover-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_mover_move_collection" "test" {
+  name                = "acctest-mc-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  source_region       = "%s"
+  target_region       = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
+
+func (r ResourceMoverMoveCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_mover_move_collection" "import" {
+  name                = azurerm_resource_mover_move_collection.test.name
+  resource_group_name = azurerm_resource_mover_move_collection.test.resource_group_name
+  location            = azurerm_resource_mover_move_collection.test.location
+  source_region       = azurerm_resource_mover_move_collection.test.source_region
+  target_region       = azurerm_resource_mover_move_collection.test.target_region
+}
+`, r.basic(data))
+}
+
+func (r ResourceMoverMoveCollectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-rmover-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_mover_move_collection" "test" {
+  name                = "acctest-mc-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  source_region       = "%s"
+  target_region       = "%s"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
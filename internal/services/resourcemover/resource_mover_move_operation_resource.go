@@ -0,0 +1,189 @@
+package resourcemover
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resourcemover/mgmt/2021-01-01/resourcemover"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resourcemover/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resourcemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceResourceMoverMoveOperation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceResourceMoverMoveOperationCreate,
+		Read:   resourceResourceMoverMoveOperationRead,
+		Delete: resourceResourceMoverMoveOperationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			parts := strings.Split(id, "/moveOperations/")
+			if len(parts) != 2 || parts[1] == "" {
+				return fmt.Errorf("expected an id in the format `{moveCollectionId}/moveOperations/{operation}` but got %q", id)
+			}
+			_, err := parse.MoveCollectionID(parts[0])
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"move_collection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MoveCollectionID,
+			},
+
+			"operation": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ResolveDependencies",
+					"Prepare",
+					"InitiateMove",
+					"Commit",
+					"Discard",
+				}, false),
+			},
+
+			"move_resource_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+		},
+	}
+}
+
+func resourceResourceMoverMoveOperationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ResourceMover.MoveCollectionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	collectionId, err := parse.MoveCollectionID(d.Get("move_collection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	operation := d.Get("operation").(string)
+
+	moveResources := make([]string, 0)
+	for _, v := range d.Get("move_resource_ids").([]interface{}) {
+		moveResources = append(moveResources, v.(string))
+	}
+	if operation != "ResolveDependencies" && len(moveResources) == 0 {
+		return fmt.Errorf("`move_resource_ids` must be specified when `operation` is %q", operation)
+	}
+
+	switch operation {
+	case "ResolveDependencies":
+		future, err := client.ResolveDependencies(ctx, collectionId.ResourceGroup, collectionId.Name)
+		if err != nil {
+			return fmt.Errorf("resolving dependencies for %s: %+v", collectionId, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for dependency resolution of %s: %+v", collectionId, err)
+		}
+
+	case "Prepare":
+		future, err := client.Prepare(ctx, collectionId.ResourceGroup, collectionId.Name, &resourcemover.PrepareRequest{
+			MoveResources:         &moveResources,
+			MoveResourceInputType: resourcemover.MoveResourceID,
+			ValidateOnly:          utils.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("preparing move resources in %s: %+v", collectionId, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for prepare of move resources in %s: %+v", collectionId, err)
+		}
+
+	case "InitiateMove":
+		future, err := client.InitiateMove(ctx, collectionId.ResourceGroup, collectionId.Name, &resourcemover.ResourceMoveRequestType{
+			MoveResources:         &moveResources,
+			MoveResourceInputType: resourcemover.MoveResourceID,
+			ValidateOnly:          utils.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("initiating move of resources in %s: %+v", collectionId, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for move of resources in %s: %+v", collectionId, err)
+		}
+
+	case "Commit":
+		future, err := client.Commit(ctx, collectionId.ResourceGroup, collectionId.Name, &resourcemover.CommitRequest{
+			MoveResources:         &moveResources,
+			MoveResourceInputType: resourcemover.MoveResourceID,
+			ValidateOnly:          utils.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("committing move of resources in %s: %+v", collectionId, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for commit of move of resources in %s: %+v", collectionId, err)
+		}
+
+	case "Discard":
+		future, err := client.Discard(ctx, collectionId.ResourceGroup, collectionId.Name, &resourcemover.DiscardRequest{
+			MoveResources:         &moveResources,
+			MoveResourceInputType: resourcemover.MoveResourceID,
+			ValidateOnly:          utils.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("discarding move of resources in %s: %+v", collectionId, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for discard of move of resources in %s: %+v", collectionId, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/moveOperations/%s", collectionId.ID(), strings.ToLower(operation)))
+
+	return resourceResourceMoverMoveOperationRead(d, meta)
+}
+
+func resourceResourceMoverMoveOperationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ResourceMover.MoveCollectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	collectionId, err := parse.MoveCollectionID(d.Get("move_collection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the operation itself has no representation in the API - it's gone once the
+	// move collection is, which is the only drift we can detect
+	resp, err := client.Get(ctx, collectionId.ResourceGroup, collectionId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", collectionId, err)
+	}
+
+	return nil
+}
+
+func resourceResourceMoverMoveOperationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	// move operations cannot be undone - removing the resource only removes it from the state
+	return nil
+}
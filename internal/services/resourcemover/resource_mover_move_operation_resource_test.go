@@ -0,0 +1,63 @@
+package resourcemover_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resourcemover/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ResourceMoverMoveOperationResource struct{}
+
+func TestAccResourceMoverMoveOperation_resolveDependencies(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_mover_move_operation", "test")
+	r := ResourceMoverMoveOperationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resolveDependencies(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r ResourceMoverMoveOperationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	// operations have no representation of their own in the API - confirming the
+	// move collection still exists is the best we can do
+	collectionId, err := parse.MoveCollectionID(strings.Split(state.ID, "/moveOperations/")[0])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ResourceMover.MoveCollectionClient.Get(ctx, collectionId.ResourceGroup, collectionId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *collectionId, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ResourceMoverMoveOperationResource) resolveDependencies(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_mover_move_operation" "test" {
+  move_collection_id = azurerm_resource_mover_move_collection.test.id
+  operation          = "ResolveDependencies"
+
+  depends_on = [azurerm_resource_mover_move_resource.test]
+}
+`, ResourceMoverMoveResourceResource{}.basic(data))
+}
@@ -0,0 +1,362 @@
+package resourcemover
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resourcemover/mgmt/2021-01-01/resourcemover"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resourcemover/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resourcemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceResourceMoverMoveResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceResourceMoverMoveResourceCreate,
+		Read:   resourceResourceMoverMoveResourceRead,
+		Delete: resourceResourceMoverMoveResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.MoveResourceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"move_collection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MoveCollectionID,
+			},
+
+			"source_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_resource_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"existing_target_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"move_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"resolution_required": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"dependency": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"resolution_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"resolution_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"dependency_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"optional": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceResourceMoverMoveResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ResourceMover.MoveResourceClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	collectionId, err := parse.MoveCollectionID(d.Get("move_collection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewMoveResourceID(collectionId.SubscriptionId, collectionId.ResourceGroup, collectionId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.MoveCollectionName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_resource_mover_move_resource", id.ID())
+	}
+
+	sourceId := d.Get("source_id").(string)
+	resourceSettings, err := moveResourceSettings(sourceId, d.Get("target_resource_name").(string))
+	if err != nil {
+		return err
+	}
+
+	properties := resourcemover.MoveResourceProperties{
+		SourceID:         utils.String(sourceId),
+		ResourceSettings: resourceSettings,
+	}
+
+	if v, ok := d.GetOk("existing_target_id"); ok {
+		properties.ExistingTargetID = utils.String(v.(string))
+	}
+
+	parameters := resourcemover.MoveResource{
+		Properties: &properties,
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.MoveCollectionName, id.Name, &parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceResourceMoverMoveResourceRead(d, meta)
+}
+
+func resourceResourceMoverMoveResourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ResourceMover.MoveResourceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MoveResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.MoveCollectionName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("move_collection_id", parse.NewMoveCollectionID(id.SubscriptionId, id.ResourceGroup, id.MoveCollectionName).ID())
+
+	if props := resp.Properties; props != nil {
+		d.Set("source_id", props.SourceID)
+		d.Set("existing_target_id", props.ExistingTargetID)
+		d.Set("target_id", props.TargetID)
+		d.Set("resolution_required", props.IsResolveRequired != nil && *props.IsResolveRequired)
+
+		d.Set("target_resource_name", moveResourceTargetResourceName(props.ResourceSettings))
+
+		moveState := ""
+		if props.MoveStatus != nil {
+			moveState = string(props.MoveStatus.MoveState)
+		}
+		d.Set("move_state", moveState)
+
+		if err := d.Set("dependency", flattenMoveResourceDependencies(props.DependsOn)); err != nil {
+			return fmt.Errorf("setting `dependency`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceResourceMoverMoveResourceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ResourceMover.MoveResourceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MoveResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.MoveCollectionName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// moveResourceSettings maps the type of the source resource to the matching
+// resource settings model, the service rejects the request when the settings
+// type does not match the type of the source resource.
+func moveResourceSettings(sourceId string, targetResourceName string) (resourcemover.BasicResourceSettings, error) {
+	targetName := utils.String(targetResourceName)
+
+	resourceType := ""
+	if parts := strings.SplitN(sourceId, "/providers/", 2); len(parts) == 2 {
+		segments := strings.Split(parts[1], "/")
+		for i := 1; i < len(segments); i += 2 {
+			if resourceType == "" {
+				resourceType = segments[0]
+			}
+			resourceType = fmt.Sprintf("%s/%s", resourceType, segments[i])
+		}
+	} else {
+		// a resource id without a provider segment refers to a resource group
+		resourceType = "resourceGroups"
+	}
+
+	switch {
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftComputevirtualMachines)):
+		return resourcemover.VirtualMachineResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftComputevirtualMachines, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftComputeavailabilitySets)):
+		return resourcemover.AvailabilitySetResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftComputeavailabilitySets, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftComputediskEncryptionSets)):
+		return resourcemover.DiskEncryptionSetResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftComputediskEncryptionSets, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftKeyVaultvaults)):
+		return resourcemover.KeyVaultResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftKeyVaultvaults, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftNetworkvirtualNetworks)):
+		return resourcemover.VirtualNetworkResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftNetworkvirtualNetworks, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftNetworknetworkInterfaces)):
+		return resourcemover.NetworkInterfaceResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftNetworknetworkInterfaces, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftNetworknetworkSecurityGroups)):
+		return resourcemover.NetworkSecurityGroupResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftNetworknetworkSecurityGroups, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftNetworkloadBalancers)):
+		return resourcemover.LoadBalancerResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftNetworkloadBalancers, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftNetworkpublicIPAddresses)):
+		return resourcemover.PublicIPAddressResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftNetworkpublicIPAddresses, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftSqlservers)):
+		return resourcemover.SQLServerResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftSqlservers, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftSqlserverselasticPools)):
+		return resourcemover.SQLElasticPoolResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftSqlserverselasticPools, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, string(resourcemover.ResourceTypeMicrosoftSqlserversdatabases)):
+		return resourcemover.SQLDatabaseResourceSettings{ResourceType: resourcemover.ResourceTypeMicrosoftSqlserversdatabases, TargetResourceName: targetName}, nil
+	case strings.EqualFold(resourceType, "resourceGroups"):
+		return resourcemover.ResourceGroupResourceSettings{ResourceType: resourcemover.ResourceTypeResourceGroups, TargetResourceName: targetName}, nil
+	}
+
+	return nil, fmt.Errorf("resources of type %q cannot be moved with Azure Resource Mover", resourceType)
+}
+
+func moveResourceTargetResourceName(input resourcemover.BasicResourceSettings) string {
+	if input == nil {
+		return ""
+	}
+
+	if v, ok := input.AsVirtualMachineResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsAvailabilitySetResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsDiskEncryptionSetResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsKeyVaultResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsVirtualNetworkResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsNetworkInterfaceResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsNetworkSecurityGroupResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsLoadBalancerResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsPublicIPAddressResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsSQLServerResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsSQLElasticPoolResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsSQLDatabaseResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+	if v, ok := input.AsResourceGroupResourceSettings(); ok && v.TargetResourceName != nil {
+		return *v.TargetResourceName
+	}
+
+	return ""
+}
+
+func flattenMoveResourceDependencies(input *[]resourcemover.MoveResourceDependency) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, dependency := range *input {
+		results = append(results, map[string]interface{}{
+			"id":                utils.NormalizeNilableString(dependency.ID),
+			"resolution_status": utils.NormalizeNilableString(dependency.ResolutionStatus),
+			"resolution_type":   string(dependency.ResolutionType),
+			"dependency_type":   string(dependency.DependencyType),
+			"optional":          dependency.IsOptional != nil && strings.EqualFold(*dependency.IsOptional, "true"),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,122 @@
+package resourcemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resourcemover/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ResourceMoverMoveResourceResource struct{}
+
+func TestAccResourceMoverMoveResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_mover_move_resource", "test")
+	r := ResourceMoverMoveResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("move_state").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceMoverMoveResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_mover_move_resource", "test")
+	r := ResourceMoverMoveResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ResourceMoverMoveResourceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.MoveResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ResourceMover.MoveResourceClient.Get(ctx, id.ResourceGroup, id.MoveCollectionName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ResourceMoverMoveResourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-rmover-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_resource_mover_move_collection" "test" {
+  name                = "acctest-mc-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  source_region       = "%[2]s"
+  target_region       = "%[3]s"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
+
+func (r ResourceMoverMoveResourceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_mover_move_resource" "test" {
+  name                 = "acctest-mr-%d"
+  move_collection_id   = azurerm_resource_mover_move_collection.test.id
+  source_id            = azurerm_virtual_network.test.id
+  target_resource_name = "acctest-vnet-target-%d"
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ResourceMoverMoveResourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_mover_move_resource" "import" {
+  name                 = azurerm_resource_mover_move_resource.test.name
+  move_collection_id   = azurerm_resource_mover_move_resource.test.move_collection_id
+  source_id            = azurerm_resource_mover_move_resource.test.source_id
+  target_resource_name = azurerm_resource_mover_move_resource.test.target_resource_name
+}
+`, r.basic(data))
+}
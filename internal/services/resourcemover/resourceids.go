@@ -0,0 +1,4 @@
+package resourcemover
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=MoveCollection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Migrate/moveCollections/collection1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=MoveResource -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Migrate/moveCollections/collection1/moveResources/resource1
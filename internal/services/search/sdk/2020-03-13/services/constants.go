@@ -5,6 +5,34 @@ import "strings"
 // Copyright (c) Microsoft Corporation. All rights reserved.
 // Licensed under the MIT License. See NOTICE.txt in the project root for license information.
 
+type AadAuthFailureMode string
+
+const (
+	AadAuthFailureModeHTTPFourZeroOneWithBearerChallenge AadAuthFailureMode = "http401WithBearerChallenge"
+	AadAuthFailureModeHTTPFourZeroThree                  AadAuthFailureMode = "http403"
+)
+
+func PossibleValuesForAadAuthFailureMode() []string {
+	return []string{
+		string(AadAuthFailureModeHTTPFourZeroOneWithBearerChallenge),
+		string(AadAuthFailureModeHTTPFourZeroThree),
+	}
+}
+
+func parseAadAuthFailureMode(input string) (*AadAuthFailureMode, error) {
+	vals := map[string]AadAuthFailureMode{
+		"http401withbearerchallenge": AadAuthFailureModeHTTPFourZeroOneWithBearerChallenge,
+		"http403":                    AadAuthFailureModeHTTPFourZeroThree,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AadAuthFailureMode(input)
+	return &out, nil
+}
+
 type HostingMode string
 
 const (
@@ -151,6 +179,37 @@ func parseResourceType(input string) (*ResourceType, error) {
 	return &out, nil
 }
 
+type SearchSemanticSearch string
+
+const (
+	SearchSemanticSearchDisabled SearchSemanticSearch = "disabled"
+	SearchSemanticSearchFree     SearchSemanticSearch = "free"
+	SearchSemanticSearchStandard SearchSemanticSearch = "standard"
+)
+
+func PossibleValuesForSearchSemanticSearch() []string {
+	return []string{
+		string(SearchSemanticSearchDisabled),
+		string(SearchSemanticSearchFree),
+		string(SearchSemanticSearchStandard),
+	}
+}
+
+func parseSearchSemanticSearch(input string) (*SearchSemanticSearch, error) {
+	vals := map[string]SearchSemanticSearch{
+		"disabled": SearchSemanticSearchDisabled,
+		"free":     SearchSemanticSearchFree,
+		"standard": SearchSemanticSearchStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SearchSemanticSearch(input)
+	return &out, nil
+}
+
 type SearchServiceStatus string
 
 const (
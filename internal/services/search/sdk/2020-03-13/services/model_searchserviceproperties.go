@@ -4,6 +4,8 @@ package services
 // Licensed under the MIT License. See NOTICE.txt in the project root for license information.
 
 type SearchServiceProperties struct {
+	AuthOptions                *DataPlaneAuthOptions        `json:"authOptions,omitempty"`
+	DisableLocalAuth           *bool                        `json:"disableLocalAuth,omitempty"`
 	HostingMode                *HostingMode                 `json:"hostingMode,omitempty"`
 	NetworkRuleSet             *NetworkRuleSet              `json:"networkRuleSet,omitempty"`
 	PartitionCount             *int64                       `json:"partitionCount,omitempty"`
@@ -11,6 +13,7 @@ type SearchServiceProperties struct {
 	ProvisioningState          *ProvisioningState           `json:"provisioningState,omitempty"`
 	PublicNetworkAccess        *PublicNetworkAccess         `json:"publicNetworkAccess,omitempty"`
 	ReplicaCount               *int64                       `json:"replicaCount,omitempty"`
+	SemanticSearch             *SearchSemanticSearch        `json:"semanticSearch,omitempty"`
 	Status                     *SearchServiceStatus         `json:"status,omitempty"`
 	StatusDetails              *string                      `json:"statusDetails,omitempty"`
 }
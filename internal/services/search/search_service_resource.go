@@ -12,11 +12,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2020-03-13/adminkeys"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2020-03-13/querykeys"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2020-03-13/services"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2020-03-13/services"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -25,9 +25,9 @@ import (
 
 func resourceSearchService() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceSearchServiceCreateUpdate,
+		Create: resourceSearchServiceCreate,
 		Read:   resourceSearchServiceRead,
-		Update: resourceSearchServiceCreateUpdate,
+		Update: resourceSearchServiceUpdate,
 		Delete: resourceSearchServiceDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -115,6 +115,30 @@ func resourceSearchService() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"local_authentication_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"authentication_failure_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(services.AadAuthFailureModeHTTPFourZeroOneWithBearerChallenge),
+					string(services.AadAuthFailureModeHTTPFourZeroThree),
+				}, false),
+			},
+
+			"semantic_search_sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(services.SearchSemanticSearchFree),
+					string(services.SearchSemanticSearchStandard),
+				}, false),
+			},
+
 			"allowed_ips": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -134,26 +158,24 @@ func resourceSearchService() *pluginsdk.Resource {
 	}
 }
 
-func resourceSearchServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceSearchServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Search.ServicesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	id := services.NewSearchServiceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id, services.GetOperationOptions{})
-		if err != nil {
-			if !response.WasNotFound(existing.HttpResponse) {
-				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
-			}
-		}
-
+	existing, err := client.Get(ctx, id, services.GetOperationOptions{})
+	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
-			return tf.ImportAsExistsError("azurerm_search_service", id.ID())
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
 
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_search_service", id.ID())
+	}
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 
 	publicNetworkAccess := services.PublicNetworkAccessEnabled
@@ -161,6 +183,12 @@ func resourceSearchServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		publicNetworkAccess = services.PublicNetworkAccessDisabled
 	}
 
+	localAuthenticationEnabled := d.Get("local_authentication_enabled").(bool)
+	authenticationFailureMode := d.Get("authentication_failure_mode").(string)
+	if !localAuthenticationEnabled && authenticationFailureMode != "" {
+		return fmt.Errorf("`authentication_failure_mode` cannot be defined if `local_authentication_enabled` is set to `false`")
+	}
+
 	expandedIdentity, err := identity.ExpandSystemAssigned(d.Get("identity").([]interface{}))
 	if err != nil {
 		return fmt.Errorf("expanding `identity`: %+v", err)
@@ -173,6 +201,7 @@ func resourceSearchServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface
 			Name: &skuName,
 		},
 		Properties: &services.SearchServiceProperties{
+			DisableLocalAuth:    utils.Bool(!localAuthenticationEnabled),
 			PublicNetworkAccess: &publicNetworkAccess,
 			NetworkRuleSet: &services.NetworkRuleSet{
 				IPRules: expandSearchServiceIPRules(d.Get("allowed_ips").([]interface{})),
@@ -182,6 +211,19 @@ func resourceSearchServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if authenticationFailureMode != "" {
+		properties.Properties.AuthOptions = expandSearchServiceAuthOptions(authenticationFailureMode)
+	}
+
+	if v, ok := d.GetOk("semantic_search_sku"); ok {
+		if skuName == services.SkuNameFree {
+			return fmt.Errorf("`semantic_search_sku` cannot be defined if `sku` is set to `free`")
+		}
+
+		semanticSearchSku := services.SearchSemanticSearch(v.(string))
+		properties.Properties.SemanticSearch = &semanticSearchSku
+	}
+
 	if v, ok := d.GetOk("replica_count"); ok {
 		replicaCount := int64(v.(int))
 		properties.Properties.ReplicaCount = utils.Int64(replicaCount)
@@ -194,13 +236,103 @@ func resourceSearchServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface
 
 	err = client.CreateOrUpdateThenPoll(ctx, id, properties, services.CreateOrUpdateOperationOptions{})
 	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
 	return resourceSearchServiceRead(d, meta)
 }
 
+func resourceSearchServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Search.ServicesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := services.ParseSearchServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id, services.GetOperationOptions{})
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `model.Properties` was nil", *id)
+	}
+	model := *resp.Model
+
+	if d.HasChange("public_network_access_enabled") {
+		publicNetworkAccess := services.PublicNetworkAccessEnabled
+		if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
+			publicNetworkAccess = services.PublicNetworkAccessDisabled
+		}
+
+		model.Properties.PublicNetworkAccess = &publicNetworkAccess
+	}
+
+	if d.HasChanges("authentication_failure_mode", "local_authentication_enabled") {
+		localAuthenticationEnabled := d.Get("local_authentication_enabled").(bool)
+		authenticationFailureMode := d.Get("authentication_failure_mode").(string)
+		if !localAuthenticationEnabled && authenticationFailureMode != "" {
+			return fmt.Errorf("`authentication_failure_mode` cannot be defined if `local_authentication_enabled` is set to `false`")
+		}
+
+		model.Properties.DisableLocalAuth = utils.Bool(!localAuthenticationEnabled)
+		model.Properties.AuthOptions = nil
+		if authenticationFailureMode != "" {
+			model.Properties.AuthOptions = expandSearchServiceAuthOptions(authenticationFailureMode)
+		}
+	}
+
+	if d.HasChange("semantic_search_sku") {
+		semanticSearchSku := services.SearchSemanticSearchDisabled
+		if v := d.Get("semantic_search_sku").(string); v != "" {
+			if model.Sku != nil && model.Sku.Name != nil && *model.Sku.Name == services.SkuNameFree {
+				return fmt.Errorf("`semantic_search_sku` cannot be defined if `sku` is set to `free`")
+			}
+
+			semanticSearchSku = services.SearchSemanticSearch(v)
+		}
+
+		model.Properties.SemanticSearch = &semanticSearchSku
+	}
+
+	if d.HasChange("replica_count") {
+		model.Properties.ReplicaCount = utils.Int64(int64(d.Get("replica_count").(int)))
+	}
+
+	if d.HasChange("partition_count") {
+		model.Properties.PartitionCount = utils.Int64(int64(d.Get("partition_count").(int)))
+	}
+
+	if d.HasChange("allowed_ips") {
+		model.Properties.NetworkRuleSet = &services.NetworkRuleSet{
+			IPRules: expandSearchServiceIPRules(d.Get("allowed_ips").([]interface{})),
+		}
+	}
+
+	if d.HasChange("identity") {
+		expandedIdentity, err := identity.ExpandSystemAssigned(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+
+		model.Identity = expandedIdentity
+	}
+
+	if d.HasChange("tags") {
+		model.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, model, services.CreateOrUpdateOperationOptions{}); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceSearchServiceRead(d, meta)
+}
+
 func resourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Search.ServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -255,6 +387,20 @@ func resourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			d.Set("replica_count", replicaCount)
 			d.Set("public_network_access_enabled", publicNetworkAccess)
 			d.Set("allowed_ips", flattenSearchServiceIPRules(props.NetworkRuleSet))
+
+			d.Set("local_authentication_enabled", props.DisableLocalAuth == nil || !*props.DisableLocalAuth)
+
+			authenticationFailureMode := ""
+			if props.AuthOptions != nil && props.AuthOptions.AadOrApiKey != nil && props.AuthOptions.AadOrApiKey.AadAuthFailureMode != nil {
+				authenticationFailureMode = string(*props.AuthOptions.AadOrApiKey.AadAuthFailureMode)
+			}
+			d.Set("authentication_failure_mode", authenticationFailureMode)
+
+			semanticSearchSku := ""
+			if props.SemanticSearch != nil && *props.SemanticSearch != services.SearchSemanticSearchDisabled {
+				semanticSearchSku = string(*props.SemanticSearch)
+			}
+			d.Set("semantic_search_sku", semanticSearchSku)
 		}
 
 		if err = d.Set("identity", identity.FlattenSystemAssigned(model.Identity)); err != nil {
@@ -334,6 +480,16 @@ func flattenSearchQueryKeys(input []querykeys.QueryKey) []interface{} {
 	return results
 }
 
+func expandSearchServiceAuthOptions(authenticationFailureMode string) *services.DataPlaneAuthOptions {
+	failureMode := services.AadAuthFailureMode(authenticationFailureMode)
+
+	return &services.DataPlaneAuthOptions{
+		AadOrApiKey: &services.DataPlaneAadOrApiKeyAuthOption{
+			AadAuthFailureMode: &failureMode,
+		},
+	}
+}
+
 func expandSearchServiceIPRules(input []interface{}) *[]services.IPRule {
 	output := make([]services.IPRule, 0)
 	if input == nil {
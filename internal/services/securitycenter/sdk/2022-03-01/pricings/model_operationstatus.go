@@ -0,0 +1,9 @@
+package pricings
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type OperationStatus struct {
+	Code    *Code   `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
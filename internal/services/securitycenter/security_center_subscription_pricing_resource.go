@@ -7,10 +7,10 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v3.0/security" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	pricings_v2022_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/security/2022-03-01/pricings"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter/parse"
+	pricings_v2022_03_01 "github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter/sdk/2022-03-01/pricings"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -68,12 +68,33 @@ func resourceSecurityCenterSubscriptionPricing() *pluginsdk.Resource {
 					"OpenSourceRelationalDatabases",
 					"Containers",
 					"CloudPosture",
+					"CosmosDbs",
 				}, false),
 			},
 			"subplan": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 			},
+			"extension": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"additional_extension_properties": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -97,6 +118,44 @@ func resourceSecurityCenterSubscriptionPricingUpdate(d *pluginsdk.ResourceData,
 		pricing.Properties.SubPlan = utils.String(v.(string))
 	}
 
+	extensions := expandSecurityCenterSubscriptionPricingExtensions(d.Get("extension").(*pluginsdk.Set).List())
+
+	// any extension enabled on the plan but no longer present in the config needs to be explicitly disabled,
+	// since extensions omitted from the request are left untouched by the API
+	existing, err := client.Get(ctx, id)
+	if err != nil && !response.WasNotFound(existing.HttpResponse) {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.Extensions != nil {
+		configured := make(map[string]struct{})
+		if extensions != nil {
+			for _, extension := range *extensions {
+				configured[extension.Name] = struct{}{}
+			}
+		}
+
+		disabled := make([]pricings_v2022_03_01.Extension, 0)
+		for _, extension := range *model.Properties.Extensions {
+			if _, ok := configured[extension.Name]; !ok {
+				disabled = append(disabled, pricings_v2022_03_01.Extension{
+					Name:      extension.Name,
+					IsEnabled: pricings_v2022_03_01.IsEnabledFalse,
+				})
+			}
+		}
+
+		if len(disabled) > 0 {
+			if extensions == nil {
+				extensions = &disabled
+			} else {
+				combined := append(*extensions, disabled...)
+				extensions = &combined
+			}
+		}
+	}
+
+	pricing.Properties.Extensions = extensions
+
 	if _, err := client.Update(ctx, id, pricing); err != nil {
 		return fmt.Errorf("setting %s: %+v", id, err)
 	}
@@ -131,6 +190,10 @@ func resourceSecurityCenterSubscriptionPricingRead(d *pluginsdk.ResourceData, me
 		if properties := resp.Model.Properties; properties != nil {
 			d.Set("tier", properties.PricingTier)
 			d.Set("subplan", properties.SubPlan)
+
+			if err := d.Set("extension", flattenSecurityCenterSubscriptionPricingExtensions(properties.Extensions)); err != nil {
+				return fmt.Errorf("setting `extension`: %+v", err)
+			}
 		}
 	}
 
@@ -143,3 +206,53 @@ func resourceSecurityCenterSubscriptionPricingDelete(_ *pluginsdk.ResourceData,
 	log.Printf("[DEBUG] Security Center Subscription deletion invocation")
 	return nil
 }
+
+func expandSecurityCenterSubscriptionPricingExtensions(input []interface{}) *[]pricings_v2022_03_01.Extension {
+	if len(input) == 0 {
+		return nil
+	}
+
+	extensions := make([]pricings_v2022_03_01.Extension, 0)
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		extension := pricings_v2022_03_01.Extension{
+			Name:      v["name"].(string),
+			IsEnabled: pricings_v2022_03_01.IsEnabledTrue,
+		}
+
+		if props := v["additional_extension_properties"].(map[string]interface{}); len(props) > 0 {
+			extension.AdditionalExtensionProperties = &props
+		}
+
+		extensions = append(extensions, extension)
+	}
+
+	return &extensions
+}
+
+func flattenSecurityCenterSubscriptionPricingExtensions(input *[]pricings_v2022_03_01.Extension) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, extension := range *input {
+		// disabled extensions aren't tracked in the configuration
+		if extension.IsEnabled != pricings_v2022_03_01.IsEnabledTrue {
+			continue
+		}
+
+		properties := make(map[string]interface{})
+		if extension.AdditionalExtensionProperties != nil {
+			properties = *extension.AdditionalExtensionProperties
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                            extension.Name,
+			"additional_extension_properties": properties,
+		})
+	}
+
+	return results
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
+	securityinsight "github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2022-10-01-preview/securityinsights"
 )
 
 type DataConnectorsClient struct {
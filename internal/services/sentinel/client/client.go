@@ -4,7 +4,8 @@ import (
 	alertruletemplates "github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/sentinelonboardingstates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
-	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
+	securityinsight "github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2022-10-01-preview/securityinsights"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-04-01-preview/contentpackages"
 )
 
 type Client struct {
@@ -15,6 +16,7 @@ type Client struct {
 	WatchlistsClient         *securityinsight.WatchlistsClient
 	WatchlistItemsClient     *securityinsight.WatchlistItemsClient
 	OnboardingStatesClient   *sentinelonboardingstates.SentinelOnboardingStatesClient
+	ContentPackagesClient    *contentpackages.ContentPackagesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -39,6 +41,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	onboardingStatesClient := sentinelonboardingstates.NewSentinelOnboardingStatesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&onboardingStatesClient.Client, o.ResourceManagerAuthorizer)
 
+	contentPackagesClient := contentpackages.NewContentPackagesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&contentPackagesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AlertRulesClient:         &alertRulesClient,
 		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
@@ -47,5 +52,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		WatchlistsClient:         &watchListsClient,
 		WatchlistItemsClient:     &watchListItemsClient,
 		OnboardingStatesClient:   &onboardingStatesClient,
+		ContentPackagesClient:    &contentPackagesClient,
 	}
 }
@@ -25,6 +25,8 @@ func PossibleActionTypeValues() []ActionType {
 type ActionTypeBasicAutomationRuleAction string
 
 const (
+	// ActionTypeBasicAutomationRuleActionActionTypeAddIncidentTask ...
+	ActionTypeBasicAutomationRuleActionActionTypeAddIncidentTask ActionTypeBasicAutomationRuleAction = "AddIncidentTask"
 	// ActionTypeBasicAutomationRuleActionActionTypeAutomationRuleAction ...
 	ActionTypeBasicAutomationRuleActionActionTypeAutomationRuleAction ActionTypeBasicAutomationRuleAction = "AutomationRuleAction"
 	// ActionTypeBasicAutomationRuleActionActionTypeModifyProperties ...
@@ -35,7 +37,7 @@ const (
 
 // PossibleActionTypeBasicAutomationRuleActionValues returns an array of possible values for the ActionTypeBasicAutomationRuleAction const type.
 func PossibleActionTypeBasicAutomationRuleActionValues() []ActionTypeBasicAutomationRuleAction {
-	return []ActionTypeBasicAutomationRuleAction{ActionTypeBasicAutomationRuleActionActionTypeAutomationRuleAction, ActionTypeBasicAutomationRuleActionActionTypeModifyProperties, ActionTypeBasicAutomationRuleActionActionTypeRunPlaybook}
+	return []ActionTypeBasicAutomationRuleAction{ActionTypeBasicAutomationRuleActionActionTypeAddIncidentTask, ActionTypeBasicAutomationRuleActionActionTypeAutomationRuleAction, ActionTypeBasicAutomationRuleActionActionTypeModifyProperties, ActionTypeBasicAutomationRuleActionActionTypeRunPlaybook}
 }
 
 // AlertDetail enumerates the values for alert detail.
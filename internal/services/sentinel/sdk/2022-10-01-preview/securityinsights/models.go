@@ -3789,11 +3789,69 @@ func (ar *AutomationRule) UnmarshalJSON(body []byte) error {
 
 // BasicAutomationRuleAction describes an automation rule action.
 type BasicAutomationRuleAction interface {
+	AsAutomationRuleAddIncidentTaskAction() (*AutomationRuleAddIncidentTaskAction, bool)
 	AsAutomationRuleModifyPropertiesAction() (*AutomationRuleModifyPropertiesAction, bool)
 	AsAutomationRuleRunPlaybookAction() (*AutomationRuleRunPlaybookAction, bool)
 	AsAutomationRuleAction() (*AutomationRuleAction, bool)
 }
 
+// AddIncidentTaskActionProperties ...
+type AddIncidentTaskActionProperties struct {
+	// Title - The title of the task.
+	Title *string `json:"title,omitempty"`
+	// Description - The description of the task.
+	Description *string `json:"description,omitempty"`
+}
+
+// AutomationRuleAddIncidentTaskAction describes an automation rule action to add a task to an incident
+type AutomationRuleAddIncidentTaskAction struct {
+	ActionConfiguration *AddIncidentTaskActionProperties `json:"actionConfiguration,omitempty"`
+	Order               *int32                           `json:"order,omitempty"`
+	// ActionType - Possible values include: 'ActionTypeBasicAutomationRuleActionActionTypeAutomationRuleAction', 'ActionTypeBasicAutomationRuleActionActionTypeAddIncidentTask', 'ActionTypeBasicAutomationRuleActionActionTypeModifyProperties', 'ActionTypeBasicAutomationRuleActionActionTypeRunPlaybook'
+	ActionType ActionTypeBasicAutomationRuleAction `json:"actionType,omitempty"`
+}
+
+// MarshalJSON is the custom marshaler for AutomationRuleAddIncidentTaskAction.
+func (araita AutomationRuleAddIncidentTaskAction) MarshalJSON() ([]byte, error) {
+	araita.ActionType = ActionTypeBasicAutomationRuleActionActionTypeAddIncidentTask
+	objectMap := make(map[string]interface{})
+	if araita.ActionConfiguration != nil {
+		objectMap["actionConfiguration"] = araita.ActionConfiguration
+	}
+	if araita.Order != nil {
+		objectMap["order"] = araita.Order
+	}
+	if araita.ActionType != "" {
+		objectMap["actionType"] = araita.ActionType
+	}
+	return json.Marshal(objectMap)
+}
+
+// AsAutomationRuleAddIncidentTaskAction is the BasicAutomationRuleAction implementation for AutomationRuleAddIncidentTaskAction.
+func (araita AutomationRuleAddIncidentTaskAction) AsAutomationRuleAddIncidentTaskAction() (*AutomationRuleAddIncidentTaskAction, bool) {
+	return &araita, true
+}
+
+// AsAutomationRuleModifyPropertiesAction is the BasicAutomationRuleAction implementation for AutomationRuleAddIncidentTaskAction.
+func (araita AutomationRuleAddIncidentTaskAction) AsAutomationRuleModifyPropertiesAction() (*AutomationRuleModifyPropertiesAction, bool) {
+	return nil, false
+}
+
+// AsAutomationRuleRunPlaybookAction is the BasicAutomationRuleAction implementation for AutomationRuleAddIncidentTaskAction.
+func (araita AutomationRuleAddIncidentTaskAction) AsAutomationRuleRunPlaybookAction() (*AutomationRuleRunPlaybookAction, bool) {
+	return nil, false
+}
+
+// AsAutomationRuleAction is the BasicAutomationRuleAction implementation for AutomationRuleAddIncidentTaskAction.
+func (araita AutomationRuleAddIncidentTaskAction) AsAutomationRuleAction() (*AutomationRuleAction, bool) {
+	return nil, false
+}
+
+// AsBasicAutomationRuleAction is the BasicAutomationRuleAction implementation for AutomationRuleAddIncidentTaskAction.
+func (araita AutomationRuleAddIncidentTaskAction) AsBasicAutomationRuleAction() (BasicAutomationRuleAction, bool) {
+	return &araita, true
+}
+
 // AutomationRuleAction describes an automation rule action.
 type AutomationRuleAction struct {
 	Order *int32 `json:"order,omitempty"`
@@ -3809,6 +3867,10 @@ func unmarshalBasicAutomationRuleAction(body []byte) (BasicAutomationRuleAction,
 	}
 
 	switch m["actionType"] {
+	case string(ActionTypeBasicAutomationRuleActionActionTypeAddIncidentTask):
+		var araita AutomationRuleAddIncidentTaskAction
+		err := json.Unmarshal(body, &araita)
+		return araita, err
 	case string(ActionTypeBasicAutomationRuleActionActionTypeModifyProperties):
 		var armpa AutomationRuleModifyPropertiesAction
 		err := json.Unmarshal(body, &armpa)
@@ -3855,6 +3917,11 @@ func (ara AutomationRuleAction) MarshalJSON() ([]byte, error) {
 	return json.Marshal(objectMap)
 }
 
+// AsAutomationRuleAddIncidentTaskAction is the BasicAutomationRuleAction implementation for AutomationRuleAction.
+func (ara AutomationRuleAction) AsAutomationRuleAddIncidentTaskAction() (*AutomationRuleAddIncidentTaskAction, bool) {
+	return nil, false
+}
+
 // AsAutomationRuleModifyPropertiesAction is the BasicAutomationRuleAction implementation for AutomationRuleAction.
 func (ara AutomationRuleAction) AsAutomationRuleModifyPropertiesAction() (*AutomationRuleModifyPropertiesAction, bool) {
 	return nil, false
@@ -4053,6 +4120,11 @@ func (armpa AutomationRuleModifyPropertiesAction) MarshalJSON() ([]byte, error)
 	return json.Marshal(objectMap)
 }
 
+// AsAutomationRuleAddIncidentTaskAction is the BasicAutomationRuleAction implementation for AutomationRuleModifyPropertiesAction.
+func (armpa AutomationRuleModifyPropertiesAction) AsAutomationRuleAddIncidentTaskAction() (*AutomationRuleAddIncidentTaskAction, bool) {
+	return nil, false
+}
+
 // AsAutomationRuleModifyPropertiesAction is the BasicAutomationRuleAction implementation for AutomationRuleModifyPropertiesAction.
 func (armpa AutomationRuleModifyPropertiesAction) AsAutomationRuleModifyPropertiesAction() (*AutomationRuleModifyPropertiesAction, bool) {
 	return &armpa, true
@@ -4298,6 +4370,11 @@ func (arrpa AutomationRuleRunPlaybookAction) MarshalJSON() ([]byte, error) {
 	return json.Marshal(objectMap)
 }
 
+// AsAutomationRuleAddIncidentTaskAction is the BasicAutomationRuleAction implementation for AutomationRuleRunPlaybookAction.
+func (arrpa AutomationRuleRunPlaybookAction) AsAutomationRuleAddIncidentTaskAction() (*AutomationRuleAddIncidentTaskAction, bool) {
+	return nil, false
+}
+
 // AsAutomationRuleModifyPropertiesAction is the BasicAutomationRuleAction implementation for AutomationRuleRunPlaybookAction.
 func (arrpa AutomationRuleRunPlaybookAction) AsAutomationRuleModifyPropertiesAction() (*AutomationRuleModifyPropertiesAction, bool) {
 	return nil, false
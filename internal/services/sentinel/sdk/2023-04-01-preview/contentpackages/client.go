@@ -0,0 +1,18 @@
+package contentpackages
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ContentPackagesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewContentPackagesClientWithBaseURI(endpoint string) ContentPackagesClient {
+	return ContentPackagesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
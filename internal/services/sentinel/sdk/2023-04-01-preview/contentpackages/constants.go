@@ -0,0 +1,34 @@
+package contentpackages
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PackageKind string
+
+const (
+	PackageKindSolution   PackageKind = "Solution"
+	PackageKindStandalone PackageKind = "Standalone"
+)
+
+func PossibleValuesForPackageKind() []string {
+	return []string{
+		string(PackageKindSolution),
+		string(PackageKindStandalone),
+	}
+}
+
+func parsePackageKind(input string) (*PackageKind, error) {
+	vals := map[string]PackageKind{
+		"solution":   PackageKindSolution,
+		"standalone": PackageKindStandalone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PackageKind(input)
+	return &out, nil
+}
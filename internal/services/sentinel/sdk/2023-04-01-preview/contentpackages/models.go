@@ -0,0 +1,31 @@
+package contentpackages
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PackageModel struct {
+	Etag       *string            `json:"etag,omitempty"`
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *PackageProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
+
+type PackageProperties struct {
+	Author               *PackageAuthor `json:"author,omitempty"`
+	ContentId            *string        `json:"contentId,omitempty"`
+	ContentKind          *PackageKind   `json:"contentKind,omitempty"`
+	ContentProductId     *string        `json:"contentProductId,omitempty"`
+	ContentSchemaVersion *string        `json:"contentSchemaVersion,omitempty"`
+	Description          *string        `json:"description,omitempty"`
+	DisplayName          *string        `json:"displayName,omitempty"`
+	IsNew                *string        `json:"isNew,omitempty"`
+	Publisher            *string        `json:"publisherDisplayName,omitempty"`
+	Version              *string        `json:"version,omitempty"`
+}
+
+type PackageAuthor struct {
+	Email *string `json:"email,omitempty"`
+	Link  *string `json:"link,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
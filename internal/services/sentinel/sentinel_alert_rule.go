@@ -6,9 +6,9 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	securityinsight "github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2022-10-01-preview/securityinsights"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
 
 func alertRuleID(rule securityinsight.BasicAlertRule) *string {
@@ -16,12 +16,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	securityinsight "github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2022-10-01-preview/securityinsights"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
 
 func resourceSentinelAutomationRule() *pluginsdk.Resource {
@@ -161,7 +161,34 @@ func resourceSentinelAutomationRule() *pluginsdk.Resource {
 					},
 				},
 			},
-			AtLeastOneOf: []string{"action_incident", "action_playbook"},
+			AtLeastOneOf: []string{"action_incident", "action_incident_task", "action_playbook"},
+		},
+
+		"action_incident_task": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"order": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"title": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+			AtLeastOneOf: []string{"action_incident", "action_incident_task", "action_playbook"},
 		},
 
 		"action_playbook": {
@@ -190,7 +217,7 @@ func resourceSentinelAutomationRule() *pluginsdk.Resource {
 					},
 				},
 			},
-			AtLeastOneOf: []string{"action_incident", "action_playbook"},
+			AtLeastOneOf: []string{"action_incident", "action_incident_task", "action_playbook"},
 		},
 	}
 
@@ -214,6 +241,8 @@ func resourceSentinelAutomationRule() *pluginsdk.Resource {
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAccountPUID),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAccountSid),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAccountUPNSuffix),
+							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAlertAnalyticRuleIds),
+							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAlertProductNames),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAzureResourceResourceID),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyAzureResourceSubscriptionID),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyCloudApplicationAppID),
@@ -228,13 +257,17 @@ func resourceSentinelAutomationRule() *pluginsdk.Resource {
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyHostNetBiosName),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyHostOSVersion),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIPAddress),
+							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentCustomDetailsKey),
+							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentCustomDetailsValue),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentDescription),
+							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentLabel),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentProviderName),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentRelatedAnalyticRuleIds),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentSeverity),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentStatus),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentTactics),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentTitle),
+							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIncidentUpdatedBySource),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIoTDeviceID),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIoTDeviceModel),
 							string(securityinsight.AutomationRulePropertyConditionSupportedPropertyIoTDeviceName),
@@ -448,11 +481,14 @@ func resourceSentinelAutomationRuleRead(d *pluginsdk.ResourceData, meta interfac
 			d.Set("condition_json", conditionJSON)
 		}
 
-		actionIncident, actionPlaybook := flattenAutomationRuleActions(prop.Actions)
+		actionIncident, actionIncidentTask, actionPlaybook := flattenAutomationRuleActions(prop.Actions)
 
 		if err := d.Set("action_incident", actionIncident); err != nil {
 			return fmt.Errorf("setting `action_incident`: %v", err)
 		}
+		if err := d.Set("action_incident_task", actionIncidentTask); err != nil {
+			return fmt.Errorf("setting `action_incident_task`: %v", err)
+		}
 		if err := d.Set("action_playbook", actionPlaybook); err != nil {
 			return fmt.Errorf("setting `action_playbook`: %v", err)
 		}
@@ -558,33 +594,38 @@ func expandAutomationRuleActions(d *pluginsdk.ResourceData, defaultTenantId stri
 	if err != nil {
 		return nil, err
 	}
+	actionIncidentTask := expandAutomationRuleActionIncidentTask(d.Get("action_incident_task").([]interface{}))
 	actionPlaybook, err := expandAutomationRuleActionPlaybook(d.Get("action_playbook").([]interface{}), defaultTenantId)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(actionIncident)+len(actionPlaybook) == 0 {
+	if len(actionIncident)+len(actionIncidentTask)+len(actionPlaybook) == 0 {
 		return nil, nil
 	}
 
-	out := make([]securityinsight.BasicAutomationRuleAction, 0, len(actionIncident)+len(actionPlaybook))
+	out := make([]securityinsight.BasicAutomationRuleAction, 0, len(actionIncident)+len(actionIncidentTask)+len(actionPlaybook))
 	out = append(out, actionIncident...)
+	out = append(out, actionIncidentTask...)
 	out = append(out, actionPlaybook...)
 	return &out, nil
 }
 
-func flattenAutomationRuleActions(input *[]securityinsight.BasicAutomationRuleAction) (actionIncident []interface{}, actionPlaybook []interface{}) {
+func flattenAutomationRuleActions(input *[]securityinsight.BasicAutomationRuleAction) (actionIncident []interface{}, actionIncidentTask []interface{}, actionPlaybook []interface{}) {
 	if input == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	actionIncident = make([]interface{}, 0)
+	actionIncidentTask = make([]interface{}, 0)
 	actionPlaybook = make([]interface{}, 0)
 
 	for _, action := range *input {
 		switch action := action.(type) {
 		case securityinsight.AutomationRuleModifyPropertiesAction:
 			actionIncident = append(actionIncident, flattenAutomationRuleActionIncident(action))
+		case securityinsight.AutomationRuleAddIncidentTaskAction:
+			actionIncidentTask = append(actionIncidentTask, flattenAutomationRuleActionIncidentTask(action))
 		case securityinsight.AutomationRuleRunPlaybookAction:
 			actionPlaybook = append(actionPlaybook, flattenAutomationRuleActionPlaybook(action))
 		}
@@ -725,6 +766,58 @@ func flattenAutomationRuleActionIncident(input securityinsight.AutomationRuleMod
 	}
 }
 
+func expandAutomationRuleActionIncidentTask(input []interface{}) []securityinsight.BasicAutomationRuleAction {
+	if len(input) == 0 {
+		return nil
+	}
+
+	out := make([]securityinsight.BasicAutomationRuleAction, 0, len(input))
+	for _, b := range input {
+		b := b.(map[string]interface{})
+
+		action := securityinsight.AutomationRuleAddIncidentTaskAction{
+			ActionType: securityinsight.ActionTypeBasicAutomationRuleActionActionTypeAddIncidentTask,
+			Order:      utils.Int32(int32(b["order"].(int))),
+			ActionConfiguration: &securityinsight.AddIncidentTaskActionProperties{
+				Title: utils.String(b["title"].(string)),
+			},
+		}
+		if description := b["description"].(string); description != "" {
+			action.ActionConfiguration.Description = utils.String(description)
+		}
+
+		out = append(out, action)
+	}
+	return out
+}
+
+func flattenAutomationRuleActionIncidentTask(input securityinsight.AutomationRuleAddIncidentTaskAction) map[string]interface{} {
+	var order int
+	if input.Order != nil {
+		order = int(*input.Order)
+	}
+
+	var (
+		title       string
+		description string
+	)
+
+	if cfg := input.ActionConfiguration; cfg != nil {
+		if cfg.Title != nil {
+			title = *cfg.Title
+		}
+		if cfg.Description != nil {
+			description = *cfg.Description
+		}
+	}
+
+	return map[string]interface{}{
+		"order":       order,
+		"title":       title,
+		"description": description,
+	}
+}
+
 func expandAutomationRuleActionPlaybook(input []interface{}, defaultTenantId string) ([]securityinsight.BasicAutomationRuleAction, error) {
 	if len(input) == 0 {
 		return nil, nil
@@ -135,6 +135,20 @@ func TestAccSentinelAutomationRule_trigger(t *testing.T) {
 	})
 }
 
+func TestAccSentinelAutomationRule_incidentTask(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_automation_rule", "test")
+	r := SentinelAutomationRuleResource{uuid: uuid.New().String()}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.incidentTask(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSentinelAutomationRule_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_sentinel_automation_rule", "test")
 	r := SentinelAutomationRuleResource{uuid: uuid.New().String()}
@@ -447,6 +461,46 @@ resource "azurerm_sentinel_automation_rule" "test" {
 `, template, data.RandomInteger, r.uuid, r.clientSecret)
 }
 
+func (r SentinelAutomationRuleResource) incidentTask(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_automation_rule" "test" {
+  name                       = "%s"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "acctest-SentinelAutoRule-%d"
+  order                      = 1
+
+  condition_json = jsonencode([
+    {
+      conditionType = "PropertyArray"
+      conditionProperties = {
+        arrayType          = "CustomDetails"
+        arrayConditionType = "AnyItem"
+        itemConditions = [
+          {
+            conditionType = "Property"
+            conditionProperties = {
+              propertyName   = "IncidentCustomDetailsKey"
+              operator       = "Contains"
+              propertyValues = ["Computer"]
+            }
+          }
+        ]
+      }
+    }
+  ])
+
+  action_incident_task {
+    order       = 1
+    title       = "Verify the affected host"
+    description = "Check whether the host is a corporate asset before closing the incident."
+  }
+}
+`, template, r.uuid, data.RandomInteger)
+}
+
 func (r SentinelAutomationRuleResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
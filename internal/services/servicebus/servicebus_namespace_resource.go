@@ -99,13 +99,24 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 					Schema: map[string]*pluginsdk.Schema{
 						"key_vault_key_id": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+							ExactlyOneOf: []string{"customer_managed_key.0.key_vault_key_id", "customer_managed_key.0.key_vault_key_ids"},
+						},
+
+						"key_vault_key_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+							},
+							ExactlyOneOf: []string{"customer_managed_key.0.key_vault_key_id", "customer_managed_key.0.key_vault_key_ids"},
 						},
 
 						"identity_id": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
 						},
 
@@ -231,6 +242,11 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		publicNetworkEnabled = namespaces.PublicNetworkAccessDisabled
 	}
 
+	encryption, err := expandServiceBusNamespaceEncryption(d.Get("customer_managed_key").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `customer_managed_key`: %+v", err)
+	}
+
 	s := namespaces.SkuTier(sku)
 	parameters := namespaces.SBNamespace{
 		Location: location,
@@ -241,7 +257,7 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		},
 		Properties: &namespaces.SBNamespaceProperties{
 			ZoneRedundant:       utils.Bool(d.Get("zone_redundant").(bool)),
-			Encryption:          expandServiceBusNamespaceEncryption(d.Get("customer_managed_key").([]interface{})),
+			Encryption:          encryption,
 			DisableLocalAuth:    utils.Bool(!d.Get("local_auth_enabled").(bool)),
 			PublicNetworkAccess: &publicNetworkEnabled,
 		},
@@ -320,7 +336,7 @@ func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}
 
 			if props := model.Properties; props != nil {
 				d.Set("zone_redundant", props.ZoneRedundant)
-				if customerManagedKey, err := flattenServiceBusNamespaceEncryption(props.Encryption); err == nil {
+				if customerManagedKey, err := flattenServiceBusNamespaceEncryption(d, props.Encryption); err == nil {
 					d.Set("customer_managed_key", customerManagedKey)
 				}
 				localAuthEnabled := true
@@ -380,52 +396,98 @@ func resourceServiceBusNamespaceDelete(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
-func expandServiceBusNamespaceEncryption(input []interface{}) *namespaces.Encryption {
+func expandServiceBusNamespaceEncryption(input []interface{}) (*namespaces.Encryption, error) {
 	if len(input) == 0 || input[0] == nil {
-		return nil
+		return nil, nil
 	}
 	v := input[0].(map[string]interface{})
-	keyId, _ := keyVaultParse.ParseOptionallyVersionedNestedItemID(v["key_vault_key_id"].(string))
+
+	keyVaultKeyIds := make([]interface{}, 0)
+	if keyId := v["key_vault_key_id"].(string); keyId != "" {
+		keyVaultKeyIds = append(keyVaultKeyIds, keyId)
+	}
+	keyVaultKeyIds = append(keyVaultKeyIds, v["key_vault_key_ids"].(*pluginsdk.Set).List()...)
+
+	keyVaultProperties := make([]namespaces.KeyVaultProperties, 0)
+	for _, item := range keyVaultKeyIds {
+		keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(item.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		props := namespaces.KeyVaultProperties{
+			KeyName:     utils.String(keyId.Name),
+			KeyVersion:  utils.String(keyId.Version),
+			KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
+		}
+
+		// when `identity_id` isn't specified the namespaces System Assigned Identity is used
+		if identityId := v["identity_id"].(string); identityId != "" {
+			props.Identity = &namespaces.UserAssignedIdentityProperties{
+				UserAssignedIdentity: utils.String(identityId),
+			}
+		}
+
+		keyVaultProperties = append(keyVaultProperties, props)
+	}
+
 	keySource := namespaces.KeySourceMicrosoftPointKeyVault
 	return &namespaces.Encryption{
-		KeyVaultProperties: &[]namespaces.KeyVaultProperties{
-			{
-				KeyName:     utils.String(keyId.Name),
-				KeyVersion:  utils.String(keyId.Version),
-				KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
-				Identity: &namespaces.UserAssignedIdentityProperties{
-					UserAssignedIdentity: utils.String(v["identity_id"].(string)),
-				},
-			},
-		},
+		KeyVaultProperties:              &keyVaultProperties,
 		KeySource:                       &keySource,
 		RequireInfrastructureEncryption: utils.Bool(v["infrastructure_encryption_enabled"].(bool)),
-	}
+	}, nil
 }
 
-func flattenServiceBusNamespaceEncryption(encryption *namespaces.Encryption) ([]interface{}, error) {
+func flattenServiceBusNamespaceEncryption(d *pluginsdk.ResourceData, encryption *namespaces.Encryption) ([]interface{}, error) {
 	if encryption == nil {
 		return []interface{}{}, nil
 	}
 
-	var keyId string
-	var identityId string
-	if keyVaultProperties := encryption.KeyVaultProperties; keyVaultProperties != nil && len(*keyVaultProperties) != 0 {
-		props := (*keyVaultProperties)[0]
-		keyVaultKeyId, err := keyVaultParse.NewNestedItemID(*props.KeyVaultUri, "keys", *props.KeyName, *props.KeyVersion)
-		if err != nil {
-			return nil, fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
-		}
-		keyId = keyVaultKeyId.ID()
-		if props.Identity != nil && props.Identity.UserAssignedIdentity != nil {
-			identityId = *props.Identity.UserAssignedIdentity
+	keyIds := make([]interface{}, 0)
+	identityId := ""
+	if keyVaultProperties := encryption.KeyVaultProperties; keyVaultProperties != nil {
+		for _, props := range *keyVaultProperties {
+			keyName := ""
+			if props.KeyName != nil {
+				keyName = *props.KeyName
+			}
+
+			keyVaultUri := ""
+			if props.KeyVaultUri != nil {
+				keyVaultUri = *props.KeyVaultUri
+			}
+
+			keyVersion := ""
+			if props.KeyVersion != nil {
+				keyVersion = *props.KeyVersion
+			}
+
+			keyVaultKeyId, err := keyVaultParse.NewNestedItemID(keyVaultUri, "keys", keyName, keyVersion)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
+			}
+			keyIds = append(keyIds, keyVaultKeyId.ID())
+
+			if props.Identity != nil && props.Identity.UserAssignedIdentity != nil {
+				identityId = *props.Identity.UserAssignedIdentity
+			}
 		}
 	}
 
+	// set the singular `key_vault_key_id` when the existing configuration uses it, so that
+	// configurations with a single key aren't forced onto `key_vault_key_ids`
+	keyId := ""
+	if _, ok := d.GetOk("customer_managed_key.0.key_vault_key_id"); ok && len(keyIds) == 1 {
+		keyId = keyIds[0].(string)
+		keyIds = make([]interface{}, 0)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"infrastructure_encryption_enabled": encryption.RequireInfrastructureEncryption,
 			"key_vault_key_id":                  keyId,
+			"key_vault_key_ids":                 keyIds,
 			"identity_id":                       identityId,
 		},
 	}, nil
@@ -3,16 +3,21 @@ package client
 import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2022-02-01/signalr"
-	webpubsub_v2021_10_01 "github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2021-10-01"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	webpubsub_v2021_10_01 "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2021-10-01"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2023-06-01-preview/replicas"
 )
 
 type Client struct {
+	ReplicasClient  *replicas.ReplicasClient
 	SignalRClient   *signalr.SignalRClient
 	WebPubSubClient *webpubsub_v2021_10_01.Client
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	replicasClient := replicas.NewReplicasClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&replicasClient.Client, o.ResourceManagerAuthorizer)
+
 	signalRClient := signalr.NewSignalRClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&signalRClient.Client, o.ResourceManagerAuthorizer)
 
@@ -21,6 +26,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	})
 
 	return &Client{
+		ReplicasClient:  &replicasClient,
 		SignalRClient:   &signalRClient,
 		WebPubSubClient: &webPubSubClient,
 	}
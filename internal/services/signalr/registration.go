@@ -40,6 +40,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_signalr_service":                         resourceArmSignalRService(),
 		"azurerm_signalr_service_network_acl":             resourceArmSignalRServiceNetworkACL(),
+		"azurerm_signalr_service_replica":                 resourceArmSignalRServiceReplica(),
 		"azurerm_signalr_shared_private_link_resource":    resourceSignalRSharedPrivateLinkResource(),
 		"azurerm_web_pubsub":                              resourceWebPubSub(),
 		"azurerm_web_pubsub_hub":                          resourceWebPubSubHub(),
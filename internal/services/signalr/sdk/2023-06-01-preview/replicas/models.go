@@ -0,0 +1,24 @@
+package replicas
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Replica struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *ReplicaProperties `json:"properties,omitempty"`
+	Sku        *ResourceSku       `json:"sku,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
+
+type ReplicaProperties struct {
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+type ResourceSku struct {
+	Capacity *int64  `json:"capacity,omitempty"`
+	Name     string  `json:"name"`
+	Tier     *string `json:"tier,omitempty"`
+}
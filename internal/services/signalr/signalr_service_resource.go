@@ -111,6 +111,9 @@ func resourceArmSignalRServiceCreate(d *pluginsdk.ResourceData, meta interface{}
 			Features:               &expandedFeatures,
 			Upstream:               expandUpstreamSettings(upstreamSettings),
 			LiveTraceConfiguration: expandSignalRLiveTraceConfig(d.Get("live_trace").([]interface{})),
+			Tls: &signalr.SignalRTlsSettings{
+				ClientCertEnabled: utils.Bool(d.Get("tls_client_cert_enabled").(bool)),
+			},
 		},
 		Sku:  expandSignalRServiceSku(sku),
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
@@ -189,6 +192,12 @@ func resourceArmSignalRServiceRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("live_trace_enabled", liveTraceEnabled)
 			d.Set("service_mode", serviceMode)
 
+			tlsClientCertEnabled := false
+			if props.Tls != nil && props.Tls.ClientCertEnabled != nil {
+				tlsClientCertEnabled = *props.Tls.ClientCertEnabled
+			}
+			d.Set("tls_client_cert_enabled", tlsClientCertEnabled)
+
 			if err := d.Set("cors", flattenSignalRCors(props.Cors)); err != nil {
 				return fmt.Errorf("setting `cors`: %+v", err)
 			}
@@ -229,9 +238,15 @@ func resourceArmSignalRServiceUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	resourceType := signalr.SignalRResource{}
 
-	if d.HasChanges("cors", "features", "upstream_endpoint", "connectivity_logs_enabled", "messaging_logs_enabled", "service_mode", "live_trace_enabled", "live_trace") {
+	if d.HasChanges("cors", "features", "upstream_endpoint", "connectivity_logs_enabled", "messaging_logs_enabled", "service_mode", "live_trace_enabled", "live_trace", "tls_client_cert_enabled") {
 		resourceType.Properties = &signalr.SignalRProperties{}
 
+		if d.HasChange("tls_client_cert_enabled") {
+			resourceType.Properties.Tls = &signalr.SignalRTlsSettings{
+				ClientCertEnabled: utils.Bool(d.Get("tls_client_cert_enabled").(bool)),
+			}
+		}
+
 		if d.HasChange("cors") {
 			corsRaw := d.Get("cors").([]interface{})
 			resourceType.Properties.Cors = expandSignalRCors(corsRaw)
@@ -663,6 +678,12 @@ func resourceArmSignalRServiceSchema() map[string]*pluginsdk.Schema {
 			}, false),
 		},
 
+		"tls_client_cert_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"upstream_endpoint": {
 			Type:     pluginsdk.TypeSet,
 			Optional: true,
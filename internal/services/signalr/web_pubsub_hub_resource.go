@@ -8,10 +8,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2021-10-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2021-10-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -105,6 +106,47 @@ func resourceWebPubSubHub() *pluginsdk.Resource {
 				},
 			},
 
+			"event_listener": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"system_event_name_filter": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"connected",
+									"disconnected",
+								}, false),
+							},
+						},
+
+						"user_event_name_filter": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"eventhub_namespace_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: eventhubValidate.ValidateEventHubNamespaceName(),
+						},
+
+						"eventhub_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: eventhubValidate.ValidateEventHubName(),
+						},
+					},
+				},
+			},
+
 			"anonymous_connections_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -147,6 +189,7 @@ func resourceWebPubSubHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 	parameters := webpubsub.WebPubSubHub{
 		Properties: webpubsub.WebPubSubHubProperties{
 			EventHandlers:          expandEventHandler(d.Get("event_handler").([]interface{})),
+			EventListeners:         expandEventListener(d.Get("event_listener").([]interface{})),
 			AnonymousConnectPolicy: &anonymousPolicyEnabled,
 		},
 	}
@@ -187,6 +230,9 @@ func resourceWebPubSubHubRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if err := d.Set("event_handler", flattenEventHandler(model.Properties.EventHandlers)); err != nil {
 			return fmt.Errorf("setting `event_handler`: %+v", err)
 		}
+		if err := d.Set("event_listener", flattenEventListener(model.Properties.EventListeners)); err != nil {
+			return fmt.Errorf("setting `event_listener`: %+v", err)
+		}
 		d.Set("anonymous_connections_enabled", strings.EqualFold(*model.Properties.AnonymousConnectPolicy, "Allow"))
 	}
 
@@ -278,6 +324,71 @@ func flattenEventHandler(input *[]webpubsub.EventHandler) []interface{} {
 	return eventHandlerBlock
 }
 
+func expandEventListener(input []interface{}) *[]webpubsub.EventListener {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]webpubsub.EventListener, 0)
+
+	for _, eventListenerItem := range input {
+		block := eventListenerItem.(map[string]interface{})
+
+		filter := webpubsub.EventNameFilter{}
+		if v := utils.ExpandStringSlice(block["system_event_name_filter"].([]interface{})); v != nil && len(*v) > 0 {
+			filter.SystemEvents = v
+		}
+		if v := utils.ExpandStringSlice(block["user_event_name_filter"].([]interface{})); v != nil && len(*v) > 0 {
+			filter.UserEventPattern = utils.String(strings.Join(*v, ","))
+		}
+
+		results = append(results, webpubsub.EventListener{
+			Filter: filter,
+			Endpoint: webpubsub.EventHubEndpoint{
+				FullyQualifiedNamespace: fmt.Sprintf("%s.servicebus.windows.net", block["eventhub_namespace_name"].(string)),
+				EventHubName:            block["eventhub_name"].(string),
+			},
+		})
+	}
+	return &results
+}
+
+func flattenEventListener(input *[]webpubsub.EventListener) []interface{} {
+	eventListenerBlock := make([]interface{}, 0)
+	if input == nil {
+		return eventListenerBlock
+	}
+
+	for _, item := range *input {
+		systemEventNameFilter := make([]interface{}, 0)
+		userEventNameFilter := make([]interface{}, 0)
+		if filter, ok := item.Filter.(webpubsub.EventNameFilter); ok {
+			if filter.SystemEvents != nil {
+				systemEventNameFilter = utils.FlattenStringSlice(filter.SystemEvents)
+			}
+			if filter.UserEventPattern != nil {
+				userEventNames := strings.Split(*filter.UserEventPattern, ",")
+				userEventNameFilter = utils.FlattenStringSlice(&userEventNames)
+			}
+		}
+
+		eventHubNamespaceName := ""
+		eventHubName := ""
+		if endpoint, ok := item.Endpoint.(webpubsub.EventHubEndpoint); ok {
+			eventHubNamespaceName = strings.TrimSuffix(endpoint.FullyQualifiedNamespace, ".servicebus.windows.net")
+			eventHubName = endpoint.EventHubName
+		}
+
+		eventListenerBlock = append(eventListenerBlock, map[string]interface{}{
+			"system_event_name_filter": systemEventNameFilter,
+			"user_event_name_filter":   userEventNameFilter,
+			"eventhub_namespace_name":  eventHubNamespaceName,
+			"eventhub_name":            eventHubName,
+		})
+	}
+	return eventListenerBlock
+}
+
 func expandAuth(input []interface{}) *webpubsub.UpstreamAuthSettings {
 	if len(input) == 0 || input[0] == nil {
 		authType := webpubsub.UpstreamAuthTypeNone
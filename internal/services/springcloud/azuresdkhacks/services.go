@@ -0,0 +1,111 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
+	"github.com/tombuildsstuff/kermit/sdk/appplatform/2022-11-01-preview/appplatform"
+)
+
+// The `maintenanceScheduleConfiguration` property only exists in newer API versions than the
+// one exposed by the generated SDK - so the Get and Update methods are re-implemented here
+// against that newer API version using a minimal model containing it.
+
+const servicesApiVersion = "2023-05-01-preview"
+
+type ServicesWorkaroundClient struct {
+	sdkClient *appplatform.ServicesClient
+}
+
+func NewServicesWorkaroundClient(client *appplatform.ServicesClient) ServicesWorkaroundClient {
+	return ServicesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type ServiceResource struct {
+	Properties *ClusterResourceProperties `json:"properties,omitempty"`
+}
+
+type ClusterResourceProperties struct {
+	// MaintenanceScheduleConfiguration is deliberately not `omitempty` so that the
+	// configuration can be unset again by sending an explicit null
+	MaintenanceScheduleConfiguration *WeeklyMaintenanceScheduleConfiguration `json:"maintenanceScheduleConfiguration"`
+}
+
+// WeeklyMaintenanceScheduleConfiguration is a discriminated union in the API - the only
+// supported implementation is `Weekly` so it's modelled as a flat struct here.
+type WeeklyMaintenanceScheduleConfiguration struct {
+	Frequency string `json:"frequency"`
+	Hour      *int32 `json:"hour,omitempty"`
+	Day       string `json:"day,omitempty"`
+}
+
+type GetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *ServiceResource
+}
+
+func (c ServicesWorkaroundClient) Get(ctx context.Context, id parse.SpringCloudServiceId) (result GetOperationResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.ServicesWorkaroundClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.sdkClient.Client.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.ServicesWorkaroundClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.ServicesWorkaroundClient", "Get", result.HttpResponse, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c ServicesWorkaroundClient) UpdateThenPoll(ctx context.Context, id parse.SpringCloudServiceId, input ServiceResource) error {
+	req, err := c.preparer(ctx, id, autorest.AsPatch(), autorest.WithJSON(input))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuresdkhacks.ServicesWorkaroundClient", "Update", nil, "Failure preparing request")
+	}
+
+	resp, err := c.sdkClient.Client.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuresdkhacks.ServicesWorkaroundClient", "Update", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.sdkClient.Client, "PATCH")
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuresdkhacks.ServicesWorkaroundClient", "Update", resp, "Failure creating poller")
+	}
+
+	return poller.PollUntilDone()
+}
+
+func (c ServicesWorkaroundClient) preparer(ctx context.Context, id parse.SpringCloudServiceId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": servicesApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/azuresdkhacks"
 	"github.com/tombuildsstuff/kermit/sdk/appplatform/2022-11-01-preview/appplatform"
 )
 
@@ -27,6 +28,7 @@ type Client struct {
 	MonitoringSettingsClient     *appplatform.MonitoringSettingsClient
 	DeploymentsClient            *appplatform.DeploymentsClient
 	ServicesClient               *appplatform.ServicesClient
+	ServicesWorkaroundClient     *azuresdkhacks.ServicesWorkaroundClient
 	ServiceRegistryClient        *appplatform.ServiceRegistriesClient
 	StoragesClient               *appplatform.StoragesClient
 }
@@ -95,6 +97,8 @@ func NewClient(o *common.ClientOptions) *Client {
 	servicesClient := appplatform.NewServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
+	servicesWorkaroundClient := azuresdkhacks.NewServicesWorkaroundClient(&servicesClient)
+
 	serviceRegistryClient := appplatform.NewServiceRegistriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&serviceRegistryClient.Client, o.ResourceManagerAuthorizer)
 
@@ -123,6 +127,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		GatewayRouteConfigClient:     &gatewayRouteConfigClient,
 		MonitoringSettingsClient:     &monitoringSettingsClient,
 		ServicesClient:               &servicesClient,
+		ServicesWorkaroundClient:     &servicesWorkaroundClient,
 		ServiceRegistryClient:        &serviceRegistryClient,
 		StoragesClient:               &storageClient,
 	}
@@ -43,6 +43,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_spring_cloud_api_portal":               resourceSpringCloudAPIPortal(),
 		"azurerm_spring_cloud_api_portal_custom_domain": resourceSpringCloudAPIPortalCustomDomain(),
 		"azurerm_spring_cloud_app":                      resourceSpringCloudApp(),
+		"azurerm_spring_cloud_app_association":          resourceSpringCloudAppAssociation(),
 		"azurerm_spring_cloud_app_cosmosdb_association": resourceSpringCloudAppCosmosDBAssociation(),
 		"azurerm_spring_cloud_app_mysql_association":    resourceSpringCloudAppMysqlAssociation(),
 		"azurerm_spring_cloud_app_redis_association":    resourceSpringCloudAppRedisAssociation(),
@@ -0,0 +1,164 @@
+package springcloud_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SpringCloudAppAssociationResource struct{}
+
+func TestAccSpringCloudAppAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_app_association", "test")
+	r := SpringCloudAppAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key"),
+	})
+}
+
+func TestAccSpringCloudAppAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_app_association", "test")
+	r := SpringCloudAppAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSpringCloudAppAssociation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_app_association", "test")
+	r := SpringCloudAppAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key"),
+	})
+}
+
+func (t SpringCloudAppAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SpringCloudAppAssociationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AppPlatform.BindingsClient.Get(ctx, id.ResourceGroup, id.SpringName, id.AppName, id.BindingName)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r SpringCloudAppAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_app_association" "test" {
+  name                = "acctestscab-%d"
+  spring_cloud_app_id = azurerm_spring_cloud_app.test.id
+  target_resource_id  = azurerm_redis_cache.test.id
+  key                 = azurerm_redis_cache.test.primary_access_key
+
+  binding_parameters = {
+    useSsl = "true"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SpringCloudAppAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_app_association" "import" {
+  name                = azurerm_spring_cloud_app_association.test.name
+  spring_cloud_app_id = azurerm_spring_cloud_app_association.test.spring_cloud_app_id
+  target_resource_id  = azurerm_spring_cloud_app_association.test.target_resource_id
+  key                 = azurerm_spring_cloud_app_association.test.key
+}
+`, r.basic(data))
+}
+
+func (r SpringCloudAppAssociationResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_app_association" "test" {
+  name                = "acctestscab-%d"
+  spring_cloud_app_id = azurerm_spring_cloud_app.test.id
+  target_resource_id  = azurerm_redis_cache.test.id
+  key                 = azurerm_redis_cache.test.secondary_access_key
+
+  binding_parameters = {
+    useSsl = "false"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SpringCloudAppAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-spring-%d"
+  location = "%s"
+}
+
+resource "azurerm_spring_cloud_service" "test" {
+  name                = "acctest-sc-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_spring_cloud_app" "test" {
+  name                = "acctest-sca-%d"
+  resource_group_name = azurerm_spring_cloud_service.test.resource_group_name
+  service_name        = azurerm_spring_cloud_service.test.name
+}
+
+resource "azurerm_redis_cache" "test" {
+  name                = "acctestredis-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  capacity            = 0
+  family              = "C"
+  sku_name            = "Basic"
+  enable_non_ssl_port = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
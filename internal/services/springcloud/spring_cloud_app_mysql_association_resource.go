@@ -19,8 +19,9 @@ import (
 )
 
 const (
-	springCloudAppMysqlAssociationKeyDatabase = "databaseName"
-	springCloudAppMysqlAssociationKeyUsername = "username"
+	springCloudAppMysqlAssociationKeyDatabase                  = "databaseName"
+	springCloudAppMysqlAssociationKeyUsername                  = "username"
+	springCloudAppMysqlAssociationKeyUseSystemAssignedIdentity = "useSystemAssignedIdentity"
 )
 
 func resourceSpringCloudAppMysqlAssociation() *pluginsdk.Resource {
@@ -63,10 +64,13 @@ func resourceSpringCloudAppMysqlAssociation() *pluginsdk.Resource {
 			},
 
 			"mysql_server_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: mysqlValidate.ServerID,
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					mysqlValidate.ServerID,
+					mysqlValidate.FlexibleServerID,
+				),
 			},
 
 			"database_name": {
@@ -77,15 +81,25 @@ func resourceSpringCloudAppMysqlAssociation() *pluginsdk.Resource {
 
 			"username": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"password"},
 			},
 
 			"password": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				Sensitive:    true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				RequiredWith:  []string{"username"},
+				ConflictsWith: []string{"aad_authentication_enabled"},
+			},
+
+			"aad_authentication_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"password"},
 			},
 		},
 	}
@@ -114,17 +128,32 @@ func resourceSpringCloudAppMysqlAssociationCreateUpdate(d *pluginsdk.ResourceDat
 		}
 	}
 
+	aadAuthenticationEnabled := d.Get("aad_authentication_enabled").(bool)
+	if !aadAuthenticationEnabled && d.Get("password").(string) == "" {
+		return fmt.Errorf("one of `password` or `aad_authentication_enabled` must be specified")
+	}
+
+	bindingParameters := map[string]interface{}{
+		springCloudAppMysqlAssociationKeyDatabase: d.Get("database_name").(string),
+	}
+	if username := d.Get("username").(string); username != "" {
+		bindingParameters[springCloudAppMysqlAssociationKeyUsername] = username
+	}
+	if aadAuthenticationEnabled {
+		bindingParameters[springCloudAppMysqlAssociationKeyUseSystemAssignedIdentity] = true
+	}
+
 	bindingResource := appplatform.BindingResource{
 		Properties: &appplatform.BindingResourceProperties{
-			BindingParameters: map[string]interface{}{
-				springCloudAppMysqlAssociationKeyDatabase: d.Get("database_name").(string),
-				springCloudAppMysqlAssociationKeyUsername: d.Get("username").(string),
-			},
-			Key:        utils.String(d.Get("password").(string)),
-			ResourceID: utils.String(d.Get("mysql_server_id").(string)),
+			BindingParameters: bindingParameters,
+			ResourceID:        utils.String(d.Get("mysql_server_id").(string)),
 		},
 	}
 
+	if password := d.Get("password").(string); password != "" {
+		bindingResource.Properties.Key = utils.String(password)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SpringName, id.AppName, id.BindingName, bindingResource)
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -173,6 +202,17 @@ func resourceSpringCloudAppMysqlAssociationRead(d *pluginsdk.ResourceData, meta
 			username = v.(string)
 		}
 		d.Set("username", username)
+
+		aadAuthenticationEnabled := false
+		if v, ok := props.BindingParameters[springCloudAppMysqlAssociationKeyUseSystemAssignedIdentity]; ok {
+			switch value := v.(type) {
+			case bool:
+				aadAuthenticationEnabled = value
+			case string:
+				aadAuthenticationEnabled = value == "true"
+			}
+		}
+		d.Set("aad_authentication_enabled", aadAuthenticationEnabled)
 	}
 	return nil
 }
@@ -67,6 +67,22 @@ func TestAccSpringCloudAppMysqlAssociation_update(t *testing.T) {
 	})
 }
 
+func TestAccSpringCloudAppMysqlAssociation_flexibleServerAadAuthentication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_app_mysql_association", "test")
+	r := SpringCloudAppMysqlAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.flexibleServerAadAuthentication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("aad_authentication_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r SpringCloudAppMysqlAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SpringCloudAppAssociationID(state.ID)
 	if err != nil {
@@ -134,6 +150,61 @@ resource "azurerm_spring_cloud_app_mysql_association" "test" {
 `, r.template(data), data.RandomInteger, data.RandomInteger)
 }
 
+func (r SpringCloudAppMysqlAssociationResource) flexibleServerAadAuthentication(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-spring-%d"
+  location = "%s"
+}
+
+resource "azurerm_spring_cloud_service" "test" {
+  name                = "acctest-sc-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_spring_cloud_app" "test" {
+  name                = "acctest-sca-%d"
+  resource_group_name = azurerm_spring_cloud_service.test.resource_group_name
+  service_name        = azurerm_spring_cloud_service.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                   = "acctestmysqlfs-%d"
+  location               = azurerm_resource_group.test.location
+  resource_group_name    = azurerm_resource_group.test.name
+  administrator_login    = "acctestun"
+  administrator_password = "H@Sh1CoR3!"
+  sku_name               = "B_Standard_B1s"
+  zone                   = "2"
+}
+
+resource "azurerm_mysql_flexible_database" "test" {
+  name                = "acctest-db-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_mysql_flexible_server.test.name
+  charset             = "utf8"
+  collation           = "utf8_unicode_ci"
+}
+
+resource "azurerm_spring_cloud_app_mysql_association" "test" {
+  name                       = "acctestscamb-%d"
+  spring_cloud_app_id        = azurerm_spring_cloud_app.test.id
+  mysql_server_id            = azurerm_mysql_flexible_server.test.id
+  database_name              = azurerm_mysql_flexible_database.test.name
+  aad_authentication_enabled = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (r SpringCloudAppMysqlAssociationResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -6,9 +6,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2021-06-01/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2021-06-01/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/validate"
@@ -23,9 +23,9 @@ const springCloudAppRedisAssociationKeySSL = "useSsl"
 
 func resourceSpringCloudAppRedisAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceSpringCloudAppRedisAssociationCreateUpdate,
+		Create: resourceSpringCloudAppRedisAssociationCreate,
 		Read:   resourceSpringCloudAppRedisAssociationRead,
-		Update: resourceSpringCloudAppRedisAssociationCreateUpdate,
+		Update: resourceSpringCloudAppRedisAssociationUpdate,
 		Delete: resourceSpringCloudAppRedisAssociationDelete,
 
 		SchemaVersion: 1,
@@ -71,6 +71,15 @@ func resourceSpringCloudAppRedisAssociation() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
+					return d.Get("ignore_access_key_changes").(bool)
+				},
+			},
+
+			"ignore_access_key_changes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"ssl_enabled": {
@@ -82,9 +91,9 @@ func resourceSpringCloudAppRedisAssociation() *pluginsdk.Resource {
 	}
 }
 
-func resourceSpringCloudAppRedisAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceSpringCloudAppRedisAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).AppPlatform.BindingsClient
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	appId, err := parse.SpringCloudAppID(d.Get("spring_cloud_app_id").(string))
@@ -93,17 +102,15 @@ func resourceSpringCloudAppRedisAssociationCreateUpdate(d *pluginsdk.ResourceDat
 	}
 
 	id := parse.NewSpringCloudAppAssociationID(appId.SubscriptionId, appId.ResourceGroup, appId.SpringName, appId.AppName, d.Get("name").(string))
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.SpringName, id.AppName, id.BindingName)
-		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for present of existing %s: %+v", id, err)
-			}
-		}
+	existing, err := client.Get(ctx, id.ResourceGroup, id.SpringName, id.AppName, id.BindingName)
+	if err != nil {
 		if !utils.ResponseWasNotFound(existing.Response) {
-			return tf.ImportAsExistsError("azurerm_spring_cloud_app_redis_association", id.ID())
+			return fmt.Errorf("checking for present of existing %s: %+v", id, err)
 		}
 	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_spring_cloud_app_redis_association", id.ID())
+	}
 
 	bindingResource := appplatform.BindingResource{
 		Properties: &appplatform.BindingResourceProperties{
@@ -121,12 +128,48 @@ func resourceSpringCloudAppRedisAssociationCreateUpdate(d *pluginsdk.ResourceDat
 	}
 
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %q: %+v", id, err)
+		return fmt.Errorf("waiting for creation of %q: %+v", id, err)
 	}
 	d.SetId(id.ID())
 	return resourceSpringCloudAppRedisAssociationRead(d, meta)
 }
 
+func resourceSpringCloudAppRedisAssociationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppPlatform.BindingsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SpringCloudAppAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	bindingResource := appplatform.BindingResource{
+		Properties: &appplatform.BindingResourceProperties{
+			BindingParameters: map[string]interface{}{
+				springCloudAppRedisAssociationKeySSL: d.Get("ssl_enabled").(bool),
+			},
+			ResourceID: utils.String(d.Get("redis_cache_id").(string)),
+		},
+	}
+
+	// when changes to the access key are ignored the key stored in state may be stale, in which case
+	// it shouldn't be sent as part of the patch as it would overwrite a key rotated outside of Terraform
+	if !d.Get("ignore_access_key_changes").(bool) {
+		bindingResource.Properties.Key = utils.String(d.Get("redis_access_key").(string))
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.SpringName, id.AppName, id.BindingName, bindingResource)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %q: %+v", id, err)
+	}
+	return resourceSpringCloudAppRedisAssociationRead(d, meta)
+}
+
 func resourceSpringCloudAppRedisAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).AppPlatform.BindingsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -89,6 +89,26 @@ func TestAccSpringCloudAppRedisAssociation_update(t *testing.T) {
 	})
 }
 
+func TestAccSpringCloudAppRedisAssociation_ignoreAccessKeyChanges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_app_redis_association", "test")
+	r := SpringCloudAppRedisAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ignoreAccessKeyChanges(data, "primary_access_key"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("redis_access_key", "ignore_access_key_changes"),
+		{
+			Config:   r.ignoreAccessKeyChanges(data, "secondary_access_key"),
+			PlanOnly: true,
+		},
+		data.ImportStep("redis_access_key", "ignore_access_key_changes"),
+	})
+}
+
 func (t SpringCloudAppRedisAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SpringCloudAppAssociationID(state.ID)
 	if err != nil {
@@ -143,6 +163,20 @@ resource "azurerm_spring_cloud_app_redis_association" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r SpringCloudAppRedisAssociationResource) ignoreAccessKeyChanges(data acceptance.TestData, accessKey string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_app_redis_association" "test" {
+  name                      = "acctestscarb-%d"
+  spring_cloud_app_id       = azurerm_spring_cloud_app.test.id
+  redis_cache_id            = azurerm_redis_cache.test.id
+  redis_access_key          = azurerm_redis_cache.test.%s
+  ignore_access_key_changes = true
+}
+`, r.template(data), data.RandomInteger, accessKey)
+}
+
 func (r SpringCloudAppRedisAssociationResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/validate"
@@ -300,6 +301,27 @@ func resourceSpringCloudService() *pluginsdk.Resource {
 				},
 			},
 
+			"maintenance_window": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"day_of_week": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsDayOfTheWeek(false),
+						},
+
+						"start_hour": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+					},
+				},
+			},
+
 			"zone_redundant": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -318,6 +340,7 @@ func resourceSpringCloudService() *pluginsdk.Resource {
 
 func resourceSpringCloudServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).AppPlatform.ServicesClient
+	servicesWorkaroundClient := meta.(*clients.Client).AppPlatform.ServicesWorkaroundClient
 	configServersClient := meta.(*clients.Client).AppPlatform.ConfigServersClient
 	monitoringSettingsClient := meta.(*clients.Client).AppPlatform.MonitoringSettingsClient
 	serviceRegistryClient := meta.(*clients.Client).AppPlatform.ServiceRegistryClient
@@ -375,6 +398,19 @@ func resourceSpringCloudServiceCreate(d *pluginsdk.ResourceData, meta interface{
 	}
 	d.SetId(id.ID())
 
+	if maintenanceWindow := expandSpringCloudServiceMaintenanceScheduleConfiguration(d.Get("maintenance_window").([]interface{})); maintenanceWindow != nil {
+		log.Printf("[DEBUG] Updating Maintenance Window for %s..", id)
+		model := azuresdkhacks.ServiceResource{
+			Properties: &azuresdkhacks.ClusterResourceProperties{
+				MaintenanceScheduleConfiguration: maintenanceWindow,
+			},
+		}
+		if err := servicesWorkaroundClient.UpdateThenPoll(ctx, id, model); err != nil {
+			return fmt.Errorf("setting `maintenance_window` for %s: %+v", id, err)
+		}
+		log.Printf("[DEBUG] Updated Maintenance Window for %s.", id)
+	}
+
 	skuName := d.Get("sku_name").(string)
 	if skuName == "E0" && gitProperty != nil {
 		return fmt.Errorf("`config_server_git_setting` is not supported for sku `E0`")
@@ -435,6 +471,7 @@ func resourceSpringCloudServiceCreate(d *pluginsdk.ResourceData, meta interface{
 
 func resourceSpringCloudServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).AppPlatform.ServicesClient
+	servicesWorkaroundClient := meta.(*clients.Client).AppPlatform.ServicesWorkaroundClient
 	configServersClient := meta.(*clients.Client).AppPlatform.ConfigServersClient
 	monitoringSettingsClient := meta.(*clients.Client).AppPlatform.MonitoringSettingsClient
 	serviceRegistryClient := meta.(*clients.Client).AppPlatform.ServiceRegistryClient
@@ -464,6 +501,19 @@ func resourceSpringCloudServiceUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	if d.HasChange("maintenance_window") {
+		log.Printf("[DEBUG] Updating Maintenance Window for %s..", id)
+		model := azuresdkhacks.ServiceResource{
+			Properties: &azuresdkhacks.ClusterResourceProperties{
+				MaintenanceScheduleConfiguration: expandSpringCloudServiceMaintenanceScheduleConfiguration(d.Get("maintenance_window").([]interface{})),
+			},
+		}
+		if err := servicesWorkaroundClient.UpdateThenPoll(ctx, *id, model); err != nil {
+			return fmt.Errorf("setting `maintenance_window` for %s: %+v", id, err)
+		}
+		log.Printf("[DEBUG] Updated Maintenance Window for %s.", id)
+	}
+
 	if d.HasChange("config_server_git_setting") {
 		gitPropertyRaw := d.Get("config_server_git_setting").([]interface{})
 		gitProperty, err := expandSpringCloudConfigServerGitProperty(gitPropertyRaw)
@@ -543,6 +593,7 @@ func resourceSpringCloudServiceUpdate(d *pluginsdk.ResourceData, meta interface{
 
 func resourceSpringCloudServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).AppPlatform.ServicesClient
+	servicesWorkaroundClient := meta.(*clients.Client).AppPlatform.ServicesWorkaroundClient
 	configServersClient := meta.(*clients.Client).AppPlatform.ConfigServersClient
 	monitoringSettingsClient := meta.(*clients.Client).AppPlatform.MonitoringSettingsClient
 	serviceRegistryClient := meta.(*clients.Client).AppPlatform.ServiceRegistryClient
@@ -642,6 +693,18 @@ func resourceSpringCloudServiceRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("zone_redundant", props.ZoneRedundant)
 	}
 
+	serviceResource, err := servicesWorkaroundClient.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving maintenance window for %s: %+v", id, err)
+	}
+	maintenanceWindow := make([]interface{}, 0)
+	if model := serviceResource.Model; model != nil && model.Properties != nil {
+		maintenanceWindow = flattenSpringCloudServiceMaintenanceScheduleConfiguration(model.Properties.MaintenanceScheduleConfiguration)
+	}
+	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
+		return fmt.Errorf("setting `maintenance_window`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -1159,3 +1222,34 @@ func flattenRequiredTraffic(input *appplatform.NetworkProfile) []interface{} {
 	}
 	return result
 }
+
+func expandSpringCloudServiceMaintenanceScheduleConfiguration(input []interface{}) *azuresdkhacks.WeeklyMaintenanceScheduleConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &azuresdkhacks.WeeklyMaintenanceScheduleConfiguration{
+		Frequency: "Weekly",
+		Hour:      utils.Int32(int32(raw["start_hour"].(int))),
+		Day:       raw["day_of_week"].(string),
+	}
+}
+
+func flattenSpringCloudServiceMaintenanceScheduleConfiguration(input *azuresdkhacks.WeeklyMaintenanceScheduleConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	startHour := 0
+	if input.Hour != nil {
+		startHour = int(*input.Hour)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"day_of_week": input.Day,
+			"start_hour":  startHour,
+		},
+	}
+}
@@ -218,6 +218,35 @@ func TestAccSpringCloudService_zoneRedundant(t *testing.T) {
 	})
 }
 
+func TestAccSpringCloudService_maintenanceWindow(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_service", "test")
+	r := SpringCloudServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.maintenanceWindow(data, "Friday", 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.maintenanceWindow(data, "Sunday", 8),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t SpringCloudServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SpringCloudServiceID(state.ID)
 	if err != nil {
@@ -251,6 +280,30 @@ resource "azurerm_spring_cloud_service" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (SpringCloudServiceResource) maintenanceWindow(data acceptance.TestData, dayOfWeek string, startHour int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-spring-%d"
+  location = "%s"
+}
+
+resource "azurerm_spring_cloud_service" "test" {
+  name                = "acctest-sc-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  maintenance_window {
+    day_of_week = "%s"
+    start_hour  = %d
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, dayOfWeek, startHour)
+}
+
 func (SpringCloudServiceResource) zoneRedundant(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
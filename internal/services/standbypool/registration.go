@@ -0,0 +1,29 @@
+package standbypool
+
+import "github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Standby Pool"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		StandbyVirtualMachinePoolResource{},
+	}
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Standby Pool",
+	}
+}
+
+var (
+	_ sdk.TypedServiceRegistration = (*Registration)(nil)
+)
@@ -0,0 +1,18 @@
+package standbyvirtualmachinepools
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type StandbyVirtualMachinePoolsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStandbyVirtualMachinePoolsClientWithBaseURI(endpoint string) StandbyVirtualMachinePoolsClient {
+	return StandbyVirtualMachinePoolsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
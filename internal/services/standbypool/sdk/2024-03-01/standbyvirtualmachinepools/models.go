@@ -0,0 +1,36 @@
+package standbyvirtualmachinepools
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type StandbyVirtualMachinePoolResource struct {
+	Id         *string                                      `json:"id,omitempty"`
+	Location   string                                       `json:"location"`
+	Name       *string                                      `json:"name,omitempty"`
+	Properties *StandbyVirtualMachinePoolResourceProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                           `json:"tags,omitempty"`
+	Type       *string                                      `json:"type,omitempty"`
+}
+
+type StandbyVirtualMachinePoolResourceProperties struct {
+	AttachedVirtualMachineScaleSetId *string                                     `json:"attachedVirtualMachineScaleSetId,omitempty"`
+	ElasticityProfile                *StandbyVirtualMachinePoolElasticityProfile `json:"elasticityProfile,omitempty"`
+	ProvisioningState                *ProvisioningState                          `json:"provisioningState,omitempty"`
+	VirtualMachineState              VirtualMachineState                         `json:"virtualMachineState"`
+}
+
+type StandbyVirtualMachinePoolElasticityProfile struct {
+	MaxReadyCapacity int64  `json:"maxReadyCapacity"`
+	MinReadyCapacity *int64 `json:"minReadyCapacity,omitempty"`
+}
+
+type StandbyVirtualMachinePoolResourceUpdate struct {
+	Properties *StandbyVirtualMachinePoolResourceUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                                 `json:"tags,omitempty"`
+}
+
+type StandbyVirtualMachinePoolResourceUpdateProperties struct {
+	AttachedVirtualMachineScaleSetId *string                                     `json:"attachedVirtualMachineScaleSetId,omitempty"`
+	ElasticityProfile                *StandbyVirtualMachinePoolElasticityProfile `json:"elasticityProfile,omitempty"`
+	VirtualMachineState              *VirtualMachineState                        `json:"virtualMachineState,omitempty"`
+}
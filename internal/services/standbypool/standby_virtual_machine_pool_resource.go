@@ -0,0 +1,305 @@
+package standbypool
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2024-03-01/standbyvirtualmachinepools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StandbyVirtualMachinePoolResource struct{}
+
+var _ sdk.ResourceWithUpdate = StandbyVirtualMachinePoolResource{}
+
+type StandbyVirtualMachinePoolResourceModel struct {
+	Name                             string                   `tfschema:"name"`
+	ResourceGroup                    string                   `tfschema:"resource_group_name"`
+	Location                         string                   `tfschema:"location"`
+	AttachedVirtualMachineScaleSetId string                   `tfschema:"attached_virtual_machine_scale_set_id"`
+	ElasticityProfile                []ElasticityProfileModel `tfschema:"elasticity_profile"`
+	VirtualMachineState              string                   `tfschema:"virtual_machine_state"`
+	Tags                             map[string]string        `tfschema:"tags"`
+}
+
+type ElasticityProfileModel struct {
+	MaxReadyCapacity int64 `tfschema:"max_ready_capacity"`
+	MinReadyCapacity int64 `tfschema:"min_ready_capacity"`
+}
+
+func (r StandbyVirtualMachinePoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9-]{3,24}$`),
+				"`name` must be between 3 and 24 characters in length and may contain only letters, numbers and dashes",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		// NOTE: the Virtual Machine Scale Set must be in Flexible orchestration mode
+		"attached_virtual_machine_scale_set_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: computeValidate.VirtualMachineScaleSetID,
+		},
+
+		"elasticity_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"max_ready_capacity": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 2000),
+					},
+
+					"min_ready_capacity": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 2000),
+					},
+				},
+			},
+		},
+
+		"virtual_machine_state": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(standbyvirtualmachinepools.PossibleValuesForVirtualMachineState(), false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StandbyVirtualMachinePoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StandbyVirtualMachinePoolResource) ModelObject() interface{} {
+	return &StandbyVirtualMachinePoolResourceModel{}
+}
+
+func (r StandbyVirtualMachinePoolResource) ResourceType() string {
+	return "azurerm_standby_virtual_machine_pool"
+}
+
+func (r StandbyVirtualMachinePoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return standbyvirtualmachinepools.ValidateStandbyVirtualMachinePoolID
+}
+
+func (r StandbyVirtualMachinePoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StandbyPool.StandbyVirtualMachinePoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StandbyVirtualMachinePoolResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := standbyvirtualmachinepools.NewStandbyVirtualMachinePoolID(subscriptionId, model.ResourceGroup, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			elasticityProfile, err := expandStandbyVirtualMachinePoolElasticityProfile(model.ElasticityProfile)
+			if err != nil {
+				return err
+			}
+
+			payload := standbyvirtualmachinepools.StandbyVirtualMachinePoolResource{
+				Location: location.Normalize(model.Location),
+				Properties: &standbyvirtualmachinepools.StandbyVirtualMachinePoolResourceProperties{
+					AttachedVirtualMachineScaleSetId: utils.String(model.AttachedVirtualMachineScaleSetId),
+					ElasticityProfile:                elasticityProfile,
+					VirtualMachineState:              standbyvirtualmachinepools.VirtualMachineState(model.VirtualMachineState),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StandbyVirtualMachinePoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StandbyPool.StandbyVirtualMachinePoolsClient
+
+			id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StandbyVirtualMachinePoolResourceModel{
+				Name:          id.StandbyVirtualMachinePoolName,
+				ResourceGroup: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					if props.AttachedVirtualMachineScaleSetId != nil {
+						state.AttachedVirtualMachineScaleSetId = *props.AttachedVirtualMachineScaleSetId
+					}
+					state.ElasticityProfile = flattenStandbyVirtualMachinePoolElasticityProfile(props.ElasticityProfile)
+					state.VirtualMachineState = string(props.VirtualMachineState)
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StandbyVirtualMachinePoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StandbyPool.StandbyVirtualMachinePoolsClient
+
+			id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StandbyVirtualMachinePoolResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			payload := standbyvirtualmachinepools.StandbyVirtualMachinePoolResourceUpdate{}
+			props := standbyvirtualmachinepools.StandbyVirtualMachinePoolResourceUpdateProperties{}
+
+			if metadata.ResourceData.HasChange("attached_virtual_machine_scale_set_id") {
+				props.AttachedVirtualMachineScaleSetId = utils.String(model.AttachedVirtualMachineScaleSetId)
+				payload.Properties = &props
+			}
+
+			if metadata.ResourceData.HasChange("elasticity_profile") {
+				elasticityProfile, err := expandStandbyVirtualMachinePoolElasticityProfile(model.ElasticityProfile)
+				if err != nil {
+					return err
+				}
+				props.ElasticityProfile = elasticityProfile
+				payload.Properties = &props
+			}
+
+			if metadata.ResourceData.HasChange("virtual_machine_state") {
+				virtualMachineState := standbyvirtualmachinepools.VirtualMachineState(model.VirtualMachineState)
+				props.VirtualMachineState = &virtualMachineState
+				payload.Properties = &props
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StandbyVirtualMachinePoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StandbyPool.StandbyVirtualMachinePoolsClient
+
+			id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStandbyVirtualMachinePoolElasticityProfile(input []ElasticityProfileModel) (*standbyvirtualmachinepools.StandbyVirtualMachinePoolElasticityProfile, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	profile := input[0]
+	if profile.MinReadyCapacity > profile.MaxReadyCapacity {
+		return nil, fmt.Errorf("`min_ready_capacity` cannot exceed `max_ready_capacity`")
+	}
+
+	return &standbyvirtualmachinepools.StandbyVirtualMachinePoolElasticityProfile{
+		MaxReadyCapacity: profile.MaxReadyCapacity,
+		MinReadyCapacity: utils.Int64(profile.MinReadyCapacity),
+	}, nil
+}
+
+func flattenStandbyVirtualMachinePoolElasticityProfile(input *standbyvirtualmachinepools.StandbyVirtualMachinePoolElasticityProfile) []ElasticityProfileModel {
+	if input == nil {
+		return []ElasticityProfileModel{}
+	}
+
+	minReadyCapacity := int64(0)
+	if input.MinReadyCapacity != nil {
+		minReadyCapacity = *input.MinReadyCapacity
+	}
+
+	return []ElasticityProfileModel{
+		{
+			MaxReadyCapacity: input.MaxReadyCapacity,
+			MinReadyCapacity: minReadyCapacity,
+		},
+	}
+}
@@ -0,0 +1,218 @@
+package standbypool_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2024-03-01/standbyvirtualmachinepools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StandbyVirtualMachinePoolResource struct{}
+
+func TestAccStandbyVirtualMachinePool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_virtual_machine_pool", "test")
+	r := StandbyVirtualMachinePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStandbyVirtualMachinePool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_virtual_machine_pool", "test")
+	r := StandbyVirtualMachinePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStandbyVirtualMachinePool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_virtual_machine_pool", "test")
+	r := StandbyVirtualMachinePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StandbyVirtualMachinePoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StandbyPool.StandbyVirtualMachinePoolsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StandbyVirtualMachinePoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-standbypool-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestnw-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_orchestrated_virtual_machine_scale_set" "test" {
+  name                = "acctestOVMSS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name  = "Standard_F2"
+  instances = 1
+
+  platform_fault_domain_count = 1
+
+  os_profile {
+    linux_configuration {
+      computer_name_prefix = "testvm-%[1]d"
+      admin_username       = "myadmin"
+      admin_password       = "Passwword1234"
+
+      disable_password_authentication = false
+    }
+  }
+
+  network_interface {
+    name    = "TestNetworkProfile-%[1]d"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+
+  os_disk {
+    storage_account_type = "Standard_LRS"
+    caching              = "ReadWrite"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r StandbyVirtualMachinePoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_virtual_machine_pool" "test" {
+  name                                  = "acctest-svmp-%d"
+  resource_group_name                   = azurerm_resource_group.test.name
+  location                              = azurerm_resource_group.test.location
+  attached_virtual_machine_scale_set_id = azurerm_orchestrated_virtual_machine_scale_set.test.id
+  virtual_machine_state                 = "Deallocated"
+
+  elasticity_profile {
+    max_ready_capacity = 5
+    min_ready_capacity = 1
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StandbyVirtualMachinePoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_virtual_machine_pool" "import" {
+  name                                  = azurerm_standby_virtual_machine_pool.test.name
+  resource_group_name                   = azurerm_standby_virtual_machine_pool.test.resource_group_name
+  location                              = azurerm_standby_virtual_machine_pool.test.location
+  attached_virtual_machine_scale_set_id = azurerm_standby_virtual_machine_pool.test.attached_virtual_machine_scale_set_id
+  virtual_machine_state                 = azurerm_standby_virtual_machine_pool.test.virtual_machine_state
+
+  elasticity_profile {
+    max_ready_capacity = 5
+    min_ready_capacity = 1
+  }
+}
+`, r.basic(data))
+}
+
+func (r StandbyVirtualMachinePoolResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_virtual_machine_pool" "test" {
+  name                                  = "acctest-svmp-%d"
+  resource_group_name                   = azurerm_resource_group.test.name
+  location                              = azurerm_resource_group.test.location
+  attached_virtual_machine_scale_set_id = azurerm_orchestrated_virtual_machine_scale_set.test.id
+  virtual_machine_state                 = "Running"
+
+  elasticity_profile {
+    max_ready_capacity = 10
+    min_ready_capacity = 2
+  }
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
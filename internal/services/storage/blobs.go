@@ -13,8 +13,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2019-12-12/blob/blobs"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
 )
 
 const pollingInterval = time.Second * 15
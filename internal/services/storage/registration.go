@@ -33,6 +33,8 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_account":                    dataSourceStorageAccount(),
 		"azurerm_storage_blob":                       dataSourceStorageBlob(),
 		"azurerm_storage_container":                  dataSourceStorageContainer(),
+		"azurerm_storage_deleted_containers":         dataSourceStorageDeletedContainers(),
+		"azurerm_storage_deleted_shares":             dataSourceStorageDeletedShares(),
 		"azurerm_storage_encryption_scope":           dataSourceStorageEncryptionScope(),
 		"azurerm_storage_management_policy":          dataSourceStorageManagementPolicy(),
 		"azurerm_storage_share":                      dataSourceStorageShare(),
@@ -8,8 +8,8 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/tombuildsstuff/giovanni/storage/internal/endpoints"
-	"github.com/tombuildsstuff/giovanni/storage/internal/metadata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/internal/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/internal/metadata"
 )
 
 type CopyInput struct {
@@ -4,6 +4,7 @@ type AccessTier string
 
 var (
 	Archive AccessTier = "Archive"
+	Cold    AccessTier = "Cold"
 	Cool    AccessTier = "Cool"
 	Hot     AccessTier = "Hot"
 )
@@ -12,10 +13,18 @@ type ArchiveStatus string
 
 var (
 	None                   ArchiveStatus = ""
+	RehydratePendingToCold ArchiveStatus = "rehydrate-pending-to-cold"
 	RehydratePendingToCool ArchiveStatus = "rehydrate-pending-to-cool"
 	RehydratePendingToHot  ArchiveStatus = "rehydrate-pending-to-hot"
 )
 
+type RehydratePriority string
+
+var (
+	High     RehydratePriority = "High"
+	Standard RehydratePriority = "Standard"
+)
+
 type BlockListType string
 
 var (
@@ -10,8 +10,8 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/tombuildsstuff/giovanni/storage/internal/endpoints"
-	"github.com/tombuildsstuff/giovanni/storage/internal/metadata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/internal/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/internal/metadata"
 )
 
 type GetPropertiesInput struct {
@@ -235,7 +235,7 @@ func (client Client) GetPropertiesResponder(resp *http.Response) (result GetProp
 	if resp != nil && resp.Header != nil {
 		result.AccessTier = AccessTier(resp.Header.Get("x-ms-access-tier"))
 		result.AccessTierChangeTime = resp.Header.Get(" x-ms-access-tier-change-time")
-		result.ArchiveStatus = ArchiveStatus(resp.Header.Get(" x-ms-archive-status"))
+		result.ArchiveStatus = ArchiveStatus(resp.Header.Get("x-ms-archive-status"))
 		result.BlobCommittedBlockCount = resp.Header.Get("x-ms-blob-committed-block-count")
 		result.BlobSequenceNumber = resp.Header.Get("x-ms-blob-sequence-number")
 		result.BlobType = BlobType(resp.Header.Get("x-ms-blob-type"))
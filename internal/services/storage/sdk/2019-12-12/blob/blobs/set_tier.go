@@ -8,11 +8,18 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/tombuildsstuff/giovanni/storage/internal/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/internal/endpoints"
 )
 
+type SetTierInput struct {
+	Tier AccessTier
+
+	// The priority to use when rehydrating an Archived blob
+	RehydratePriority *RehydratePriority
+}
+
 // SetTier sets the tier on a blob.
-func (client Client) SetTier(ctx context.Context, accountName, containerName, blobName string, tier AccessTier) (result autorest.Response, err error) {
+func (client Client) SetTier(ctx context.Context, accountName, containerName, blobName string, input SetTierInput) (result autorest.Response, err error) {
 	if accountName == "" {
 		return result, validation.NewError("blobs.Client", "SetTier", "`accountName` cannot be an empty string.")
 	}
@@ -26,7 +33,7 @@ func (client Client) SetTier(ctx context.Context, accountName, containerName, bl
 		return result, validation.NewError("blobs.Client", "SetTier", "`blobName` cannot be an empty string.")
 	}
 
-	req, err := client.SetTierPreparer(ctx, accountName, containerName, blobName, tier)
+	req, err := client.SetTierPreparer(ctx, accountName, containerName, blobName, input)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "blobs.Client", "SetTier", nil, "Failure preparing request")
 		return
@@ -49,7 +56,7 @@ func (client Client) SetTier(ctx context.Context, accountName, containerName, bl
 }
 
 // SetTierPreparer prepares the SetTier request.
-func (client Client) SetTierPreparer(ctx context.Context, accountName, containerName, blobName string, tier AccessTier) (*http.Request, error) {
+func (client Client) SetTierPreparer(ctx context.Context, accountName, containerName, blobName string, input SetTierInput) (*http.Request, error) {
 	pathParameters := map[string]interface{}{
 		"containerName": autorest.Encode("path", containerName),
 		"blobName":      autorest.Encode("path", blobName),
@@ -61,7 +68,11 @@ func (client Client) SetTierPreparer(ctx context.Context, accountName, container
 
 	headers := map[string]interface{}{
 		"x-ms-version":     APIVersion,
-		"x-ms-access-tier": string(tier),
+		"x-ms-access-tier": string(input.Tier),
+	}
+
+	if input.RehydratePriority != nil {
+		headers["x-ms-rehydrate-priority"] = string(*input.RehydratePriority)
 	}
 
 	preparer := autorest.CreatePreparer(
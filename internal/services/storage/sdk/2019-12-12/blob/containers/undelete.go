@@ -0,0 +1,101 @@
+package containers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/internal/endpoints"
+)
+
+type UndeleteInput struct {
+	// The name of the previously deleted container to be restored
+	DeletedContainerName string
+
+	// The version of the previously deleted container to be restored
+	DeletedContainerVersion string
+}
+
+// Undelete restores a previously deleted container when container soft delete is enabled.
+func (client Client) Undelete(ctx context.Context, accountName, containerName string, input UndeleteInput) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, validation.NewError("containers.Client", "Undelete", "`accountName` cannot be an empty string.")
+	}
+	if containerName == "" {
+		return result, validation.NewError("containers.Client", "Undelete", "`containerName` cannot be an empty string.")
+	}
+	if input.DeletedContainerName == "" {
+		return result, validation.NewError("containers.Client", "Undelete", "`input.DeletedContainerName` cannot be an empty string.")
+	}
+	if input.DeletedContainerVersion == "" {
+		return result, validation.NewError("containers.Client", "Undelete", "`input.DeletedContainerVersion` cannot be an empty string.")
+	}
+
+	req, err := client.UndeletePreparer(ctx, accountName, containerName, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containers.Client", "Undelete", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UndeleteSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containers.Client", "Undelete", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UndeleteResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containers.Client", "Undelete", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UndeletePreparer prepares the Undelete request.
+func (client Client) UndeletePreparer(ctx context.Context, accountName string, containerName string, input UndeleteInput) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"containerName": autorest.Encode("path", containerName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"restype": autorest.Encode("path", "container"),
+		"comp":    autorest.Encode("path", "undelete"),
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version":                   APIVersion,
+		"x-ms-deleted-container-name":    input.DeletedContainerName,
+		"x-ms-deleted-container-version": input.DeletedContainerVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/xml; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(endpoints.GetBlobEndpoint(client.BaseURI, accountName)),
+		autorest.WithPathParameters("/{containerName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// UndeleteSender sends the Undelete request. The method will close the
+// http.Response Body if it receives an error.
+func (client Client) UndeleteSender(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+}
+
+// UndeleteResponder handles the response to the Undelete request. The method always
+// closes the http.Response Body.
+func (client Client) UndeleteResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusCreated),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	return
+}
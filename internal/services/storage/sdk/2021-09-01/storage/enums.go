@@ -10,6 +10,8 @@ package storage
 type AccessTier string
 
 const (
+	// AccessTierCold ...
+	AccessTierCold AccessTier = "Cold"
 	// AccessTierCool ...
 	AccessTierCool AccessTier = "Cool"
 	// AccessTierHot ...
@@ -20,7 +22,7 @@ const (
 
 // PossibleAccessTierValues returns an array of possible values for the AccessTier const type.
 func PossibleAccessTierValues() []AccessTier {
-	return []AccessTier{AccessTierCool, AccessTierHot, AccessTierPremium}
+	return []AccessTier{AccessTierCold, AccessTierCool, AccessTierHot, AccessTierPremium}
 }
 
 // AccountExpand enumerates the values for account expand.
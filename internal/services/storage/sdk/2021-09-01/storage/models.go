@@ -9,16 +9,17 @@ package storage
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/Azure/go-autorest/tracing"
-	"net/http"
 )
 
 // The package's fully qualified name.
-const fqdn = "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+const fqdn = "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-09-01/storage"
 
 // AccessPolicy ...
 type AccessPolicy struct {
@@ -4359,6 +4360,8 @@ type ManagementPolicyAction struct {
 type ManagementPolicyBaseBlob struct {
 	// TierToCool - The function to tier blobs to cool storage. Support blobs currently at Hot tier
 	TierToCool *DateAfterModification `json:"tierToCool,omitempty"`
+	// TierToCold - The function to tier blobs to cold storage. Support blobs currently at Hot or Cool tier
+	TierToCold *DateAfterModification `json:"tierToCold,omitempty"`
 	// TierToArchive - The function to tier blobs to archive storage. Support blobs currently at Hot or Cool tier
 	TierToArchive *DateAfterModification `json:"tierToArchive,omitempty"`
 	// Delete - The function to delete the blob
@@ -4427,6 +4430,8 @@ type ManagementPolicySchema struct {
 type ManagementPolicySnapShot struct {
 	// TierToCool - The function to tier blob snapshot to cool storage. Support blob snapshot currently at Hot tier
 	TierToCool *DateAfterCreation `json:"tierToCool,omitempty"`
+	// TierToCold - The function to tier blob snapshot to cold storage. Support blob snapshot currently at Hot or Cool tier
+	TierToCold *DateAfterCreation `json:"tierToCold,omitempty"`
 	// TierToArchive - The function to tier blob snapshot to archive storage. Support blob snapshot currently at Hot or Cool tier
 	TierToArchive *DateAfterCreation `json:"tierToArchive,omitempty"`
 	// Delete - The function to delete the blob snapshot
@@ -4437,6 +4442,8 @@ type ManagementPolicySnapShot struct {
 type ManagementPolicyVersion struct {
 	// TierToCool - The function to tier blob version to cool storage. Support blob version currently at Hot tier
 	TierToCool *DateAfterCreation `json:"tierToCool,omitempty"`
+	// TierToCold - The function to tier blob version to cold storage. Support blob version currently at Hot or Cool tier
+	TierToCold *DateAfterCreation `json:"tierToCold,omitempty"`
 	// TierToArchive - The function to tier blob version to archive storage. Support blob version currently at Hot or Cool tier
 	TierToArchive *DateAfterCreation `json:"tierToArchive,omitempty"`
 	// Delete - The function to delete the blob version
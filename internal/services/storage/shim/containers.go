@@ -3,7 +3,7 @@ package shim
 import (
 	"context"
 
-	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2019-12-12/blob/containers"
 )
 
 type StorageContainerWrapper interface {
@@ -11,6 +11,7 @@ type StorageContainerWrapper interface {
 	Delete(ctx context.Context, resourceGroup, accountName, containerName string) error
 	Exists(ctx context.Context, resourceGroup, accountName, containerName string) (*bool, error)
 	Get(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerProperties, error)
+	Restore(ctx context.Context, resourceGroup, accountName, containerName, deletedContainerVersion string) error
 	UpdateAccessLevel(ctx context.Context, resourceGroup, accountName, containerName string, level containers.AccessLevel) error
 	UpdateMetaData(ctx context.Context, resourceGroup, accountName, containerName string, metadata map[string]string) error
 }
@@ -6,9 +6,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2019-12-12/blob/containers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
 )
 
 type DataPlaneStorageContainerWrapper struct {
@@ -88,6 +88,17 @@ func (w DataPlaneStorageContainerWrapper) Get(ctx context.Context, _, accountNam
 	}, nil
 }
 
+func (w DataPlaneStorageContainerWrapper) Restore(ctx context.Context, _, accountName, containerName, deletedContainerVersion string) error {
+	input := containers.UndeleteInput{
+		DeletedContainerName:    containerName,
+		DeletedContainerVersion: deletedContainerVersion,
+	}
+	if _, err := w.client.Undelete(ctx, accountName, containerName, input); err != nil {
+		return fmt.Errorf("restoring container: %+v", err)
+	}
+	return nil
+}
+
 func (w DataPlaneStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, _, accountName, containerName string, level containers.AccessLevel) error {
 	_, err := w.client.SetAccessControl(ctx, accountName, containerName, level)
 	return err
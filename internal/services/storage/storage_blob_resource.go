@@ -9,12 +9,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2019-12-12/blob/blobs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
 )
 
 func resourceStorageBlob() *pluginsdk.Resource {
@@ -88,11 +88,21 @@ func resourceStorageBlob() *pluginsdk.Resource {
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(blobs.Archive),
+					string(blobs.Cold),
 					string(blobs.Cool),
 					string(blobs.Hot),
 				}, false),
 			},
 
+			"rehydration_priority": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(blobs.High),
+					string(blobs.Standard),
+				}, false),
+			},
+
 			"content_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -252,9 +262,19 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	if d.HasChange("access_tier") {
 		// this is only applicable for Gen2/BlobStorage accounts
 		log.Printf("[DEBUG] Updating Access Tier for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
-		accessTier := blobs.AccessTier(d.Get("access_tier").(string))
+		input := blobs.SetTierInput{
+			Tier: blobs.AccessTier(d.Get("access_tier").(string)),
+		}
 
-		if _, err := blobsClient.SetTier(ctx, id.AccountName, id.ContainerName, id.BlobName, accessTier); err != nil {
+		// moving a blob out of the Archive tier requires it to be rehydrated, which can optionally be prioritised
+		if oldTier, _ := d.GetChange("access_tier"); oldTier.(string) == string(blobs.Archive) {
+			if v := d.Get("rehydration_priority").(string); v != "" {
+				priority := blobs.RehydratePriority(v)
+				input.RehydratePriority = &priority
+			}
+		}
+
+		if _, err := blobsClient.SetTier(ctx, id.AccountName, id.ContainerName, id.BlobName, input); err != nil {
 			return fmt.Errorf("updating Access Tier for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
 		}
 
@@ -341,7 +361,18 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("storage_container_name", id.ContainerName)
 	d.Set("storage_account_name", id.AccountName)
 
-	d.Set("access_tier", string(props.AccessTier))
+	// whilst a blob is being rehydrated out of the Archive tier it remains in Archive with an archive status
+	// pointing at the target tier - surface the target tier to avoid a persistent diff during rehydration
+	accessTier := props.AccessTier
+	switch props.ArchiveStatus {
+	case blobs.RehydratePendingToCold:
+		accessTier = blobs.Cold
+	case blobs.RehydratePendingToCool:
+		accessTier = blobs.Cool
+	case blobs.RehydratePendingToHot:
+		accessTier = blobs.Hot
+	}
+	d.Set("access_tier", string(accessTier))
 	d.Set("content_type", props.ContentType)
 	d.Set("cache_control", props.CacheControl)
 
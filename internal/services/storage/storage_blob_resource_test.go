@@ -10,9 +10,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2019-12-12/blob/blobs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
 )
 
 type StorageBlobResource struct{}
@@ -124,6 +124,13 @@ func TestAccStorageBlob_blockEmptyAccessTier(t *testing.T) {
 				check.That(data.ResourceName).Key("access_tier").HasValue("Hot"),
 			),
 		},
+		{
+			Config: r.blockEmptyAccessTier(data, blobs.Cold),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Cold"),
+			),
+		},
 		{
 			Config: r.blockEmptyAccessTier(data, blobs.Cool),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -134,6 +141,30 @@ func TestAccStorageBlob_blockEmptyAccessTier(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_blockArchiveRehydration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockEmptyAccessTier(data, blobs.Archive),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Archive"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
+		{
+			Config: r.blockAccessTierWithRehydrationPriority(data, blobs.Hot, blobs.High),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Hot"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type", "rehydration_priority"),
+	})
+}
+
 func TestAccStorageBlob_blockFromInlineContent(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -636,6 +667,26 @@ resource "azurerm_storage_blob" "test" {
 `, template, string(accessTier))
 }
 
+func (r StorageBlobResource) blockAccessTierWithRehydrationPriority(data acceptance.TestData, accessTier blobs.AccessTier, priority blobs.RehydratePriority) string {
+	template := r.templateBlockBlobStorage(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  access_tier            = "%s"
+  rehydration_priority   = "%s"
+}
+`, template, string(accessTier), string(priority))
+}
+
 func (r StorageBlobResource) blockFromInlineContent(data acceptance.TestData) string {
 	template := r.template(data, "blob")
 	return fmt.Sprintf(`
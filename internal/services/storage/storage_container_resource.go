@@ -5,15 +5,16 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/blobcontainers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2019-12-12/blob/containers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
 )
 
 func resourceStorageContainer() *pluginsdk.Resource {
@@ -122,6 +123,38 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_storage_container", id)
 	}
 
+	if meta.(*clients.Client).Features.Storage.RecoverSoftDeletedContainers {
+		accountId := blobcontainers.NewStorageAccountID(storageClient.SubscriptionId, account.ResourceGroup, accountName)
+		include := blobcontainers.ListContainersIncludeDeleted
+		deleted, err := storageClient.ResourceManager.BlobContainers.ListComplete(ctx, accountId, blobcontainers.ListOperationOptions{Include: &include})
+		if err != nil {
+			return fmt.Errorf("listing soft-deleted containers within %s: %+v", accountId, err)
+		}
+
+		for _, item := range deleted.Items {
+			if item.Name == nil || *item.Name != containerName || item.Properties == nil || item.Properties.Deleted == nil || !*item.Properties.Deleted || item.Properties.Version == nil {
+				continue
+			}
+
+			log.Printf("[INFO] Restoring soft-deleted Container %q in Storage Account %q", containerName, accountName)
+			if err := client.Restore(ctx, account.ResourceGroup, accountName, containerName, *item.Properties.Version); err != nil {
+				return fmt.Errorf("restoring soft-deleted Container %q (Account %q / Resource Group %q): %+v", containerName, accountName, account.ResourceGroup, err)
+			}
+
+			// a restored container retains the metadata and access level it was deleted with, so
+			// bring these in line with the config
+			if err := client.UpdateAccessLevel(ctx, account.ResourceGroup, accountName, containerName, accessLevel); err != nil {
+				return fmt.Errorf("updating Access Level for restored Container %q (Account %q / Resource Group %q): %+v", containerName, accountName, account.ResourceGroup, err)
+			}
+			if err := client.UpdateMetaData(ctx, account.ResourceGroup, accountName, containerName, metaData); err != nil {
+				return fmt.Errorf("updating MetaData for restored Container %q (Account %q / Resource Group %q): %+v", containerName, accountName, account.ResourceGroup, err)
+			}
+
+			d.SetId(id)
+			return resourceStorageContainerRead(d, meta)
+		}
+	}
+
 	log.Printf("[INFO] Creating Container %q in Storage Account %q", containerName, accountName)
 	input := containers.CreateInput{
 		AccessLevel: accessLevel,
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/blobcontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceStorageDeletedContainers() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageDeletedContainersRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: blobcontainers.ValidateStorageAccountID,
+			},
+
+			"containers": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"deleted_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"remaining_retention_days": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStorageDeletedContainersRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.BlobContainers
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := blobcontainers.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	include := blobcontainers.ListContainersIncludeDeleted
+	resp, err := client.ListComplete(ctx, *accountId, blobcontainers.ListOperationOptions{
+		Include: &include,
+	})
+	if err != nil {
+		return fmt.Errorf("listing deleted containers in %s: %+v", *accountId, err)
+	}
+
+	containers := make([]interface{}, 0)
+	for _, item := range resp.Items {
+		if item.Properties == nil || item.Properties.Deleted == nil || !*item.Properties.Deleted {
+			continue
+		}
+
+		container := map[string]interface{}{
+			"name": "",
+		}
+		if item.Name != nil {
+			container["name"] = *item.Name
+		}
+		if version := item.Properties.Version; version != nil {
+			container["version"] = *version
+		}
+		if deletedTime := item.Properties.DeletedTime; deletedTime != nil {
+			container["deleted_time"] = *deletedTime
+		}
+		if remainingDays := item.Properties.RemainingRetentionDays; remainingDays != nil {
+			container["remaining_retention_days"] = int(*remainingDays)
+		}
+		containers = append(containers, container)
+	}
+
+	d.SetId(accountId.ID())
+
+	if err := d.Set("containers", containers); err != nil {
+		return fmt.Errorf("setting `containers`: %+v", err)
+	}
+
+	return nil
+}
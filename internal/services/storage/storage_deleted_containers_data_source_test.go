@@ -0,0 +1,60 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type dataSourceStorageDeletedContainers struct{}
+
+func TestAccDataSourceStorageDeletedContainers_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_deleted_containers", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: dataSourceStorageDeletedContainers{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("containers.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (d dataSourceStorageDeletedContainers) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsadsc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    container_delete_retention_policy {
+      days = 7
+    }
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                 = "acctestcontainer"
+  storage_account_name = azurerm_storage_account.test.name
+}
+
+data "azurerm_storage_deleted_containers" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
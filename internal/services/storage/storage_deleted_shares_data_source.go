@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/fileshares"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceStorageDeletedShares() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageDeletedSharesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: fileshares.ValidateStorageAccountID,
+			},
+
+			"shares": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"deleted_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"remaining_retention_days": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStorageDeletedSharesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.FileShares
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := fileshares.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListComplete(ctx, *accountId, fileshares.ListOperationOptions{
+		Expand: utils.String("deleted"),
+	})
+	if err != nil {
+		return fmt.Errorf("listing deleted shares in %s: %+v", *accountId, err)
+	}
+
+	shares := make([]interface{}, 0)
+	for _, item := range resp.Items {
+		if item.Properties == nil || item.Properties.Deleted == nil || !*item.Properties.Deleted {
+			continue
+		}
+
+		share := map[string]interface{}{
+			"name": "",
+		}
+		if item.Name != nil {
+			share["name"] = *item.Name
+		}
+		if version := item.Properties.Version; version != nil {
+			share["version"] = *version
+		}
+		if deletedTime := item.Properties.DeletedTime; deletedTime != nil {
+			share["deleted_time"] = *deletedTime
+		}
+		if remainingDays := item.Properties.RemainingRetentionDays; remainingDays != nil {
+			share["remaining_retention_days"] = int(*remainingDays)
+		}
+		shares = append(shares, share)
+	}
+
+	d.SetId(accountId.ID())
+
+	if err := d.Set("shares", shares); err != nil {
+		return fmt.Errorf("setting `shares`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type dataSourceStorageDeletedShares struct{}
+
+func TestAccDataSourceStorageDeletedShares_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_deleted_shares", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: dataSourceStorageDeletedShares{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("shares.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (d dataSourceStorageDeletedShares) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsadss%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "acctestshare"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 1
+}
+
+data "azurerm_storage_deleted_shares" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -5,10 +5,10 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-09-01/storage" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -143,6 +143,24 @@ func resourceStorageManagementPolicy() *pluginsdk.Resource {
 													Default:      -1,
 													ValidateFunc: validation.IntBetween(0, 99999),
 												},
+												"tier_to_cold_after_days_since_modification_greater_than": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"tier_to_cold_after_days_since_last_access_time_greater_than": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"tier_to_cold_after_days_since_creation_greater_than": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
 												"tier_to_archive_after_days_since_modification_greater_than": {
 													Type:         pluginsdk.TypeInt,
 													Optional:     true,
@@ -213,6 +231,12 @@ func resourceStorageManagementPolicy() *pluginsdk.Resource {
 													Default:      -1,
 													ValidateFunc: validation.IntBetween(0, 99999),
 												},
+												"change_tier_to_cold_after_days_since_creation": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
 												"delete_after_days_since_creation_greater_than": {
 													Type:         pluginsdk.TypeInt,
 													Optional:     true,
@@ -246,6 +270,12 @@ func resourceStorageManagementPolicy() *pluginsdk.Resource {
 													Default:      -1,
 													ValidateFunc: validation.IntBetween(0, 99999),
 												},
+												"change_tier_to_cold_after_days_since_creation": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
 												"delete_after_days_since_creation": {
 													Type:         pluginsdk.TypeInt,
 													Optional:     true,
@@ -457,6 +487,40 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 				}
 			}
 
+			sinceMod = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cold_after_days_since_modification_greater_than", ruleIndex))
+			sinceModOK = sinceMod != -1
+			sinceAccess = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cold_after_days_since_last_access_time_greater_than", ruleIndex))
+			sinceAccessOK = sinceAccess != -1
+			sinceCreate = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cold_after_days_since_creation_greater_than", ruleIndex))
+			sinceCreateOK = sinceCreate != -1
+
+			cnt = 0
+			if sinceModOK {
+				cnt++
+			}
+			if sinceAccessOK {
+				cnt++
+			}
+			if sinceCreateOK {
+				cnt++
+			}
+			if cnt > 1 {
+				return nil, fmt.Errorf("Only one of `tier_to_cold_after_days_since_modification_greater_than`, `tier_to_cold_after_days_since_last_access_time_greater_than` and `tier_to_cold_after_days_since_creation_greater_than` can be specified at the same time")
+			}
+
+			if sinceModOK || sinceAccessOK || sinceCreateOK {
+				baseBlob.TierToCold = &storage.DateAfterModification{}
+				if sinceModOK {
+					baseBlob.TierToCold.DaysAfterModificationGreaterThan = utils.Float(float64(sinceMod.(int)))
+				}
+				if sinceAccessOK {
+					baseBlob.TierToCold.DaysAfterLastAccessTimeGreaterThan = utils.Float(float64(sinceAccess.(int)))
+				}
+				if sinceCreateOK {
+					baseBlob.TierToCold.DaysAfterCreationGreaterThan = utils.Float(float64(sinceCreate.(int)))
+				}
+			}
+
 			sinceMod = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", ruleIndex))
 			sinceModOK = sinceMod != -1
 			sinceAccess = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_archive_after_days_since_last_access_time_greater_than", ruleIndex))
@@ -551,6 +615,11 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 					DaysAfterCreationGreaterThan: utils.Float(float64(v.(int))),
 				}
 			}
+			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.snapshot.0.change_tier_to_cold_after_days_since_creation", ruleIndex)); v != -1 {
+				snapshot.TierToCold = &storage.DateAfterCreation{
+					DaysAfterCreationGreaterThan: utils.Float(float64(v.(int))),
+				}
+			}
 			definition.Actions.Snapshot = snapshot
 		}
 
@@ -574,6 +643,11 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 					DaysAfterCreationGreaterThan: utils.Float(float64(v.(int))),
 				}
 			}
+			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.version.0.change_tier_to_cold_after_days_since_creation", ruleIndex)); v != -1 {
+				version.TierToCold = &storage.DateAfterCreation{
+					DaysAfterCreationGreaterThan: utils.Float(float64(v.(int))),
+				}
+			}
 			definition.Actions.Version = version
 		}
 	}
@@ -635,6 +709,9 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 						tierToCoolSinceMod               = -1
 						tierToCoolSinceAccess            = -1
 						tierToCoolSinceCreate            = -1
+						tierToColdSinceMod               = -1
+						tierToColdSinceAccess            = -1
+						tierToColdSinceCreate            = -1
 						tierToArchiveSinceMod            = -1
 						tierToArchiveSinceAccess         = -1
 						tierToArchiveSinceCreate         = -1
@@ -655,6 +732,17 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 							tierToCoolSinceCreate = int(*props.DaysAfterCreationGreaterThan)
 						}
 					}
+					if props := armActionBaseBlob.TierToCold; props != nil {
+						if props.DaysAfterModificationGreaterThan != nil {
+							tierToColdSinceMod = int(*props.DaysAfterModificationGreaterThan)
+						}
+						if props.DaysAfterLastAccessTimeGreaterThan != nil {
+							tierToColdSinceAccess = int(*props.DaysAfterLastAccessTimeGreaterThan)
+						}
+						if props.DaysAfterCreationGreaterThan != nil {
+							tierToColdSinceCreate = int(*props.DaysAfterCreationGreaterThan)
+						}
+					}
 					if props := armActionBaseBlob.TierToArchive; props != nil {
 						if props.DaysAfterModificationGreaterThan != nil {
 							tierToArchiveSinceMod = int(*props.DaysAfterModificationGreaterThan)
@@ -685,6 +773,9 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 							"tier_to_cool_after_days_since_modification_greater_than":        tierToCoolSinceMod,
 							"tier_to_cool_after_days_since_last_access_time_greater_than":    tierToCoolSinceAccess,
 							"tier_to_cool_after_days_since_creation_greater_than":            tierToCoolSinceCreate,
+							"tier_to_cold_after_days_since_modification_greater_than":        tierToColdSinceMod,
+							"tier_to_cold_after_days_since_last_access_time_greater_than":    tierToColdSinceAccess,
+							"tier_to_cold_after_days_since_creation_greater_than":            tierToColdSinceCreate,
 							"tier_to_archive_after_days_since_modification_greater_than":     tierToArchiveSinceMod,
 							"tier_to_archive_after_days_since_last_access_time_greater_than": tierToArchiveSinceAccess,
 							"tier_to_archive_after_days_since_last_tier_change_greater_than": tierToArchiveSinceLastTierChange,
@@ -698,7 +789,7 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 
 				armActionSnaphost := armAction.Snapshot
 				if armActionSnaphost != nil {
-					deleteAfterCreation, archiveAfterCreation, archiveAfterLastTierChange, coolAfterCreation := -1, -1, -1, -1
+					deleteAfterCreation, archiveAfterCreation, archiveAfterLastTierChange, coolAfterCreation, coldAfterCreation := -1, -1, -1, -1, -1
 					if armActionSnaphost.Delete != nil && armActionSnaphost.Delete.DaysAfterCreationGreaterThan != nil {
 						deleteAfterCreation = int(*armActionSnaphost.Delete.DaysAfterCreationGreaterThan)
 					}
@@ -712,16 +803,20 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 					if armActionSnaphost.TierToCool != nil && armActionSnaphost.TierToCool.DaysAfterCreationGreaterThan != nil {
 						coolAfterCreation = int(*armActionSnaphost.TierToCool.DaysAfterCreationGreaterThan)
 					}
+					if armActionSnaphost.TierToCold != nil && armActionSnaphost.TierToCold.DaysAfterCreationGreaterThan != nil {
+						coldAfterCreation = int(*armActionSnaphost.TierToCold.DaysAfterCreationGreaterThan)
+					}
 					action["snapshot"] = []interface{}{map[string]interface{}{
 						"delete_after_days_since_creation_greater_than":                  deleteAfterCreation,
 						"change_tier_to_archive_after_days_since_creation":               archiveAfterCreation,
 						"tier_to_archive_after_days_since_last_tier_change_greater_than": archiveAfterLastTierChange,
 						"change_tier_to_cool_after_days_since_creation":                  coolAfterCreation,
+						"change_tier_to_cold_after_days_since_creation":                  coldAfterCreation,
 					}}
 				}
 
 				if armActionVersion := armAction.Version; armActionVersion != nil {
-					deleteAfterCreation, archiveAfterCreation, archiveAfterLastTierChange, coolAfterCreation := -1, -1, -1, -1
+					deleteAfterCreation, archiveAfterCreation, archiveAfterLastTierChange, coolAfterCreation, coldAfterCreation := -1, -1, -1, -1, -1
 					if armActionVersion.Delete != nil && armActionVersion.Delete.DaysAfterCreationGreaterThan != nil {
 						deleteAfterCreation = int(*armActionVersion.Delete.DaysAfterCreationGreaterThan)
 					}
@@ -735,11 +830,15 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 					if armActionVersion.TierToCool != nil && armActionVersion.TierToCool.DaysAfterCreationGreaterThan != nil {
 						coolAfterCreation = int(*armActionVersion.TierToCool.DaysAfterCreationGreaterThan)
 					}
+					if armActionVersion.TierToCold != nil && armActionVersion.TierToCold.DaysAfterCreationGreaterThan != nil {
+						coldAfterCreation = int(*armActionVersion.TierToCold.DaysAfterCreationGreaterThan)
+					}
 					action["version"] = []interface{}{map[string]interface{}{
 						"delete_after_days_since_creation":                               deleteAfterCreation,
 						"change_tier_to_archive_after_days_since_creation":               archiveAfterCreation,
 						"tier_to_archive_after_days_since_last_tier_change_greater_than": archiveAfterLastTierChange,
 						"change_tier_to_cool_after_days_since_creation":                  coolAfterCreation,
+						"change_tier_to_cold_after_days_since_creation":                  coldAfterCreation,
 					}}
 				}
 
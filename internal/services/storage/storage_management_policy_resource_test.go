@@ -400,6 +400,20 @@ func TestAccStorageManagementPolicy_baseblobAccessTimeBased(t *testing.T) {
 	})
 }
 
+func TestAccStorageManagementPolicy_coldTier(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy", "test")
+	r := StorageManagementPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.coldTier(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageManagementPolicyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	storageAccountId := state.Attributes["storage_account_id"]
 	id, err := parse.StorageAccountID(storageAccountId)
@@ -1088,6 +1102,38 @@ resource "azurerm_storage_management_policy" "test" {
 `, r.templateLastAccessTimeEnabled(data))
 }
 
+func (r StorageManagementPolicyResource) coldTier(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  rule {
+    name    = "rule-1"
+    enabled = true
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+    actions {
+      base_blob {
+        tier_to_cold_after_days_since_modification_greater_than    = 10
+        tier_to_archive_after_days_since_modification_greater_than = 50
+        delete_after_days_since_modification_greater_than          = 100
+      }
+      snapshot {
+        change_tier_to_cold_after_days_since_creation = 23
+      }
+      version {
+        change_tier_to_cold_after_days_since_creation = 23
+      }
+    }
+  }
+}
+`, r.templateLastAccessTimeEnabled(data))
+}
+
 func (r StorageManagementPolicyResource) templateLastAccessTimeEnabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/fileshares"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/shares"
 )
 
@@ -175,6 +177,49 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return tf.ImportAsExistsError("azurerm_storage_share", id)
 	}
 
+	if meta.(*clients.Client).Features.Storage.RecoverSoftDeletedShares {
+		accountId := fileshares.NewStorageAccountID(storageClient.SubscriptionId, account.ResourceGroup, accountName)
+		deleted, err := storageClient.ResourceManager.FileShares.ListComplete(ctx, accountId, fileshares.ListOperationOptions{Expand: utils.String("deleted")})
+		if err != nil {
+			return fmt.Errorf("listing soft-deleted shares within %s: %+v", accountId, err)
+		}
+
+		for _, item := range deleted.Items {
+			if item.Name == nil || *item.Name != shareName || item.Properties == nil || item.Properties.Deleted == nil || !*item.Properties.Deleted || item.Properties.Version == nil {
+				continue
+			}
+
+			log.Printf("[INFO] Restoring soft-deleted Share %q in Storage Account %q", shareName, accountName)
+			shareId := fileshares.NewShareID(storageClient.SubscriptionId, account.ResourceGroup, accountName, shareName)
+			restoreInput := fileshares.DeletedShare{
+				DeletedShareName:    shareName,
+				DeletedShareVersion: *item.Properties.Version,
+			}
+			if _, err := storageClient.ResourceManager.FileShares.Restore(ctx, shareId, restoreInput); err != nil {
+				return fmt.Errorf("restoring soft-deleted Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+			}
+
+			// a restored share retains the properties it was deleted with, so bring these in line with the config
+			if err := client.UpdateQuota(ctx, account.ResourceGroup, accountName, shareName, quota); err != nil {
+				return fmt.Errorf("updating Quota for restored Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+			}
+			if err := client.UpdateMetaData(ctx, account.ResourceGroup, accountName, shareName, metaData); err != nil {
+				return fmt.Errorf("updating MetaData for restored Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+			}
+			if err := client.UpdateACLs(ctx, account.ResourceGroup, accountName, shareName, acls); err != nil {
+				return fmt.Errorf("updating ACL's for restored Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+			}
+			if accessTier := d.Get("access_tier").(string); accessTier != "" {
+				if err := client.UpdateTier(ctx, account.ResourceGroup, accountName, shareName, shares.AccessTier(accessTier)); err != nil {
+					return fmt.Errorf("updating Access Tier for restored Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+				}
+			}
+
+			d.SetId(id)
+			return resourceStorageShareRead(d, meta)
+		}
+	}
+
 	log.Printf("[INFO] Creating Share %q in Storage Account %q", shareName, accountName)
 	input := shares.CreateInput{
 		QuotaInGB:       quota,
@@ -90,6 +90,19 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
 
+			"blob_path_prefix": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"blob_write_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(outputs.BlobWriteModeAppend),
+				ValidateFunc: validation.StringInSlice(outputs.PossibleValuesForBlobWriteMode(), false),
+			},
+
 			"authentication_mode": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -154,23 +167,30 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("expanding `serialization`: %+v", err)
 	}
 
+	datasourceProps := &outputs.BlobOutputDataSourceProperties{
+		StorageAccounts: &[]outputs.StorageAccount{
+			{
+				AccountKey:  getStorageAccountKey(d.Get("storage_account_key").(string)),
+				AccountName: utils.String(storageAccountName),
+			},
+		},
+		Container:          utils.String(containerName),
+		DateFormat:         utils.String(dateFormat),
+		PathPattern:        utils.String(pathPattern),
+		TimeFormat:         utils.String(timeFormat),
+		AuthenticationMode: utils.ToPtr(outputs.AuthenticationMode(d.Get("authentication_mode").(string))),
+		BlobWriteMode:      utils.ToPtr(outputs.BlobWriteMode(d.Get("blob_write_mode").(string))),
+	}
+
+	if v, ok := d.GetOk("blob_path_prefix"); ok {
+		datasourceProps.BlobPathPrefix = utils.String(v.(string))
+	}
+
 	props := outputs.Output{
 		Name: utils.String(id.OutputName),
 		Properties: &outputs.OutputProperties{
 			Datasource: &outputs.BlobOutputDataSource{
-				Properties: &outputs.BlobOutputDataSourceProperties{
-					StorageAccounts: &[]outputs.StorageAccount{
-						{
-							AccountKey:  getStorageAccountKey(d.Get("storage_account_key").(string)),
-							AccountName: utils.String(storageAccountName),
-						},
-					},
-					Container:          utils.String(containerName),
-					DateFormat:         utils.String(dateFormat),
-					PathPattern:        utils.String(pathPattern),
-					TimeFormat:         utils.String(timeFormat),
-					AuthenticationMode: utils.ToPtr(outputs.AuthenticationMode(d.Get("authentication_mode").(string))),
-				},
+				Properties: datasourceProps,
 			},
 			Serialization: serialization,
 		},
@@ -267,6 +287,18 @@ func resourceStreamAnalyticsOutputBlobRead(d *pluginsdk.ResourceData, meta inter
 			}
 			d.Set("authentication_mode", authenticationMode)
 
+			blobPathPrefix := ""
+			if v := output.Properties.BlobPathPrefix; v != nil {
+				blobPathPrefix = *v
+			}
+			d.Set("blob_path_prefix", blobPathPrefix)
+
+			blobWriteMode := string(outputs.BlobWriteModeAppend)
+			if v := output.Properties.BlobWriteMode; v != nil {
+				blobWriteMode = string(*v)
+			}
+			d.Set("blob_write_mode", blobWriteMode)
+
 			if accounts := output.Properties.StorageAccounts; accounts != nil && len(*accounts) > 0 {
 				account := (*accounts)[0]
 				d.Set("storage_account_name", account.AccountName)
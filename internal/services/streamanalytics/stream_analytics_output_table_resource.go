@@ -339,7 +339,7 @@ func (r OutputTableResource) CustomImporter() sdk.ResourceRunFunc {
 
 		props := resp.Model.Properties
 		if _, ok := props.Datasource.(outputs.AzureTableOutputDataSource); !ok {
-			return fmt.Errorf("specified output is not of type")
+			return fmt.Errorf("specified output is not of type Microsoft.Storage/Table")
 		}
 		return nil
 	}
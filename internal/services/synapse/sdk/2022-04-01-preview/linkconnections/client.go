@@ -0,0 +1,18 @@
+package linkconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LinkConnectionClient struct {
+	Client   autorest.Client
+	endpoint string
+}
+
+func NewLinkConnectionClient(endpoint string) LinkConnectionClient {
+	return LinkConnectionClient{
+		Client:   autorest.NewClientWithUserAgent(userAgent()),
+		endpoint: endpoint,
+	}
+}
@@ -0,0 +1,71 @@
+package linkconnections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type EditTablesOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// EditTables ...
+func (c LinkConnectionClient) EditTables(ctx context.Context, linkConnectionName string, input EditTablesRequest) (result EditTablesOperationResponse, err error) {
+	req, err := c.preparerForEditTables(ctx, linkConnectionName, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "linkconnections.LinkConnectionClient", "EditTables", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "linkconnections.LinkConnectionClient", "EditTables", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForEditTables(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "linkconnections.LinkConnectionClient", "EditTables", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForEditTables prepares the EditTables request.
+func (c LinkConnectionClient) preparerForEditTables(ctx context.Context, linkConnectionName string, input EditTablesRequest) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.endpoint),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}/edittables", pathParameters),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForEditTables handles the response to the EditTables request. The method always
+// closes the http.Response Body.
+func (c LinkConnectionClient) responderForEditTables(resp *http.Response) (result EditTablesOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
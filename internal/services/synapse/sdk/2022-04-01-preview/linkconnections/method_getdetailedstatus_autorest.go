@@ -0,0 +1,72 @@
+package linkconnections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type GetDetailedStatusOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *LinkConnectionDetailedStatus
+}
+
+// GetDetailedStatus ...
+func (c LinkConnectionClient) GetDetailedStatus(ctx context.Context, linkConnectionName string) (result GetDetailedStatusOperationResponse, err error) {
+	req, err := c.preparerForGetDetailedStatus(ctx, linkConnectionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "linkconnections.LinkConnectionClient", "GetDetailedStatus", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "linkconnections.LinkConnectionClient", "GetDetailedStatus", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetDetailedStatus(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "linkconnections.LinkConnectionClient", "GetDetailedStatus", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetDetailedStatus prepares the GetDetailedStatus request.
+func (c LinkConnectionClient) preparerForGetDetailedStatus(ctx context.Context, linkConnectionName string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.endpoint),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}/detailedstatus", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetDetailedStatus handles the response to the GetDetailedStatus request. The method always
+// closes the http.Response Body.
+func (c LinkConnectionClient) responderForGetDetailedStatus(resp *http.Response) (result GetDetailedStatusOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
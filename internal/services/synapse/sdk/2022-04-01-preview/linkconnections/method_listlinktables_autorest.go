@@ -0,0 +1,72 @@
+package linkconnections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ListLinkTablesOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *LinkTableListResponse
+}
+
+// ListLinkTables ...
+func (c LinkConnectionClient) ListLinkTables(ctx context.Context, linkConnectionName string) (result ListLinkTablesOperationResponse, err error) {
+	req, err := c.preparerForListLinkTables(ctx, linkConnectionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "linkconnections.LinkConnectionClient", "ListLinkTables", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "linkconnections.LinkConnectionClient", "ListLinkTables", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListLinkTables(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "linkconnections.LinkConnectionClient", "ListLinkTables", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListLinkTables prepares the ListLinkTables request.
+func (c LinkConnectionClient) preparerForListLinkTables(ctx context.Context, linkConnectionName string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.endpoint),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}/linktables", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListLinkTables handles the response to the ListLinkTables request. The method always
+// closes the http.Response Body.
+func (c LinkConnectionClient) responderForListLinkTables(resp *http.Response) (result ListLinkTablesOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
@@ -0,0 +1,93 @@
+package linkconnections
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LinkConnectionResource struct {
+	Id         *string         `json:"id,omitempty"`
+	Name       string          `json:"name"`
+	Type       *string         `json:"type,omitempty"`
+	Properties *LinkConnection `json:"properties,omitempty"`
+}
+
+type LinkConnection struct {
+	SourceDatabase *LinkConnectionSourceDatabase `json:"sourceDatabase,omitempty"`
+	TargetDatabase *LinkConnectionTargetDatabase `json:"targetDatabase,omitempty"`
+	LandingZone    *LinkConnectionLandingZone    `json:"landingZone,omitempty"`
+	Compute        *LinkConnectionCompute        `json:"compute,omitempty"`
+}
+
+type LinkConnectionSourceDatabase struct {
+	LinkedService *LinkedServiceReference `json:"linkedService,omitempty"`
+}
+
+type LinkConnectionTargetDatabase struct {
+	LinkedService *LinkedServiceReference `json:"linkedService,omitempty"`
+}
+
+type LinkConnectionLandingZone struct {
+	LinkedService *LinkedServiceReference `json:"linkedService,omitempty"`
+	FileSystem    string                  `json:"fileSystem"`
+	FolderPath    string                  `json:"folderPath"`
+	SasToken      *SecureString           `json:"sasToken,omitempty"`
+}
+
+type LinkConnectionCompute struct {
+	CoreCount                  *int64  `json:"coreCount,omitempty"`
+	ComputeType                *string `json:"computeType,omitempty"`
+	DataProcessIntervalMinutes *int64  `json:"dataProcessIntervalMinutes,omitempty"`
+}
+
+type LinkedServiceReference struct {
+	ReferenceName string `json:"referenceName"`
+	Type          string `json:"type"`
+}
+
+type SecureString struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type LinkConnectionDetailedStatus struct {
+	Id     *string `json:"id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Status *string `json:"status,omitempty"`
+}
+
+type EditTablesRequest struct {
+	LinkTables *[]LinkTableRequest `json:"linkTables,omitempty"`
+}
+
+type LinkTableRequest struct {
+	Id            *string                 `json:"id,omitempty"`
+	Source        *LinkTableRequestSource `json:"source,omitempty"`
+	Target        *LinkTableRequestTarget `json:"target,omitempty"`
+	OperationType string                  `json:"operationType"`
+}
+
+type LinkTableRequestSource struct {
+	TableName  string `json:"tableName"`
+	SchemaName string `json:"schemaName"`
+}
+
+type LinkTableRequestTarget struct {
+	TableName           string                                     `json:"tableName"`
+	SchemaName          string                                     `json:"schemaName"`
+	DistributionOptions *LinkTableRequestTargetDistributionOptions `json:"distributionOptions,omitempty"`
+}
+
+type LinkTableRequestTargetDistributionOptions struct {
+	Type               *string `json:"type,omitempty"`
+	DistributionColumn *string `json:"distributionColumn,omitempty"`
+}
+
+type LinkTableListResponse struct {
+	Value *[]LinkTableResource `json:"value,omitempty"`
+}
+
+type LinkTableResource struct {
+	Id     *string                 `json:"id,omitempty"`
+	Name   *string                 `json:"name,omitempty"`
+	Source *LinkTableRequestSource `json:"source,omitempty"`
+	Target *LinkTableRequestTarget `json:"target,omitempty"`
+}
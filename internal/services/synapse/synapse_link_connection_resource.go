@@ -0,0 +1,711 @@
+package synapse
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2022-04-01-preview/linkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+const (
+	linkConnectionStatusRunning  = "Running"
+	linkConnectionStatusStarting = "Starting"
+	linkConnectionStatusStopped  = "Stopped"
+	linkConnectionStatusStopping = "Stopping"
+)
+
+func resourceSynapseLinkConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseLinkConnectionCreate,
+		Read:   resourceSynapseLinkConnectionRead,
+		Update: resourceSynapseLinkConnectionUpdate,
+		Delete: resourceSynapseLinkConnectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LinkConnectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"source_database": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"target_database": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"landing_zone": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"file_system": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"folder_path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"sas_token": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"table": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source_schema_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"source_table_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"target_schema_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"target_table_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"distribution_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "Round_Robin",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Hash",
+								"Replicate",
+								"Round_Robin",
+							}, false),
+						},
+
+						"distribution_column": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"compute": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"core_count": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      16,
+							ValidateFunc: validation.IntAtLeast(4),
+						},
+
+						"compute_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "General",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"data_process_interval_minutes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntBetween(20, 1440),
+						},
+					},
+				},
+			},
+
+			"running": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceSynapseLinkConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LinkConnectionClient(workspaceId.Name, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLinkConnectionID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.Name)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_synapse_link_connection", id.ID())
+	}
+
+	linkConnection := linkconnections.LinkConnectionResource{
+		Name: id.Name,
+		Properties: &linkconnections.LinkConnection{
+			SourceDatabase: expandLinkConnectionSourceDatabase(d.Get("source_database").([]interface{})),
+			TargetDatabase: expandLinkConnectionTargetDatabase(d.Get("target_database").([]interface{})),
+			LandingZone:    expandLinkConnectionLandingZone(d.Get("landing_zone").([]interface{})),
+			Compute:        expandLinkConnectionCompute(d.Get("compute").([]interface{})),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.Name, linkConnection); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	tableRequests := make([]linkconnections.LinkTableRequest, 0)
+	for _, v := range d.Get("table").(*pluginsdk.Set).List() {
+		tableRequests = append(tableRequests, expandLinkConnectionTableRequest(v.(map[string]interface{}), "add", nil))
+	}
+	if _, err := client.EditTables(ctx, id.Name, linkconnections.EditTablesRequest{LinkTables: &tableRequests}); err != nil {
+		return fmt.Errorf("adding tables to %s: %+v", id, err)
+	}
+
+	if d.Get("running").(bool) {
+		if err := startLinkConnection(ctx, client, id, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSynapseLinkConnectionRead(d, meta)
+}
+
+func resourceSynapseLinkConnectionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.LinkConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LinkConnectionClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	// the link connection must be stopped before its definition or table mapping can change
+	if d.HasChanges("source_database", "target_database", "landing_zone", "compute", "table") {
+		if err := stopLinkConnection(ctx, client, *id, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("source_database", "target_database", "landing_zone", "compute") {
+		linkConnection := linkconnections.LinkConnectionResource{
+			Name: id.Name,
+			Properties: &linkconnections.LinkConnection{
+				SourceDatabase: expandLinkConnectionSourceDatabase(d.Get("source_database").([]interface{})),
+				TargetDatabase: expandLinkConnectionTargetDatabase(d.Get("target_database").([]interface{})),
+				LandingZone:    expandLinkConnectionLandingZone(d.Get("landing_zone").([]interface{})),
+				Compute:        expandLinkConnectionCompute(d.Get("compute").([]interface{})),
+			},
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, id.Name, linkConnection); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+	}
+
+	if d.HasChange("table") {
+		oldRaw, newRaw := d.GetChange("table")
+		oldSet := oldRaw.(*pluginsdk.Set)
+		newSet := newRaw.(*pluginsdk.Set)
+
+		tableRequests := make([]linkconnections.LinkTableRequest, 0)
+
+		removed := oldSet.Difference(newSet)
+		if removed.Len() > 0 {
+			tableIds, err := linkTableIdsBySource(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+			for _, v := range removed.List() {
+				table := v.(map[string]interface{})
+				key := fmt.Sprintf("%s.%s", table["source_schema_name"].(string), table["source_table_name"].(string))
+				tableId, ok := tableIds[key]
+				if !ok {
+					continue
+				}
+				tableRequests = append(tableRequests, expandLinkConnectionTableRequest(table, "remove", &tableId))
+			}
+		}
+
+		for _, v := range newSet.Difference(oldSet).List() {
+			tableRequests = append(tableRequests, expandLinkConnectionTableRequest(v.(map[string]interface{}), "add", nil))
+		}
+
+		if len(tableRequests) > 0 {
+			if _, err := client.EditTables(ctx, id.Name, linkconnections.EditTablesRequest{LinkTables: &tableRequests}); err != nil {
+				return fmt.Errorf("updating tables for %s: %+v", id, err)
+			}
+		}
+	}
+
+	if d.Get("running").(bool) {
+		if err := startLinkConnection(ctx, client, *id, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+			return err
+		}
+	} else if err := stopLinkConnection(ctx, client, *id, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceSynapseLinkConnectionRead(d, meta)
+}
+
+func resourceSynapseLinkConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.LinkConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LinkConnectionClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.Name)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+		if err := d.Set("source_database", flattenLinkConnectionSourceDatabase(props.SourceDatabase)); err != nil {
+			return fmt.Errorf("setting `source_database`: %+v", err)
+		}
+		if err := d.Set("target_database", flattenLinkConnectionTargetDatabase(props.TargetDatabase)); err != nil {
+			return fmt.Errorf("setting `target_database`: %+v", err)
+		}
+		// the sas token is not returned by the service so the configured value is carried over
+		sasToken := ""
+		if landingZone, ok := d.GetOk("landing_zone"); ok {
+			if raw := landingZone.([]interface{}); len(raw) > 0 && raw[0] != nil {
+				sasToken = raw[0].(map[string]interface{})["sas_token"].(string)
+			}
+		}
+		if err := d.Set("landing_zone", flattenLinkConnectionLandingZone(props.LandingZone, sasToken)); err != nil {
+			return fmt.Errorf("setting `landing_zone`: %+v", err)
+		}
+		if err := d.Set("compute", flattenLinkConnectionCompute(props.Compute)); err != nil {
+			return fmt.Errorf("setting `compute`: %+v", err)
+		}
+	}
+
+	tablesResp, err := client.ListLinkTables(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving tables for %s: %+v", id, err)
+	}
+	if err := d.Set("table", flattenLinkConnectionTables(tablesResp.Model)); err != nil {
+		return fmt.Errorf("setting `table`: %+v", err)
+	}
+
+	status, err := client.GetDetailedStatus(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving status of %s: %+v", id, err)
+	}
+	running := false
+	if status.Model != nil && status.Model.Status != nil {
+		running = *status.Model.Status == linkConnectionStatusRunning || *status.Model.Status == linkConnectionStatusStarting
+	}
+	d.Set("running", running)
+
+	return nil
+}
+
+func resourceSynapseLinkConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.LinkConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LinkConnectionClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	if err := stopLinkConnection(ctx, client, *id, d.Timeout(pluginsdk.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func startLinkConnection(ctx context.Context, client *linkconnections.LinkConnectionClient, id parse.LinkConnectionId, timeout time.Duration) error {
+	status, err := client.GetDetailedStatus(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving status of %s: %+v", id, err)
+	}
+	if status.Model != nil && status.Model.Status != nil && *status.Model.Status == linkConnectionStatusRunning {
+		return nil
+	}
+
+	if _, err := client.Start(ctx, id.Name); err != nil {
+		return fmt.Errorf("starting %s: %+v", id, err)
+	}
+
+	return waitForLinkConnectionStatus(ctx, client, id, []string{linkConnectionStatusStarting, linkConnectionStatusStopped}, linkConnectionStatusRunning, timeout)
+}
+
+func stopLinkConnection(ctx context.Context, client *linkconnections.LinkConnectionClient, id parse.LinkConnectionId, timeout time.Duration) error {
+	status, err := client.GetDetailedStatus(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving status of %s: %+v", id, err)
+	}
+	if status.Model == nil || status.Model.Status == nil || *status.Model.Status == linkConnectionStatusStopped {
+		return nil
+	}
+
+	if _, err := client.Stop(ctx, id.Name); err != nil {
+		return fmt.Errorf("stopping %s: %+v", id, err)
+	}
+
+	return waitForLinkConnectionStatus(ctx, client, id, []string{linkConnectionStatusStopping, linkConnectionStatusRunning, linkConnectionStatusStarting}, linkConnectionStatusStopped, timeout)
+}
+
+func waitForLinkConnectionStatus(ctx context.Context, client *linkconnections.LinkConnectionClient, id parse.LinkConnectionId, pending []string, target string, timeout time.Duration) error {
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetDetailedStatus(ctx, id.Name)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving status of %s: %+v", id, err)
+			}
+			if resp.Model == nil || resp.Model.Status == nil {
+				return resp, linkConnectionStatusStopped, nil
+			}
+			return resp, *resp.Model.Status, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to become %q: %+v", id, target, err)
+	}
+	return nil
+}
+
+func linkTableIdsBySource(ctx context.Context, client *linkconnections.LinkConnectionClient, id parse.LinkConnectionId) (map[string]string, error) {
+	resp, err := client.ListLinkTables(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving tables for %s: %+v", id, err)
+	}
+
+	result := make(map[string]string)
+	if resp.Model != nil && resp.Model.Value != nil {
+		for _, table := range *resp.Model.Value {
+			if table.Id == nil || table.Source == nil {
+				continue
+			}
+			result[fmt.Sprintf("%s.%s", table.Source.SchemaName, table.Source.TableName)] = *table.Id
+		}
+	}
+	return result, nil
+}
+
+func expandLinkConnectionSourceDatabase(input []interface{}) *linkconnections.LinkConnectionSourceDatabase {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+	return &linkconnections.LinkConnectionSourceDatabase{
+		LinkedService: &linkconnections.LinkedServiceReference{
+			ReferenceName: v["linked_service_name"].(string),
+			Type:          "LinkedServiceReference",
+		},
+	}
+}
+
+func expandLinkConnectionTargetDatabase(input []interface{}) *linkconnections.LinkConnectionTargetDatabase {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+	return &linkconnections.LinkConnectionTargetDatabase{
+		LinkedService: &linkconnections.LinkedServiceReference{
+			ReferenceName: v["linked_service_name"].(string),
+			Type:          "LinkedServiceReference",
+		},
+	}
+}
+
+func expandLinkConnectionLandingZone(input []interface{}) *linkconnections.LinkConnectionLandingZone {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+	result := &linkconnections.LinkConnectionLandingZone{
+		LinkedService: &linkconnections.LinkedServiceReference{
+			ReferenceName: v["linked_service_name"].(string),
+			Type:          "LinkedServiceReference",
+		},
+		FileSystem: v["file_system"].(string),
+		FolderPath: v["folder_path"].(string),
+	}
+	if sasToken := v["sas_token"].(string); sasToken != "" {
+		result.SasToken = &linkconnections.SecureString{
+			Type:  "SecureString",
+			Value: sasToken,
+		}
+	}
+	return result
+}
+
+func expandLinkConnectionCompute(input []interface{}) *linkconnections.LinkConnectionCompute {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+	return &linkconnections.LinkConnectionCompute{
+		CoreCount:                  pointer.To(int64(v["core_count"].(int))),
+		ComputeType:                pointer.To(v["compute_type"].(string)),
+		DataProcessIntervalMinutes: pointer.To(int64(v["data_process_interval_minutes"].(int))),
+	}
+}
+
+func expandLinkConnectionTableRequest(input map[string]interface{}, operationType string, tableId *string) linkconnections.LinkTableRequest {
+	target := &linkconnections.LinkTableRequestTarget{
+		TableName:  input["target_table_name"].(string),
+		SchemaName: input["target_schema_name"].(string),
+		DistributionOptions: &linkconnections.LinkTableRequestTargetDistributionOptions{
+			Type: pointer.To(input["distribution_type"].(string)),
+		},
+	}
+	if distributionColumn := input["distribution_column"].(string); distributionColumn != "" {
+		target.DistributionOptions.DistributionColumn = pointer.To(distributionColumn)
+	}
+
+	return linkconnections.LinkTableRequest{
+		Id: tableId,
+		Source: &linkconnections.LinkTableRequestSource{
+			TableName:  input["source_table_name"].(string),
+			SchemaName: input["source_schema_name"].(string),
+		},
+		Target:        target,
+		OperationType: operationType,
+	}
+}
+
+func flattenLinkConnectionSourceDatabase(input *linkconnections.LinkConnectionSourceDatabase) []interface{} {
+	if input == nil || input.LinkedService == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"linked_service_name": input.LinkedService.ReferenceName,
+		},
+	}
+}
+
+func flattenLinkConnectionTargetDatabase(input *linkconnections.LinkConnectionTargetDatabase) []interface{} {
+	if input == nil || input.LinkedService == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"linked_service_name": input.LinkedService.ReferenceName,
+		},
+	}
+}
+
+func flattenLinkConnectionLandingZone(input *linkconnections.LinkConnectionLandingZone, sasToken string) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+	linkedServiceName := ""
+	if input.LinkedService != nil {
+		linkedServiceName = input.LinkedService.ReferenceName
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"linked_service_name": linkedServiceName,
+			"file_system":         input.FileSystem,
+			"folder_path":         input.FolderPath,
+			"sas_token":           sasToken,
+		},
+	}
+}
+
+func flattenLinkConnectionCompute(input *linkconnections.LinkConnectionCompute) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"core_count":                    int(pointer.From(input.CoreCount)),
+			"compute_type":                  pointer.From(input.ComputeType),
+			"data_process_interval_minutes": int(pointer.From(input.DataProcessIntervalMinutes)),
+		},
+	}
+}
+
+func flattenLinkConnectionTables(input *linkconnections.LinkTableListResponse) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil || input.Value == nil {
+		return result
+	}
+
+	for _, table := range *input.Value {
+		if table.Source == nil || table.Target == nil {
+			continue
+		}
+		distributionType := ""
+		distributionColumn := ""
+		if table.Target.DistributionOptions != nil {
+			distributionType = pointer.From(table.Target.DistributionOptions.Type)
+			distributionColumn = pointer.From(table.Target.DistributionOptions.DistributionColumn)
+		}
+		result = append(result, map[string]interface{}{
+			"source_schema_name":  table.Source.SchemaName,
+			"source_table_name":   table.Source.TableName,
+			"target_schema_name":  table.Target.SchemaName,
+			"target_table_name":   table.Target.TableName,
+			"distribution_type":   distributionType,
+			"distribution_column": distributionColumn,
+		})
+	}
+	return result
+}
@@ -0,0 +1,317 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SynapseLinkConnectionResource struct{}
+
+func TestAccSynapseLinkConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_link_connection", "test")
+	r := SynapseLinkConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("landing_zone.0.sas_token"),
+	})
+}
+
+func TestAccSynapseLinkConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_link_connection", "test")
+	r := SynapseLinkConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseLinkConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_link_connection", "test")
+	r := SynapseLinkConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("table.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("landing_zone.0.sas_token"),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("table.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("landing_zone.0.sas_token"),
+	})
+}
+
+func (r SynapseLinkConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LinkConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	environment := client.Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	linkConnectionClient, err := client.Synapse.LinkConnectionClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := linkConnectionClient.Get(ctx, id.Name)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SynapseLinkConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-synapse-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "StorageV2"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%[1]d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%[1]d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_synapse_firewall_rule" "test" {
+  name                 = "allowAll"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  start_ip_address     = "0.0.0.0"
+  end_ip_address       = "255.255.255.255"
+}
+
+resource "azurerm_synapse_sql_pool" "test" {
+  name                 = "acctestsp%[1]d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  sku_name             = "DW100c"
+  create_mode          = "Default"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "H@Sh1CoR3!"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctestdb%[1]d"
+  server_id = azurerm_mssql_server.test.id
+  sku_name  = "S1"
+}
+
+resource "azurerm_synapse_linked_service" "source" {
+  name                 = "acctestlssource%[1]d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  type                 = "AzureSqlDatabase"
+  type_properties_json = <<JSON
+{
+  "connectionString": "Integrated Security=False;Encrypt=True;Connection Timeout=30;Data Source=${azurerm_mssql_server.test.fully_qualified_domain_name};Initial Catalog=${azurerm_mssql_database.test.name};User ID=mradministrator;Password=H@Sh1CoR3!"
+}
+JSON
+
+  depends_on = [azurerm_synapse_firewall_rule.test]
+}
+
+resource "azurerm_synapse_linked_service" "target" {
+  name                 = "acctestlstarget%[1]d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  type                 = "AzureSqlDW"
+  type_properties_json = <<JSON
+{
+  "connectionString": "Integrated Security=False;Encrypt=True;Connection Timeout=30;Data Source=${azurerm_synapse_workspace.test.connectivity_endpoints["sql"]};Initial Catalog=${azurerm_synapse_sql_pool.test.name};User ID=sqladminuser;Password=H@Sh1CoR3!"
+}
+JSON
+
+  depends_on = [azurerm_synapse_firewall_rule.test]
+}
+
+resource "azurerm_synapse_linked_service" "landing" {
+  name                 = "acctestlslanding%[1]d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  type                 = "AzureBlobFS"
+  type_properties_json = <<JSON
+{
+  "url": "${azurerm_storage_account.test.primary_dfs_endpoint}"
+}
+JSON
+
+  depends_on = [azurerm_synapse_firewall_rule.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r SynapseLinkConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_link_connection" "test" {
+  name                 = "acctestlc%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+
+  source_database {
+    linked_service_name = azurerm_synapse_linked_service.source.name
+  }
+
+  target_database {
+    linked_service_name = azurerm_synapse_linked_service.target.name
+  }
+
+  landing_zone {
+    linked_service_name = azurerm_synapse_linked_service.landing.name
+    file_system         = azurerm_storage_data_lake_gen2_filesystem.test.name
+    folder_path         = "landing"
+  }
+
+  table {
+    source_schema_name = "dbo"
+    source_table_name  = "orders"
+    target_schema_name = "dbo"
+    target_table_name  = "orders"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SynapseLinkConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_link_connection" "import" {
+  name                 = azurerm_synapse_link_connection.test.name
+  synapse_workspace_id = azurerm_synapse_link_connection.test.synapse_workspace_id
+
+  source_database {
+    linked_service_name = azurerm_synapse_linked_service.source.name
+  }
+
+  target_database {
+    linked_service_name = azurerm_synapse_linked_service.target.name
+  }
+
+  landing_zone {
+    linked_service_name = azurerm_synapse_linked_service.landing.name
+    file_system         = azurerm_storage_data_lake_gen2_filesystem.test.name
+    folder_path         = "landing"
+  }
+
+  table {
+    source_schema_name = "dbo"
+    source_table_name  = "orders"
+    target_schema_name = "dbo"
+    target_table_name  = "orders"
+  }
+}
+`, r.basic(data))
+}
+
+func (r SynapseLinkConnectionResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_link_connection" "test" {
+  name                 = "acctestlc%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+
+  source_database {
+    linked_service_name = azurerm_synapse_linked_service.source.name
+  }
+
+  target_database {
+    linked_service_name = azurerm_synapse_linked_service.target.name
+  }
+
+  landing_zone {
+    linked_service_name = azurerm_synapse_linked_service.landing.name
+    file_system         = azurerm_storage_data_lake_gen2_filesystem.test.name
+    folder_path         = "landing"
+  }
+
+  compute {
+    core_count                    = 16
+    data_process_interval_minutes = 30
+  }
+
+  table {
+    source_schema_name = "dbo"
+    source_table_name  = "orders"
+    target_schema_name = "dbo"
+    target_table_name  = "orders"
+  }
+
+  table {
+    source_schema_name  = "dbo"
+    source_table_name   = "customers"
+    target_schema_name  = "dbo"
+    target_table_name   = "customers"
+    distribution_type   = "Hash"
+    distribution_column = "customer_id"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
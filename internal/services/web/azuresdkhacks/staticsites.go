@@ -0,0 +1,312 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the Static Sites operations below (linked backends, database connections and the
+// `enterpriseGradeCdnStatus` property) only exist in newer versions of the Web API than
+// the vendored 2021-02-01 SDK exposes, so these are implemented by hand until the
+// provider is migrated onto a newer version of the SDK
+const staticSitesApiVersion = "2022-09-01"
+
+// StaticSiteARMResource is a version of web.StaticSiteARMResource which surfaces the
+// `enterpriseGradeCdnStatus` property missing from the vendored SDK
+type StaticSiteARMResource struct {
+	autorest.Response `json:"-"`
+	Sku               *web.SkuDescription         `json:"sku,omitempty"`
+	Properties        *StaticSite                 `json:"properties,omitempty"`
+	Identity          *web.ManagedServiceIdentity `json:"identity,omitempty"`
+	Location          *string                     `json:"location,omitempty"`
+	Tags              map[string]*string          `json:"tags"`
+}
+
+type StaticSite struct {
+	EnterpriseGradeCdnStatus *string `json:"enterpriseGradeCdnStatus,omitempty"`
+}
+
+type StaticSiteLinkedBackendARMResource struct {
+	autorest.Response `json:"-"`
+	ID                *string                  `json:"id,omitempty"`
+	Name              *string                  `json:"name,omitempty"`
+	Properties        *StaticSiteLinkedBackend `json:"properties,omitempty"`
+}
+
+type StaticSiteLinkedBackend struct {
+	BackendResourceID *string `json:"backendResourceId,omitempty"`
+	Region            *string `json:"region,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+type DatabaseConnection struct {
+	autorest.Response `json:"-"`
+	ID                *string                       `json:"id,omitempty"`
+	Name              *string                       `json:"name,omitempty"`
+	Properties        *DatabaseConnectionProperties `json:"properties,omitempty"`
+}
+
+type DatabaseConnectionProperties struct {
+	ResourceID         *string `json:"resourceId,omitempty"`
+	ConnectionIdentity *string `json:"connectionIdentity,omitempty"`
+	ConnectionString   *string `json:"connectionString,omitempty"`
+	Region             *string `json:"region,omitempty"`
+}
+
+// CreateOrUpdateStaticSite is a version of web.StaticSitesClient.CreateOrUpdateStaticSite
+// which is able to set the `enterpriseGradeCdnStatus` property
+func CreateOrUpdateStaticSite(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, staticSiteEnvelope StaticSiteARMResource) (web.StaticSitesCreateOrUpdateStaticSiteFuture, error) {
+	req, err := staticSitePreparer(ctx, client, resourceGroupName, name, http.MethodPut, staticSiteEnvelope)
+	if err != nil {
+		return web.StaticSitesCreateOrUpdateStaticSiteFuture{}, autorest.NewErrorWithError(err, "web.StaticSitesClient", "CreateOrUpdateStaticSite", nil, "Failure preparing request")
+	}
+
+	return client.CreateOrUpdateStaticSiteSender(req)
+}
+
+// GetStaticSite is a version of web.StaticSitesClient.GetStaticSite which surfaces the
+// `enterpriseGradeCdnStatus` property
+func GetStaticSite(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string) (result StaticSiteARMResource, err error) {
+	req, err := staticSitePreparer(ctx, client, resourceGroupName, name, http.MethodGet, nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetStaticSite", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetStaticSiteSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetStaticSite", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetStaticSite", resp, "Failure responding to request")
+	}
+	return
+}
+
+// LinkBackend links a Function App, App Service or Container App backend to a Static Site
+// and waits for the linking to complete
+func LinkBackend(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, linkedBackendName string, backendEnvelope StaticSiteLinkedBackendARMResource) error {
+	req, err := linkedBackendPreparer(ctx, client, resourceGroupName, name, linkedBackendName, http.MethodPut, backendEnvelope)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.StaticSitesClient", "LinkBackend", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.StaticSitesClient", "LinkBackend", resp, "Failure sending request")
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.StaticSitesClient", "LinkBackend", resp, "Failure tracking request")
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
+
+// GetLinkedBackend retrieves a backend linked to a Static Site
+func GetLinkedBackend(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, linkedBackendName string) (result StaticSiteLinkedBackendARMResource, err error) {
+	req, err := linkedBackendPreparer(ctx, client, resourceGroupName, name, linkedBackendName, http.MethodGet, nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetLinkedBackend", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetLinkedBackend", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetLinkedBackend", resp, "Failure responding to request")
+	}
+	return
+}
+
+// UnlinkBackend detaches a linked backend from a Static Site
+func UnlinkBackend(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, linkedBackendName string) (result autorest.Response, err error) {
+	req, err := linkedBackendPreparer(ctx, client, resourceGroupName, name, linkedBackendName, http.MethodDelete, nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "UnlinkBackend", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "UnlinkBackend", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "UnlinkBackend", resp, "Failure responding to request")
+	}
+	return
+}
+
+// CreateOrUpdateDatabaseConnection creates or updates a database connection on a Static Site
+func CreateOrUpdateDatabaseConnection(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, databaseConnectionName string, databaseConnectionEnvelope DatabaseConnection) (result DatabaseConnection, err error) {
+	req, err := databaseConnectionPreparer(ctx, client, resourceGroupName, name, databaseConnectionName, http.MethodPut, databaseConnectionEnvelope)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "CreateOrUpdateDatabaseConnection", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "CreateOrUpdateDatabaseConnection", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "CreateOrUpdateDatabaseConnection", resp, "Failure responding to request")
+	}
+	return
+}
+
+// GetDatabaseConnection retrieves a database connection on a Static Site
+func GetDatabaseConnection(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, databaseConnectionName string) (result DatabaseConnection, err error) {
+	req, err := databaseConnectionPreparer(ctx, client, resourceGroupName, name, databaseConnectionName, http.MethodGet, nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetDatabaseConnection", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetDatabaseConnection", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetDatabaseConnection", resp, "Failure responding to request")
+	}
+	return
+}
+
+// DeleteDatabaseConnection removes a database connection from a Static Site
+func DeleteDatabaseConnection(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, databaseConnectionName string) (result autorest.Response, err error) {
+	req, err := databaseConnectionPreparer(ctx, client, resourceGroupName, name, databaseConnectionName, http.MethodDelete, nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "DeleteDatabaseConnection", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "DeleteDatabaseConnection", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.StaticSitesClient", "DeleteDatabaseConnection", resp, "Failure responding to request")
+	}
+	return
+}
+
+func staticSitePreparer(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, method string, body interface{}) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"name":              autorest.Encode("path", name),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	return preparerForMethod(method, body,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Web/staticSites/{name}", pathParameters),
+	).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func linkedBackendPreparer(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, linkedBackendName string, method string, body interface{}) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"linkedBackendName": autorest.Encode("path", linkedBackendName),
+		"name":              autorest.Encode("path", name),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	return preparerForMethod(method, body,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Web/staticSites/{name}/linkedBackends/{linkedBackendName}", pathParameters),
+	).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func databaseConnectionPreparer(ctx context.Context, client *web.StaticSitesClient, resourceGroupName string, name string, databaseConnectionName string, method string, body interface{}) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"databaseConnectionName": autorest.Encode("path", databaseConnectionName),
+		"name":                   autorest.Encode("path", name),
+		"resourceGroupName":      autorest.Encode("path", resourceGroupName),
+		"subscriptionId":         autorest.Encode("path", client.SubscriptionID),
+	}
+
+	return preparerForMethod(method, body,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Web/staticSites/{name}/databaseConnections/{databaseConnectionName}", pathParameters),
+	).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func preparerForMethod(method string, body interface{}, decorators ...autorest.PrepareDecorator) autorest.Preparer {
+	queryParameters := map[string]interface{}{
+		"api-version": staticSitesApiVersion,
+	}
+
+	base := []autorest.PrepareDecorator{
+		autorest.WithMethod(method),
+	}
+	if body != nil {
+		base = append(base, autorest.AsContentType("application/json; charset=utf-8"))
+	}
+	base = append(base, decorators...)
+	base = append(base, autorest.WithQueryParameters(queryParameters))
+	if body != nil {
+		base = append(base, autorest.WithJSON(body))
+	}
+
+	return autorest.CreatePreparer(base...)
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StaticSiteDatabaseConnectionId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	StaticSiteName         string
+	DatabaseConnectionName string
+}
+
+func NewStaticSiteDatabaseConnectionID(subscriptionId, resourceGroup, staticSiteName, databaseConnectionName string) StaticSiteDatabaseConnectionId {
+	return StaticSiteDatabaseConnectionId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		StaticSiteName:         staticSiteName,
+		DatabaseConnectionName: databaseConnectionName,
+	}
+}
+
+func (id StaticSiteDatabaseConnectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Database Connection Name %q", id.DatabaseConnectionName),
+		fmt.Sprintf("Static Site Name %q", id.StaticSiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Static Site Database Connection", segmentsStr)
+}
+
+func (id StaticSiteDatabaseConnectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/staticSites/%s/databaseConnections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StaticSiteName, id.DatabaseConnectionName)
+}
+
+// StaticSiteDatabaseConnectionID parses a StaticSiteDatabaseConnection ID into an StaticSiteDatabaseConnectionId struct
+func StaticSiteDatabaseConnectionID(input string) (*StaticSiteDatabaseConnectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StaticSiteDatabaseConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StaticSiteName, err = id.PopSegment("staticSites"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseConnectionName, err = id.PopSegment("databaseConnections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StaticSiteLinkedBackendId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	StaticSiteName    string
+	LinkedBackendName string
+}
+
+func NewStaticSiteLinkedBackendID(subscriptionId, resourceGroup, staticSiteName, linkedBackendName string) StaticSiteLinkedBackendId {
+	return StaticSiteLinkedBackendId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		StaticSiteName:    staticSiteName,
+		LinkedBackendName: linkedBackendName,
+	}
+}
+
+func (id StaticSiteLinkedBackendId) String() string {
+	segments := []string{
+		fmt.Sprintf("Linked Backend Name %q", id.LinkedBackendName),
+		fmt.Sprintf("Static Site Name %q", id.StaticSiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Static Site Linked Backend", segmentsStr)
+}
+
+func (id StaticSiteLinkedBackendId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/staticSites/%s/linkedBackends/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StaticSiteName, id.LinkedBackendName)
+}
+
+// StaticSiteLinkedBackendID parses a StaticSiteLinkedBackend ID into an StaticSiteLinkedBackendId struct
+func StaticSiteLinkedBackendID(input string) (*StaticSiteLinkedBackendId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StaticSiteLinkedBackendId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StaticSiteName, err = id.PopSegment("staticSites"); err != nil {
+		return nil, err
+	}
+	if resourceId.LinkedBackendName, err = id.PopSegment("linkedBackends"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -55,6 +55,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_function_app_slot":                                 resourceFunctionAppSlot(),
 		"azurerm_static_site":                                       resourceStaticSite(),
 		"azurerm_static_site_custom_domain":                         resourceStaticSiteCustomDomain(),
+		"azurerm_static_site_database_connection":                   resourceStaticSiteDatabaseConnection(),
+		"azurerm_static_site_linked_backend":                        resourceStaticSiteLinkedBackend(),
 	}
 }
 
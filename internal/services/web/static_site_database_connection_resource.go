@@ -0,0 +1,186 @@
+package web
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStaticSiteDatabaseConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStaticSiteDatabaseConnectionCreateOrUpdate,
+		Read:   resourceStaticSiteDatabaseConnectionRead,
+		Update: resourceStaticSiteDatabaseConnectionCreateOrUpdate,
+		Delete: resourceStaticSiteDatabaseConnectionDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StaticSiteDatabaseConnectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"static_site_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StaticSiteID,
+			},
+
+			"database_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"database_location": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			// either a connection string, or the identity the Static Site should use to
+			// connect (e.g. `SystemAssigned`), must be specified
+			"connection_identity": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"connection_identity", "connection_string"},
+			},
+
+			"connection_string": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"connection_identity", "connection_string"},
+			},
+		},
+	}
+}
+
+func resourceStaticSiteDatabaseConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Static Site database connection creation.")
+
+	staticSiteId, err := parse.StaticSiteID(d.Get("static_site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the service only supports a single database connection per Static Site, which must be named `default`
+	id := parse.NewStaticSiteDatabaseConnectionID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, "default")
+
+	if d.IsNewResource() {
+		existing, err := azuresdkhacks.GetDatabaseConnection(ctx, client, id.ResourceGroup, id.StaticSiteName, id.DatabaseConnectionName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_static_site_database_connection", id.ID())
+		}
+	}
+
+	connectionEnvelope := azuresdkhacks.DatabaseConnection{
+		Properties: &azuresdkhacks.DatabaseConnectionProperties{
+			ResourceID: utils.String(d.Get("database_resource_id").(string)),
+			Region:     utils.String(location.Normalize(d.Get("database_location").(string))),
+		},
+	}
+
+	if v := d.Get("connection_identity").(string); v != "" {
+		connectionEnvelope.Properties.ConnectionIdentity = utils.String(v)
+	}
+
+	if v := d.Get("connection_string").(string); v != "" {
+		connectionEnvelope.Properties.ConnectionString = utils.String(v)
+	}
+
+	if _, err := azuresdkhacks.CreateOrUpdateDatabaseConnection(ctx, client, id.ResourceGroup, id.StaticSiteName, id.DatabaseConnectionName, connectionEnvelope); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStaticSiteDatabaseConnectionRead(d, meta)
+}
+
+func resourceStaticSiteDatabaseConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteDatabaseConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := azuresdkhacks.GetDatabaseConnection(ctx, client, id.ResourceGroup, id.StaticSiteName, id.DatabaseConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("static_site_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
+
+	if props := resp.Properties; props != nil {
+		d.Set("database_resource_id", utils.NormalizeNilableString(props.ResourceID))
+		d.Set("database_location", location.NormalizeNilable(props.Region))
+		d.Set("connection_identity", utils.NormalizeNilableString(props.ConnectionIdentity))
+
+		// the connection string is not returned by the API, so the configured value is preserved
+		if props.ConnectionString != nil {
+			d.Set("connection_string", *props.ConnectionString)
+		}
+	}
+
+	return nil
+}
+
+func resourceStaticSiteDatabaseConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteDatabaseConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Static Site Database Connection %q (resource group %q)", id.DatabaseConnectionName, id.ResourceGroup)
+
+	if _, err := azuresdkhacks.DeleteDatabaseConnection(ctx, client, id.ResourceGroup, id.StaticSiteName, id.DatabaseConnectionName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
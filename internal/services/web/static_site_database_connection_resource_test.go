@@ -0,0 +1,132 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StaticSiteDatabaseConnectionResource struct{}
+
+func TestAccAzureStaticSiteDatabaseConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_site_database_connection", "test")
+	r := StaticSiteDatabaseConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func TestAccAzureStaticSiteDatabaseConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_site_database_connection", "test")
+	r := StaticSiteDatabaseConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StaticSiteDatabaseConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StaticSiteDatabaseConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := azuresdkhacks.GetDatabaseConnection(ctx, clients.Web.StaticSitesClient, id.ResourceGroup, id.StaticSiteName, id.DatabaseConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StaticSiteDatabaseConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_static_site_database_connection" "test" {
+  static_site_id       = azurerm_static_site.test.id
+  database_resource_id = azurerm_cosmosdb_account.test.id
+  database_location    = azurerm_cosmosdb_account.test.location
+  connection_string    = azurerm_cosmosdb_account.test.connection_strings[0]
+}
+`, r.template(data))
+}
+
+func (r StaticSiteDatabaseConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_static_site_database_connection" "import" {
+  static_site_id       = azurerm_static_site_database_connection.test.static_site_id
+  database_resource_id = azurerm_static_site_database_connection.test.database_resource_id
+  database_location    = azurerm_static_site_database_connection.test.database_location
+  connection_string    = azurerm_cosmosdb_account.test.connection_strings[0]
+}
+`, r.basic(data))
+}
+
+func (r StaticSiteDatabaseConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-cosmos-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "GlobalDocumentDB"
+
+  capabilities {
+    name = "EnableServerless"
+  }
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+}
+
+resource "azurerm_static_site" "test" {
+  name                = "acctestSS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_size            = "Standard"
+  sku_tier            = "Standard"
+}
+`, data.RandomInteger, data.Locations.Secondary)
+}
@@ -0,0 +1,158 @@
+package web
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStaticSiteLinkedBackend() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStaticSiteLinkedBackendCreate,
+		Read:   resourceStaticSiteLinkedBackendRead,
+		Delete: resourceStaticSiteLinkedBackendDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StaticSiteLinkedBackendID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"static_site_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StaticSiteID,
+			},
+
+			// a Function App, App Service or Container App
+			"backend_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"backend_location": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceStaticSiteLinkedBackendCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Static Site linked backend creation.")
+
+	staticSiteId, err := parse.StaticSiteID(d.Get("static_site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	backendResourceId := d.Get("backend_resource_id").(string)
+
+	// the name of the linked backend is the name of the backend resource itself
+	segments := strings.Split(strings.TrimSuffix(backendResourceId, "/"), "/")
+	backendName := segments[len(segments)-1]
+
+	id := parse.NewStaticSiteLinkedBackendID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, backendName)
+
+	existing, err := azuresdkhacks.GetLinkedBackend(ctx, client, id.ResourceGroup, id.StaticSiteName, id.LinkedBackendName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_static_site_linked_backend", id.ID())
+	}
+
+	backendEnvelope := azuresdkhacks.StaticSiteLinkedBackendARMResource{
+		Properties: &azuresdkhacks.StaticSiteLinkedBackend{
+			BackendResourceID: utils.String(backendResourceId),
+			Region:            utils.String(location.Normalize(d.Get("backend_location").(string))),
+		},
+	}
+
+	if err := azuresdkhacks.LinkBackend(ctx, client, id.ResourceGroup, id.StaticSiteName, id.LinkedBackendName, backendEnvelope); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStaticSiteLinkedBackendRead(d, meta)
+}
+
+func resourceStaticSiteLinkedBackendRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteLinkedBackendID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := azuresdkhacks.GetLinkedBackend(ctx, client, id.ResourceGroup, id.StaticSiteName, id.LinkedBackendName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("static_site_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
+
+	if props := resp.Properties; props != nil {
+		d.Set("backend_resource_id", utils.NormalizeNilableString(props.BackendResourceID))
+		d.Set("backend_location", location.NormalizeNilable(props.Region))
+	}
+
+	return nil
+}
+
+func resourceStaticSiteLinkedBackendDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteLinkedBackendID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Unlinking backend %q from Static Site %q (resource group %q)", id.LinkedBackendName, id.StaticSiteName, id.ResourceGroup)
+
+	if _, err := azuresdkhacks.UnlinkBackend(ctx, client, id.ResourceGroup, id.StaticSiteName, id.LinkedBackendName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
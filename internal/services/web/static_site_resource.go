@@ -3,6 +3,7 @@ package web
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -71,6 +73,12 @@ func resourceStaticSite() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"enterprise_grade_cdn_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"default_host_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -126,18 +134,28 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("a Managed Identity cannot be used when tier is set to `Free`")
 	}
 
-	siteEnvelope := web.StaticSiteARMResource{
+	enterpriseGradeCdnStatus := "Disabled"
+	if d.Get("enterprise_grade_cdn_enabled").(bool) {
+		if skuName == string(web.SkuNameFree) {
+			return fmt.Errorf("the Enterprise Grade CDN cannot be used when tier is set to `Free`")
+		}
+		enterpriseGradeCdnStatus = "Enabled"
+	}
+
+	siteEnvelope := azuresdkhacks.StaticSiteARMResource{
 		Sku: &web.SkuDescription{
 			Name: &skuName,
 			Tier: utils.String(d.Get("sku_tier").(string)),
 		},
-		StaticSite: &web.StaticSite{},
-		Location:   &loc,
-		Identity:   identity,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Properties: &azuresdkhacks.StaticSite{
+			EnterpriseGradeCdnStatus: utils.String(enterpriseGradeCdnStatus),
+		},
+		Location: &loc,
+		Identity: identity,
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	future, err := client.CreateOrUpdateStaticSite(ctx, id.ResourceGroup, id.Name, siteEnvelope)
+	future, err := azuresdkhacks.CreateOrUpdateStaticSite(ctx, client, id.ResourceGroup, id.Name, siteEnvelope)
 	if err != nil {
 		return fmt.Errorf("failed creating %s: %+v", id, err)
 	}
@@ -202,6 +220,17 @@ func resourceStaticSiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("sku_size", skuName)
 	d.Set("sku_tier", skuTier)
 
+	// the enterprise grade CDN status is only surfaced by newer versions of the API
+	hackResp, err := azuresdkhacks.GetStaticSite(ctx, client, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving enterprise grade CDN status for %s: %+v", *id, err)
+	}
+	enterpriseGradeCdnEnabled := false
+	if props := hackResp.Properties; props != nil && props.EnterpriseGradeCdnStatus != nil {
+		enterpriseGradeCdnEnabled = strings.EqualFold(*props.EnterpriseGradeCdnStatus, "Enabled")
+	}
+	d.Set("enterprise_grade_cdn_enabled", enterpriseGradeCdnEnabled)
+
 	secretResp, err := client.ListStaticSiteSecrets(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("listing secretes for %s: %v", id, err)